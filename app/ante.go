@@ -70,6 +70,8 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
 		// Custom Archway minimum fee checker
 		rewardsAnte.NewMinFeeDecorator(options.RewardsKeeper),
+		// Custom Archway mempool priority estimation (CheckTx only)
+		rewardsAnte.NewTxPriorityDecorator(options.RewardsKeeper),
 		// Custom Archway interceptor to track new transactions
 		trackingAnte.NewTxGasTrackingDecorator(options.TrackingKeeper),
 		// Custom Archway fee deduction, which splits fees between x/rewards and x/auth fee collector
@@ -100,10 +100,12 @@ import (
 
 	"github.com/archway-network/archway/wasmbinding"
 	"github.com/archway-network/archway/x/rewards"
+	rewardsClient "github.com/archway-network/archway/x/rewards/client"
 	rewardsKeeper "github.com/archway-network/archway/x/rewards/keeper"
 	"github.com/archway-network/archway/x/rewards/mintbankkeeper"
 	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
 	"github.com/archway-network/archway/x/tracking"
+	trackingClient "github.com/archway-network/archway/x/tracking/client"
 	trackingKeeper "github.com/archway-network/archway/x/tracking/keeper"
 	trackingTypes "github.com/archway-network/archway/x/tracking/types"
 
@@ -191,6 +193,10 @@ var (
 				upgradeclient.CancelProposalHandler,
 				ibcclientclient.UpdateClientProposalHandler,
 				ibcclientclient.UpgradeProposalHandler,
+				rewardsClient.TreasurySpendProposalHandler,
+				rewardsClient.TreasuryBurnProposalHandler,
+				rewardsClient.SetContractMetadataProposalHandler,
+				trackingClient.SetGasAdjustmentProposalHandler,
 			)...,
 		),
 		params.AppModuleBasic{},
@@ -220,6 +226,7 @@ var (
 		ibctransfertypes.ModuleName:          {authtypes.Minter, authtypes.Burner},
 		wasm.ModuleName:                      {authtypes.Burner},
 		rewardsTypes.TreasuryCollector:       {authtypes.Burner},
+		rewardsTypes.SponsorshipCollector:    nil,
 	}
 )
 
@@ -234,6 +241,7 @@ type ArchwayApp struct {
 	legacyAmino       *codec.LegacyAmino //nolint:staticcheck
 	appCodec          codec.Codec
 	interfaceRegistry types.InterfaceRegistry
+	txDecoder         sdk.TxDecoder
 
 	invCheckPeriod uint
 
@@ -275,6 +283,9 @@ type ArchwayApp struct {
 
 	// module configurator
 	configurator module.Configurator
+
+	// deliverHeader is the current block header set by the BeginBlocker (used by the DeliverTx extension)
+	deliverHeader tmproto.Header
 }
 
 // NewArchwayApp returns a reference to an initialized ArchwayApp.
@@ -308,7 +319,7 @@ func NewArchwayApp(
 		feegrant.StoreKey, authzkeeper.StoreKey, wasm.StoreKey,
 		trackingTypes.StoreKey, rewardsTypes.StoreKey,
 	)
-	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramstypes.TStoreKey, trackingTypes.TStoreKey, rewardsTypes.TStoreKey)
 	memKeys := sdk.NewMemoryStoreKeys(capabilitytypes.MemStoreKey)
 
 	app := &ArchwayApp{
@@ -316,6 +327,7 @@ func NewArchwayApp(
 		legacyAmino:       legacyAmino,
 		appCodec:          appCodec,
 		interfaceRegistry: interfaceRegistry,
+		txDecoder:         encodingConfig.TxConfig.TxDecoder(),
 		invCheckPeriod:    invCheckPeriod,
 		keys:              keys,
 		tkeys:             tkeys,
@@ -461,6 +473,7 @@ func NewArchwayApp(
 	app.TrackingKeeper = trackingKeeper.NewKeeper(
 		appCodec,
 		keys[trackingTypes.StoreKey],
+		tkeys[trackingTypes.TStoreKey],
 		defaultGasRegister,
 	)
 
@@ -505,18 +518,24 @@ func NewArchwayApp(
 		wasmOpts...,
 	)
 
-	// Setting gas recorder here to avoid cyclic loop
+	// Setting gas recorder here to avoid cyclic loop (contract info viewer must be set before the keeper is copied)
+	app.TrackingKeeper.SetContractInfoViewer(app.WASMKeeper)
 	trackingWasmVm.SetGasRecorder(app.TrackingKeeper)
+	govRouter.AddRoute(trackingTypes.RouterKey, tracking.NewProposalHandler(app.TrackingKeeper))
 
 	app.RewardsKeeper = rewardsKeeper.NewKeeper(
 		appCodec,
 		keys[rewardsTypes.StoreKey],
+		tkeys[rewardsTypes.TStoreKey],
 		app.WASMKeeper,
 		app.TrackingKeeper,
 		app.AccountKeeper,
 		app.BankKeeper,
+		app.TransferKeeper,
+		app.StakingKeeper,
 		app.getSubspace(rewardsTypes.ModuleName),
 	)
+	govRouter.AddRoute(rewardsTypes.RouterKey, rewards.NewProposalHandler(app.RewardsKeeper))
 
 	// Note we set up mint keeper after the x/rewards keeper
 	app.MintKeeper = mintkeeper.NewKeeper(
@@ -557,7 +576,7 @@ func NewArchwayApp(
 		genutil.NewAppModule(
 			app.AccountKeeper,
 			app.StakingKeeper,
-			app.BaseApp.DeliverTx,
+			app.DeliverTx,
 			encodingConfig.TxConfig,
 		),
 		auth.NewAppModule(appCodec, app.AccountKeeper, nil),
@@ -762,6 +781,8 @@ func (app *ArchwayApp) Name() string { return app.BaseApp.Name() }
 
 // BeginBlocker processes application updates every begin block
 func (app *ArchwayApp) BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock) abci.ResponseBeginBlock {
+	app.deliverHeader = ctx.BlockHeader()
+
 	return app.mm.BeginBlock(ctx, req)
 }
 
@@ -770,6 +791,42 @@ func (app *ArchwayApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) abc
 	return app.mm.EndBlock(ctx, req)
 }
 
+// DeliverTx extends the BaseApp DeliverTx tracking the transaction gas usage (refer to the x/tracking TrackTxGasUsage).
+// Gas used is only known once the transaction is executed (the out of gas case included), so it can't be tracked
+// by the AnteHandler or a message handler, and SDK v0.45 has no post-handler to do that within the tx.
+// The EndBlocker can't get the value either, since the tx gas meter is gone by then.
+// The context is built on top of the BaseApp deliver state using the current block header (set by the BeginBlocker).
+func (app *ArchwayApp) DeliverTx(req abci.RequestDeliverTx) abci.ResponseDeliverTx {
+	res := app.BaseApp.DeliverTx(req)
+
+	ctx := app.BaseApp.NewContext(false, app.deliverHeader)
+	app.TrackingKeeper.TrackTxGasUsage(ctx, uint64(res.GasWanted), uint64(res.GasUsed))
+
+	return res
+}
+
+// CheckTx extends the BaseApp CheckTx setting the transaction mempool priority estimated by the AnteHandler
+// (refer to the x/rewards TxPriorityDecorator).
+// SDK v0.45 Context has no priority field (no ctx.WithPriority) and the BaseApp doesn't set it,
+// so the value is passed via the x/rewards transient store:
+//   - the AnteHandler writes the value to the check state only if it succeeds (a failed AnteHandler cache is discarded);
+//   - the value is read from the check state and removed right after the BaseApp CheckTx, so it never outlives the call;
+//   - CheckTx calls are serialized by Tendermint (the mempool lock), so the value can't be overwritten in between;
+//   - the context header is not used (only the transient store is accessed), so an empty one is passed.
+//
+// Priority is only used by the Tendermint priority mempool (mempool.version = "v1").
+func (app *ArchwayApp) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
+	res := app.BaseApp.CheckTx(req)
+
+	ctx := app.BaseApp.NewContext(true, tmproto.Header{})
+	priority := app.RewardsKeeper.PopCheckTxPriority(ctx)
+	if res.IsOK() {
+		res.Priority = priority
+	}
+
+	return res
+}
+
 // InitChainer application update at chain initialization
 func (app *ArchwayApp) InitChainer(ctx sdk.Context, req abci.RequestInitChain) abci.ResponseInitChain {
 	var genesisState GenesisState
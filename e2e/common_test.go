@@ -5,9 +5,11 @@ import (
 
 	cwTypes "github.com/CosmWasm/cosmwasm-go/std/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	mintTypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/stretchr/testify/suite"
 
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
 )
 
 var (
@@ -39,6 +41,34 @@ func (s *E2ETestSuite) CosmWasmCoinsToSDK(cwCoins ...cwTypes.Coin) sdk.Coins {
 	return coins
 }
 
+// GetDelegatedTokens returns the amount of tokens delegated by the delegator to the validator.
+func (s *E2ETestSuite) GetDelegatedTokens(chain *e2eTesting.TestChain, delAddr sdk.AccAddress, valAddr sdk.ValAddress) sdk.Int {
+	ctx, stakingKeeper := chain.GetContext(), chain.GetApp().StakingKeeper
+
+	delegation, found := stakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	if !found {
+		return sdk.ZeroInt()
+	}
+
+	validator, found := stakingKeeper.GetValidator(ctx, valAddr)
+	s.Require().True(found)
+
+	return validator.TokensFromShares(delegation.Shares).TruncateInt()
+}
+
+// CreateRewardsRecords mints rewards to the rewards pool and creates a number of separate rewards records for the rewardsAddr.
+// Distribution merges rewards into the latest rewardsAddr record, so that is the way to get multiple records per address.
+func (s *E2ETestSuite) CreateRewardsRecords(chain *e2eTesting.TestChain, rewardsAddr sdk.AccAddress, rewards sdk.Coins, num int) {
+	ctx, app := chain.GetContext(), chain.GetApp()
+
+	for i := 0; i < num; i++ {
+		s.Require().NoError(app.MintKeeper.MintCoins(ctx, rewards))
+		s.Require().NoError(app.BankKeeper.SendCoinsFromModuleToModule(ctx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, rewards))
+
+		app.RewardsKeeper.GetState().RewardsRecord(ctx).CreateRewardsRecord(rewardsAddr, rewards, ctx.BlockHeight(), ctx.BlockTime())
+	}
+}
+
 func TestE2E(t *testing.T) {
 	suite.Run(t, new(E2ETestSuite))
 }
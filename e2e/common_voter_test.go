@@ -1,10 +1,7 @@
 package e2e
 
 import (
-	"encoding/hex"
 	"encoding/json"
-	"strconv"
-	"strings"
 	"time"
 
 	cwMath "github.com/CosmWasm/cosmwasm-go/std/math"
@@ -156,50 +153,7 @@ func (s *E2ETestSuite) VoterIBCVote(chain *e2eTesting.TestChain, contractAddr sd
 
 	_, res, _, _ := chain.SendMsgs(acc, true, []sdk.Msg{&msg})
 
-	// Assemble the IBC packet from the response
-	var packet channelTypes.Packet
-
-	pSeqRaw := e2eTesting.GetStringEventAttribute(res.Events, channelTypes.EventTypeSendPacket, channelTypes.AttributeKeySequence)
-	s.Require().NotEmpty(pSeqRaw)
-	packet.Sequence, err = strconv.ParseUint(pSeqRaw, 10, 64)
-	s.Require().NoError(err)
-
-	pSrcPort := e2eTesting.GetStringEventAttribute(res.Events, channelTypes.EventTypeSendPacket, channelTypes.AttributeKeySrcPort)
-	s.Require().NotEmpty(pSrcPort)
-	packet.SourcePort = pSrcPort
-
-	pSrcChannel := e2eTesting.GetStringEventAttribute(res.Events, channelTypes.EventTypeSendPacket, channelTypes.AttributeKeySrcChannel)
-	s.Require().NotEmpty(pSrcChannel)
-	packet.SourceChannel = pSrcChannel
-
-	pDstPort := e2eTesting.GetStringEventAttribute(res.Events, channelTypes.EventTypeSendPacket, channelTypes.AttributeKeyDstPort)
-	s.Require().NotEmpty(pDstPort)
-	packet.DestinationPort = pDstPort
-
-	pDstChannel := e2eTesting.GetStringEventAttribute(res.Events, channelTypes.EventTypeSendPacket, channelTypes.AttributeKeyDstChannel)
-	s.Require().NotEmpty(pDstChannel)
-	packet.DestinationChannel = pDstChannel
-
-	pData := e2eTesting.GetStringEventAttribute(res.Events, channelTypes.EventTypeSendPacket, channelTypes.AttributeKeyDataHex)
-	s.Require().NotEmpty(pData)
-	packet.Data, err = hex.DecodeString(pData)
-	s.Require().NoError(err)
-
-	pTimeoutHeightRaw := e2eTesting.GetStringEventAttribute(res.Events, channelTypes.EventTypeSendPacket, channelTypes.AttributeKeyTimeoutHeight)
-	s.Require().NotEmpty(pTimeoutHeightRaw)
-	pTimeoutHeightSplit := strings.Split(pTimeoutHeightRaw, "-")
-	s.Require().Len(pTimeoutHeightSplit, 2)
-	packet.TimeoutHeight.RevisionNumber, err = strconv.ParseUint(pTimeoutHeightSplit[0], 10, 64)
-	s.Require().NoError(err)
-	packet.TimeoutHeight.RevisionHeight, err = strconv.ParseUint(pTimeoutHeightSplit[1], 10, 64)
-	s.Require().NoError(err)
-
-	pTimeoutTSRaw := e2eTesting.GetStringEventAttribute(res.Events, channelTypes.EventTypeSendPacket, channelTypes.AttributeKeyTimeoutTimestamp)
-	s.Require().NotEmpty(pTimeoutTSRaw)
-	packet.TimeoutTimestamp, err = strconv.ParseUint(pTimeoutTSRaw, 10, 64)
-	s.Require().NoError(err)
-
-	return packet
+	return chain.ParseSendPacketEvent(res.Events)
 }
 
 // VoterRelease releases contract funds to the owner.
@@ -14,6 +14,7 @@ import (
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
 	"github.com/archway-network/archway/pkg"
 	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+	trackingKeeper "github.com/archway-network/archway/x/tracking/keeper"
 	trackingTypes "github.com/archway-network/archway/x/tracking/types"
 )
 
@@ -60,12 +61,14 @@ func (s *E2ETestSuite) TestGasTrackingAndRewardsDistribution() {
 	var abciEvents []abci.Event        // all ABCI events from Tx execution, BeginBlocker and EndBlockers
 	var txID uint64                    // tracked Tx ID
 	var txGasUsed, txGasTracked uint64 // tx gas tracking
+	var contractGasTracked uint64      // contract operations gas tracking
 
 	// Expected values (set below)
 	contractMetadataExpected := rewardsTypes.ContractMetadata{
-		ContractAddress: contractAddr.String(),
-		OwnerAddress:    senderAcc.Address.String(),
-		RewardsAddress:  rewardsAcc.Address.String(),
+		ContractAddress:   contractAddr.String(),
+		OwnerAddress:      senderAcc.Address.String(),
+		RewardsAddress:    rewardsAcc.Address.String(),
+		RewardsRecipients: []rewardsTypes.RewardsRecipient{}, // event JSON encodes an empty list
 	}
 	var contractTxRewardsExpected sdk.Coins       // contract tx fee rebate rewards expected
 	var contractInflationRewardsExpected sdk.Coin // contract inflation rewards expected
@@ -74,7 +77,7 @@ func (s *E2ETestSuite) TestGasTrackingAndRewardsDistribution() {
 
 	// Set metadata and fetch ABCI events
 	{
-		msg := rewardsTypes.NewMsgSetContractMetadata(senderAcc.Address, contractAddr, &senderAcc.Address, &rewardsAcc.Address)
+		msg := rewardsTypes.NewMsgSetContractMetadata(senderAcc.Address, contractAddr, &senderAcc.Address, &rewardsAcc.Address, nil)
 		_, _, events, _ := chain.SendMsgs(senderAcc, true, []sdk.Msg{msg})
 
 		abciEvents = append(abciEvents, events...)
@@ -180,22 +183,29 @@ func (s *E2ETestSuite) TestGasTrackingAndRewardsDistribution() {
 		s.Assert().NotEmpty(contractOps[0].VmGas)
 		s.Assert().NotEmpty(contractOps[0].SdkGas)
 
-		contractGasTracked := contractOps[0].VmGas + contractOps[0].SdkGas
+		contractGasTracked = contractOps[0].VmGas + contractOps[0].SdkGas
 
-		// Assert gas consumptions
-		s.Assert().Equal(txGasTracked, contractGasTracked)
-		s.Assert().LessOrEqual(txGasTracked, txGasUsed)
+		// Assert gas consumptions (tx gas tracked includes the non-contract gas)
+		s.Assert().NotEmpty(txInfos[0].NonContractGas)
+		s.Assert().Equal(contractGasTracked, txInfos[0].ContractGas())
+		s.Assert().Equal(txGasUsed, txGasTracked)
 	})
 
 	// Estimate contract rewards
 	// This should be done after the actual Tx to get gas tracking data
 	{
-		// Contract fee rewards
+		// Contract fee rewards (contract share of the tx gas used)
 		txFeeRewards, _ := pkg.SplitCoins(txFees, txFeeRebateRewardsRatio)
-		contractTxRewardsExpected = txFeeRewards
+		contractToTxGasRatio := sdk.NewDec(int64(contractGasTracked)).Quo(sdk.NewDec(int64(txGasTracked)))
+		for _, coin := range txFeeRewards {
+			contractTxRewardsExpected = contractTxRewardsExpected.Add(sdk.NewCoin(
+				coin.Denom,
+				coin.Amount.ToDec().Mul(contractToTxGasRatio).TruncateInt(),
+			))
+		}
 
 		// Contract inflation rewards
-		contractToBlockGasRatio := sdk.NewDec(int64(txGasTracked)).Quo(sdk.NewDec(blockGasLimit))
+		contractToBlockGasRatio := sdk.NewDec(int64(contractGasTracked)).Quo(sdk.NewDec(blockGasLimit))
 		contractInflationRewardsExpected = sdk.Coin{
 			Denom:  blockInflationRewardsExpected.Denom,
 			Amount: blockInflationRewardsExpected.Amount.ToDec().Mul(contractToBlockGasRatio).TruncateInt(),
@@ -214,7 +224,8 @@ func (s *E2ETestSuite) TestGasTrackingAndRewardsDistribution() {
 		s.Require().Len(txRewards, 1)
 		s.Assert().Equal(txID, txRewards[0].TxId)
 		s.Assert().Equal(ctx.BlockHeight()-1, txRewards[0].Height)
-		s.Assert().Equal(contractTxRewardsExpected.String(), sdk.NewCoins(txRewards[0].FeeRewards...).String())
+		txFeeRewardsExpected, _ := pkg.SplitCoins(txFees, txFeeRebateRewardsRatio)
+		s.Assert().Equal(txFeeRewardsExpected.String(), sdk.NewCoins(txRewards[0].FeeRewards...).String())
 	})
 
 	// Check x/rewards Block record
@@ -265,7 +276,7 @@ func (s *E2ETestSuite) TestGasTrackingAndRewardsDistribution() {
 		s.Require().NoError(json.Unmarshal([]byte(eventMetadataBz), &metadataReceived))
 
 		s.Assert().Equal(contractAddr.String(), eventContractAddr)
-		s.Assert().Equal(txGasTracked, gasConsumedReceived)
+		s.Assert().Equal(contractGasTracked, gasConsumedReceived)
 		s.Assert().Equal(contractInflationRewardsExpected.String(), inflationRewardsReceived.String())
 		s.Assert().Equal(contractTxRewardsExpected.String(), feeRebateRewardsReceived.String())
 		s.Assert().Equal(contractMetadataExpected, metadataReceived)
@@ -298,3 +309,141 @@ func (s *E2ETestSuite) TestGasTrackingAndRewardsDistribution() {
 		s.Assert().Equal(contractTotalRewardsExpected.String(), accCoins.String())
 	})
 }
+
+// TestGasAdjustment tests the x/tracking governance managed contract gas adjustment using two identical contracts
+// (one of them is adjusted via a gov proposal):
+//   - checks the gas adjustments query;
+//   - checks that the original and the adjusted gas are tracked;
+//   - checks that the transaction gas used (fees required) is reduced;
+//   - checks that the adjusted contract rewards are reduced;
+func (s *E2ETestSuite) TestGasAdjustment() {
+	// Setup (create new chain here with custom params)
+	chain := e2eTesting.NewTestChain(s.T(), 1,
+		e2eTesting.WithTxFeeRebatesRewardsRatio(sdk.NewDecWithPrec(5, 1)),
+		e2eTesting.WithInflationRewardsRatio(sdk.NewDecWithPrec(5, 1)),
+		e2eTesting.WithBlockGasLimit(10_000_000),
+		// Artificially increase the minted inflation coin to get some rewards for the contract
+		e2eTesting.WithMintParams(
+			sdk.NewDecWithPrec(8, 1),
+			sdk.NewDecWithPrec(8, 1),
+			1000000,
+		),
+		// Set default Tx fee for non-manual transaction like Upload / Instantiate
+		e2eTesting.WithDefaultFeeAmount("10000"),
+	)
+	tKeeper, rewardsKeeper := chain.GetApp().TrackingKeeper, chain.GetApp().RewardsKeeper
+
+	senderAcc := chain.GetAccount(0)
+	adjustedContractAddr := s.VoterUploadAndInstantiate(chain, senderAcc)
+	contractAddr := s.VoterUploadAndInstantiate(chain, senderAcc)
+	rewardsAddrs := e2eTesting.GenContractAddresses(2)
+	adjustedRewardsAddr, rewardsAddr := rewardsAddrs[0], rewardsAddrs[1]
+
+	for i, addr := range []sdk.AccAddress{adjustedContractAddr, contractAddr} {
+		msg := rewardsTypes.NewMsgSetContractMetadata(senderAcc.Address, addr, &senderAcc.Address, &rewardsAddrs[i], nil)
+		_, _, _, err := chain.SendMsgs(senderAcc, true, []sdk.Msg{msg})
+		s.Require().NoError(err)
+	}
+
+	txFees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000)))
+	multiplier := sdk.NewDecWithPrec(5, 1)
+
+	// Set the contract adjustment via gov
+	chain.ExecuteGovProposal(senderAcc, true, trackingTypes.NewSetGasAdjustmentProposal("Title", "Description", trackingTypes.GasAdjustment{
+		ContractAddress: adjustedContractAddr.String(),
+		Multiplier:      multiplier,
+	}))
+
+	s.Run("Check gas adjustments query", func() {
+		res, err := trackingKeeper.NewQueryServer(tKeeper).GasAdjustments(sdk.WrapSDKContext(chain.GetContext()), &trackingTypes.QueryGasAdjustmentsRequest{})
+		s.Require().NoError(err)
+		s.Require().Len(res.Adjustments, 1)
+		s.Assert().Equal(adjustedContractAddr.String(), res.Adjustments[0].ContractAddress)
+		s.Assert().Equal(multiplier.String(), res.Adjustments[0].Multiplier.String())
+	})
+
+	// executeTx sends a contract Execute Tx (new voting) for every contract provided and returns the Tx gas used
+	// and the tracked contract operations
+	executeTx := func(contractAddrs ...sdk.AccAddress) (uint64, []trackingTypes.ContractOperationInfo) {
+		req := voterTypes.MsgExecute{
+			NewVoting: &voterTypes.NewVotingRequest{
+				Name:        "Test",
+				VoteOptions: []string{"Yes", "No"},
+				Duration:    uint64(time.Minute),
+			},
+		}
+		reqBz, err := req.MarshalJSON()
+		s.Require().NoError(err)
+
+		msgs := make([]sdk.Msg, 0, len(contractAddrs))
+		for _, addr := range contractAddrs {
+			msgs = append(msgs, &wasmdTypes.MsgExecuteContract{
+				Sender:   senderAcc.Address.String(),
+				Contract: addr.String(),
+				Msg:      reqBz,
+				Funds: sdk.NewCoins(sdk.Coin{
+					Denom:  sdk.DefaultBondDenom,
+					Amount: sdk.NewIntFromUint64(DefNewVotingCostAmt),
+				}),
+			})
+		}
+		gasInfo, _, _, err := chain.SendMsgs(senderAcc, true, msgs, e2eTesting.WithMsgFees(txFees...))
+		s.Require().NoError(err)
+
+		ctx := chain.GetContext()
+
+		txInfos := tKeeper.GetState().TxInfoState(ctx).GetTxInfosByBlock(ctx.BlockHeight() - 1)
+		s.Require().Len(txInfos, 1)
+		s.Assert().Equal(gasInfo.GasUsed, txInfos[0].TotalGas)
+
+		contractOps := tKeeper.GetState().ContractOpInfoState(ctx).GetContractOpInfoByTxID(txInfos[0].Id)
+		s.Require().Len(contractOps, len(contractAddrs))
+
+		return gasInfo.GasUsed, contractOps
+	}
+
+	// Both contracts have the same state before the execution
+	adjustedTxGasUsed, adjustedContractOps := executeTx(adjustedContractAddr)
+	txGasUsed, contractOps := executeTx(contractAddr)
+
+	s.Run("Check gas is adjusted", func() {
+		adjustedOp, op := adjustedContractOps[0], contractOps[0]
+
+		s.Assert().Equal(op.VmGas, op.OriginalVmGas)
+		s.Assert().Equal(op.SdkGas, op.OriginalSdkGas)
+
+		s.Assert().Equal(op.OriginalVmGas, adjustedOp.OriginalVmGas)
+		s.Assert().Equal(op.OriginalSdkGas, adjustedOp.OriginalSdkGas)
+		s.Assert().InDelta(multiplier.MulInt64(int64(op.VmGas)).TruncateInt64(), adjustedOp.VmGas, 1)
+		s.Assert().InDelta(multiplier.MulInt64(int64(op.SdkGas)).TruncateInt64(), adjustedOp.SdkGas, 1)
+	})
+
+	s.Run("Check tx gas used is reduced", func() {
+		// Tx gas used is reduced by the adjusted operation gas (non-contract gas slightly differs between txs, so the delta is used)
+		gasUsed, _ := contractOps[0].GasUsed()
+		adjustedGasUsed, _ := adjustedContractOps[0].GasUsed()
+
+		s.Assert().Less(adjustedTxGasUsed, txGasUsed)
+		s.Assert().InDelta(gasUsed-adjustedGasUsed, txGasUsed-adjustedTxGasUsed, 1000)
+	})
+
+	s.Run("Check contract rewards are reduced", func() {
+		// Both contracts are executed within the same Tx, rewards are distributed proportionally to the adjusted gas
+		// (new rewards might be merged into existing records, so balances diff is used)
+		getRewardsBalance := func(rewardsAddr sdk.AccAddress) sdk.Int {
+			return rewardsKeeper.GetState().RewardsRecord(chain.GetContext()).GetRewardsBalance(rewardsAddr).AmountOf(sdk.DefaultBondDenom)
+		}
+		adjustedRewardsBefore, rewardsBefore := getRewardsBalance(adjustedRewardsAddr), getRewardsBalance(rewardsAddr)
+
+		_, contractOps := executeTx(adjustedContractAddr, contractAddr)
+
+		adjustedGasUsed, _ := contractOps[0].GasUsed()
+		gasUsed, _ := contractOps[1].GasUsed()
+		s.Require().Less(adjustedGasUsed, gasUsed)
+
+		adjustedRewards := getRewardsBalance(adjustedRewardsAddr).Sub(adjustedRewardsBefore)
+		rewards := getRewardsBalance(rewardsAddr).Sub(rewardsBefore)
+		s.Require().True(rewards.IsPositive())
+		s.Assert().True(adjustedRewards.LT(rewards), "rewards: adjusted %s, not adjusted %s", adjustedRewards, rewards)
+	})
+}
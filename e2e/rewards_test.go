@@ -7,11 +7,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	mintTypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	ibcTransferTypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channelTypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 
 	voterCustomTypes "github.com/archway-network/voter/src/pkg/archway/custom"
 	voterTypes "github.com/archway-network/voter/src/types"
 
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/pkg"
 	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
 )
 
@@ -375,3 +378,226 @@ func (s *E2ETestSuite) TestRewardsRecordsQueryLimit() {
 	// Invariants extra check
 	chain.NextBlock(0)
 }
+
+// TestRewardsIBCWithdraw checks that rewards withdrawn with the IBC transfer option are delivered to a receiver on the counterparty chain.
+func (s *E2ETestSuite) TestRewardsIBCWithdraw() {
+	chainA, chainB := s.chainA, s.chainB
+
+	rewardsAcc, receiverAcc := chainA.GetAccount(0), chainB.GetAccount(0)
+	rewardsKeeper := chainA.GetApp().RewardsKeeper
+
+	// Create a transfer channel
+	ibcPath := e2eTesting.NewIBCPath(
+		s.T(),
+		chainA, chainB,
+		ibcTransferTypes.PortID, ibcTransferTypes.PortID,
+		ibcTransferTypes.Version, channelTypes.UNORDERED,
+	)
+	channelA, channelB := ibcPath.EndpointA().ChannelID(), ibcPath.EndpointB().ChannelID()
+
+	// Add mock rewards records for the account and mint tokens to pass invariant checks
+	recordRewards := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+	{
+		ctx := chainA.GetContext()
+		recordsState := rewardsKeeper.GetState().RewardsRecord(ctx)
+
+		recordsState.CreateRewardsRecord(rewardsAcc.Address, recordRewards, ctx.BlockHeight(), ctx.BlockTime())
+
+		s.Require().NoError(chainA.GetApp().MintKeeper.MintCoins(ctx, recordRewards))
+		s.Require().NoError(chainA.GetApp().BankKeeper.SendCoinsFromModuleToModule(ctx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, recordRewards))
+	}
+
+	// Withdraw rewards to the chainB receiver
+	msg := rewardsTypes.NewMsgWithdrawRewardsByLimit(rewardsAcc.Address, 1)
+	msg.IbcTransfer = rewardsTypes.NewMsgWithdrawRewardsIBCTransfer(
+		channelA,
+		receiverAcc.Address.String(),
+		uint64(chainA.GetBlockTime().Add(time.Hour).UnixNano()),
+	)
+
+	_, res, _, _ := chainA.SendMsgs(rewardsAcc, true, []sdk.Msg{msg})
+
+	eventChannel := e2eTesting.GetStringEventAttribute(res.Events, "archway.rewards.v1beta1.RewardsIBCWithdrawEvent", "source_channel")
+	s.Assert().Equal(channelA, eventChannel)
+
+	records, _, err := rewardsKeeper.GetRewardsRecords(chainA.GetContext(), rewardsAcc.Address, nil)
+	s.Require().NoError(err)
+	s.Assert().Empty(records)
+
+	// Relay the transfer packet and check the receiver got vouchers
+	packet := chainA.ParseSendPacketEvent(res.Events)
+	ibcPath.RelayPacket(packet, channelTypes.NewResultAcknowledgement([]byte{byte(1)}).Acknowledgement())
+
+	voucherDenom := ibcTransferTypes.ParseDenomTrace(
+		ibcTransferTypes.GetPrefixedDenom(ibcTransferTypes.PortID, channelB, sdk.DefaultBondDenom),
+	).IBCDenom()
+	s.Assert().Equal(recordRewards.AmountOf(sdk.DefaultBondDenom), chainB.GetBalance(receiverAcc.Address).AmountOf(voucherDenom))
+}
+
+// TestRewardsWithdrawAndDelegate checks that the staking denom part of withdrawn rewards is delegated and other denoms are kept by the rewards address.
+func (s *E2ETestSuite) TestRewardsWithdrawAndDelegate() {
+	chain := s.chainA
+
+	rewardsAcc := chain.GetAccount(0)
+	bankKeeper, mintKeeper, rewardsKeeper, stakingKeeper := chain.GetApp().BankKeeper, chain.GetApp().MintKeeper, chain.GetApp().RewardsKeeper, chain.GetApp().StakingKeeper
+
+	valAddr := stakingKeeper.GetAllValidators(chain.GetContext())[0].GetOperator()
+
+	// Add mock rewards records for the account and mint tokens to pass invariant checks
+	stakingRewards := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))
+	otherRewards := sdk.NewCoin("uother", sdk.NewInt(500))
+	recordRewards := sdk.NewCoins(stakingRewards, otherRewards)
+	{
+		ctx := chain.GetContext()
+		recordsState := rewardsKeeper.GetState().RewardsRecord(ctx)
+
+		recordsState.CreateRewardsRecord(rewardsAcc.Address, recordRewards, ctx.BlockHeight(), ctx.BlockTime())
+
+		s.Require().NoError(mintKeeper.MintCoins(ctx, recordRewards))
+		s.Require().NoError(bankKeeper.SendCoinsFromModuleToModule(ctx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, recordRewards))
+	}
+
+	balanceBefore := chain.GetBalance(rewardsAcc.Address)
+	delegatedBefore := s.GetDelegatedTokens(chain, rewardsAcc.Address, valAddr)
+
+	// Withdraw and delegate
+	msg := rewardsTypes.NewMsgWithdrawRewardsAndDelegateByLimit(rewardsAcc.Address, valAddr, 0)
+	chain.SendMsgs(rewardsAcc, true, []sdk.Msg{msg})
+
+	// Check records pruned, non-staking rewards credited and staking rewards delegated
+	records, _, err := rewardsKeeper.GetRewardsRecords(chain.GetContext(), rewardsAcc.Address, nil)
+	s.Require().NoError(err)
+	s.Assert().Empty(records)
+
+	balanceAfter := chain.GetBalance(rewardsAcc.Address)
+	s.Assert().Equal(otherRewards.Amount, balanceAfter.AmountOf(otherRewards.Denom).Sub(balanceBefore.AmountOf(otherRewards.Denom)))
+
+	s.Assert().Equal(stakingRewards.Amount, s.GetDelegatedTokens(chain, rewardsAcc.Address, valAddr).Sub(delegatedBefore))
+}
+
+// TestRewardsWithdrawFeesFromRecords ensures that a rewards address without funds can pay the withdraw Tx fees from its rewards records
+// and that the unused gas refund is credited to the rewards address.
+func (s *E2ETestSuite) TestRewardsWithdrawFeesFromRecords() {
+	chain := e2eTesting.NewTestChain(s.T(), 1,
+		e2eTesting.WithGenAccounts(2),
+	)
+	rewardsAcc, otherAcc := chain.GetAccount(0), chain.GetAccount(1)
+	bankKeeper, mintKeeper, rewardsKeeper := chain.GetApp().BankKeeper, chain.GetApp().MintKeeper, chain.GetApp().RewardsKeeper
+
+	rewardsModuleAddr := chain.GetApp().AccountKeeper.GetModuleAddress(rewardsTypes.ContractRewardCollector)
+	refundRatio := sdk.NewDecWithPrec(5, 1)
+	fees := chain.GetDefaultTxFee()
+
+	// Enable refunds and move all the rewards address funds out
+	{
+		ctx := chain.GetContext()
+
+		params := rewardsKeeper.GetParams(ctx)
+		params.UnusedGasRefundRatio = refundRatio
+		rewardsKeeper.SetParams(ctx, params)
+
+		s.Require().NoError(bankKeeper.SendCoins(ctx, rewardsAcc.Address, otherAcc.Address, chain.GetBalance(rewardsAcc.Address)))
+	}
+
+	msg := rewardsTypes.NewMsgWithdrawRewardsByLimit(rewardsAcc.Address, 0)
+
+	s.Run("Fail: no rewards records to pay fees", func() {
+		_, _, _, err := chain.SendMsgs(rewardsAcc, false, []sdk.Msg{msg},
+			e2eTesting.WithFeeGranter(rewardsModuleAddr),
+		)
+		s.Assert().ErrorIs(err, rewardsTypes.ErrInsufficientRewards)
+	})
+
+	// Add a mock rewards record for the account and mint tokens to pass invariant checks
+	recordRewards := fees.Add(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)))
+	{
+		ctx := chain.GetContext()
+
+		rewardsKeeper.GetState().RewardsRecord(ctx).CreateRewardsRecord(rewardsAcc.Address, recordRewards, ctx.BlockHeight(), ctx.BlockTime())
+
+		s.Require().NoError(mintKeeper.MintCoins(ctx, recordRewards))
+		s.Require().NoError(bankKeeper.SendCoinsFromModuleToModule(ctx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, recordRewards))
+	}
+
+	s.Run("OK: fees are paid from rewards records", func() {
+		txHeight := chain.GetContext().BlockHeight()
+		chain.SendMsgs(rewardsAcc, true, []sdk.Msg{msg},
+			e2eTesting.WithFeeGranter(rewardsModuleAddr),
+		)
+
+		// Check records pruned
+		records, _, err := rewardsKeeper.GetRewardsRecords(chain.GetContext(), rewardsAcc.Address, nil)
+		s.Require().NoError(err)
+		s.Assert().Empty(records)
+
+		// Check the rewards address is tracked as the fee payer
+		txInfos := chain.GetApp().TrackingKeeper.GetState().TxInfoState(chain.GetContext()).GetTxInfosByBlock(txHeight)
+		s.Require().Len(txInfos, 1)
+		txInfo := txInfos[0]
+
+		s.Assert().Equal(rewardsAcc.Address.String(), txInfo.FeePayer)
+		s.Require().NotZero(txInfo.UnusedGas())
+
+		// Check the rewards address received rewards minus fees plus the refund (non-wasm tx fees are sent to the FeeCollector)
+		refundShare := pkg.NewDecFromUint64(txInfo.UnusedGas()).Quo(pkg.NewDecFromUint64(txInfo.GasLimit)).Mul(refundRatio)
+		refundExpected, _ := pkg.SplitCoins(fees, refundShare)
+		s.Require().False(refundExpected.IsZero())
+
+		s.Assert().Equal(recordRewards.Sub(fees).Add(refundExpected...).String(), chain.GetBalance(rewardsAcc.Address).String())
+	})
+}
+
+// TestRewardsCodeMetadataFallback ensures that contracts without metadata use the code metadata for the rewards distribution
+// and that the contract metadata overrides the code metadata.
+func (s *E2ETestSuite) TestRewardsCodeMetadataFallback() {
+	chain := s.chainA
+
+	creatorAcc, codeRewardsAcc, contractRewardsAcc := chain.GetAccount(0), chain.GetAccount(1), chain.GetAccount(2)
+	rewardsKeeper := chain.GetApp().RewardsKeeper
+
+	// Upload a new contract and create two instances
+	codeID := chain.UploadContract(creatorAcc, VoterWasmPath, wasmdTypes.DefaultUploadAccess)
+	instMsg := voterTypes.MsgInstantiate{
+		Params: s.VoterDefaultParams(creatorAcc),
+	}
+	contractAddr1, _ := chain.InstantiateContract(creatorAcc, codeID, creatorAcc.Address.String(), "voter", nil, instMsg)
+	contractAddr2, _ := chain.InstantiateContract(creatorAcc, codeID, creatorAcc.Address.String(), "voter", nil, instMsg)
+
+	// Set the code metadata
+	{
+		msg := rewardsTypes.NewMsgSetCodeMetadata(creatorAcc.Address, codeID, &codeRewardsAcc.Address, nil)
+		_, _, _, err := chain.SendMsgs(creatorAcc, true, []sdk.Msg{msg})
+		s.Require().NoError(err)
+	}
+
+	getRecordsNum := func(rewardsAddr sdk.AccAddress) int {
+		records, _, err := rewardsKeeper.GetRewardsRecords(chain.GetContext(), rewardsAddr, nil)
+		s.Require().NoError(err)
+		return len(records)
+	}
+
+	s.Run("Code metadata is used for contracts without metadata", func() {
+		codeRecordsBefore := getRecordsNum(codeRewardsAcc.Address)
+
+		s.VoterNewVoting(chain, contractAddr1, creatorAcc, "Test", []string{"a"}, time.Minute)
+		chain.NextBlock(0)
+
+		s.Assert().Greater(getRecordsNum(codeRewardsAcc.Address), codeRecordsBefore)
+	})
+
+	// Set the contract metadata for the 2nd contract
+	chain.SetContractMetadata(creatorAcc, contractAddr2, rewardsTypes.ContractMetadata{
+		RewardsAddress: contractRewardsAcc.Address.String(),
+	})
+
+	s.Run("Contract metadata overrides code metadata", func() {
+		codeRecordsBefore := getRecordsNum(codeRewardsAcc.Address)
+		contractRecordsBefore := getRecordsNum(contractRewardsAcc.Address)
+
+		s.VoterNewVoting(chain, contractAddr2, creatorAcc, "Test", []string{"a"}, time.Minute)
+		chain.NextBlock(0)
+
+		s.Assert().Equal(codeRecordsBefore, getRecordsNum(codeRewardsAcc.Address))
+		s.Assert().Greater(getRecordsNum(contractRewardsAcc.Address), contractRecordsBefore)
+	})
+}
@@ -17,6 +17,9 @@ import (
 	cryptoTypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/simapp/helpers"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authSigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	slashingTypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
@@ -38,7 +41,7 @@ import (
 // Reasons for creating a custom TestChain rather than using the ibc-go's one are: to simplify it,
 // add contract related helpers and fix errors caused by x/gastracker module (ibc-go version starts at block 2).
 type TestChain struct {
-	t *testing.T
+	t testing.TB
 
 	cfg         chainConfig
 	app         *app.ArchwayApp         // main application
@@ -51,7 +54,7 @@ type TestChain struct {
 }
 
 // NewTestChain creates a new TestChain with the default amount of genesis accounts and validators.
-func NewTestChain(t *testing.T, chainIdx int, opts ...interface{}) *TestChain {
+func NewTestChain(t testing.TB, chainIdx int, opts ...interface{}) *TestChain {
 	const (
 		chainIDPrefix = "test-"
 	)
@@ -381,6 +384,7 @@ type (
 
 	sendMsgOptions struct {
 		fees          sdk.Coins
+		feeGranter    sdk.AccAddress
 		gasLimit      uint64
 		noBlockChange bool
 		simulate      bool
@@ -394,6 +398,13 @@ func WithMsgFees(coins ...sdk.Coin) SendMsgOption {
 	}
 }
 
+// WithFeeGranter option sets the fee granter for the transaction (fees are paid by the granter).
+func WithFeeGranter(granter sdk.AccAddress) SendMsgOption {
+	return func(opt *sendMsgOptions) {
+		opt.feeGranter = granter
+	}
+}
+
 // WithTxGasLimit option overrides the default gas limit for the transaction.
 func WithTxGasLimit(limit uint64) SendMsgOption {
 	return func(opt *sendMsgOptions) {
@@ -447,6 +458,57 @@ func (chain *TestChain) SendMsgsRaw(senderAcc Account, msgs []sdk.Msg, opts ...S
 	t := chain.t
 	options := chain.buildSendMsgOptions(opts...)
 
+	tx := chain.buildTx(senderAcc, msgs, options)
+
+	// Check the Tx
+	if options.simulate {
+		txBz, err := chain.txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+
+		return chain.app.Simulate(txBz)
+	}
+
+	// Send the Tx (ABCI call is used to run the app level DeliverTx extensions)
+	txBz, err := chain.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	res := chain.app.DeliverTx(abci.RequestDeliverTx{Tx: txBz})
+	gasInfo := sdk.GasInfo{
+		GasWanted: uint64(res.GasWanted),
+		GasUsed:   uint64(res.GasUsed),
+	}
+	if !res.IsOK() {
+		return gasInfo, nil, sdkErrors.ABCIError(res.Codespace, res.Code, res.Log)
+	}
+
+	return gasInfo, &sdk.Result{
+		Data:   res.Data,
+		Log:    res.Log,
+		Events: res.Events,
+	}, nil
+}
+
+// CheckTx builds a transaction of a series of messages and passes it to the CheckTx ABCI call (the Tx is not delivered).
+// CheckTx uses the last committed state, so the sender account sequence is expected not to be changed by the current block.
+func (chain *TestChain) CheckTx(senderAcc Account, msgs []sdk.Msg, opts ...SendMsgOption) abci.ResponseCheckTx {
+	t := chain.t
+	options := chain.buildSendMsgOptions(opts...)
+
+	tx := chain.buildTx(senderAcc, msgs, options)
+
+	txBz, err := chain.txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+
+	return chain.app.CheckTx(abci.RequestCheckTx{
+		Tx:   txBz,
+		Type: abci.CheckTxType_New,
+	})
+}
+
+// buildTx builds and signs a transaction of a series of messages.
+func (chain *TestChain) buildTx(senderAcc Account, msgs []sdk.Msg, options sendMsgOptions) sdk.Tx {
+	t := chain.t
+
 	// Get the sender account
 	senderAccI := chain.app.AccountKeeper.GetAccount(chain.GetContext(), senderAcc.Address)
 	require.NotNil(t, senderAccI)
@@ -464,16 +526,37 @@ func (chain *TestChain) SendMsgsRaw(senderAcc Account, msgs []sdk.Msg, opts ...S
 	)
 	require.NoError(t, err)
 
-	// Check the Tx
-	if options.simulate {
-		txBz, err := chain.txConfig.TxEncoder()(tx)
-		require.NoError(t, err)
+	if options.feeGranter == nil {
+		return tx
+	}
 
-		return chain.app.Simulate(txBz)
+	// Set the fee granter and re-sign the Tx (granter is a part of the sign bytes)
+	txBuilder, err := chain.txConfig.WrapTxBuilder(tx)
+	require.NoError(t, err)
+	txBuilder.SetFeeGranter(options.feeGranter)
+
+	signMode := chain.txConfig.SignModeHandler().DefaultMode()
+	signerData := authSigning.SignerData{
+		ChainID:       chain.GetChainID(),
+		AccountNumber: senderAccI.GetAccountNumber(),
+		Sequence:      senderAccI.GetSequence(),
 	}
+	signBytes, err := chain.txConfig.SignModeHandler().GetSignBytes(signMode, signerData, txBuilder.GetTx())
+	require.NoError(t, err)
+
+	sig, err := senderAcc.PrivKey.Sign(signBytes)
+	require.NoError(t, err)
+
+	require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey: senderAcc.PrivKey.PubKey(),
+		Data: &signing.SingleSignatureData{
+			SignMode:  signMode,
+			Signature: sig,
+		},
+		Sequence: senderAccI.GetSequence(),
+	}))
 
-	// Send the Tx
-	return chain.app.Deliver(chain.txConfig.TxEncoder(), tx)
+	return txBuilder.GetTx()
 }
 
 // ParseSDKResultData converts TX result data into a slice of Msgs.
@@ -1,7 +1,10 @@
 package e2eTesting
 
 import (
+	"encoding/hex"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -124,6 +127,43 @@ func (chain *TestChain) GetNextIBCPacketSequence(portID, channelID string) uint6
 	return seq
 }
 
+// ParseSendPacketEvent assembles an IBC packet from the TX response send_packet event.
+func (chain *TestChain) ParseSendPacketEvent(events []abci.Event) channelTypes.Packet {
+	t := chain.t
+
+	var packet channelTypes.Packet
+	var err error
+
+	getAttr := func(attrKey string) string {
+		value := GetStringEventAttribute(events, channelTypes.EventTypeSendPacket, attrKey)
+		require.NotEmpty(t, value, "send_packet event attribute %q not found", attrKey)
+		return value
+	}
+
+	packet.Sequence, err = strconv.ParseUint(getAttr(channelTypes.AttributeKeySequence), 10, 64)
+	require.NoError(t, err)
+
+	packet.SourcePort = getAttr(channelTypes.AttributeKeySrcPort)
+	packet.SourceChannel = getAttr(channelTypes.AttributeKeySrcChannel)
+	packet.DestinationPort = getAttr(channelTypes.AttributeKeyDstPort)
+	packet.DestinationChannel = getAttr(channelTypes.AttributeKeyDstChannel)
+
+	packet.Data, err = hex.DecodeString(getAttr(channelTypes.AttributeKeyDataHex))
+	require.NoError(t, err)
+
+	timeoutHeightSplit := strings.Split(getAttr(channelTypes.AttributeKeyTimeoutHeight), "-")
+	require.Len(t, timeoutHeightSplit, 2)
+	packet.TimeoutHeight.RevisionNumber, err = strconv.ParseUint(timeoutHeightSplit[0], 10, 64)
+	require.NoError(t, err)
+	packet.TimeoutHeight.RevisionHeight, err = strconv.ParseUint(timeoutHeightSplit[1], 10, 64)
+	require.NoError(t, err)
+
+	packet.TimeoutTimestamp, err = strconv.ParseUint(getAttr(channelTypes.AttributeKeyTimeoutTimestamp), 10, 64)
+	require.NoError(t, err)
+
+	return packet
+}
+
 // GetTMClientLastHeader creates an IBC TM client header from the last committed block.
 // Used to create a new client.
 func (chain *TestChain) GetTMClientLastHeader() ibcTmTypes.Header {
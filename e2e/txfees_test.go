@@ -8,11 +8,15 @@ import (
 	wasmdTypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
 	voterTypes "github.com/archway-network/voter/src/types"
 
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/pkg"
 	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
 )
 
@@ -20,7 +24,7 @@ import (
 // Test configures a chain based on the Archway mainnet parameters.
 func (s *E2ETestSuite) TestTxFees() {
 	const (
-		txGasLimit        = 200_000
+		txGasLimit        = 250_000
 		txFeeAmtIncrement = 1000
 	)
 
@@ -264,3 +268,232 @@ func (s *E2ETestSuite) TestTxFees() {
 		}
 	}
 }
+
+// TestTxPriority ensures that the CheckTx priority follows the x/rewards TxPriorityTiers param.
+// Priority estimated by the AnteHandler is passed to the app CheckTx via the x/rewards transient store,
+// so the test also checks that the value doesn't outlive the CheckTx call.
+func (s *E2ETestSuite) TestTxPriority() {
+	const txGasLimit = 100_000
+
+	chain := e2eTesting.NewTestChain(s.T(), 1,
+		e2eTesting.WithGenAccounts(7),
+	)
+
+	// Set the min consensus fee (10000stake for the txGasLimit) and priority tiers
+	{
+		ctx := chain.GetContext()
+		keeper := chain.GetApp().RewardsKeeper
+
+		keeper.GetState().MinConsensusFee(ctx).SetFee(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 1)))
+
+		params := keeper.GetParams(ctx)
+		params.TxPriorityTiers = []rewardsTypes.TxPriorityTier{
+			{MinFeeRatio: sdk.NewDecWithPrec(15, 1), Priority: 10},
+			{MinFeeRatio: sdk.NewDec(2), Priority: 20},
+			{MinFeeRatio: sdk.NewDec(10), Priority: 100},
+		}
+		keeper.SetParams(ctx, params)
+
+		// Commit the state for CheckTx
+		chain.NextBlock(0)
+	}
+
+	type testCase struct {
+		feeAmt           int64
+		priorityExpected int64
+	}
+
+	testCases := []testCase{
+		{feeAmt: 10_000, priorityExpected: 0},
+		{feeAmt: 15_000, priorityExpected: 10},
+		{feeAmt: 20_000, priorityExpected: 20},
+		{feeAmt: 100_000, priorityExpected: 100},
+	}
+
+	// Every Tx is sent by a different account as CheckTx increments the sender sequence
+	var prevPriority int64
+	for i, tc := range testCases {
+		senderAcc := chain.GetAccount(i)
+		msg := bankTypes.NewMsgSend(senderAcc.Address, senderAcc.Address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+
+		res := chain.CheckTx(senderAcc, []sdk.Msg{msg},
+			e2eTesting.WithMsgFees(sdk.NewInt64Coin(sdk.DefaultBondDenom, tc.feeAmt)),
+			e2eTesting.WithTxGasLimit(txGasLimit),
+		)
+		s.Require().True(res.IsOK(), "fee %d: %s", tc.feeAmt, res.Log)
+
+		s.Assert().Equal(tc.priorityExpected, res.Priority, "fee %d", tc.feeAmt)
+		s.Assert().GreaterOrEqual(res.Priority, prevPriority, "fee %d", tc.feeAmt)
+		prevPriority = res.Priority
+	}
+
+	s.Run("Fail: fees below the minimum are rejected", func() {
+		senderAcc := chain.GetAccount(len(testCases))
+		msg := bankTypes.NewMsgSend(senderAcc.Address, senderAcc.Address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+
+		res := chain.CheckTx(senderAcc, []sdk.Msg{msg},
+			e2eTesting.WithMsgFees(sdk.NewInt64Coin(sdk.DefaultBondDenom, 9_999)),
+			e2eTesting.WithTxGasLimit(txGasLimit),
+		)
+		s.Assert().False(res.IsOK())
+		s.Assert().EqualValues(0, res.Priority)
+	})
+
+	s.Run("OK: priority of a tx rejected after the estimation is not passed on", func() {
+		// Fees exceed the sender balance: the tx gets the highest priority estimated and fails on the fee deduction
+		senderAcc := chain.GetAccount(len(testCases) + 1)
+		msg := bankTypes.NewMsgSend(senderAcc.Address, senderAcc.Address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+		senderBalance := chain.GetBalance(senderAcc.Address).AmountOf(sdk.DefaultBondDenom)
+
+		res := chain.CheckTx(senderAcc, []sdk.Msg{msg},
+			e2eTesting.WithMsgFees(sdk.NewCoin(sdk.DefaultBondDenom, senderBalance.AddRaw(1))),
+			e2eTesting.WithTxGasLimit(txGasLimit),
+		)
+		s.Require().False(res.IsOK())
+		s.Assert().EqualValues(0, res.Priority)
+
+		// The next tx gets its own priority
+		senderAcc = chain.GetAccount(len(testCases) + 2)
+		msg = bankTypes.NewMsgSend(senderAcc.Address, senderAcc.Address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+
+		res = chain.CheckTx(senderAcc, []sdk.Msg{msg},
+			e2eTesting.WithMsgFees(sdk.NewInt64Coin(sdk.DefaultBondDenom, 15_000)),
+			e2eTesting.WithTxGasLimit(txGasLimit),
+		)
+		s.Require().True(res.IsOK(), res.Log)
+		s.Assert().EqualValues(10, res.Priority)
+
+		// Nothing is left in the transient store
+		checkCtx := chain.GetApp().NewContext(true, tmproto.Header{})
+		s.Assert().EqualValues(0, chain.GetApp().RewardsKeeper.PopCheckTxPriority(checkCtx))
+	})
+}
+
+// TestUnusedGasRefund ensures that the unused gas fees share is refunded to the fee granter.
+func (s *E2ETestSuite) TestUnusedGasRefund() {
+	chain := e2eTesting.NewTestChain(s.T(), 1,
+		e2eTesting.WithGenAccounts(2),
+	)
+	granterAcc, granteeAcc := chain.GetAccount(0), chain.GetAccount(1)
+
+	refundRatio := sdk.NewDecWithPrec(5, 1)
+
+	// Enable refunds and grant fees allowance
+	{
+		ctx := chain.GetContext()
+		keeper := chain.GetApp().RewardsKeeper
+
+		params := keeper.GetParams(ctx)
+		params.UnusedGasRefundRatio = refundRatio
+		keeper.SetParams(ctx, params)
+
+		s.Require().NoError(chain.GetApp().FeeGrantKeeper.GrantAllowance(ctx, granterAcc.Address, granteeAcc.Address, &feegrant.BasicAllowance{}))
+	}
+
+	fees := chain.GetDefaultTxFee()
+	txHeight := chain.GetContext().BlockHeight()
+	granterBalanceBefore, granteeBalanceBefore := chain.GetBalance(granterAcc.Address), chain.GetBalance(granteeAcc.Address)
+
+	msg := bankTypes.NewMsgSend(granteeAcc.Address, granteeAcc.Address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	gasInfo, _, abciEvents, _ := chain.SendMsgs(granteeAcc, true, []sdk.Msg{msg},
+		e2eTesting.WithFeeGranter(granterAcc.Address),
+	)
+
+	// Check the tracked gas usage
+	txInfos := chain.GetApp().TrackingKeeper.GetState().TxInfoState(chain.GetContext()).GetTxInfosByBlock(txHeight)
+	s.Require().Len(txInfos, 1)
+	txInfo := txInfos[0]
+
+	s.Assert().Equal(granterAcc.Address.String(), txInfo.FeePayer)
+	s.Assert().Equal(gasInfo.GasWanted, txInfo.GasLimit)
+	s.Assert().Equal(gasInfo.GasUsed, txInfo.GasUsed)
+	s.Require().NotZero(txInfo.UnusedGas())
+
+	// Check the refund (non-wasm tx fees are sent to the FeeCollector)
+	refundShare := pkg.NewDecFromUint64(txInfo.UnusedGas()).Quo(pkg.NewDecFromUint64(txInfo.GasLimit)).Mul(refundRatio)
+	refundExpected, feeCollectorFeesLeftExpected := pkg.SplitCoins(fees, refundShare)
+	s.Require().False(refundExpected.IsZero())
+
+	s.Assert().Equal(granterBalanceBefore.Sub(fees).Add(refundExpected...).String(), chain.GetBalance(granterAcc.Address).String())
+	s.Assert().Equal(granteeBalanceBefore.String(), chain.GetBalance(granteeAcc.Address).String())
+
+	// Check the refund transfer and the refund events are emitted by the EndBlocker
+	s.Assert().Equal(granterAcc.Address.String(), e2eTesting.GetStringEventAttribute(abciEvents, "archway.rewards.v1beta1.TxFeeRefundEvent", "fee_payer"))
+
+	refundTransferFound := false
+	for _, event := range abciEvents {
+		if event.Type != bankTypes.EventTypeTransfer {
+			continue
+		}
+		if e2eTesting.GetStringEventAttribute([]abci.Event{event}, event.Type, bankTypes.AttributeKeyRecipient) == granterAcc.Address.String() &&
+			e2eTesting.GetStringEventAttribute([]abci.Event{event}, event.Type, sdk.AttributeKeyAmount) == refundExpected.String() {
+			refundTransferFound = true
+		}
+	}
+	s.Assert().True(refundTransferFound)
+
+	txRewards, found := chain.GetApp().RewardsKeeper.GetState().TxRewardsState(chain.GetContext()).GetTxRewards(txInfo.Id)
+	s.Require().True(found)
+	s.Assert().Empty(txRewards.FeeRewards)
+	s.Assert().Equal(feeCollectorFeesLeftExpected.String(), sdk.Coins(txRewards.FeeCollectorFees).String())
+}
+
+// TestUnusedGasRefundReplay ensures that the unused gas refund only depends on the delivered transaction gas usage.
+// Simulation and CheckTx calls of the same transaction and a delivered transaction rejected by the AnteHandler are not tracked.
+func (s *E2ETestSuite) TestUnusedGasRefundReplay() {
+	chain := e2eTesting.NewTestChain(s.T(), 1,
+		e2eTesting.WithGenAccounts(2),
+	)
+	senderAcc, otherAcc := chain.GetAccount(0), chain.GetAccount(1)
+
+	refundRatio := sdk.NewDecWithPrec(5, 1)
+
+	// Enable refunds
+	{
+		ctx := chain.GetContext()
+		keeper := chain.GetApp().RewardsKeeper
+
+		params := keeper.GetParams(ctx)
+		params.UnusedGasRefundRatio = refundRatio
+		keeper.SetParams(ctx, params)
+	}
+
+	fees := chain.GetDefaultTxFee()
+	txHeight := chain.GetContext().BlockHeight()
+	msgs := []sdk.Msg{
+		bankTypes.NewMsgSend(senderAcc.Address, senderAcc.Address, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1))),
+	}
+
+	// Simulate and CheckTx the transaction (those are executed on a different state)
+	_, _, err := chain.SendMsgsRaw(senderAcc, msgs, e2eTesting.WithSimulation())
+	s.Require().NoError(err)
+	s.Require().True(chain.CheckTx(senderAcc, msgs).IsOK())
+
+	// Deliver the transaction
+	senderBalanceBefore := chain.GetBalance(senderAcc.Address)
+	gasInfo, _, err := chain.SendMsgsRaw(senderAcc, msgs)
+	s.Require().NoError(err)
+
+	// Deliver a transaction rejected by the AnteHandler after the tx tracking is started (fee grant is not found)
+	_, _, err = chain.SendMsgsRaw(senderAcc, msgs, e2eTesting.WithFeeGranter(otherAcc.Address))
+	s.Require().Error(err)
+
+	chain.NextBlock(0)
+
+	// Check the tracked gas usage
+	txInfos := chain.GetApp().TrackingKeeper.GetState().TxInfoState(chain.GetContext()).GetTxInfosByBlock(txHeight)
+	s.Require().Len(txInfos, 1)
+	txInfo := txInfos[0]
+
+	s.Assert().Equal(senderAcc.Address.String(), txInfo.FeePayer)
+	s.Assert().Equal(gasInfo.GasWanted, txInfo.GasLimit)
+	s.Assert().Equal(gasInfo.GasUsed, txInfo.GasUsed)
+	s.Require().NotZero(txInfo.UnusedGas())
+
+	// Check the refund
+	refundShare := pkg.NewDecFromUint64(txInfo.UnusedGas()).Quo(pkg.NewDecFromUint64(txInfo.GasLimit)).Mul(refundRatio)
+	refundExpected, _ := pkg.SplitCoins(fees, refundShare)
+	s.Require().False(refundExpected.IsZero())
+
+	s.Assert().Equal(senderBalanceBefore.Sub(fees).Add(refundExpected...).String(), chain.GetBalance(senderAcc.Address).String())
+}
@@ -878,14 +878,26 @@ func (s *E2ETestSuite) TestVoter_WASMBindingsMetadataUpdate() {
 		s.Assert().Equal(acc2.Address.String(), meta.RewardsAddress)
 	})
 
-	s.Run("OK: update OwnerAddress (change ownership)", func() {
+	s.Run("OK: update OwnerAddress (nominate new owner)", func() {
 		req := voterCustomTypes.UpdateContractMetadataRequest{
 			OwnerAddress: acc1.Address.String(),
 		}
 		s.VoterUpdateMetadata(chain, contractAddr, acc1, req, true)
 
+		meta := chain.GetContractMetadata(contractAddr)
+		s.Assert().Equal(contractAddr.String(), meta.OwnerAddress)
+		s.Assert().Equal(acc1.Address.String(), meta.PendingOwnerAddress)
+		s.Assert().Equal(acc2.Address.String(), meta.RewardsAddress)
+	})
+
+	s.Run("OK: accept ownership (change ownership)", func() {
+		msg := rewardsTypes.NewMsgAcceptContractMetadataOwnership(acc1.Address, contractAddr)
+		_, _, _, err := chain.SendMsgs(acc1, true, []sdk.Msg{msg})
+		s.Require().NoError(err)
+
 		meta := chain.GetContractMetadata(contractAddr)
 		s.Assert().Equal(acc1.Address.String(), meta.OwnerAddress)
+		s.Assert().Empty(meta.PendingOwnerAddress)
 		s.Assert().Equal(acc2.Address.String(), meta.RewardsAddress)
 	})
 }
@@ -931,6 +943,9 @@ func (s *E2ETestSuite) TestVoter_WASMBindingsRewardsRecordsQuery() {
 		s.VoterNewVoting(chain, contractAddr, acc, "Test", []string{"a", "b"}, 1*time.Hour)
 		s.VoterVote(chain, contractAddr, acc, 0, "a", true)
 
+		// Rewards from all blocks are merged into one record, add one more to check pagination
+		s.CreateRewardsRecords(chain, contractAddr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), 1)
+
 		recordsExpected = chain.GetApp().RewardsKeeper.GetState().RewardsRecord(chain.GetContext()).GetRewardsRecordByRewardsAddress(contractAddr)
 		s.Require().Len(recordsExpected, 2)
 	}
@@ -1035,12 +1050,11 @@ func (s *E2ETestSuite) TestVoter_WASMBindingsWithdrawRewards() {
 		s.VoterVote(chain, contractAddr, acc2, 0, "b", false)
 		s.VoterVote(chain, contractAddr, acc3, 0, "c", true)
 
+		// Rewards from all blocks are merged into one record, add more to check both withdraw modes
+		s.CreateRewardsRecords(chain, contractAddr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 10)), 3)
+
 		recordsExpected = chain.GetApp().RewardsKeeper.GetState().RewardsRecord(chain.GetContext()).GetRewardsRecordByRewardsAddress(contractAddr)
 		s.Require().Len(recordsExpected, 4)
-
-		for _, record := range recordsExpected {
-			totalRewardsExpected = totalRewardsExpected.Add(record.Rewards...)
-		}
 	}
 
 	// Get the rewardsAddr initial balance to check it after all the withdrawals are done
@@ -1055,18 +1069,25 @@ func (s *E2ETestSuite) TestVoter_WASMBindingsWithdrawRewards() {
 			true,
 		)
 
-		rewardsExpected := sdk.NewCoins()
-		rewardsExpected = rewardsExpected.Add(recordsExpected[0].Rewards...)
-		rewardsExpected = rewardsExpected.Add(recordsExpected[1].Rewards...)
+		totalRewardsExpected = totalRewardsExpected.Add(recordsExpected[0].Rewards...)
+		totalRewardsExpected = totalRewardsExpected.Add(recordsExpected[1].Rewards...)
 
 		stats := s.VoterGetWithdrawStats(chain, contractAddr)
 		s.Assert().EqualValues(1, stats.Count)
-		s.Assert().Equal(rewardsExpected.String(), s.CosmWasmCoinsToSDK(stats.TotalAmount...).String())
+		s.Assert().Equal(totalRewardsExpected.String(), s.CosmWasmCoinsToSDK(stats.TotalAmount...).String())
 		s.Assert().EqualValues(2, stats.TotalRecordsUsed)
 	})
 
 	// Withdraw the rest using record IDs
 	s.Run("Withdraw using record IDs and check Reply stats", func() {
+		// Previous withdraw tx rewards are merged into the latest record, so records are re-read
+		rewardsRecordState := chain.GetApp().RewardsKeeper.GetState().RewardsRecord(chain.GetContext())
+		for _, record := range recordsExpected[2:] {
+			recordUpdated, found := rewardsRecordState.GetRewardsRecord(record.Id)
+			s.Require().True(found)
+			totalRewardsExpected = totalRewardsExpected.Add(recordUpdated.Rewards...)
+		}
+
 		s.VoterWithdrawRewards(
 			chain, contractAddr, acc1,
 			nil,
@@ -17,6 +17,16 @@ func ParseAccAddressArg(argName, argValue string) (sdk.AccAddress, error) {
 	return addr, nil
 }
 
+// ParseValAddressArg is a helper function to parse a validator address CLI argument.
+func ParseValAddressArg(argName, argValue string) (sdk.ValAddress, error) {
+	addr, err := sdk.ValAddressFromBech32(argValue)
+	if err != nil {
+		return sdk.ValAddress{}, fmt.Errorf("parsing %s argument: invalid address: %w", argName, err)
+	}
+
+	return addr, nil
+}
+
 // ParseUint64Arg is a helper function to parse uint64 CLI argument.
 func ParseUint64Arg(argName, argValue string) (uint64, error) {
 	v, err := strconv.ParseUint(argValue, 10, 64)
@@ -26,3 +36,23 @@ func ParseUint64Arg(argName, argValue string) (uint64, error) {
 
 	return v, nil
 }
+
+// ParseCoinArg is a helper function to parse sdk.Coin CLI argument.
+func ParseCoinArg(argName, argValue string) (sdk.Coin, error) {
+	v, err := sdk.ParseCoinNormalized(argValue)
+	if err != nil {
+		return sdk.Coin{}, fmt.Errorf("parsing %s argument: invalid sdk.Coin value: %w", argName, err)
+	}
+
+	return v, nil
+}
+
+// ParseCoinsArg is a helper function to parse sdk.Coins CLI argument.
+func ParseCoinsArg(argName, argValue string) (sdk.Coins, error) {
+	v, err := sdk.ParseCoinsNormalized(argValue)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s argument: invalid sdk.Coins value: %w", argName, err)
+	}
+
+	return v, nil
+}
@@ -38,6 +38,13 @@ func WithMockFeeTxPayer(payer sdk.AccAddress) MockFeeTxOption {
 	}
 }
 
+// WithMockFeeTxGranter option sets the feeGranter of the MockFeeTx.
+func WithMockFeeTxGranter(granter sdk.AccAddress) MockFeeTxOption {
+	return func(tx *MockFeeTx) {
+		tx.feeGranter = granter
+	}
+}
+
 // WithMockFeeTxGas option sets the gas limit of the MockFeeTx.
 func WithMockFeeTxGas(gas uint64) MockFeeTxOption {
 	return func(tx *MockFeeTx) {
@@ -10,15 +10,19 @@ import (
 var _ wasmKeeper.Messenger = (*MockMessenger)(nil)
 
 // MockContractViewer mocks x/wasmd module dependency.
-// Mock returns a contract info if admin is set.
+// Mock returns a contract info if admin or code ID is set and a code info if creator is set.
 type MockContractViewer struct {
-	contractAdminSet map[string]string // key: contractAddr, value: adminAddr
+	contractAdminSet  map[string]string // key: contractAddr, value: adminAddr
+	contractCodeIDSet map[string]uint64 // key: contractAddr, value: codeID
+	codeCreatorSet    map[uint64]string // key: codeID, value: creatorAddr
 }
 
 // NewMockContractViewer creates a new MockContractViewer instance.
 func NewMockContractViewer() *MockContractViewer {
 	return &MockContractViewer{
-		contractAdminSet: make(map[string]string),
+		contractAdminSet:  make(map[string]string),
+		contractCodeIDSet: make(map[string]uint64),
+		codeCreatorSet:    make(map[uint64]string),
 	}
 }
 
@@ -27,15 +31,39 @@ func (v *MockContractViewer) AddContractAdmin(contractAddr, adminAddr string) {
 	v.contractAdminSet[contractAddr] = adminAddr
 }
 
-// GetContractInfo returns a contract info if admin is set.
+// AddContractCodeID adds a contract code ID link.
+func (v *MockContractViewer) AddContractCodeID(contractAddr string, codeID uint64) {
+	v.contractCodeIDSet[contractAddr] = codeID
+}
+
+// AddCodeCreator adds a code creator link.
+func (v *MockContractViewer) AddCodeCreator(codeID uint64, creatorAddr string) {
+	v.codeCreatorSet[codeID] = creatorAddr
+}
+
+// GetContractInfo returns a contract info if admin or code ID is set.
 func (v MockContractViewer) GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmdTypes.ContractInfo {
-	adminAddr, found := v.contractAdminSet[contractAddress.String()]
-	if !found {
+	adminAddr, adminFound := v.contractAdminSet[contractAddress.String()]
+	codeID, codeIDFound := v.contractCodeIDSet[contractAddress.String()]
+	if !adminFound && !codeIDFound {
 		return nil
 	}
 
 	return &wasmdTypes.ContractInfo{
-		Admin: adminAddr,
+		CodeID: codeID,
+		Admin:  adminAddr,
+	}
+}
+
+// GetCodeInfo returns a code info if creator is set.
+func (v MockContractViewer) GetCodeInfo(ctx sdk.Context, codeID uint64) *wasmdTypes.CodeInfo {
+	creatorAddr, found := v.codeCreatorSet[codeID]
+	if !found {
+		return nil
+	}
+
+	return &wasmdTypes.CodeInfo{
+		Creator: creatorAddr,
 	}
 }
 
@@ -50,8 +50,22 @@ func (d MsgDispatcher) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress,
 	switch {
 	case customMsg.UpdateContractMetadata != nil:
 		return d.rewardsHandler.UpdateContractMetadata(ctx, contractAddr, *customMsg.UpdateContractMetadata)
+	case customMsg.AcceptContractMetadataOwnership != nil:
+		return d.rewardsHandler.AcceptContractMetadataOwnership(ctx, contractAddr, *customMsg.AcceptContractMetadataOwnership)
+	case customMsg.CancelContractMetadataOwnership != nil:
+		return d.rewardsHandler.CancelContractMetadataOwnership(ctx, contractAddr, *customMsg.CancelContractMetadataOwnership)
 	case customMsg.WithdrawRewards != nil:
 		return d.rewardsHandler.WithdrawContractRewards(ctx, contractAddr, *customMsg.WithdrawRewards)
+	case customMsg.WithdrawRewardsAndDelegate != nil:
+		return d.rewardsHandler.WithdrawContractRewardsAndDelegate(ctx, contractAddr, *customMsg.WithdrawRewardsAndDelegate)
+	case customMsg.SetFlatFee != nil:
+		return d.rewardsHandler.SetFlatFee(ctx, contractAddr, *customMsg.SetFlatFee)
+	case customMsg.SetSponsorship != nil:
+		return d.rewardsHandler.SetSponsorship(ctx, contractAddr, *customMsg.SetSponsorship)
+	case customMsg.DepositSponsorship != nil:
+		return d.rewardsHandler.DepositSponsorship(ctx, contractAddr, *customMsg.DepositSponsorship)
+	case customMsg.WithdrawSponsorship != nil:
+		return d.rewardsHandler.WithdrawSponsorship(ctx, contractAddr, *customMsg.WithdrawSponsorship)
 	default:
 		// That should never happen, since we validate the input above
 		return nil, nil, sdkErrors.Wrap(wasmdTypes.ErrUnknownMsg, "no custom handler found")
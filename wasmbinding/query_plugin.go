@@ -44,6 +44,10 @@ func (d QueryDispatcher) DispatchQuery(ctx sdk.Context, request json.RawMessage)
 		resData, resErr = d.rewardsHandler.GetContractMetadata(ctx, *req.ContractMetadata)
 	case req.RewardsRecords != nil:
 		resData, resErr = d.rewardsHandler.GetRewardsRecords(ctx, *req.RewardsRecords)
+	case req.FlatFee != nil:
+		resData, resErr = d.rewardsHandler.GetFlatFee(ctx, *req.FlatFee)
+	case req.Sponsorship != nil:
+		resData, resErr = d.rewardsHandler.GetSponsorship(ctx, *req.Sponsorship)
 	default:
 		// That should never happen, since we validate the input above
 		return nil, wasmVmTypes.UnsupportedRequest{Kind: "no custom querier found"}
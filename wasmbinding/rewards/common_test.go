@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	wasmVmTypes "github.com/CosmWasm/wasmvm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	mintTypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/stretchr/testify/assert"
@@ -127,6 +128,31 @@ func TestRewardsWASMBindings(t *testing.T) {
 	require.NoError(t, err)
 
 	// Update metadata
+	t.Run("Update metadata (set weighted rewards recipients)", func(t *testing.T) {
+		msg := rewardsWbTypes.UpdateContractMetadataRequest{
+			RewardsRecipients: []rewardsWbTypes.RewardsRecipient{
+				{Address: acc.Address.String(), Weight: "0.4"},
+				{Address: contractAddr.String(), Weight: "0.6"},
+			},
+		}
+
+		_, _, err := msgPlugin.UpdateContractMetadata(ctx, contractAddr, msg)
+		require.NoError(t, err)
+
+		query := rewardsWbTypes.ContractMetadataRequest{
+			ContractAddress: contractAddr.String(),
+		}
+
+		res, err := queryPlugin.GetContractMetadata(ctx, query)
+		require.NoError(t, err)
+		assert.Empty(t, res.RewardsAddress)
+		require.Len(t, res.RewardsRecipients, 2)
+		assert.Equal(t, acc.Address.String(), res.RewardsRecipients[0].Address)
+		assert.Equal(t, "0.400000000000000000", res.RewardsRecipients[0].Weight)
+		assert.Equal(t, contractAddr.String(), res.RewardsRecipients[1].Address)
+		assert.Equal(t, "0.600000000000000000", res.RewardsRecipients[1].Weight)
+	})
+
 	t.Run("Update metadata (set contractAddr as the rewardsAddr)", func(t *testing.T) {
 		msg := rewardsWbTypes.UpdateContractMetadataRequest{
 			OwnerAddress:   contractAddr.String(),
@@ -146,6 +172,112 @@ func TestRewardsWASMBindings(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, contractAddr.String(), res.OwnerAddress)
 		assert.Equal(t, contractAddr.String(), res.RewardsAddress)
+		assert.Empty(t, res.RewardsRecipients)
+	})
+
+	// Nominate a new owner and cancel the ownership transfer
+	t.Run("Update metadata (nominate pending owner) and cancel ownership transfer", func(t *testing.T) {
+		msg := rewardsWbTypes.UpdateContractMetadataRequest{
+			OwnerAddress: acc.Address.String(),
+		}
+
+		_, _, err := msgPlugin.UpdateContractMetadata(ctx, contractAddr, msg)
+		require.NoError(t, err)
+
+		query := rewardsWbTypes.ContractMetadataRequest{
+			ContractAddress: contractAddr.String(),
+		}
+
+		res, err := queryPlugin.GetContractMetadata(ctx, query)
+		require.NoError(t, err)
+		assert.Equal(t, contractAddr.String(), res.OwnerAddress)
+		assert.Equal(t, acc.Address.String(), res.PendingOwnerAddress)
+
+		_, _, err = msgPlugin.CancelContractMetadataOwnership(ctx, contractAddr, rewardsWbTypes.ContractMetadataOwnershipRequest{
+			ContractAddress: contractAddr.String(),
+		})
+		require.NoError(t, err)
+
+		res, err = queryPlugin.GetContractMetadata(ctx, query)
+		require.NoError(t, err)
+		assert.Equal(t, contractAddr.String(), res.OwnerAddress)
+		assert.Empty(t, res.PendingOwnerAddress)
+	})
+
+	// Accept the ownership of other contract metadata
+	t.Run("Accept other contract metadata ownership", func(t *testing.T) {
+		otherContractAddr := e2eTesting.GenContractAddresses(2)[1]
+		contractViewer.AddContractAdmin(otherContractAddr.String(), acc.Address.String())
+
+		req := rewardsWbTypes.ContractMetadataOwnershipRequest{
+			ContractAddress: otherContractAddr.String(),
+		}
+
+		_, _, err := msgPlugin.AcceptContractMetadataOwnership(ctx, contractAddr, req)
+		assert.ErrorIs(t, err, rewardsTypes.ErrMetadataNotFound)
+
+		require.NoError(t, keeper.SetContractMetadata(ctx, acc.Address, otherContractAddr, rewardsTypes.ContractMetadata{
+			OwnerAddress: contractAddr.String(),
+		}))
+
+		_, _, err = msgPlugin.AcceptContractMetadataOwnership(ctx, contractAddr, req)
+		require.NoError(t, err)
+
+		meta := keeper.GetContractMetadata(ctx, otherContractAddr)
+		require.NotNil(t, meta)
+		assert.Equal(t, contractAddr.String(), meta.OwnerAddress)
+		assert.Empty(t, meta.PendingOwnerAddress)
+	})
+
+	// Set and query the contract flat fee
+	t.Run("Set invalid flat fee", func(t *testing.T) {
+		msg := rewardsWbTypes.SetFlatFeeRequest{
+			FlatFeeAmount: wasmVmTypes.Coin{Denom: "stake", Amount: "invalid"},
+		}
+
+		_, _, err := msgPlugin.SetFlatFee(ctx, contractAddr, msg)
+		assert.ErrorContains(t, err, "flatFeeAmount: invalid amount")
+	})
+
+	t.Run("Query non-existing flat fee", func(t *testing.T) {
+		query := rewardsWbTypes.FlatFeeRequest{
+			ContractAddress: contractAddr.String(),
+		}
+
+		_, err := queryPlugin.GetFlatFee(ctx, query)
+		assert.ErrorIs(t, err, rewardsTypes.ErrInvalidRequest)
+	})
+
+	t.Run("Set flat fee", func(t *testing.T) {
+		msg := rewardsWbTypes.SetFlatFeeRequest{
+			FlatFeeAmount: wasmVmTypes.Coin{Denom: sdk.DefaultBondDenom, Amount: "1000"},
+		}
+
+		_, _, err := msgPlugin.SetFlatFee(ctx, contractAddr, msg)
+		require.NoError(t, err)
+	})
+
+	t.Run("Check flat fee set", func(t *testing.T) {
+		query := rewardsWbTypes.FlatFeeRequest{
+			ContractAddress: contractAddr.String(),
+		}
+
+		res, err := queryPlugin.GetFlatFee(ctx, query)
+		require.NoError(t, err)
+		assert.Equal(t, sdk.DefaultBondDenom, res.FlatFeeAmount.Denom)
+		assert.Equal(t, "1000", res.FlatFeeAmount.Amount)
+	})
+
+	t.Run("Remove flat fee", func(t *testing.T) {
+		msg := rewardsWbTypes.SetFlatFeeRequest{
+			FlatFeeAmount: wasmVmTypes.Coin{Denom: sdk.DefaultBondDenom, Amount: "0"},
+		}
+
+		_, _, err := msgPlugin.SetFlatFee(ctx, contractAddr, msg)
+		require.NoError(t, err)
+
+		_, found := keeper.GetFlatFee(ctx, contractAddr)
+		assert.False(t, found)
 	})
 
 	// Add some rewards to withdraw (create new records and mint tokens)
@@ -263,4 +395,116 @@ func TestRewardsWASMBindings(t *testing.T) {
 
 		assert.Equal(t, recordsRewards.String(), chain.GetBalance(contractAddr).String())
 	})
+
+	// Withdraw new rewards delegating them to a validator
+	t.Run("Withdraw and delegate rewards", func(t *testing.T) {
+		record4RewardsExpected := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50))
+		keeper.GetState().RewardsRecord(ctx).CreateRewardsRecord(contractAddr, record4RewardsExpected, ctx.BlockHeight(), ctx.BlockTime())
+		require.NoError(t, chain.GetApp().MintKeeper.MintCoins(ctx, record4RewardsExpected))
+		require.NoError(t, chain.GetApp().BankKeeper.SendCoinsFromModuleToModule(ctx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, record4RewardsExpected))
+
+		valAddr := chain.GetApp().StakingKeeper.GetAllValidators(ctx)[0].GetOperator()
+		msg := rewardsWbTypes.WithdrawRewardsAndDelegateRequest{
+			ValidatorAddress: valAddr.String(),
+			RecordsLimit:     archPkg.Uint64Ptr(0),
+		}
+
+		_, resData, err := msgPlugin.WithdrawContractRewardsAndDelegate(ctx, contractAddr, msg)
+		require.NoError(t, err)
+		require.Len(t, resData, 1)
+
+		var res rewardsWbTypes.WithdrawRewardsAndDelegateResponse
+		require.NoError(t, json.Unmarshal(resData[0], &res))
+
+		assert.EqualValues(t, 1, res.RecordsNum)
+		totalRewardsReceived, err := pkg.WasmCoinsToSDK(res.TotalRewards)
+		require.NoError(t, err)
+		assert.EqualValues(t, record4RewardsExpected.String(), totalRewardsReceived.String())
+		assert.Equal(t, record4RewardsExpected[0].Amount.String(), res.DelegatedAmount.Amount)
+
+		assert.Equal(t, recordsRewards.String(), chain.GetBalance(contractAddr).String())
+		delegation, found := chain.GetApp().StakingKeeper.GetDelegation(ctx, contractAddr, valAddr)
+		require.True(t, found)
+		assert.True(t, delegation.Shares.IsPositive())
+	})
+
+	// Manage the contract sponsorship
+	t.Run("Query non-existing sponsorship", func(t *testing.T) {
+		query := rewardsWbTypes.SponsorshipRequest{
+			ContractAddress: contractAddr.String(),
+		}
+
+		_, err := queryPlugin.GetSponsorship(ctx, query)
+		assert.ErrorIs(t, err, rewardsTypes.ErrSponsorshipNotFound)
+	})
+
+	t.Run("Deposit to non-existing sponsorship", func(t *testing.T) {
+		msg := rewardsWbTypes.SponsorshipFundsRequest{
+			Amount: wasmVmTypes.Coins{{Denom: sdk.DefaultBondDenom, Amount: "100"}},
+		}
+
+		_, _, err := msgPlugin.DepositSponsorship(ctx, contractAddr, msg)
+		assert.ErrorIs(t, err, rewardsTypes.ErrSponsorshipNotFound)
+	})
+
+	t.Run("Set invalid sponsorship", func(t *testing.T) {
+		msg := rewardsWbTypes.SetSponsorshipRequest{
+			UserFeeLimit: wasmVmTypes.Coins{{Denom: sdk.DefaultBondDenom, Amount: "invalid"}},
+		}
+
+		_, _, err := msgPlugin.SetSponsorship(ctx, contractAddr, msg)
+		assert.ErrorContains(t, err, "userFeeLimit: invalid amount")
+	})
+
+	t.Run("Set sponsorship and deposit", func(t *testing.T) {
+		setMsg := rewardsWbTypes.SetSponsorshipRequest{
+			UserFeeLimit:       wasmVmTypes.Coins{{Denom: sdk.DefaultBondDenom, Amount: "10"}},
+			UserFeeLimitPeriod: 100,
+			FundFromRewards:    true,
+		}
+
+		_, _, err := msgPlugin.SetSponsorship(ctx, contractAddr, setMsg)
+		require.NoError(t, err)
+
+		depositMsg := rewardsWbTypes.SponsorshipFundsRequest{
+			Amount: wasmVmTypes.Coins{{Denom: sdk.DefaultBondDenom, Amount: "100"}},
+		}
+
+		_, _, err = msgPlugin.DepositSponsorship(ctx, contractAddr, depositMsg)
+		require.NoError(t, err)
+	})
+
+	t.Run("Check sponsorship set", func(t *testing.T) {
+		query := rewardsWbTypes.SponsorshipRequest{
+			ContractAddress: contractAddr.String(),
+			UserAddress:     acc.Address.String(),
+		}
+
+		res, err := queryPlugin.GetSponsorship(ctx, query)
+		require.NoError(t, err)
+		assert.Equal(t, wasmVmTypes.Coins{{Denom: sdk.DefaultBondDenom, Amount: "100"}}, res.Deposit)
+		assert.Equal(t, wasmVmTypes.Coins{{Denom: sdk.DefaultBondDenom, Amount: "10"}}, res.UserFeeLimit)
+		assert.EqualValues(t, 100, res.UserFeeLimitPeriod)
+		assert.Empty(t, res.BlockFeeLimit)
+		assert.True(t, res.FundFromRewards)
+		assert.Empty(t, res.UserFees)
+	})
+
+	t.Run("Withdraw from sponsorship", func(t *testing.T) {
+		msg := rewardsWbTypes.SponsorshipFundsRequest{
+			Amount: wasmVmTypes.Coins{{Denom: sdk.DefaultBondDenom, Amount: "150"}},
+		}
+
+		_, _, err := msgPlugin.WithdrawSponsorship(ctx, contractAddr, msg)
+		assert.ErrorIs(t, err, rewardsTypes.ErrInsufficientSponsorship)
+
+		msg.Amount[0].Amount = "40"
+		_, _, err = msgPlugin.WithdrawSponsorship(ctx, contractAddr, msg)
+		require.NoError(t, err)
+
+		sponsorship, found := keeper.GetSponsorship(ctx, contractAddr)
+		require.True(t, found)
+		assert.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 60)).String(), sponsorship.Deposit.String())
+		assert.Equal(t, recordsRewards.Sub(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 60))).String(), chain.GetBalance(contractAddr).String())
+	})
 }
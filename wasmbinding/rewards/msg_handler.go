@@ -13,8 +13,18 @@ import (
 // KeeperWriterExpected defines the x/rewards keeper expected write operations.
 type KeeperWriterExpected interface {
 	SetContractMetadata(ctx sdk.Context, senderAddr, contractAddr sdk.AccAddress, metaUpdates rewardsTypes.ContractMetadata) error
+	AcceptContractMetadataOwnership(ctx sdk.Context, senderAddr, contractAddr sdk.AccAddress) error
+	CancelContractMetadataOwnership(ctx sdk.Context, senderAddr, contractAddr sdk.AccAddress) error
 	WithdrawRewardsByRecordsLimit(ctx sdk.Context, rewardsAddr sdk.AccAddress, recordsLimit uint64) (sdk.Coins, int, error)
 	WithdrawRewardsByRecordIDs(ctx sdk.Context, rewardsAddr sdk.AccAddress, recordIDs []uint64) (sdk.Coins, int, error)
+	IBCWithdrawRewardsByRecordsLimit(ctx sdk.Context, rewardsAddr sdk.AccAddress, recordsLimit uint64, ibcTransfer rewardsTypes.MsgWithdrawRewards_IBCTransfer) (sdk.Coins, int, error)
+	IBCWithdrawRewardsByRecordIDs(ctx sdk.Context, rewardsAddr sdk.AccAddress, recordIDs []uint64, ibcTransfer rewardsTypes.MsgWithdrawRewards_IBCTransfer) (sdk.Coins, int, error)
+	WithdrawRewardsAndDelegateByRecordsLimit(ctx sdk.Context, rewardsAddr sdk.AccAddress, recordsLimit uint64, valAddr sdk.ValAddress) (sdk.Coins, int, sdk.Coin, error)
+	WithdrawRewardsAndDelegateByRecordIDs(ctx sdk.Context, rewardsAddr sdk.AccAddress, recordIDs []uint64, valAddr sdk.ValAddress) (sdk.Coins, int, sdk.Coin, error)
+	SetFlatFee(ctx sdk.Context, senderAddr, contractAddr sdk.AccAddress, flatFee sdk.Coin) error
+	SetSponsorship(ctx sdk.Context, senderAddr, contractAddr sdk.AccAddress, userFeeLimit sdk.Coins, userFeeLimitPeriod uint64, blockFeeLimit sdk.Coins, fundFromRewards bool) error
+	DepositSponsorship(ctx sdk.Context, senderAddr, contractAddr sdk.AccAddress, amount sdk.Coins) error
+	WithdrawSponsorship(ctx sdk.Context, senderAddr, contractAddr sdk.AccAddress, amount sdk.Coins) error
 }
 
 // MsgHandler provides a custom WASM message handler for the x/rewards module.
@@ -42,6 +52,32 @@ func (h MsgHandler) UpdateContractMetadata(ctx sdk.Context, contractAddr sdk.Acc
 	return nil, nil, nil
 }
 
+// AcceptContractMetadataOwnership accepts the metadata ownership of the target contract (sender contract must be the pending owner).
+func (h MsgHandler) AcceptContractMetadataOwnership(ctx sdk.Context, contractAddr sdk.AccAddress, req rewardsMsgTypes.ContractMetadataOwnershipRequest) ([]sdk.Event, [][]byte, error) {
+	if err := req.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("acceptContractMetadataOwnership: %w", err)
+	}
+
+	if err := h.rewardsKeeper.AcceptContractMetadataOwnership(ctx, contractAddr, req.MustGetContractAddress()); err != nil {
+		return nil, nil, err
+	}
+
+	return nil, nil, nil
+}
+
+// CancelContractMetadataOwnership cancels the metadata ownership transfer of the target contract (sender contract must be the owner or the pending owner).
+func (h MsgHandler) CancelContractMetadataOwnership(ctx sdk.Context, contractAddr sdk.AccAddress, req rewardsMsgTypes.ContractMetadataOwnershipRequest) ([]sdk.Event, [][]byte, error) {
+	if err := req.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("cancelContractMetadataOwnership: %w", err)
+	}
+
+	if err := h.rewardsKeeper.CancelContractMetadataOwnership(ctx, contractAddr, req.MustGetContractAddress()); err != nil {
+		return nil, nil, err
+	}
+
+	return nil, nil, nil
+}
+
 // WithdrawContractRewards withdraws the rewards for the contract address.
 func (h MsgHandler) WithdrawContractRewards(ctx sdk.Context, contractAddr sdk.AccAddress, req rewardsMsgTypes.WithdrawRewardsRequest) ([]sdk.Event, [][]byte, error) {
 	if err := req.Validate(); err != nil {
@@ -52,10 +88,14 @@ func (h MsgHandler) WithdrawContractRewards(ctx sdk.Context, contractAddr sdk.Ac
 	var recordsUsed int
 	var err error
 
-	if req.RecordsLimit != nil {
+	switch {
+	case req.RecordsLimit != nil && req.IBCTransfer != nil:
+		totalRewards, recordsUsed, err = h.rewardsKeeper.IBCWithdrawRewardsByRecordsLimit(ctx, contractAddr, *req.RecordsLimit, req.IBCTransfer.ToSDK())
+	case req.RecordsLimit != nil:
 		totalRewards, recordsUsed, err = h.rewardsKeeper.WithdrawRewardsByRecordsLimit(ctx, contractAddr, *req.RecordsLimit)
-	}
-	if len(req.RecordIDs) > 0 {
+	case len(req.RecordIDs) > 0 && req.IBCTransfer != nil:
+		totalRewards, recordsUsed, err = h.rewardsKeeper.IBCWithdrawRewardsByRecordIDs(ctx, contractAddr, req.RecordIDs, req.IBCTransfer.ToSDK())
+	case len(req.RecordIDs) > 0:
 		totalRewards, recordsUsed, err = h.rewardsKeeper.WithdrawRewardsByRecordIDs(ctx, contractAddr, req.RecordIDs)
 	}
 	if err != nil {
@@ -69,3 +109,86 @@ func (h MsgHandler) WithdrawContractRewards(ctx sdk.Context, contractAddr sdk.Ac
 
 	return nil, [][]byte{resBz}, nil
 }
+
+// WithdrawContractRewardsAndDelegate withdraws the rewards for the contract address and delegates the staking denom rewards to the validator.
+func (h MsgHandler) WithdrawContractRewardsAndDelegate(ctx sdk.Context, contractAddr sdk.AccAddress, req rewardsMsgTypes.WithdrawRewardsAndDelegateRequest) ([]sdk.Event, [][]byte, error) {
+	if err := req.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("withdrawRewardsAndDelegate: %w", err)
+	}
+
+	valAddr := req.MustGetValidatorAddress()
+
+	var totalRewards sdk.Coins
+	var recordsUsed int
+	var delegatedAmt sdk.Coin
+	var err error
+
+	switch {
+	case req.RecordsLimit != nil:
+		totalRewards, recordsUsed, delegatedAmt, err = h.rewardsKeeper.WithdrawRewardsAndDelegateByRecordsLimit(ctx, contractAddr, *req.RecordsLimit, valAddr)
+	case len(req.RecordIDs) > 0:
+		totalRewards, recordsUsed, delegatedAmt, err = h.rewardsKeeper.WithdrawRewardsAndDelegateByRecordIDs(ctx, contractAddr, req.RecordIDs, valAddr)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resBz, err := json.Marshal(rewardsMsgTypes.NewWithdrawRewardsAndDelegateResponse(totalRewards, recordsUsed, delegatedAmt))
+	if err != nil {
+		return nil, nil, fmt.Errorf("result JSON marshal: %w", err)
+	}
+
+	return nil, [][]byte{resBz}, nil
+}
+
+// SetFlatFee sets the contract flat fee (contract must be the metadata owner).
+func (h MsgHandler) SetFlatFee(ctx sdk.Context, contractAddr sdk.AccAddress, req rewardsMsgTypes.SetFlatFeeRequest) ([]sdk.Event, [][]byte, error) {
+	if err := req.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("setFlatFee: %w", err)
+	}
+
+	if err := h.rewardsKeeper.SetFlatFee(ctx, contractAddr, contractAddr, req.MustGetFlatFee()); err != nil {
+		return nil, nil, err
+	}
+
+	return nil, nil, nil
+}
+
+// SetSponsorship creates / updates the contract sponsorship limits (contract is the sponsor).
+func (h MsgHandler) SetSponsorship(ctx sdk.Context, contractAddr sdk.AccAddress, req rewardsMsgTypes.SetSponsorshipRequest) ([]sdk.Event, [][]byte, error) {
+	if err := req.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("setSponsorship: %w", err)
+	}
+
+	if err := h.rewardsKeeper.SetSponsorship(ctx, contractAddr, contractAddr, req.MustGetUserFeeLimit(), req.UserFeeLimitPeriod, req.MustGetBlockFeeLimit(), req.FundFromRewards); err != nil {
+		return nil, nil, err
+	}
+
+	return nil, nil, nil
+}
+
+// DepositSponsorship funds the contract sponsorship deposit from the contract balance.
+func (h MsgHandler) DepositSponsorship(ctx sdk.Context, contractAddr sdk.AccAddress, req rewardsMsgTypes.SponsorshipFundsRequest) ([]sdk.Event, [][]byte, error) {
+	if err := req.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("depositSponsorship: %w", err)
+	}
+
+	if err := h.rewardsKeeper.DepositSponsorship(ctx, contractAddr, contractAddr, req.MustGetAmount()); err != nil {
+		return nil, nil, err
+	}
+
+	return nil, nil, nil
+}
+
+// WithdrawSponsorship withdraws funds from the contract sponsorship deposit to the contract balance.
+func (h MsgHandler) WithdrawSponsorship(ctx sdk.Context, contractAddr sdk.AccAddress, req rewardsMsgTypes.SponsorshipFundsRequest) ([]sdk.Event, [][]byte, error) {
+	if err := req.Validate(); err != nil {
+		return nil, nil, fmt.Errorf("withdrawSponsorship: %w", err)
+	}
+
+	if err := h.rewardsKeeper.WithdrawSponsorship(ctx, contractAddr, contractAddr, req.MustGetAmount()); err != nil {
+		return nil, nil, err
+	}
+
+	return nil, nil, nil
+}
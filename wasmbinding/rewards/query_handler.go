@@ -16,6 +16,9 @@ type KeeperReaderExpected interface {
 	GetContractMetadata(ctx sdk.Context, contractAddr sdk.AccAddress) *rewardsTypes.ContractMetadata
 	GetRewardsRecords(ctx sdk.Context, rewardsAddr sdk.AccAddress, pageReq *query.PageRequest) ([]rewardsTypes.RewardsRecord, *query.PageResponse, error)
 	MaxWithdrawRecords(ctx sdk.Context) uint64
+	GetFlatFee(ctx sdk.Context, contractAddr sdk.AccAddress) (sdk.Coin, bool)
+	GetSponsorship(ctx sdk.Context, contractAddr sdk.AccAddress) (rewardsTypes.Sponsorship, bool)
+	GetSponsoredUserFees(ctx sdk.Context, contractAddr, userAddr sdk.AccAddress) sdk.Coins
 }
 
 // QueryHandler provides a custom WASM query handler for the x/rewards module.
@@ -63,3 +66,38 @@ func (h QueryHandler) GetRewardsRecords(ctx sdk.Context, req types.RewardsRecord
 
 	return types.NewRewardsRecordsResponse(records, *pageResp), nil
 }
+
+// GetFlatFee returns the contract flat fee.
+func (h QueryHandler) GetFlatFee(ctx sdk.Context, req types.FlatFeeRequest) (types.FlatFeeResponse, error) {
+	if err := req.Validate(); err != nil {
+		return types.FlatFeeResponse{}, fmt.Errorf("flatFee: %w", err)
+	}
+
+	flatFee, found := h.rewardsKeeper.GetFlatFee(ctx, req.MustGetContractAddress())
+	if !found {
+		return types.FlatFeeResponse{}, sdkErrors.Wrap(rewardsTypes.ErrInvalidRequest, "flat fee not found")
+	}
+
+	return types.NewFlatFeeResponse(flatFee), nil
+}
+
+// GetSponsorship returns the contract sponsorship and the amount of fees sponsored for the user (if set).
+func (h QueryHandler) GetSponsorship(ctx sdk.Context, req types.SponsorshipRequest) (types.SponsorshipResponse, error) {
+	if err := req.Validate(); err != nil {
+		return types.SponsorshipResponse{}, fmt.Errorf("sponsorship: %w", err)
+	}
+
+	contractAddr := req.MustGetContractAddress()
+
+	sponsorship, found := h.rewardsKeeper.GetSponsorship(ctx, contractAddr)
+	if !found {
+		return types.SponsorshipResponse{}, rewardsTypes.ErrSponsorshipNotFound
+	}
+
+	userFees := sdk.NewCoins()
+	if userAddr := req.MustGetUserAddress(); userAddr != nil {
+		userFees = h.rewardsKeeper.GetSponsoredUserFees(ctx, contractAddr, userAddr)
+	}
+
+	return types.NewSponsorshipResponse(sponsorship, userFees), nil
+}
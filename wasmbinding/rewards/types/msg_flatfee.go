@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+
+	wasmVmTypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/archway-network/archway/pkg"
+	wasmPkg "github.com/archway-network/archway/wasmbinding/pkg"
+)
+
+// SetFlatFeeRequest is the Msg.SetFlatFee request.
+type SetFlatFeeRequest struct {
+	// FlatFeeAmount defines the minimum flat fee set by the contract owner per contract execution.
+	// Zero amount removes the flat fee.
+	FlatFeeAmount wasmVmTypes.Coin `json:"flat_fee_amount"`
+}
+
+// Validate performs request fields validation.
+func (r SetFlatFeeRequest) Validate() error {
+	coin, err := wasmPkg.WasmCoinToSDK(r.FlatFeeAmount)
+	if err != nil {
+		return fmt.Errorf("flatFeeAmount: %w", err)
+	}
+
+	if err := pkg.ValidateCoin(coin); err != nil {
+		return fmt.Errorf("flatFeeAmount: %w", err)
+	}
+
+	return nil
+}
+
+// MustGetFlatFee returns the flat fee as sdk.Coin.
+// CONTRACT: panics in case of an error (should not happen since we validate the request).
+func (r SetFlatFeeRequest) MustGetFlatFee() sdk.Coin {
+	coin, err := wasmPkg.WasmCoinToSDK(r.FlatFeeAmount)
+	if err != nil {
+		// Should not happen since we validate the request before this call
+		panic(fmt.Errorf("wasm bindings: setFlatFee request: parsing flatFeeAmount: %w", err))
+	}
+
+	return coin
+}
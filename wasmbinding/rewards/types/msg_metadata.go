@@ -10,10 +10,28 @@ import (
 
 // UpdateContractMetadataRequest is the Msg.UpdateMetadata request.
 type UpdateContractMetadataRequest struct {
-	// OwnerAddress if not empty, changes the contract metadata ownership.
+	// OwnerAddress if not empty, nominates a new contract metadata owner (pending owner has to accept the ownership).
+	// Ownership transfer to the contract itself is applied immediately.
 	OwnerAddress string `json:"owner_address"`
 	// RewardsAddress if not empty, changes the rewards distribution destination address.
 	RewardsAddress string `json:"rewards_address"`
+	// RewardsRecipients if not empty, changes the rewards distribution destinations to a weighted list.
+	// Can not be set together with RewardsAddress.
+	RewardsRecipients []RewardsRecipient `json:"rewards_recipients,omitempty"`
+}
+
+// ContractMetadataOwnershipRequest is the Msg.AcceptContractMetadataOwnership / Msg.CancelContractMetadataOwnership request.
+type ContractMetadataOwnershipRequest struct {
+	// ContractAddress is the bech32 encoded address of the contract which metadata ownership transfer is in progress.
+	ContractAddress string `json:"contract_address"`
+}
+
+// RewardsRecipient is the weighted rewards distribution destination.
+type RewardsRecipient struct {
+	// Address is the bech32 encoded address to distribute rewards to.
+	Address string `json:"address"`
+	// Weight is the share of contract rewards for this recipient (decimal string, 0.0 < weight <= 1.0).
+	Weight string `json:"weight"`
 }
 
 // Validate performs request fields validation.
@@ -34,6 +52,22 @@ func (r UpdateContractMetadataRequest) Validate() error {
 		changeCnt++
 	}
 
+	if len(r.RewardsRecipients) > 0 {
+		if r.RewardsAddress != "" {
+			return fmt.Errorf("rewardsRecipients: can not be set together with rewardsAddress")
+		}
+
+		recipients, err := r.rewardsRecipientsToSDK()
+		if err != nil {
+			return fmt.Errorf("rewardsRecipients: %w", err)
+		}
+
+		if err := rewardsTypes.ValidateRewardsRecipients(recipients); err != nil {
+			return fmt.Errorf("rewardsRecipients: %w", err)
+		}
+		changeCnt++
+	}
+
 	if changeCnt == 0 {
 		return fmt.Errorf("empty request")
 	}
@@ -42,11 +76,58 @@ func (r UpdateContractMetadataRequest) Validate() error {
 }
 
 // ToSDK convert the UpdateMetadataRequest to a rewardsTypes.Metadata.
+// CONTRACT: panics in case of an error (should not happen since we validate the request).
 func (r UpdateContractMetadataRequest) ToSDK() rewardsTypes.ContractMetadata {
+	recipients, err := r.rewardsRecipientsToSDK()
+	if err != nil {
+		// Should not happen since we validate the request before this call
+		panic(fmt.Errorf("wasm bindings: meta update: parsing rewardsRecipients: %w", err))
+	}
+
 	return rewardsTypes.ContractMetadata{
-		OwnerAddress:   r.OwnerAddress,
-		RewardsAddress: r.RewardsAddress,
+		OwnerAddress:      r.OwnerAddress,
+		RewardsAddress:    r.RewardsAddress,
+		RewardsRecipients: recipients,
+	}
+}
+
+// rewardsRecipientsToSDK converts the RewardsRecipients to rewardsTypes.RewardsRecipient slice.
+func (r UpdateContractMetadataRequest) rewardsRecipientsToSDK() ([]rewardsTypes.RewardsRecipient, error) {
+	if len(r.RewardsRecipients) == 0 {
+		return nil, nil
+	}
+
+	recipients := make([]rewardsTypes.RewardsRecipient, 0, len(r.RewardsRecipients))
+	for i, recipient := range r.RewardsRecipients {
+		weight, err := sdk.NewDecFromStr(recipient.Weight)
+		if err != nil {
+			return nil, fmt.Errorf("recipient [%d]: parsing weight: %w", i, err)
+		}
+
+		recipients = append(recipients, rewardsTypes.RewardsRecipient{
+			Address: recipient.Address,
+			Weight:  weight,
+		})
 	}
+
+	return recipients, nil
+}
+
+// NewRewardsRecipients converts rewardsTypes.RewardsRecipient slice to RewardsRecipient slice.
+func NewRewardsRecipients(recipients []rewardsTypes.RewardsRecipient) []RewardsRecipient {
+	if len(recipients) == 0 {
+		return nil
+	}
+
+	res := make([]RewardsRecipient, 0, len(recipients))
+	for _, recipient := range recipients {
+		res = append(res, RewardsRecipient{
+			Address: recipient.Address,
+			Weight:  recipient.Weight.String(),
+		})
+	}
+
+	return res
 }
 
 // MustGetOwnerAddressOk returns the contract owner address as sdk.AccAddress if set to be updated.
@@ -79,3 +160,24 @@ func (r UpdateContractMetadataRequest) MustGetRewardsAddressOk() (*sdk.AccAddres
 
 	return &addr, true
 }
+
+// Validate performs request fields validation.
+func (r ContractMetadataOwnershipRequest) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.ContractAddress); err != nil {
+		return fmt.Errorf("contractAddress: parsing: %w", err)
+	}
+
+	return nil
+}
+
+// MustGetContractAddress returns the contract address as sdk.AccAddress.
+// CONTRACT: panics in case of an error (should not happen since we validate the request).
+func (r ContractMetadataOwnershipRequest) MustGetContractAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(r.ContractAddress)
+	if err != nil {
+		// Should not happen since we validate the request before this call
+		panic(fmt.Errorf("wasm bindings: meta ownership request: parsing contractAddress: %w", err))
+	}
+
+	return addr
+}
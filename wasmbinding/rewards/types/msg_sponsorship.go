@@ -0,0 +1,111 @@
+package types
+
+import (
+	"fmt"
+
+	wasmVmTypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmPkg "github.com/archway-network/archway/wasmbinding/pkg"
+)
+
+// SetSponsorshipRequest is the Msg.SetSponsorship request.
+type SetSponsorshipRequest struct {
+	// UserFeeLimit defines the maximum amount of fees sponsored for a single user within a UserFeeLimitPeriod (empty to disable the sponsorship).
+	UserFeeLimit wasmVmTypes.Coins `json:"user_fee_limit"`
+	// UserFeeLimitPeriod defines the number of blocks the UserFeeLimit is applied within (must be set if the UserFeeLimit is set).
+	UserFeeLimitPeriod uint64 `json:"user_fee_limit_period"`
+	// BlockFeeLimit defines the maximum amount of fees sponsored within a single block (empty to disable the sponsorship).
+	BlockFeeLimit wasmVmTypes.Coins `json:"block_fee_limit"`
+	// FundFromRewards flag defines whether the contract rewards are pledged to the sponsorship deposit.
+	FundFromRewards bool `json:"fund_from_rewards"`
+}
+
+// SponsorshipFundsRequest is the Msg.DepositSponsorship and the Msg.WithdrawSponsorship request.
+type SponsorshipFundsRequest struct {
+	// Amount defines the funds to deposit / withdraw.
+	Amount wasmVmTypes.Coins `json:"amount"`
+}
+
+// Validate performs request fields validation.
+func (r SetSponsorshipRequest) Validate() error {
+	userFeeLimit, err := parseCoins(r.UserFeeLimit)
+	if err != nil {
+		return fmt.Errorf("userFeeLimit: %w", err)
+	}
+
+	if !userFeeLimit.Empty() && r.UserFeeLimitPeriod == 0 {
+		return fmt.Errorf("userFeeLimitPeriod: must be GT 0 if userFeeLimit is set")
+	}
+
+	if _, err := parseCoins(r.BlockFeeLimit); err != nil {
+		return fmt.Errorf("blockFeeLimit: %w", err)
+	}
+
+	return nil
+}
+
+// MustGetUserFeeLimit returns the user fee limit as sdk.Coins.
+// CONTRACT: panics in case of an error (should not happen since we validate the request).
+func (r SetSponsorshipRequest) MustGetUserFeeLimit() sdk.Coins {
+	coins, err := parseCoins(r.UserFeeLimit)
+	if err != nil {
+		// Should not happen since we validate the request before this call
+		panic(fmt.Errorf("wasm bindings: setSponsorship request: parsing userFeeLimit: %w", err))
+	}
+
+	return coins
+}
+
+// MustGetBlockFeeLimit returns the block fee limit as sdk.Coins.
+// CONTRACT: panics in case of an error (should not happen since we validate the request).
+func (r SetSponsorshipRequest) MustGetBlockFeeLimit() sdk.Coins {
+	coins, err := parseCoins(r.BlockFeeLimit)
+	if err != nil {
+		// Should not happen since we validate the request before this call
+		panic(fmt.Errorf("wasm bindings: setSponsorship request: parsing blockFeeLimit: %w", err))
+	}
+
+	return coins
+}
+
+// Validate performs request fields validation.
+func (r SponsorshipFundsRequest) Validate() error {
+	coins, err := parseCoins(r.Amount)
+	if err != nil {
+		return fmt.Errorf("amount: %w", err)
+	}
+
+	if coins.IsZero() {
+		return fmt.Errorf("amount: must be non-zero")
+	}
+
+	return nil
+}
+
+// MustGetAmount returns the amount as sdk.Coins.
+// CONTRACT: panics in case of an error (should not happen since we validate the request).
+func (r SponsorshipFundsRequest) MustGetAmount() sdk.Coins {
+	coins, err := parseCoins(r.Amount)
+	if err != nil {
+		// Should not happen since we validate the request before this call
+		panic(fmt.Errorf("wasm bindings: sponsorshipFunds request: parsing amount: %w", err))
+	}
+
+	return coins
+}
+
+// parseCoins converts wasmVmTypes.Coins to a valid sdk.Coins.
+func parseCoins(coins wasmVmTypes.Coins) (sdk.Coins, error) {
+	coinsSDK, err := wasmPkg.WasmCoinsToSDK(coins)
+	if err != nil {
+		return nil, err
+	}
+
+	coinsSDK = coinsSDK.Sort()
+	if err := coinsSDK.Validate(); err != nil {
+		return nil, err
+	}
+
+	return coinsSDK, nil
+}
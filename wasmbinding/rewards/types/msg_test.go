@@ -3,6 +3,9 @@ package types
 import (
 	"testing"
 
+	wasmVmTypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/stretchr/testify/assert"
 
 	"github.com/archway-network/archway/pkg"
@@ -42,6 +45,44 @@ func TestUpdateContractMetadataRequestValidate(t *testing.T) {
 			},
 			errExpected: true,
 		},
+		{
+			name: "OK: UpdateMetadata with RewardsRecipients",
+			msg: UpdateContractMetadataRequest{
+				RewardsRecipients: []RewardsRecipient{
+					{Address: "cosmos1zj8lgj0zp06c8n4rreyzgu3tls9yhy4mm4vu8c", Weight: "0.25"},
+					{Address: "cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd02", Weight: "0.75"},
+				},
+			},
+		},
+		{
+			name: "Fail: invalid UpdateMetadataRequest: RewardsAddress and RewardsRecipients",
+			msg: UpdateContractMetadataRequest{
+				RewardsAddress: "cosmos1zj8lgj0zp06c8n4rreyzgu3tls9yhy4mm4vu8c",
+				RewardsRecipients: []RewardsRecipient{
+					{Address: "cosmos1zj8lgj0zp06c8n4rreyzgu3tls9yhy4mm4vu8c", Weight: "1.0"},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid UpdateMetadataRequest: RewardsRecipients: invalid weight",
+			msg: UpdateContractMetadataRequest{
+				RewardsRecipients: []RewardsRecipient{
+					{Address: "cosmos1zj8lgj0zp06c8n4rreyzgu3tls9yhy4mm4vu8c", Weight: "one"},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid UpdateMetadataRequest: RewardsRecipients: weights sum",
+			msg: UpdateContractMetadataRequest{
+				RewardsRecipients: []RewardsRecipient{
+					{Address: "cosmos1zj8lgj0zp06c8n4rreyzgu3tls9yhy4mm4vu8c", Weight: "0.5"},
+					{Address: "cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd02", Weight: "0.4"},
+				},
+			},
+			errExpected: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -109,6 +150,112 @@ func TestWithdrawRewardsRequestValidate(t *testing.T) {
 			},
 			errExpected: true,
 		},
+		{
+			name: "OK: WithdrawRewards with IBCTransfer",
+			msg: WithdrawRewardsRequest{
+				RecordIDs: []uint64{1},
+				IBCTransfer: &IBCTransferRequest{
+					SourceChannel:    "channel-0",
+					Receiver:         "cosmos1receiver",
+					TimeoutTimestamp: 1,
+				},
+			},
+		},
+		{
+			name: "Fail: invalid WithdrawRewards: IBCTransfer: invalid channel",
+			msg: WithdrawRewardsRequest{
+				RecordsLimit: pkg.Uint64Ptr(1),
+				IBCTransfer: &IBCTransferRequest{
+					SourceChannel:    "invalid channel",
+					Receiver:         "cosmos1receiver",
+					TimeoutTimestamp: 1,
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid WithdrawRewards: IBCTransfer: empty receiver",
+			msg: WithdrawRewardsRequest{
+				RecordsLimit: pkg.Uint64Ptr(1),
+				IBCTransfer: &IBCTransferRequest{
+					SourceChannel:    "channel-0",
+					TimeoutTimestamp: 1,
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid WithdrawRewards: IBCTransfer: zero timeout",
+			msg: WithdrawRewardsRequest{
+				RecordsLimit: pkg.Uint64Ptr(1),
+				IBCTransfer: &IBCTransferRequest{
+					SourceChannel: "channel-0",
+					Receiver:      "cosmos1receiver",
+				},
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.Validate()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestWithdrawRewardsAndDelegateRequestValidate(t *testing.T) {
+	type testCase struct {
+		name        string
+		msg         WithdrawRewardsAndDelegateRequest
+		errExpected bool
+	}
+
+	valAddr := sdk.ValAddress(make([]byte, 20)).String()
+
+	testCases := []testCase{
+		{
+			name: "OK: RecordsLimit",
+			msg: WithdrawRewardsAndDelegateRequest{
+				ValidatorAddress: valAddr,
+				RecordsLimit:     pkg.Uint64Ptr(1),
+			},
+		},
+		{
+			name: "OK: RecordIDs",
+			msg: WithdrawRewardsAndDelegateRequest{
+				ValidatorAddress: valAddr,
+				RecordIDs:        []uint64{1},
+			},
+		},
+		{
+			name: "Fail: invalid ValidatorAddress",
+			msg: WithdrawRewardsAndDelegateRequest{
+				ValidatorAddress: "invalid",
+				RecordsLimit:     pkg.Uint64Ptr(1),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: no mode set",
+			msg: WithdrawRewardsAndDelegateRequest{
+				ValidatorAddress: valAddr,
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: duplicated ID",
+			msg: WithdrawRewardsAndDelegateRequest{
+				ValidatorAddress: valAddr,
+				RecordIDs:        []uint64{1, 1},
+			},
+			errExpected: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -122,3 +269,175 @@ func TestWithdrawRewardsRequestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestSetFlatFeeRequestValidate(t *testing.T) {
+	type testCase struct {
+		name        string
+		req         SetFlatFeeRequest
+		errExpected bool
+	}
+
+	testCases := []testCase{
+		{
+			name: "OK",
+			req: SetFlatFeeRequest{
+				FlatFeeAmount: wasmVmTypes.Coin{Denom: "uarch", Amount: "100"},
+			},
+		},
+		{
+			name: "OK: zero amount",
+			req: SetFlatFeeRequest{
+				FlatFeeAmount: wasmVmTypes.Coin{Denom: "uarch", Amount: "0"},
+			},
+		},
+		{
+			name: "Fail: invalid amount",
+			req: SetFlatFeeRequest{
+				FlatFeeAmount: wasmVmTypes.Coin{Denom: "uarch", Amount: "1.5"},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid denom",
+			req: SetFlatFeeRequest{
+				FlatFeeAmount: wasmVmTypes.Coin{Denom: "1", Amount: "100"},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: negative amount",
+			req: SetFlatFeeRequest{
+				FlatFeeAmount: wasmVmTypes.Coin{Denom: "uarch", Amount: "-1"},
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.req.Validate()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSetSponsorshipRequestValidate(t *testing.T) {
+	type testCase struct {
+		name        string
+		req         SetSponsorshipRequest
+		errExpected bool
+	}
+
+	testCases := []testCase{
+		{
+			name: "OK",
+			req: SetSponsorshipRequest{
+				UserFeeLimit:       wasmVmTypes.Coins{{Denom: "uarch", Amount: "100"}},
+				UserFeeLimitPeriod: 100,
+				BlockFeeLimit:      wasmVmTypes.Coins{{Denom: "uarch", Amount: "1000"}},
+				FundFromRewards:    true,
+			},
+		},
+		{
+			name: "OK: no limits",
+			req:  SetSponsorshipRequest{},
+		},
+		{
+			name: "OK: unsorted coins",
+			req: SetSponsorshipRequest{
+				UserFeeLimit:       wasmVmTypes.Coins{{Denom: "uarch", Amount: "100"}, {Denom: "stake", Amount: "100"}},
+				UserFeeLimitPeriod: 1,
+			},
+		},
+		{
+			name: "Fail: invalid user fee limit amount",
+			req: SetSponsorshipRequest{
+				UserFeeLimit: wasmVmTypes.Coins{{Denom: "uarch", Amount: "1.5"}},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: user fee limit period is not set",
+			req: SetSponsorshipRequest{
+				UserFeeLimit: wasmVmTypes.Coins{{Denom: "uarch", Amount: "100"}},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: zero block fee limit amount",
+			req: SetSponsorshipRequest{
+				BlockFeeLimit: wasmVmTypes.Coins{{Denom: "uarch", Amount: "0"}},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: duplicated block fee limit denoms",
+			req: SetSponsorshipRequest{
+				BlockFeeLimit: wasmVmTypes.Coins{{Denom: "uarch", Amount: "1"}, {Denom: "uarch", Amount: "2"}},
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.req.Validate()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSponsorshipFundsRequestValidate(t *testing.T) {
+	type testCase struct {
+		name        string
+		req         SponsorshipFundsRequest
+		errExpected bool
+	}
+
+	testCases := []testCase{
+		{
+			name: "OK",
+			req: SponsorshipFundsRequest{
+				Amount: wasmVmTypes.Coins{{Denom: "uarch", Amount: "100"}},
+			},
+		},
+		{
+			name:        "Fail: empty amount",
+			req:         SponsorshipFundsRequest{},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid denom",
+			req: SponsorshipFundsRequest{
+				Amount: wasmVmTypes.Coins{{Denom: "1", Amount: "100"}},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: negative amount",
+			req: SponsorshipFundsRequest{
+				Amount: wasmVmTypes.Coins{{Denom: "uarch", Amount: "-1"}},
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.req.Validate()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
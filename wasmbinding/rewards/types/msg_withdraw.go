@@ -6,6 +6,8 @@ import (
 	wasmdTypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	wasmVmTypes "github.com/CosmWasm/wasmvm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
 )
 
 // WithdrawRewardsRequest is the Msg.WithdrawRewards request.
@@ -18,6 +20,18 @@ type WithdrawRewardsRequest struct {
 	// RecordIDs defines specific RewardsRecord object IDs to process.
 	// Only one of (RecordsLimit, RecordIDs) should be set.
 	RecordIDs []uint64 `json:"record_ids"`
+	// IBCTransfer if set, rewards are transferred to the receiver on another chain via IBC (the contract is the transfer sender).
+	IBCTransfer *IBCTransferRequest `json:"ibc_transfer,omitempty"`
+}
+
+// IBCTransferRequest defines the ICS-20 transfer options to withdraw rewards to an address on another chain.
+type IBCTransferRequest struct {
+	// SourceChannel is the ICS-20 transfer channel ID (the "transfer" port is used).
+	SourceChannel string `json:"source_channel"`
+	// Receiver is the rewards receiver address on the counterparty chain.
+	Receiver string `json:"receiver"`
+	// TimeoutTimestamp is the transfer timeout timestamp (UNIX time in nanoseconds) on the counterparty chain.
+	TimeoutTimestamp uint64 `json:"timeout_timestamp"`
 }
 
 // WithdrawRewardsResponse is the Msg.WithdrawRewards response.
@@ -28,6 +42,30 @@ type WithdrawRewardsResponse struct {
 	TotalRewards wasmVmTypes.Coins `json:"total_rewards"`
 }
 
+// WithdrawRewardsAndDelegateRequest is the Msg.WithdrawRewardsAndDelegate request.
+type WithdrawRewardsAndDelegateRequest struct {
+	// ValidatorAddress is the validator address to delegate the staking denom rewards to (bech32 encoded).
+	ValidatorAddress string `json:"validator_address"`
+	// RecordsLimit defines the maximum number of RewardsRecord objects to process.
+	// Limit should not exceed the MaxWithdrawRecords param value.
+	// If 0 value is passed, the MaxWithdrawRecords value is used.
+	// Only one of (RecordsLimit, RecordIDs) should be set.
+	RecordsLimit *uint64 `json:"records_limit"`
+	// RecordIDs defines specific RewardsRecord object IDs to process.
+	// Only one of (RecordsLimit, RecordIDs) should be set.
+	RecordIDs []uint64 `json:"record_ids"`
+}
+
+// WithdrawRewardsAndDelegateResponse is the Msg.WithdrawRewardsAndDelegate response.
+type WithdrawRewardsAndDelegateResponse struct {
+	// RecordsNum is the number of RewardsRecord objects processed by the request.
+	RecordsNum uint64 `json:"records_num"`
+	// TotalRewards are the total rewards distributed.
+	TotalRewards wasmVmTypes.Coins `json:"total_rewards"`
+	// DelegatedAmount is the staking denom part of TotalRewards delegated to the validator.
+	DelegatedAmount wasmVmTypes.Coin `json:"delegated_amount"`
+}
+
 // Validate performs request fields validation.
 func (r WithdrawRewardsRequest) Validate() error {
 	if (r.RecordsLimit == nil && len(r.RecordIDs) == 0) || (r.RecordsLimit != nil && len(r.RecordIDs) > 0) {
@@ -46,9 +84,43 @@ func (r WithdrawRewardsRequest) Validate() error {
 		idsSet[id] = struct{}{}
 	}
 
+	if r.IBCTransfer != nil {
+		if err := r.IBCTransfer.ToSDK().Validate(); err != nil {
+			return fmt.Errorf("ibcTransfer: %w", err)
+		}
+	}
+
 	return nil
 }
 
+// Validate performs request fields validation.
+func (r WithdrawRewardsAndDelegateRequest) Validate() error {
+	if _, err := sdk.ValAddressFromBech32(r.ValidatorAddress); err != nil {
+		return fmt.Errorf("validatorAddress: parsing: %w", err)
+	}
+
+	return WithdrawRewardsRequest{
+		RecordsLimit: r.RecordsLimit,
+		RecordIDs:    r.RecordIDs,
+	}.Validate()
+}
+
+// MustGetValidatorAddress returns the validator address.
+// CONTRACT: panics in case of an error (should not happen since we validate the request).
+func (r WithdrawRewardsAndDelegateRequest) MustGetValidatorAddress() sdk.ValAddress {
+	addr, err := sdk.ValAddressFromBech32(r.ValidatorAddress)
+	if err != nil {
+		panic(fmt.Errorf("wasm bindings: withdrawRewardsAndDelegate request: parsing validatorAddress: %w", err))
+	}
+
+	return addr
+}
+
+// ToSDK converts the IBCTransferRequest to the x/rewards MsgWithdrawRewards_IBCTransfer.
+func (r IBCTransferRequest) ToSDK() rewardsTypes.MsgWithdrawRewards_IBCTransfer {
+	return *rewardsTypes.NewMsgWithdrawRewardsIBCTransfer(r.SourceChannel, r.Receiver, r.TimeoutTimestamp)
+}
+
 // NewWithdrawRewardsResponse creates a new WithdrawRewardsResponse.
 func NewWithdrawRewardsResponse(totalRewards sdk.Coins, recordsUsed int) WithdrawRewardsResponse {
 	return WithdrawRewardsResponse{
@@ -56,3 +128,12 @@ func NewWithdrawRewardsResponse(totalRewards sdk.Coins, recordsUsed int) Withdra
 		TotalRewards: wasmdTypes.NewWasmCoins(totalRewards),
 	}
 }
+
+// NewWithdrawRewardsAndDelegateResponse creates a new WithdrawRewardsAndDelegateResponse.
+func NewWithdrawRewardsAndDelegateResponse(totalRewards sdk.Coins, recordsUsed int, delegatedAmt sdk.Coin) WithdrawRewardsAndDelegateResponse {
+	return WithdrawRewardsAndDelegateResponse{
+		RecordsNum:      uint64(recordsUsed),
+		TotalRewards:    wasmdTypes.NewWasmCoins(totalRewards),
+		DelegatedAmount: wasmdTypes.NewWasmCoins(sdk.Coins{delegatedAmt})[0],
+	}
+}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"fmt"
+
+	wasmVmTypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FlatFeeRequest is the Query.FlatFee request.
+type FlatFeeRequest struct {
+	// ContractAddress is the bech32 encoded contract address.
+	ContractAddress string `json:"contract_address"`
+}
+
+// FlatFeeResponse is the Query.FlatFee response.
+type FlatFeeResponse struct {
+	// FlatFeeAmount is the minimum flat fee set by the contract owner per contract execution.
+	FlatFeeAmount wasmVmTypes.Coin `json:"flat_fee_amount"`
+}
+
+// Validate performs request fields validation.
+func (r FlatFeeRequest) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.ContractAddress); err != nil {
+		return fmt.Errorf("contractAddress: parsing: %w", err)
+	}
+
+	return nil
+}
+
+// MustGetContractAddress returns the contract address as sdk.AccAddress.
+// CONTRACT: panics in case of an error (should not happen since we validate the request).
+func (r FlatFeeRequest) MustGetContractAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(r.ContractAddress)
+	if err != nil {
+		// Should not happen since we validate the request before this call
+		panic(fmt.Errorf("wasm bindings: flatFee request: parsing contractAddress: %w", err))
+	}
+
+	return addr
+}
+
+// NewFlatFeeResponse creates a new FlatFeeResponse.
+func NewFlatFeeResponse(flatFee sdk.Coin) FlatFeeResponse {
+	return FlatFeeResponse{
+		FlatFeeAmount: wasmVmTypes.Coin{
+			Denom:  flatFee.Denom,
+			Amount: flatFee.Amount.String(),
+		},
+	}
+}
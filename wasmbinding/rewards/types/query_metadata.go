@@ -20,6 +20,10 @@ type ContractMetadataResponse struct {
 	OwnerAddress string `json:"owner_address"`
 	// RewardsAddress is the target address for rewards distribution.
 	RewardsAddress string `json:"rewards_address"`
+	// RewardsRecipients is the weighted list of rewards distribution targets (if set, RewardsAddress is empty).
+	RewardsRecipients []RewardsRecipient `json:"rewards_recipients,omitempty"`
+	// PendingOwnerAddress is the nominated owner address (if the ownership transfer is in progress).
+	PendingOwnerAddress string `json:"pending_owner_address,omitempty"`
 }
 
 // Validate performs request fields validation.
@@ -46,7 +50,9 @@ func (r ContractMetadataRequest) MustGetContractAddress() sdk.AccAddress {
 // NewContractMetadataResponse converts rewardsTypes.ContractMetadata to ContractMetadataResponse.
 func NewContractMetadataResponse(meta rewardsTypes.ContractMetadata) ContractMetadataResponse {
 	return ContractMetadataResponse{
-		OwnerAddress:   meta.OwnerAddress,
-		RewardsAddress: meta.RewardsAddress,
+		OwnerAddress:        meta.OwnerAddress,
+		RewardsAddress:      meta.RewardsAddress,
+		RewardsRecipients:   NewRewardsRecipients(meta.RewardsRecipients),
+		PendingOwnerAddress: meta.PendingOwnerAddress,
 	}
 }
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"fmt"
+
+	wasmdTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	wasmVmTypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
+// SponsorshipRequest is the Query.Sponsorship request.
+type SponsorshipRequest struct {
+	// ContractAddress is the bech32 encoded contract address.
+	ContractAddress string `json:"contract_address"`
+	// UserAddress is an optional bech32 encoded user address to query sponsored fees for.
+	UserAddress string `json:"user_address,omitempty"`
+}
+
+// SponsorshipResponse is the Query.Sponsorship response.
+type SponsorshipResponse struct {
+	// Deposit defines the funds left to pay transaction fees with.
+	Deposit wasmVmTypes.Coins `json:"deposit"`
+	// UserFeeLimit defines the maximum amount of fees sponsored for a single user within a UserFeeLimitPeriod (empty if disabled).
+	UserFeeLimit wasmVmTypes.Coins `json:"user_fee_limit"`
+	// UserFeeLimitPeriod defines the number of blocks the UserFeeLimit is applied within.
+	UserFeeLimitPeriod uint64 `json:"user_fee_limit_period"`
+	// BlockFeeLimit defines the maximum amount of fees sponsored within a single block (empty if disabled).
+	BlockFeeLimit wasmVmTypes.Coins `json:"block_fee_limit"`
+	// FundFromRewards flag defines whether the contract rewards are pledged to the sponsorship deposit.
+	FundFromRewards bool `json:"fund_from_rewards"`
+	// UserFees defines the amount of fees sponsored for the user within the current period (empty if the user address is not set).
+	UserFees wasmVmTypes.Coins `json:"user_fees"`
+}
+
+// Validate performs request fields validation.
+func (r SponsorshipRequest) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.ContractAddress); err != nil {
+		return fmt.Errorf("contractAddress: parsing: %w", err)
+	}
+
+	if r.UserAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(r.UserAddress); err != nil {
+			return fmt.Errorf("userAddress: parsing: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// MustGetContractAddress returns the contract address as sdk.AccAddress.
+// CONTRACT: panics in case of an error (should not happen since we validate the request).
+func (r SponsorshipRequest) MustGetContractAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(r.ContractAddress)
+	if err != nil {
+		// Should not happen since we validate the request before this call
+		panic(fmt.Errorf("wasm bindings: sponsorship request: parsing contractAddress: %w", err))
+	}
+
+	return addr
+}
+
+// MustGetUserAddress returns the user address as sdk.AccAddress (nil if not set).
+// CONTRACT: panics in case of an error (should not happen since we validate the request).
+func (r SponsorshipRequest) MustGetUserAddress() sdk.AccAddress {
+	if r.UserAddress == "" {
+		return nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(r.UserAddress)
+	if err != nil {
+		// Should not happen since we validate the request before this call
+		panic(fmt.Errorf("wasm bindings: sponsorship request: parsing userAddress: %w", err))
+	}
+
+	return addr
+}
+
+// NewSponsorshipResponse creates a new SponsorshipResponse.
+func NewSponsorshipResponse(sponsorship rewardsTypes.Sponsorship, userFees sdk.Coins) SponsorshipResponse {
+	return SponsorshipResponse{
+		Deposit:            wasmdTypes.NewWasmCoins(sponsorship.Deposit),
+		UserFeeLimit:       wasmdTypes.NewWasmCoins(sponsorship.UserFeeLimit),
+		UserFeeLimitPeriod: sponsorship.UserFeeLimitPeriod,
+		BlockFeeLimit:      wasmdTypes.NewWasmCoins(sponsorship.BlockFeeLimit),
+		FundFromRewards:    sponsorship.FundFromRewards,
+		UserFees:           wasmdTypes.NewWasmCoins(userFees),
+	}
+}
@@ -71,3 +71,84 @@ func TestRewardsRecordsRequestValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestFlatFeeRequestValidate(t *testing.T) {
+	type testCase struct {
+		name        string
+		query       FlatFeeRequest
+		errExpected bool
+	}
+
+	testCases := []testCase{
+		{
+			name: "OK: FlatFee",
+			query: FlatFeeRequest{
+				ContractAddress: "cosmos1zj8lgj0zp06c8n4rreyzgu3tls9yhy4mm4vu8c",
+			},
+		},
+		{
+			name:        "Fail: invalid FlatFee",
+			query:       FlatFeeRequest{},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.query.Validate()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSponsorshipRequestValidate(t *testing.T) {
+	type testCase struct {
+		name        string
+		query       SponsorshipRequest
+		errExpected bool
+	}
+
+	testCases := []testCase{
+		{
+			name: "OK: Sponsorship",
+			query: SponsorshipRequest{
+				ContractAddress: "cosmos1zj8lgj0zp06c8n4rreyzgu3tls9yhy4mm4vu8c",
+			},
+		},
+		{
+			name: "OK: Sponsorship with user",
+			query: SponsorshipRequest{
+				ContractAddress: "cosmos1zj8lgj0zp06c8n4rreyzgu3tls9yhy4mm4vu8c",
+				UserAddress:     "cosmos1hsk6jryyqjfhp5dhc55tc9jtckygx0eph6dd02",
+			},
+		},
+		{
+			name:        "Fail: invalid Sponsorship",
+			query:       SponsorshipRequest{},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid Sponsorship user",
+			query: SponsorshipRequest{
+				ContractAddress: "cosmos1zj8lgj0zp06c8n4rreyzgu3tls9yhy4mm4vu8c",
+				UserAddress:     "invalid",
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.query.Validate()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -12,9 +12,35 @@ type Msg struct {
 	// Request is authorized only if the contract address is set as the DeveloperAddress (metadata field).
 	UpdateContractMetadata *rewardsTypes.UpdateContractMetadataRequest `json:"update_contract_metadata"`
 
+	// AcceptContractMetadataOwnership is a request to accept the contract metadata ownership.
+	// Request is authorized only if the contract address is set as the PendingOwnerAddress (metadata field).
+	AcceptContractMetadataOwnership *rewardsTypes.ContractMetadataOwnershipRequest `json:"accept_contract_metadata_ownership"`
+
+	// CancelContractMetadataOwnership is a request to cancel the pending contract metadata ownership transfer.
+	// Request is authorized only if the contract address is set as the OwnerAddress or the PendingOwnerAddress (metadata fields).
+	CancelContractMetadataOwnership *rewardsTypes.ContractMetadataOwnershipRequest `json:"cancel_contract_metadata_ownership"`
+
 	// WithdrawRewards is a request to withdraw rewards for the contract.
 	// Contract address is used as the rewards address (metadata field).
 	WithdrawRewards *rewardsTypes.WithdrawRewardsRequest `json:"withdraw_rewards"`
+
+	// WithdrawRewardsAndDelegate is a request to withdraw rewards for the contract and delegate the staking denom rewards to a validator.
+	// Contract address is used as the rewards address (metadata field) and the delegator address.
+	WithdrawRewardsAndDelegate *rewardsTypes.WithdrawRewardsAndDelegateRequest `json:"withdraw_rewards_and_delegate"`
+
+	// SetFlatFee is a request to set the contract flat fee charged for every contract execution.
+	// Request is authorized only if the contract address is set as the OwnerAddress (metadata field).
+	SetFlatFee *rewardsTypes.SetFlatFeeRequest `json:"set_flat_fee"`
+
+	// SetSponsorship is a request to create / update the contract transaction fees sponsorship limits.
+	// Contract address is used as the sponsoring contract.
+	SetSponsorship *rewardsTypes.SetSponsorshipRequest `json:"set_sponsorship"`
+
+	// DepositSponsorship is a request to fund the contract sponsorship deposit from the contract balance.
+	DepositSponsorship *rewardsTypes.SponsorshipFundsRequest `json:"deposit_sponsorship"`
+
+	// WithdrawSponsorship is a request to withdraw funds from the contract sponsorship deposit to the contract balance.
+	WithdrawSponsorship *rewardsTypes.SponsorshipFundsRequest `json:"withdraw_sponsorship"`
 }
 
 // Validate validates the msg fields.
@@ -25,10 +51,38 @@ func (m Msg) Validate() error {
 		cnt++
 	}
 
+	if m.AcceptContractMetadataOwnership != nil {
+		cnt++
+	}
+
+	if m.CancelContractMetadataOwnership != nil {
+		cnt++
+	}
+
 	if m.WithdrawRewards != nil {
 		cnt++
 	}
 
+	if m.WithdrawRewardsAndDelegate != nil {
+		cnt++
+	}
+
+	if m.SetFlatFee != nil {
+		cnt++
+	}
+
+	if m.SetSponsorship != nil {
+		cnt++
+	}
+
+	if m.DepositSponsorship != nil {
+		cnt++
+	}
+
+	if m.WithdrawSponsorship != nil {
+		cnt++
+	}
+
 	if cnt != 1 {
 		return fmt.Errorf("one and only one field must be set (fields=%v)", cnt)
 	}
@@ -14,6 +14,12 @@ type Query struct {
 	// RewardsRecords returns a list of RewardsRecord objects that are credited for the account and are ready to be withdrawn.
 	// Request is paginated. If the limit field is not set, the MaxWithdrawRecords param is used.
 	RewardsRecords *rewardsTypes.RewardsRecordsRequest `json:"rewards_records"`
+
+	// FlatFee returns the contract flat fee charged for every contract execution.
+	FlatFee *rewardsTypes.FlatFeeRequest `json:"flat_fee"`
+
+	// Sponsorship returns the contract transaction fees sponsorship and the amount of fees sponsored for an optional user.
+	Sponsorship *rewardsTypes.SponsorshipRequest `json:"sponsorship"`
 }
 
 // Validate validates the query fields.
@@ -28,6 +34,14 @@ func (q Query) Validate() error {
 		cnt++
 	}
 
+	if q.FlatFee != nil {
+		cnt++
+	}
+
+	if q.Sponsorship != nil {
+		cnt++
+	}
+
 	if cnt != 1 {
 		return fmt.Errorf("one and only one sub-query must be set (fields=%v)", cnt)
 	}
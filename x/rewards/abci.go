@@ -11,11 +11,17 @@ import (
 	"github.com/archway-network/archway/x/rewards/types"
 )
 
-// EndBlocker calculates and distributes dApp rewards for the current block updating the treasury.
+// EndBlocker refunds unused gas fees and calculates and distributes dApp rewards for the current block updating the treasury.
+// Expired rewards records are swept to the treasury afterwards and due automatic payouts are performed.
+// The base fee is adjusted using the block gas utilization.
 func EndBlocker(ctx sdk.Context, k keeper.Keeper) []abci.ValidatorUpdate {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 
+	k.RefundUnusedGasFees(ctx, ctx.BlockHeight())
 	k.AllocateBlockRewards(ctx, ctx.BlockHeight())
+	k.ExpireRewardsRecords(ctx)
+	k.ProcessAutoPayouts(ctx)
+	k.UpdateBaseFee(ctx)
 
 	return []abci.ValidatorUpdate{}
 }
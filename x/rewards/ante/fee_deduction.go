@@ -8,8 +8,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
-	wasmdTypes "github.com/CosmWasm/wasmd/x/wasm/types"
-
 	"github.com/archway-network/archway/pkg"
 	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
 )
@@ -19,11 +17,47 @@ var _ sdk.AnteDecorator = DeductFeeDecorator{}
 // TxFeeRewardsKeeperExpected defines the expected interface for the x/rewards keeper.
 type TxFeeRewardsKeeperExpected interface {
 	TxFeeRebateRatio(ctx sdk.Context) sdk.Dec
-	TrackFeeRebatesRewards(ctx sdk.Context, rewards sdk.Coins)
+	TrackFeeRebatesRewards(ctx sdk.Context, rewards, feeCollectorFees sdk.Coins, sponsored bool)
+	UnusedGasRefundRatio(ctx sdk.Context) sdk.Dec
+	GetFlatFee(ctx sdk.Context, contractAddr sdk.AccAddress) (sdk.Coin, bool)
+	CreateFlatFeeRewardsRecords(ctx sdk.Context, contractAddr sdk.AccAddress, flatFee sdk.Coin)
+	GetBaseFeeBurn(ctx sdk.Context, fees sdk.Coins, txGasLimit uint64) sdk.Coins
+	BurnBaseFees(ctx sdk.Context, coins sdk.Coins) error
+	GetMinConsensusFees(ctx sdk.Context) (sdk.DecCoins, bool)
+	GetSponsorship(ctx sdk.Context, contractAddr sdk.AccAddress) (rewardsTypes.Sponsorship, bool)
+	UseSponsorship(ctx sdk.Context, contractAddr, userAddr sdk.AccAddress, fees sdk.Coins) error
+	WithdrawRewardsForFees(ctx sdk.Context, rewardsAddr sdk.AccAddress, fees sdk.Coins) (sdk.Coins, int, error)
+}
+
+// FlatFeeReaderExpected defines the expected interface for the x/rewards keeper to read contract flat fees.
+type FlatFeeReaderExpected interface {
+	GetFlatFee(ctx sdk.Context, contractAddr sdk.AccAddress) (sdk.Coin, bool)
+}
+
+// contractFlatFee is a flat fee charged for a contract execution.
+type contractFlatFee struct {
+	ContractAddress sdk.AccAddress
+	FlatFee         sdk.Coin
 }
 
 // DeductFeeDecorator deducts fees from the first signer of the tx.
-// If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error.
+// A single fee source pays the whole fee, sources in order of precedence:
+//  1. Fee granter: if the x/rewards module account (ContractRewardCollector) is set as the fee granter, exactly the fee
+//     amount is withdrawn from the first signer rewards records to pay fees, otherwise fees are deducted from
+//     the x/feegrant granter;
+//  2. Contract sponsorship: if the tx only executes a single contract which sponsors it, fees are deducted from
+//     the contract sponsorship deposit (only if the tx fee does not exceed the required fee: contract flat fees plus
+//     the minimum consensus fee);
+//  3. The first signer.
+//
+// Paying fees from rewards records reuses the tx fee granter field (no tx format change is needed): the module account
+// can't sign transactions or grant allowances, so the address is never a real x/feegrant granter.
+// A client sets it with the "--fee-account <x/rewards module address>" flag, the fee payer must have
+// rewards records covering the fee (in every fee denom).
+// A fee granter can't be combined with a sponsorship: a tx executing a contract with an enabled sponsorship is rejected
+// if the fee granter is set.
+// Contract flat fees, the base fee burn and the fee rebate split apply to the fees regardless of the source.
+// If the fee source does not have the funds to pay for the fees, return with InsufficientFunds error.
 // Call next AnteHandler if fees successfully deducted.
 // CONTRACT: Tx must implement FeeTx interface to use DeductFeeDecorator.
 type DeductFeeDecorator struct {
@@ -31,6 +65,7 @@ type DeductFeeDecorator struct {
 	bankKeeper     authTypes.BankKeeper
 	feegrantKeeper ante.FeegrantKeeper
 	rewardsKeeper  TxFeeRewardsKeeperExpected
+	msgInspector   WasmMsgInspector
 }
 
 // NewDeductFeeDecorator returns a new DeductFeeDecorator instance.
@@ -40,6 +75,7 @@ func NewDeductFeeDecorator(ak ante.AccountKeeper, bk authTypes.BankKeeper, fk an
 		bankKeeper:     bk,
 		feegrantKeeper: fk,
 		rewardsKeeper:  rk,
+		msgInspector:   DefaultWasmMsgInspector(),
 	}
 }
 
@@ -54,14 +90,34 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		return ctx, fmt.Errorf("fee collector module account (%s) has not been set", authTypes.FeeCollectorName)
 	}
 
+	// Check if transaction has wasmd operations (including the ones nested into wrapper msgs, authz.MsgExec for example).
+	// Transactions with msgs nested too deep are rejected even if there are no fees to deduct.
+	hasWasmMsgs, err := dfd.msgInspector.HasWasmMsgs(tx.GetMsgs())
+	if err != nil {
+		return ctx, err
+	}
+
 	fee := feeTx.GetFee()
 	feePayer := feeTx.FeePayer()
 	feeGranter := feeTx.FeeGranter()
 
 	deductFeesFrom := feePayer
 
-	// If feegranter set, deduct fee from feegranter account (only when feegrant is enabled)
-	if feeGranter != nil {
+	// Reject the ambiguous fee granter and sponsorship combination
+	sponsorAddr, isSponsorCandidate := getTxSponsorCandidate(tx)
+	if isSponsorCandidate && feeGranter != nil {
+		if sponsorship, found := dfd.rewardsKeeper.GetSponsorship(ctx, sponsorAddr); found && sponsorship.IsEnabled() {
+			return ctx, sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, "fee granter can not be set for a transaction sponsored by the contract (%s)", sponsorAddr)
+		}
+	}
+
+	// If the x/rewards module account is set as the feegranter, withdraw the fees amount from the fee payer rewards records.
+	// Otherwise, if feegranter set, deduct fee from feegranter account (only when feegrant is enabled)
+	if feeGranter != nil && feeGranter.Equals(dfd.ak.GetModuleAddress(rewardsTypes.ContractRewardCollector)) {
+		if _, _, err := dfd.rewardsKeeper.WithdrawRewardsForFees(ctx, feePayer, fee); err != nil {
+			return ctx, sdkErrors.Wrapf(err, "%s can not pay fees from rewards records", feePayer)
+		}
+	} else if feeGranter != nil {
 		if dfd.feegrantKeeper == nil {
 			return ctx, sdkErrors.Wrap(sdkErrors.ErrInvalidRequest, "fee grants are not enabled")
 		}
@@ -75,6 +131,14 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		deductFeesFrom = feeGranter
 	}
 
+	// Pay fees from the contract sponsorship deposit
+	// (the fee payer pays if the sponsorship is not found, limits are exceeded or the fee is above the required one)
+	if feeGranter == nil && !fee.IsZero() && isSponsorCandidate && isTxFeeSponsorable(ctx, dfd.rewardsKeeper, dfd.msgInspector, feeTx) {
+		if err := dfd.rewardsKeeper.UseSponsorship(ctx, sponsorAddr, feePayer, fee); err == nil {
+			deductFeesFrom = dfd.ak.GetModuleAddress(rewardsTypes.SponsorshipCollector)
+		}
+	}
+
 	deductFeesFromAcc := dfd.ak.GetAccount(ctx, deductFeesFrom)
 	if deductFeesFromAcc == nil {
 		return ctx, sdkErrors.Wrapf(sdkErrors.ErrUnknownAddress, "fee payer address (%s) does not exist", deductFeesFrom)
@@ -82,7 +146,7 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 
 	// Deduct the fees
 	if !feeTx.GetFee().IsZero() {
-		if err := dfd.deductFees(ctx, tx, deductFeesFromAcc, feeTx.GetFee()); err != nil {
+		if err := dfd.deductFees(ctx, feeTx, deductFeesFromAcc, feeTx.GetFee(), hasWasmMsgs); err != nil {
 			return ctx, err
 		}
 	}
@@ -97,32 +161,70 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 
 // deductFees deducts fees from the given account if rewards calculation and distribution is enabled.
 // If rewards module is disabled, all the fees are sent to the fee collector account.
+// The base fee share defined by the x/rewards params is burned before the split.
+// If unused gas refunds are enabled, the fee collector share is tracked as well (to be partially refunded at the EndBlock).
 // NOTE: this is the only logic being changed.
-func (dfd DeductFeeDecorator) deductFees(ctx sdk.Context, tx sdk.Tx, acc authTypes.AccountI, fees sdk.Coins) error {
+func (dfd DeductFeeDecorator) deductFees(ctx sdk.Context, tx sdk.FeeTx, acc authTypes.AccountI, fees sdk.Coins, hasWasmMsgs bool) error {
 	if !fees.IsValid() {
 		return sdkErrors.Wrapf(sdkErrors.ErrInsufficientFee, "invalid fee amount: %s", fees)
 	}
 
-	// Check if transaction has wasmd operations
-	hasWasmMsgs := false
-	for _, msg := range tx.GetMsgs() {
-		// We can use switch here, but breaking the for loop from switch is less readable
-		if _, ok := msg.(*wasmdTypes.MsgExecuteContract); ok {
-			hasWasmMsgs = true
-			break
+	// Charge contract flat fees (if any) on top of the gas fees
+	flatFees, contractFlatFees, err := getTxContractFlatFees(ctx, dfd.rewardsKeeper, dfd.msgInspector, tx)
+	if err != nil {
+		return err
+	}
+	if !flatFees.IsZero() {
+		if !fees.IsAllGTE(flatFees) {
+			return sdkErrors.Wrapf(sdkErrors.ErrInsufficientFee, "tx fee %s is less than contract flat fees: %s", fees, flatFees)
+		}
+
+		if err := dfd.bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), rewardsTypes.ContractRewardCollector, flatFees); err != nil {
+			return sdkErrors.Wrapf(sdkErrors.ErrInsufficientFunds, err.Error())
+		}
+
+		for _, contractFee := range contractFlatFees {
+			dfd.rewardsKeeper.CreateFlatFeeRewardsRecords(ctx, contractFee.ContractAddress, contractFee.FlatFee)
+		}
+
+		fees = fees.Sub(flatFees)
+		if fees.IsZero() {
+			return nil
+		}
+	}
+
+	// Burn the base fee share (if enabled)
+	baseFeeBurn := dfd.rewardsKeeper.GetBaseFeeBurn(ctx, fees, tx.GetGas())
+	if !baseFeeBurn.IsZero() {
+		if err := dfd.bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), rewardsTypes.TreasuryCollector, baseFeeBurn); err != nil {
+			return sdkErrors.Wrapf(sdkErrors.ErrInsufficientFunds, err.Error())
 		}
-		if _, ok := msg.(*wasmdTypes.MsgMigrateContract); ok {
-			hasWasmMsgs = true
-			break
+
+		if err := dfd.rewardsKeeper.BurnBaseFees(ctx, baseFeeBurn); err != nil {
+			return err
+		}
+
+		fees = fees.Sub(baseFeeBurn)
+		if fees.IsZero() {
+			return nil
 		}
 	}
 
+	// Fees are tracked for non-wasm transactions only if unused gas refunds are enabled
+	// (refunds of fees paid from a sponsorship deposit are returned to the deposit)
+	refundEnabled := !dfd.rewardsKeeper.UnusedGasRefundRatio(ctx).IsZero()
+	sponsored := acc.GetAddress().Equals(dfd.ak.GetModuleAddress(rewardsTypes.SponsorshipCollector))
+
 	// Send everything to the fee collector account if rewards are disabled or transaction is not wasm related
 	rebateRatio := dfd.rewardsKeeper.TxFeeRebateRatio(ctx)
 	if rebateRatio.IsZero() || !hasWasmMsgs {
 		if err := dfd.bankKeeper.SendCoinsFromAccountToModule(ctx, acc.GetAddress(), authTypes.FeeCollectorName, fees); err != nil {
 			return sdkErrors.Wrapf(sdkErrors.ErrInsufficientFunds, err.Error())
 		}
+
+		if refundEnabled {
+			dfd.rewardsKeeper.TrackFeeRebatesRewards(ctx, sdk.NewCoins(), fees, sponsored)
+		}
 		return nil
 	}
 
@@ -142,7 +244,44 @@ func (dfd DeductFeeDecorator) deductFees(ctx sdk.Context, tx sdk.Tx, acc authTyp
 	}
 
 	// Track transaction fee rewards
-	dfd.rewardsKeeper.TrackFeeRebatesRewards(ctx, rewardsFees)
+	var feeCollectorFees sdk.Coins
+	if refundEnabled {
+		feeCollectorFees = authFees
+	}
+	dfd.rewardsKeeper.TrackFeeRebatesRewards(ctx, rewardsFees, feeCollectorFees, sponsored)
 
 	return nil
 }
+
+// getTxContractFlatFees returns the total flat fees and flat fees per contract for all contract executions within a transaction.
+// Executions nested into wrapper msgs (authz.MsgExec for example) are also charged.
+func getTxContractFlatFees(ctx sdk.Context, rk FlatFeeReaderExpected, msgInspector WasmMsgInspector, tx sdk.Tx) (sdk.Coins, []contractFlatFee, error) {
+	executeMsgs, err := msgInspector.GetExecuteContractMsgs(tx.GetMsgs())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	flatFees := sdk.NewCoins()
+	var contractFlatFees []contractFlatFee
+
+	for _, executeMsg := range executeMsgs {
+		contractAddr, err := sdk.AccAddressFromBech32(executeMsg.Contract)
+		if err != nil {
+			// Invalid contract address is handled by the msg ValidateBasic
+			continue
+		}
+
+		flatFee, found := rk.GetFlatFee(ctx, contractAddr)
+		if !found {
+			continue
+		}
+
+		flatFees = flatFees.Add(flatFee)
+		contractFlatFees = append(contractFlatFees, contractFlatFee{
+			ContractAddress: contractAddr,
+			FlatFee:         flatFee,
+		})
+	}
+
+	return flatFees, contractFlatFees, nil
+}
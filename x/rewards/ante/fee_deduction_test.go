@@ -5,12 +5,16 @@ import (
 
 	wasmdTypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	mintTypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/pkg"
 	"github.com/archway-network/archway/pkg/testutils"
 	"github.com/archway-network/archway/x/rewards/ante"
 	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
@@ -31,6 +35,19 @@ func TestRewardsFeeDeductionAnteHandler(t *testing.T) {
 	}
 
 	mockWasmExecuteMsg := &wasmdTypes.MsgExecuteContract{}
+	mockWasmInstantiateMsg := &wasmdTypes.MsgInstantiateContract{}
+	newAuthzExecMsg := func(msgs ...sdk.Msg) sdk.Msg {
+		grantee, _ := e2eTesting.GenAccounts(1)
+		msg := authz.NewMsgExec(grantee[0], msgs)
+		return &msg
+	}
+	newNestedAuthzExecMsg := func(depth int, msgs ...sdk.Msg) sdk.Msg {
+		msg := newAuthzExecMsg(msgs...)
+		for i := 1; i < depth; i++ {
+			msg = newAuthzExecMsg(msg)
+		}
+		return msg
+	}
 
 	newStakeCoin := func(amt uint64) sdk.Coin {
 		return sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewIntFromUint64(amt))
@@ -78,6 +95,51 @@ func TestRewardsFeeDeductionAnteHandler(t *testing.T) {
 			feeCollectorBalanceDiffExpected: "1000stake",
 			rewardsBalanceDiffExpected:      "",
 		},
+		{
+			name:           "OK: 1000stake fees with 0.5 ratio (instantiate msg)",
+			feeRebateRatio: "0.5",
+			feeCoins:       sdk.Coins{newStakeCoin(1000)},
+			txMsgs: []sdk.Msg{
+				mockWasmInstantiateMsg,
+			},
+			rewardRecordExpected:            true,
+			feeCollectorBalanceDiffExpected: "500stake",
+			rewardsBalanceDiffExpected:      "500stake",
+		},
+		{
+			name:           "OK: 1000stake fees with 0.5 ratio (WASM msg wrapped into authz.MsgExec)",
+			feeRebateRatio: "0.5",
+			feeCoins:       sdk.Coins{newStakeCoin(1000)},
+			txMsgs: []sdk.Msg{
+				testutils.NewMockMsg(),
+				newAuthzExecMsg(mockWasmExecuteMsg),
+			},
+			rewardRecordExpected:            true,
+			feeCollectorBalanceDiffExpected: "500stake",
+			rewardsBalanceDiffExpected:      "500stake",
+		},
+		{
+			name:           "OK: 1000stake fees with 0.5 ratio (WASM msg wrapped into nested authz.MsgExec)",
+			feeRebateRatio: "0.5",
+			feeCoins:       sdk.Coins{newStakeCoin(1000)},
+			txMsgs: []sdk.Msg{
+				newAuthzExecMsg(newAuthzExecMsg(mockWasmInstantiateMsg)),
+			},
+			rewardRecordExpected:            true,
+			feeCollectorBalanceDiffExpected: "500stake",
+			rewardsBalanceDiffExpected:      "500stake",
+		},
+		{
+			name:           "OK: 1000stake fees with 0.5 ratio (authz.MsgExec with no WASM msgs, rewards are skipped)",
+			feeRebateRatio: "0.5",
+			feeCoins:       sdk.Coins{newStakeCoin(1000)},
+			txMsgs: []sdk.Msg{
+				newAuthzExecMsg(testutils.NewMockMsg()),
+			},
+			rewardRecordExpected:            false,
+			feeCollectorBalanceDiffExpected: "1000stake",
+			rewardsBalanceDiffExpected:      "",
+		},
 		{
 			name:           "OK: 1000stake fees with 0 ratio (rewards are skipped)",
 			feeRebateRatio: "0",
@@ -90,6 +152,24 @@ func TestRewardsFeeDeductionAnteHandler(t *testing.T) {
 			feeCollectorBalanceDiffExpected: "1000stake",
 			rewardsBalanceDiffExpected:      "",
 		},
+		{
+			name:           "Fail: WASM msg wrapped into 9 nested authz.MsgExec (max nesting depth exceeded)",
+			feeRebateRatio: "0.5",
+			feeCoins:       sdk.Coins{newStakeCoin(1000)},
+			txMsgs: []sdk.Msg{
+				newNestedAuthzExecMsg(ante.MaxMsgNestingDepth+1, mockWasmExecuteMsg),
+			},
+			errExpected: true,
+		},
+		{
+			name:           "Fail: 9 nested authz.MsgExec with no fees (max nesting depth exceeded)",
+			feeRebateRatio: "0.5",
+			feeCoins:       sdk.Coins{},
+			txMsgs: []sdk.Msg{
+				newNestedAuthzExecMsg(ante.MaxMsgNestingDepth+1, mockWasmExecuteMsg),
+			},
+			errExpected: true,
+		},
 		{
 			name:           "Fail: invalid fees",
 			feeRebateRatio: "0.5",
@@ -168,3 +248,997 @@ func TestRewardsFeeDeductionAnteHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestRewardsFeeDeductionAnteHandlerFlatFees(t *testing.T) {
+	type testCase struct {
+		name string
+		// Inputs
+		feeCoins      string // transaction fees [sdk.Coins]
+		flatFee       string // contract flat fee [sdk.Coin]
+		executionsNum int    // number of contract execution msgs
+		authzWrapped  bool   // wrap contract execution msgs into authz.MsgExec
+		// Output expected
+		errExpected                     bool
+		feeCollectorBalanceDiffExpected string // expected FeeCollector module balance diff [sdk.Coins]
+		rewardsBalanceDiffExpected      string // expected x/rewards module balance diff [sdk.Coins]
+		recipient1RewardsExpected       string // expected 1st recipient rewards records total [sdk.Coins]
+		recipient2RewardsExpected       string // expected 2nd recipient rewards records total [sdk.Coins]
+	}
+
+	testCases := []testCase{
+		{
+			name:                            "OK: 1 execution with 101stake flat fee",
+			feeCoins:                        "1101stake",
+			flatFee:                         "101stake",
+			executionsNum:                   1,
+			feeCollectorBalanceDiffExpected: "500stake",
+			// Flat fee (101stake) + fee rebate (500stake)
+			rewardsBalanceDiffExpected: "601stake",
+			// 101stake - 30stake (dust included)
+			recipient1RewardsExpected: "71stake",
+			// 101stake * 0.3
+			recipient2RewardsExpected: "30stake",
+		},
+		{
+			name:                            "OK: 2 executions with 100stake flat fee",
+			feeCoins:                        "1200stake",
+			flatFee:                         "100stake",
+			executionsNum:                   2,
+			feeCollectorBalanceDiffExpected: "500stake",
+			// Flat fees (200stake) + fee rebate (500stake)
+			rewardsBalanceDiffExpected: "700stake",
+			recipient1RewardsExpected:  "140stake",
+			recipient2RewardsExpected:  "60stake",
+		},
+		{
+			name:                            "OK: flat fee in a different denom",
+			feeCoins:                        "1000stake,10uarch",
+			flatFee:                         "10uarch",
+			executionsNum:                   1,
+			feeCollectorBalanceDiffExpected: "500stake",
+			rewardsBalanceDiffExpected:      "500stake,10uarch",
+			recipient1RewardsExpected:       "7uarch",
+			recipient2RewardsExpected:       "3uarch",
+		},
+		{
+			name:                            "OK: 2 executions wrapped into authz.MsgExec with 100stake flat fee",
+			feeCoins:                        "1200stake",
+			flatFee:                         "100stake",
+			executionsNum:                   2,
+			authzWrapped:                    true,
+			feeCollectorBalanceDiffExpected: "500stake",
+			rewardsBalanceDiffExpected:      "700stake",
+			recipient1RewardsExpected:       "140stake",
+			recipient2RewardsExpected:       "60stake",
+		},
+		{
+			name:          "Fail: flat fee is not paid",
+			feeCoins:      "1000stake",
+			flatFee:       "10uarch",
+			executionsNum: 1,
+			errExpected:   true,
+		},
+		{
+			name:          "Fail: flat fee is not paid (authz.MsgExec wrapped)",
+			feeCoins:      "1000stake",
+			flatFee:       "10uarch",
+			executionsNum: 1,
+			authzWrapped:  true,
+			errExpected:   true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create chain
+			chain := e2eTesting.NewTestChain(t, 1,
+				e2eTesting.WithTxFeeRebatesRewardsRatio(sdk.NewDecWithPrec(5, 1)),
+			)
+			acc := chain.GetAccount(0)
+			recipientAddrs, _ := e2eTesting.GenAccounts(2)
+			recipient1, recipient2 := recipientAddrs[0], recipientAddrs[1]
+			ctx := chain.GetContext()
+			keeper := chain.GetApp().RewardsKeeper
+
+			feeCoins, err := sdk.ParseCoinsNormalized(tc.feeCoins)
+			require.NoError(t, err)
+			flatFee, err := sdk.ParseCoinNormalized(tc.flatFee)
+			require.NoError(t, err)
+
+			// Mint coins for account
+			require.NoError(t, chain.GetApp().BankKeeper.MintCoins(ctx, mintTypes.ModuleName, feeCoins))
+			require.NoError(t, chain.GetApp().BankKeeper.SendCoinsFromModuleToAccount(ctx, mintTypes.ModuleName, acc.Address, feeCoins))
+
+			// Set contract metadata and flat fee
+			contractAddr := e2eTesting.GenContractAddresses(1)[0]
+			keeper.GetState().ContractMetadataState(ctx).SetContractMetadata(contractAddr, rewardsTypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				OwnerAddress:    acc.Address.String(),
+				RewardsRecipients: []rewardsTypes.RewardsRecipient{
+					{Address: recipient1.String(), Weight: sdk.NewDecWithPrec(7, 1)},
+					{Address: recipient2.String(), Weight: sdk.NewDecWithPrec(3, 1)},
+				},
+			})
+			require.NoError(t, keeper.SetFlatFee(ctx, acc.Address, contractAddr, flatFee))
+
+			// Fetch initial balances
+			feeCollectorBalanceBefore := chain.GetModuleBalance(authTypes.FeeCollectorName)
+			rewardsBalanceBefore := chain.GetModuleBalance(rewardsTypes.ContractRewardCollector)
+
+			// Build transaction
+			var executeMsgs []sdk.Msg
+			for i := 0; i < tc.executionsNum; i++ {
+				executeMsgs = append(executeMsgs, &wasmdTypes.MsgExecuteContract{
+					Sender:   acc.Address.String(),
+					Contract: contractAddr.String(),
+				})
+			}
+
+			txMsgs := []sdk.Msg{testutils.NewMockMsg()}
+			if tc.authzWrapped {
+				authzMsg := authz.NewMsgExec(acc.Address, executeMsgs)
+				txMsgs = append(txMsgs, &authzMsg)
+			} else {
+				txMsgs = append(txMsgs, executeMsgs...)
+			}
+
+			tx := testutils.NewMockFeeTx(
+				testutils.WithMockFeeTxFees(feeCoins),
+				testutils.WithMockFeeTxPayer(acc.Address),
+				testutils.WithMockFeeTxMsgs(txMsgs...),
+			)
+
+			// Call the deduction Ante handler manually
+			anteHandler := ante.NewDeductFeeDecorator(chain.GetApp().AccountKeeper, chain.GetApp().BankKeeper, chain.GetApp().FeeGrantKeeper, keeper)
+			_, err = anteHandler.AnteHandle(ctx, tx, false, testutils.NoopAnteHandler)
+			if tc.errExpected {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+
+			// Check final balances
+			feeCollectorBalanceDiffReceived := chain.GetModuleBalance(authTypes.FeeCollectorName).Sub(feeCollectorBalanceBefore)
+			rewardsBalanceDiffReceived := chain.GetModuleBalance(rewardsTypes.ContractRewardCollector).Sub(rewardsBalanceBefore)
+
+			assert.Equal(t, tc.feeCollectorBalanceDiffExpected, feeCollectorBalanceDiffReceived.String())
+			assert.Equal(t, tc.rewardsBalanceDiffExpected, rewardsBalanceDiffReceived.String())
+
+			// Check rewards records
+			getRecordsTotal := func(addr sdk.AccAddress) string {
+				total := sdk.NewCoins()
+				for _, record := range keeper.GetState().RewardsRecord(ctx).GetRewardsRecordByRewardsAddress(addr) {
+					total = total.Add(record.Rewards...)
+				}
+				return total.String()
+			}
+
+			assert.Equal(t, tc.recipient1RewardsExpected, getRecordsTotal(recipient1))
+			assert.Equal(t, tc.recipient2RewardsExpected, getRecordsTotal(recipient2))
+		})
+	}
+}
+
+func TestRewardsFeeDeductionAnteHandlerBaseFeeBurn(t *testing.T) {
+	type testCase struct {
+		name string
+		// Inputs
+		feeCoins   string // transaction fees [sdk.Coins]
+		txGasLimit uint64 // transaction gas limit
+		baseFee    string // base fee [sdk.DecCoin]
+		burnRatio  string // base fee burn ratio [sdk.Dec]
+		// Output expected
+		burnedExpected                  string // expected supply decrease [sdk.Coins]
+		feeCollectorBalanceDiffExpected string // expected FeeCollector module balance diff [sdk.Coins]
+		rewardsBalanceDiffExpected      string // expected x/rewards module balance diff [sdk.Coins]
+	}
+
+	testCases := []testCase{
+		{
+			name:                            "OK: burn is disabled",
+			feeCoins:                        "1000stake",
+			txGasLimit:                      1000,
+			baseFee:                         "0.2stake",
+			burnRatio:                       "0",
+			burnedExpected:                  "",
+			feeCollectorBalanceDiffExpected: "500stake",
+			rewardsBalanceDiffExpected:      "500stake",
+		},
+		{
+			name:                            "OK: half of 200stake base fee is burned",
+			feeCoins:                        "1000stake",
+			txGasLimit:                      1000,
+			baseFee:                         "0.2stake",
+			burnRatio:                       "0.5",
+			burnedExpected:                  "100stake",
+			feeCollectorBalanceDiffExpected: "450stake",
+			rewardsBalanceDiffExpected:      "450stake",
+		},
+		{
+			name:                            "OK: the whole fee is burned",
+			feeCoins:                        "200stake",
+			txGasLimit:                      1000,
+			baseFee:                         "0.2stake",
+			burnRatio:                       "1",
+			burnedExpected:                  "200stake",
+			feeCollectorBalanceDiffExpected: "",
+			rewardsBalanceDiffExpected:      "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create chain
+			chain := e2eTesting.NewTestChain(t, 1,
+				e2eTesting.WithTxFeeRebatesRewardsRatio(sdk.NewDecWithPrec(5, 1)),
+			)
+			acc := chain.GetAccount(0)
+			ctx := chain.GetContext()
+			keeper := chain.GetApp().RewardsKeeper
+
+			feeCoins, err := sdk.ParseCoinsNormalized(tc.feeCoins)
+			require.NoError(t, err)
+			baseFee, err := sdk.ParseDecCoin(tc.baseFee)
+			require.NoError(t, err)
+			burnRatio, err := sdk.NewDecFromStr(tc.burnRatio)
+			require.NoError(t, err)
+
+			// Mint coins for account
+			require.NoError(t, chain.GetApp().BankKeeper.MintCoins(ctx, mintTypes.ModuleName, feeCoins))
+			require.NoError(t, chain.GetApp().BankKeeper.SendCoinsFromModuleToAccount(ctx, mintTypes.ModuleName, acc.Address, feeCoins))
+
+			// Set base fee and burn ratio
+			keeper.GetState().MinConsensusFee(ctx).SetBaseFee(baseFee)
+
+			params := keeper.GetParams(ctx)
+			params.BaseFeeBurnRatio = burnRatio
+			keeper.SetParams(ctx, params)
+
+			// Fetch initial balances
+			feeCollectorBalanceBefore := chain.GetModuleBalance(authTypes.FeeCollectorName)
+			rewardsBalanceBefore := chain.GetModuleBalance(rewardsTypes.ContractRewardCollector)
+			treasuryBalanceBefore := chain.GetModuleBalance(rewardsTypes.TreasuryCollector)
+			supplyBefore := chain.GetApp().BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+
+			// Build transaction
+			tx := testutils.NewMockFeeTx(
+				testutils.WithMockFeeTxFees(feeCoins),
+				testutils.WithMockFeeTxGas(tc.txGasLimit),
+				testutils.WithMockFeeTxPayer(acc.Address),
+				testutils.WithMockFeeTxMsgs(testutils.NewMockMsg(), &wasmdTypes.MsgExecuteContract{}),
+			)
+
+			// Call the deduction Ante handler manually
+			anteHandler := ante.NewDeductFeeDecorator(chain.GetApp().AccountKeeper, chain.GetApp().BankKeeper, chain.GetApp().FeeGrantKeeper, keeper)
+			_, err = anteHandler.AnteHandle(ctx, tx, false, testutils.NoopAnteHandler)
+			require.NoError(t, err)
+
+			// Check final balances and supply
+			burnedReceived := sdk.NewCoins(supplyBefore.Sub(chain.GetApp().BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)))
+			feeCollectorBalanceDiffReceived := chain.GetModuleBalance(authTypes.FeeCollectorName).Sub(feeCollectorBalanceBefore)
+			rewardsBalanceDiffReceived := chain.GetModuleBalance(rewardsTypes.ContractRewardCollector).Sub(rewardsBalanceBefore)
+
+			assert.Equal(t, tc.burnedExpected, burnedReceived.String())
+			assert.Equal(t, tc.feeCollectorBalanceDiffExpected, feeCollectorBalanceDiffReceived.String())
+			assert.Equal(t, tc.rewardsBalanceDiffExpected, rewardsBalanceDiffReceived.String())
+			assert.Equal(t, treasuryBalanceBefore.String(), chain.GetModuleBalance(rewardsTypes.TreasuryCollector).String())
+		})
+	}
+}
+
+func TestRewardsFeeDeductionAnteHandlerGasRefundTracking(t *testing.T) {
+	type testCase struct {
+		name string
+		// Inputs
+		refundRatio string // unused gas refund ratio [sdk.Dec]
+		wasmTx      bool   // transaction has wasm msgs
+		// Output expected
+		trackedExpected          bool   // TxRewards record expected to be created
+		feeRewardsExpected       string // expected TxRewards fee rewards [sdk.Coins]
+		feeCollectorFeesExpected string // expected TxRewards fee collector fees [sdk.Coins]
+	}
+
+	testCases := []testCase{
+		{
+			name:                     "OK: refunds disabled for wasm tx",
+			refundRatio:              "0",
+			wasmTx:                   true,
+			trackedExpected:          true,
+			feeRewardsExpected:       "500stake",
+			feeCollectorFeesExpected: "",
+		},
+		{
+			name:            "OK: refunds disabled for non-wasm tx",
+			refundRatio:     "0",
+			wasmTx:          false,
+			trackedExpected: false,
+		},
+		{
+			name:                     "OK: refunds enabled for wasm tx",
+			refundRatio:              "0.5",
+			wasmTx:                   true,
+			trackedExpected:          true,
+			feeRewardsExpected:       "500stake",
+			feeCollectorFeesExpected: "500stake",
+		},
+		{
+			name:                     "OK: refunds enabled for non-wasm tx",
+			refundRatio:              "0.5",
+			wasmTx:                   false,
+			trackedExpected:          true,
+			feeRewardsExpected:       "",
+			feeCollectorFeesExpected: "1000stake",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create chain
+			chain := e2eTesting.NewTestChain(t, 1,
+				e2eTesting.WithTxFeeRebatesRewardsRatio(sdk.NewDecWithPrec(5, 1)),
+			)
+			acc := chain.GetAccount(0)
+			ctx := chain.GetContext()
+			keeper := chain.GetApp().RewardsKeeper
+
+			feeCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+			refundRatio, err := sdk.NewDecFromStr(tc.refundRatio)
+			require.NoError(t, err)
+
+			// Mint coins for account
+			require.NoError(t, chain.GetApp().BankKeeper.MintCoins(ctx, mintTypes.ModuleName, feeCoins))
+			require.NoError(t, chain.GetApp().BankKeeper.SendCoinsFromModuleToAccount(ctx, mintTypes.ModuleName, acc.Address, feeCoins))
+
+			// Set refund ratio
+			params := keeper.GetParams(ctx)
+			params.UnusedGasRefundRatio = refundRatio
+			keeper.SetParams(ctx, params)
+
+			// Build transaction
+			msgs := []sdk.Msg{testutils.NewMockMsg()}
+			if tc.wasmTx {
+				msgs = append(msgs, &wasmdTypes.MsgExecuteContract{})
+			}
+
+			tx := testutils.NewMockFeeTx(
+				testutils.WithMockFeeTxFees(feeCoins),
+				testutils.WithMockFeeTxPayer(acc.Address),
+				testutils.WithMockFeeTxMsgs(msgs...),
+			)
+
+			// Emulate x/tracking Ante handler call and call the deduction Ante handler manually
+			chain.GetApp().TrackingKeeper.TrackNewTx(ctx)
+			txID := chain.GetApp().TrackingKeeper.GetCurrentTxID(ctx)
+
+			anteHandler := ante.NewDeductFeeDecorator(chain.GetApp().AccountKeeper, chain.GetApp().BankKeeper, chain.GetApp().FeeGrantKeeper, keeper)
+			_, err = anteHandler.AnteHandle(ctx, tx, false, testutils.NoopAnteHandler)
+			require.NoError(t, err)
+
+			// Check the tracked fees
+			txRewards, found := keeper.GetState().TxRewardsState(ctx).GetTxRewards(txID)
+			require.Equal(t, tc.trackedExpected, found)
+			if !tc.trackedExpected {
+				return
+			}
+
+			assert.Equal(t, tc.feeRewardsExpected, sdk.Coins(txRewards.FeeRewards).String())
+			assert.Equal(t, tc.feeCollectorFeesExpected, sdk.Coins(txRewards.FeeCollectorFees).String())
+		})
+	}
+}
+
+func TestRewardsFeeDeductionAnteHandlerSponsorship(t *testing.T) {
+	type testCase struct {
+		name string
+		// Inputs
+		deposit       string // contract sponsorship deposit [sdk.Coins]
+		userFeeLimit  string // sponsorship user fee limit [sdk.Coins]
+		blockFeeLimit string // sponsorship block fee limit [sdk.Coins]
+		minConsFee    string // min consensus fee (gas unit price) [sdk.DecCoin]
+		flatFee       string // contract flat fee [sdk.Coin]
+		txGasLimit    uint64 // transaction gas limit (fees are 1000stake)
+		otherContract bool   // add an execution msg targeting another contract
+		nonWasmMsg    bool   // add a non-WASM msg
+		// Output expected
+		sponsoredExpected bool // fees expected to be paid from the sponsorship deposit
+	}
+
+	testCases := []testCase{
+		{
+			name:              "OK: fees are paid by the contract",
+			deposit:           "1000stake",
+			userFeeLimit:      "1000stake",
+			blockFeeLimit:     "1000stake",
+			minConsFee:        "1stake",
+			txGasLimit:        1000,
+			sponsoredExpected: true,
+		},
+		{
+			name:              "OK: fees are paid by the contract (fees are min consensus fee plus flat fee)",
+			deposit:           "1000stake",
+			userFeeLimit:      "1000stake",
+			blockFeeLimit:     "1000stake",
+			minConsFee:        "1stake",
+			flatFee:           "500stake",
+			txGasLimit:        500,
+			sponsoredExpected: true,
+		},
+		{
+			name:              "OK: fees are paid by the contract (fees are flat fee, min consensus fee is not set)",
+			deposit:           "1000stake",
+			userFeeLimit:      "1000stake",
+			blockFeeLimit:     "1000stake",
+			flatFee:           "1000stake",
+			txGasLimit:        1000,
+			sponsoredExpected: true,
+		},
+		{
+			name:          "OK: fees are paid by the user (insufficient deposit)",
+			deposit:       "999stake",
+			userFeeLimit:  "1000stake",
+			blockFeeLimit: "1000stake",
+			minConsFee:    "1stake",
+			txGasLimit:    1000,
+		},
+		{
+			name:          "OK: fees are paid by the user (user limit exceeded)",
+			deposit:       "1000stake",
+			userFeeLimit:  "999stake",
+			blockFeeLimit: "1000stake",
+			minConsFee:    "1stake",
+			txGasLimit:    1000,
+		},
+		{
+			name:          "OK: fees are paid by the user (block limit exceeded)",
+			deposit:       "1000stake",
+			userFeeLimit:  "1000stake",
+			blockFeeLimit: "999stake",
+			minConsFee:    "1stake",
+			txGasLimit:    1000,
+		},
+		{
+			name:          "OK: fees are paid by the user (sponsorship is disabled: user limit is not set)",
+			deposit:       "1000stake",
+			blockFeeLimit: "1000stake",
+			minConsFee:    "1stake",
+			txGasLimit:    1000,
+		},
+		{
+			name:         "OK: fees are paid by the user (sponsorship is disabled: block limit is not set)",
+			deposit:      "1000stake",
+			userFeeLimit: "1000stake",
+			minConsFee:   "1stake",
+			txGasLimit:   1000,
+		},
+		{
+			name:          "OK: fees are paid by the user (fees exceed min consensus fee)",
+			deposit:       "1000stake",
+			userFeeLimit:  "1000stake",
+			blockFeeLimit: "1000stake",
+			minConsFee:    "1stake",
+			txGasLimit:    999,
+		},
+		{
+			name:          "OK: fees are paid by the user (fees exceed min consensus fee plus flat fee)",
+			deposit:       "1000stake",
+			userFeeLimit:  "1000stake",
+			blockFeeLimit: "1000stake",
+			minConsFee:    "1stake",
+			flatFee:       "500stake",
+			txGasLimit:    499,
+		},
+		{
+			name:          "OK: fees are paid by the user (fees exceed flat fee, min consensus fee is not set)",
+			deposit:       "1000stake",
+			userFeeLimit:  "1000stake",
+			blockFeeLimit: "1000stake",
+			flatFee:       "999stake",
+			txGasLimit:    1000,
+		},
+		{
+			name:          "OK: fees are paid by the user (tx targets another contract)",
+			deposit:       "1000stake",
+			userFeeLimit:  "1000stake",
+			blockFeeLimit: "1000stake",
+			minConsFee:    "1stake",
+			txGasLimit:    1000,
+			otherContract: true,
+		},
+		{
+			name:          "OK: fees are paid by the user (tx has a non-WASM msg)",
+			deposit:       "1000stake",
+			userFeeLimit:  "1000stake",
+			blockFeeLimit: "1000stake",
+			minConsFee:    "1stake",
+			txGasLimit:    1000,
+			nonWasmMsg:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create chain
+			chain := e2eTesting.NewTestChain(t, 1,
+				e2eTesting.WithTxFeeRebatesRewardsRatio(sdk.NewDecWithPrec(5, 1)),
+			)
+			acc := chain.GetAccount(0)
+			userAddrs, _ := e2eTesting.GenAccounts(1)
+			user := userAddrs[0]
+			ctx := chain.GetContext()
+			keeper := chain.GetApp().RewardsKeeper
+
+			feeCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+			deposit, err := sdk.ParseCoinsNormalized(tc.deposit)
+			require.NoError(t, err)
+			userFeeLimit, err := sdk.ParseCoinsNormalized(tc.userFeeLimit)
+			require.NoError(t, err)
+			blockFeeLimit, err := sdk.ParseCoinsNormalized(tc.blockFeeLimit)
+			require.NoError(t, err)
+
+			// Mint coins for the user
+			require.NoError(t, chain.GetApp().BankKeeper.MintCoins(ctx, mintTypes.ModuleName, feeCoins))
+			require.NoError(t, chain.GetApp().BankKeeper.SendCoinsFromModuleToAccount(ctx, mintTypes.ModuleName, user, feeCoins))
+
+			// Set contract metadata and sponsorship
+			contractAddrs := e2eTesting.GenContractAddresses(2)
+			contractAddr := contractAddrs[0]
+			keeper.GetState().ContractMetadataState(ctx).SetContractMetadata(contractAddr, rewardsTypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				OwnerAddress:    acc.Address.String(),
+			})
+			require.NoError(t, keeper.SetSponsorship(ctx, acc.Address, contractAddr, userFeeLimit, 100, blockFeeLimit, false))
+			require.NoError(t, keeper.DepositSponsorship(ctx, acc.Address, contractAddr, deposit))
+
+			// Set the min consensus fee and the contract flat fee
+			if tc.minConsFee != "" {
+				minConsFee, err := sdk.ParseDecCoin(tc.minConsFee)
+				require.NoError(t, err)
+				keeper.GetState().MinConsensusFee(ctx).SetFee(minConsFee)
+			}
+			if tc.flatFee != "" {
+				flatFee, err := sdk.ParseCoinNormalized(tc.flatFee)
+				require.NoError(t, err)
+				keeper.GetState().FlatFee(ctx).SetFlatFee(contractAddr, flatFee)
+			}
+
+			// Fetch initial balances
+			userBalanceBefore := chain.GetBalance(user)
+			sponsorshipPoolBefore := keeper.SponsorshipPool(ctx)
+
+			// Build transaction
+			txMsgs := []sdk.Msg{
+				&wasmdTypes.MsgExecuteContract{Sender: user.String(), Contract: contractAddr.String()},
+			}
+			if tc.otherContract {
+				txMsgs = append(txMsgs, &wasmdTypes.MsgExecuteContract{Sender: user.String(), Contract: contractAddrs[1].String()})
+			}
+			if tc.nonWasmMsg {
+				txMsgs = append(txMsgs, testutils.NewMockMsg())
+			}
+
+			txOpts := []testutils.MockFeeTxOption{
+				testutils.WithMockFeeTxFees(feeCoins),
+				testutils.WithMockFeeTxGas(tc.txGasLimit),
+				testutils.WithMockFeeTxPayer(user),
+				testutils.WithMockFeeTxMsgs(txMsgs...),
+			}
+			tx := testutils.NewMockFeeTx(txOpts...)
+
+			// Call the deduction Ante handler manually
+			anteHandler := ante.NewDeductFeeDecorator(chain.GetApp().AccountKeeper, chain.GetApp().BankKeeper, chain.GetApp().FeeGrantKeeper, keeper)
+			_, err = anteHandler.AnteHandle(ctx, tx, false, testutils.NoopAnteHandler)
+			require.NoError(t, err)
+
+			// Check final balances and the sponsorship usage
+			userBalanceDiffReceived := userBalanceBefore.Sub(chain.GetBalance(user))
+			sponsorshipPoolDiffReceived := sponsorshipPoolBefore.Sub(keeper.SponsorshipPool(ctx))
+			sponsorship, found := keeper.GetSponsorship(ctx, contractAddr)
+			require.True(t, found)
+
+			if tc.sponsoredExpected {
+				assert.True(t, userBalanceDiffReceived.IsZero())
+				assert.Equal(t, feeCoins.String(), sponsorshipPoolDiffReceived.String())
+				assert.Equal(t, deposit.Sub(feeCoins).String(), sponsorship.Deposit.String())
+				assert.Equal(t, feeCoins.String(), keeper.GetSponsoredUserFees(ctx, contractAddr, user).String())
+				return
+			}
+
+			assert.Equal(t, feeCoins.String(), userBalanceDiffReceived.String())
+			assert.True(t, sponsorshipPoolDiffReceived.IsZero())
+			assert.Equal(t, deposit.String(), sponsorship.Deposit.String())
+			assert.True(t, keeper.GetSponsoredUserFees(ctx, contractAddr, user).IsZero())
+		})
+	}
+}
+
+func TestRewardsFeeDeductionAnteHandlerFeesFromRewards(t *testing.T) {
+	type testCase struct {
+		name string
+		// Inputs
+		feeRebateRatio string   // fee rebate rewards ratio [sdk.Dec]
+		feeCoins       string   // transaction fees [sdk.Coins]
+		records        []string // fee payer rewards records [sdk.Coins]
+		wasmTx         bool     // transaction has wasm msgs
+		// Output expected
+		errExpected                     error
+		recordsLeftExpected             int    // number of fee payer rewards records left
+		feePayerBalanceExpected         string // expected fee payer balance (withdrawn rewards minus fees, only the fees amount is withdrawn) [sdk.Coins]
+		feeCollectorBalanceDiffExpected string // expected FeeCollector module balance diff [sdk.Coins]
+		rewardsBalanceDiffExpected      string // expected x/rewards module balance diff (records left plus the fee rebate) [sdk.Coins]
+	}
+
+	testCases := []testCase{
+		{
+			name:                            "OK: 1 record with 0.5 ratio (the record is split)",
+			feeRebateRatio:                  "0.5",
+			feeCoins:                        "1000stake",
+			records:                         []string{"1500stake"},
+			wasmTx:                          true,
+			recordsLeftExpected:             1,
+			feePayerBalanceExpected:         "",
+			feeCollectorBalanceDiffExpected: "500stake",
+			// Fee rebate (500stake) + the record amount left (500stake)
+			rewardsBalanceDiffExpected: "1000stake",
+		},
+		{
+			name:                            "OK: 2 records are used with 0.5 ratio (the 2nd one is split)",
+			feeRebateRatio:                  "0.5",
+			feeCoins:                        "1000stake",
+			records:                         []string{"600stake", "600stake"},
+			wasmTx:                          true,
+			recordsLeftExpected:             1,
+			feePayerBalanceExpected:         "",
+			feeCollectorBalanceDiffExpected: "500stake",
+			// Fee rebate (500stake) + the 2nd record amount left (200stake)
+			rewardsBalanceDiffExpected: "700stake",
+		},
+		{
+			name:                            "OK: 1 of 2 records is used with 0.5 ratio",
+			feeRebateRatio:                  "0.5",
+			feeCoins:                        "1000stake",
+			records:                         []string{"1000stake", "500stake"},
+			wasmTx:                          true,
+			recordsLeftExpected:             1,
+			feePayerBalanceExpected:         "",
+			feeCollectorBalanceDiffExpected: "500stake",
+			// Fee rebate (500stake) + the 2nd record (500stake)
+			rewardsBalanceDiffExpected: "1000stake",
+		},
+		{
+			name:                            "OK: multiple denoms with 0.1 ratio",
+			feeRebateRatio:                  "0.1",
+			feeCoins:                        "1000stake,500uarch",
+			records:                         []string{"1000stake", "500uarch"},
+			wasmTx:                          true,
+			feePayerBalanceExpected:         "",
+			feeCollectorBalanceDiffExpected: "900stake,450uarch",
+			rewardsBalanceDiffExpected:      "100stake,50uarch",
+		},
+		{
+			name:                            "OK: 0 ratio (rewards are skipped)",
+			feeRebateRatio:                  "0",
+			feeCoins:                        "1000stake",
+			records:                         []string{"1000stake"},
+			wasmTx:                          true,
+			feePayerBalanceExpected:         "",
+			feeCollectorBalanceDiffExpected: "1000stake",
+			rewardsBalanceDiffExpected:      "",
+		},
+		{
+			name:                            "OK: no WASM msgs (rewards are skipped)",
+			feeRebateRatio:                  "0.5",
+			feeCoins:                        "1000stake",
+			records:                         []string{"1000stake"},
+			wasmTx:                          false,
+			feePayerBalanceExpected:         "",
+			feeCollectorBalanceDiffExpected: "1000stake",
+			rewardsBalanceDiffExpected:      "",
+		},
+		{
+			name:           "Fail: no rewards records",
+			feeRebateRatio: "0.5",
+			feeCoins:       "1000stake",
+			wasmTx:         true,
+			errExpected:    rewardsTypes.ErrInsufficientRewards,
+		},
+		{
+			name:           "Fail: records total is less than fees",
+			feeRebateRatio: "0.5",
+			feeCoins:       "1000stake",
+			records:        []string{"500stake", "499stake"},
+			wasmTx:         true,
+			errExpected:    rewardsTypes.ErrInsufficientRewards,
+		},
+		{
+			name:           "Fail: records in a different denom",
+			feeRebateRatio: "0.5",
+			feeCoins:       "1000stake",
+			records:        []string{"1000uarch"},
+			wasmTx:         true,
+			errExpected:    rewardsTypes.ErrInsufficientRewards,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create chain
+			feeRewardsRatio, err := sdk.NewDecFromStr(tc.feeRebateRatio)
+			require.NoError(t, err)
+
+			chain := e2eTesting.NewTestChain(t, 1,
+				e2eTesting.WithTxFeeRebatesRewardsRatio(feeRewardsRatio),
+			)
+			ctx := chain.GetContext()
+			keeper := chain.GetApp().RewardsKeeper
+
+			feeCoins, err := sdk.ParseCoinsNormalized(tc.feeCoins)
+			require.NoError(t, err)
+
+			// Fee payer has no funds
+			feePayerAddrs, _ := e2eTesting.GenAccounts(1)
+			feePayer := feePayerAddrs[0]
+
+			// Fetch initial balances
+			feeCollectorBalanceBefore := chain.GetModuleBalance(authTypes.FeeCollectorName)
+			rewardsBalanceBefore := chain.GetModuleBalance(rewardsTypes.ContractRewardCollector)
+
+			// Create rewards records and mint tokens for them
+			for _, recordCoins := range tc.records {
+				rewards, err := sdk.ParseCoinsNormalized(recordCoins)
+				require.NoError(t, err)
+
+				keeper.GetState().RewardsRecord(ctx).CreateRewardsRecord(feePayer, rewards, ctx.BlockHeight(), ctx.BlockTime())
+				require.NoError(t, chain.GetApp().BankKeeper.MintCoins(ctx, mintTypes.ModuleName, rewards))
+				require.NoError(t, chain.GetApp().BankKeeper.SendCoinsFromModuleToModule(ctx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, rewards))
+			}
+
+			// Build transaction (the x/rewards module account is set as the fee granter)
+			msgs := []sdk.Msg{testutils.NewMockMsg()}
+			if tc.wasmTx {
+				msgs = append(msgs, &wasmdTypes.MsgExecuteContract{})
+			}
+
+			tx := testutils.NewMockFeeTx(
+				testutils.WithMockFeeTxFees(feeCoins),
+				testutils.WithMockFeeTxPayer(feePayer),
+				testutils.WithMockFeeTxGranter(chain.GetApp().AccountKeeper.GetModuleAddress(rewardsTypes.ContractRewardCollector)),
+				testutils.WithMockFeeTxMsgs(msgs...),
+			)
+
+			// Call the deduction Ante handler manually
+			anteHandler := ante.NewDeductFeeDecorator(chain.GetApp().AccountKeeper, chain.GetApp().BankKeeper, chain.GetApp().FeeGrantKeeper, keeper)
+			_, err = anteHandler.AnteHandle(ctx, tx, false, testutils.NoopAnteHandler)
+			if tc.errExpected != nil {
+				require.ErrorIs(t, err, tc.errExpected)
+				assert.Len(t, keeper.GetState().RewardsRecord(ctx).GetRewardsRecordByRewardsAddress(feePayer), len(tc.records))
+				return
+			}
+			require.NoError(t, err)
+
+			// Check final balances
+			feeCollectorBalanceDiffReceived := chain.GetModuleBalance(authTypes.FeeCollectorName).Sub(feeCollectorBalanceBefore)
+			rewardsBalanceDiffReceived := chain.GetModuleBalance(rewardsTypes.ContractRewardCollector).Sub(rewardsBalanceBefore)
+
+			assert.Equal(t, tc.feePayerBalanceExpected, chain.GetBalance(feePayer).String())
+			assert.Equal(t, tc.feeCollectorBalanceDiffExpected, feeCollectorBalanceDiffReceived.String())
+			assert.Equal(t, tc.rewardsBalanceDiffExpected, rewardsBalanceDiffReceived.String())
+
+			// Check used records are pruned
+			assert.Len(t, keeper.GetState().RewardsRecord(ctx).GetRewardsRecordByRewardsAddress(feePayer), tc.recordsLeftExpected)
+
+			// Check the fee rebate is tracked as new rewards
+			if tc.wasmTx && !feeRewardsRatio.IsZero() {
+				txID := chain.GetApp().TrackingKeeper.GetCurrentTxID(ctx)
+				txRewards, found := keeper.GetState().TxRewardsState(ctx).GetTxRewards(txID)
+				require.True(t, found)
+
+				feeRebateExpected, _ := pkg.SplitCoins(feeCoins, feeRewardsRatio)
+				assert.Equal(t, feeRebateExpected.String(), sdk.Coins(txRewards.FeeRewards).String())
+			}
+		})
+	}
+}
+
+func TestRewardsFeeDeductionAnteHandlerFeeSources(t *testing.T) {
+	type testCase struct {
+		name string
+		// Inputs
+		sponsored   bool   // the executed contract has an enabled sponsorship
+		granter     string // fee granter: "feegrant" (x/feegrant granter), "rewards" (x/rewards module account), "self" (fee payer) or empty
+		baseFeeBurn bool   // burn a half of the 200stake base fee
+		// Output expected
+		errExpected                     error
+		feeSourceExpected               string // "payer", "granter", "rewards" or "sponsorship"
+		feeCollectorBalanceDiffExpected string // expected FeeCollector module balance diff [sdk.Coins]
+		feeRebateExpected               string // expected tracked fee rebate rewards [sdk.Coins]
+	}
+
+	testCases := []testCase{
+		{
+			name:                            "OK: fee payer",
+			feeSourceExpected:               "payer",
+			feeCollectorBalanceDiffExpected: "500stake",
+			feeRebateExpected:               "500stake",
+		},
+		{
+			name:                            "OK: fee payer with base fee burn",
+			baseFeeBurn:                     true,
+			feeSourceExpected:               "payer",
+			feeCollectorBalanceDiffExpected: "450stake",
+			feeRebateExpected:               "450stake",
+		},
+		{
+			name:                            "OK: fee granter",
+			granter:                         "feegrant",
+			feeSourceExpected:               "granter",
+			feeCollectorBalanceDiffExpected: "500stake",
+			feeRebateExpected:               "500stake",
+		},
+		{
+			name:                            "OK: fee granter with base fee burn",
+			granter:                         "feegrant",
+			baseFeeBurn:                     true,
+			feeSourceExpected:               "granter",
+			feeCollectorBalanceDiffExpected: "450stake",
+			feeRebateExpected:               "450stake",
+		},
+		{
+			name:                            "OK: self fee granter",
+			granter:                         "self",
+			feeSourceExpected:               "payer",
+			feeCollectorBalanceDiffExpected: "500stake",
+			feeRebateExpected:               "500stake",
+		},
+		{
+			name:                            "OK: rewards records",
+			granter:                         "rewards",
+			feeSourceExpected:               "rewards",
+			feeCollectorBalanceDiffExpected: "500stake",
+			feeRebateExpected:               "500stake",
+		},
+		{
+			name:                            "OK: rewards records with base fee burn",
+			granter:                         "rewards",
+			baseFeeBurn:                     true,
+			feeSourceExpected:               "rewards",
+			feeCollectorBalanceDiffExpected: "450stake",
+			feeRebateExpected:               "450stake",
+		},
+		{
+			name:                            "OK: sponsorship",
+			sponsored:                       true,
+			feeSourceExpected:               "sponsorship",
+			feeCollectorBalanceDiffExpected: "500stake",
+			feeRebateExpected:               "500stake",
+		},
+		{
+			name:                            "OK: sponsorship with base fee burn",
+			sponsored:                       true,
+			baseFeeBurn:                     true,
+			feeSourceExpected:               "sponsorship",
+			feeCollectorBalanceDiffExpected: "450stake",
+			feeRebateExpected:               "450stake",
+		},
+		{
+			name:        "Fail: fee granter and sponsorship",
+			sponsored:   true,
+			granter:     "feegrant",
+			errExpected: sdkErrors.ErrInvalidRequest,
+		},
+		{
+			name:        "Fail: self fee granter and sponsorship",
+			sponsored:   true,
+			granter:     "self",
+			errExpected: sdkErrors.ErrInvalidRequest,
+		},
+		{
+			name:        "Fail: rewards records and sponsorship",
+			sponsored:   true,
+			granter:     "rewards",
+			errExpected: sdkErrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create chain
+			chain := e2eTesting.NewTestChain(t, 2,
+				e2eTesting.WithTxFeeRebatesRewardsRatio(sdk.NewDecWithPrec(5, 1)),
+			)
+			ownerAcc, granterAcc := chain.GetAccount(0), chain.GetAccount(1)
+			ctx := chain.GetContext()
+			keeper := chain.GetApp().RewardsKeeper
+			bankKeeper := chain.GetApp().BankKeeper
+
+			feeCoins := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+			rewardsAddr := chain.GetApp().AccountKeeper.GetModuleAddress(rewardsTypes.ContractRewardCollector)
+
+			// Fee payer has the funds and the rewards records to pay fees
+			payerAddrs, _ := e2eTesting.GenAccounts(1)
+			payer := payerAddrs[0]
+
+			require.NoError(t, bankKeeper.MintCoins(ctx, mintTypes.ModuleName, feeCoins.Add(feeCoins...)))
+			require.NoError(t, bankKeeper.SendCoinsFromModuleToAccount(ctx, mintTypes.ModuleName, payer, feeCoins))
+			require.NoError(t, bankKeeper.SendCoinsFromModuleToModule(ctx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, feeCoins))
+			keeper.GetState().RewardsRecord(ctx).CreateRewardsRecord(payer, feeCoins, ctx.BlockHeight(), ctx.BlockTime())
+
+			// Set the min consensus fee, the base fee and the contract sponsorship
+			keeper.GetState().MinConsensusFee(ctx).SetFee(sdk.NewDecCoin(sdk.DefaultBondDenom, sdk.OneInt()))
+			if tc.baseFeeBurn {
+				keeper.GetState().MinConsensusFee(ctx).SetBaseFee(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(2, 1)))
+
+				params := keeper.GetParams(ctx)
+				params.BaseFeeBurnRatio = sdk.NewDecWithPrec(5, 1)
+				keeper.SetParams(ctx, params)
+			}
+
+			contractAddr := e2eTesting.GenContractAddresses(1)[0]
+			if tc.sponsored {
+				keeper.GetState().ContractMetadataState(ctx).SetContractMetadata(contractAddr, rewardsTypes.ContractMetadata{
+					ContractAddress: contractAddr.String(),
+					OwnerAddress:    ownerAcc.Address.String(),
+				})
+				require.NoError(t, keeper.SetSponsorship(ctx, ownerAcc.Address, contractAddr, feeCoins, 100, feeCoins, false))
+				require.NoError(t, keeper.DepositSponsorship(ctx, ownerAcc.Address, contractAddr, feeCoins))
+			}
+
+			// Build transaction
+			txOpts := []testutils.MockFeeTxOption{
+				testutils.WithMockFeeTxFees(feeCoins),
+				testutils.WithMockFeeTxGas(1000),
+				testutils.WithMockFeeTxPayer(payer),
+				testutils.WithMockFeeTxMsgs(&wasmdTypes.MsgExecuteContract{Sender: payer.String(), Contract: contractAddr.String()}),
+			}
+			switch tc.granter {
+			case "feegrant":
+				require.NoError(t, chain.GetApp().FeeGrantKeeper.GrantAllowance(ctx, granterAcc.Address, payer, &feegrant.BasicAllowance{}))
+				txOpts = append(txOpts, testutils.WithMockFeeTxGranter(granterAcc.Address))
+			case "rewards":
+				txOpts = append(txOpts, testutils.WithMockFeeTxGranter(rewardsAddr))
+			case "self":
+				txOpts = append(txOpts, testutils.WithMockFeeTxGranter(payer))
+			}
+			tx := testutils.NewMockFeeTx(txOpts...)
+
+			// Fetch initial balances
+			payerBalanceBefore := chain.GetBalance(payer)
+			granterBalanceBefore := chain.GetBalance(granterAcc.Address)
+			sponsorshipPoolBefore := keeper.SponsorshipPool(ctx)
+			feeCollectorBalanceBefore := chain.GetModuleBalance(authTypes.FeeCollectorName)
+
+			// Call the deduction Ante handler manually
+			anteHandler := ante.NewDeductFeeDecorator(chain.GetApp().AccountKeeper, bankKeeper, chain.GetApp().FeeGrantKeeper, keeper)
+			_, err := anteHandler.AnteHandle(ctx, tx, false, testutils.NoopAnteHandler)
+			if tc.errExpected != nil {
+				assert.ErrorIs(t, err, tc.errExpected)
+				return
+			}
+			require.NoError(t, err)
+
+			// Check the fee source
+			payerBalanceDiffReceived := payerBalanceBefore.Sub(chain.GetBalance(payer))
+			granterBalanceDiffReceived := granterBalanceBefore.Sub(chain.GetBalance(granterAcc.Address))
+			sponsorshipPoolDiffReceived := sponsorshipPoolBefore.Sub(keeper.SponsorshipPool(ctx))
+			recordsLeft := keeper.GetState().RewardsRecord(ctx).GetRewardsRecordByRewardsAddress(payer)
+
+			sourcesExpected := map[string]bool{
+				"payer":       payerBalanceDiffReceived.IsEqual(feeCoins),
+				"granter":     granterBalanceDiffReceived.IsEqual(feeCoins),
+				"rewards":     len(recordsLeft) == 0,
+				"sponsorship": sponsorshipPoolDiffReceived.IsEqual(feeCoins),
+			}
+			for source, paid := range sourcesExpected {
+				assert.Equal(t, source == tc.feeSourceExpected, paid, "fee source: %s", source)
+			}
+
+			// Check the base fee burn and the fee rebate apply regardless of the source
+			feeCollectorBalanceDiffReceived := chain.GetModuleBalance(authTypes.FeeCollectorName).Sub(feeCollectorBalanceBefore)
+			assert.Equal(t, tc.feeCollectorBalanceDiffExpected, feeCollectorBalanceDiffReceived.String())
+
+			txRewards, found := keeper.GetState().TxRewardsState(ctx).GetTxRewards(chain.GetApp().TrackingKeeper.GetCurrentTxID(ctx))
+			require.True(t, found)
+			assert.Equal(t, tc.feeRebateExpected, sdk.Coins(txRewards.FeeRewards).String())
+			assert.Equal(t, tc.feeSourceExpected == "sponsorship", txRewards.Sponsored)
+		})
+	}
+}
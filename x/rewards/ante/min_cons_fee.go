@@ -9,21 +9,27 @@ import (
 
 // MinConsensusFeeReaderExpected defines the expected interface for the x/rewards keeper.
 type MinConsensusFeeReaderExpected interface {
-	GetMinConsensusFee(ctx sdk.Context) (sdk.DecCoin, bool)
+	GetMinConsensusFees(ctx sdk.Context) (sdk.DecCoins, bool)
+	GetFlatFee(ctx sdk.Context, contractAddr sdk.AccAddress) (sdk.Coin, bool)
 }
 
 // MinFeeDecorator rejects transaction if its fees are less than minimum fees defined by the x/rewards module.
 // Estimation is done using the minimum consensus fee value which is the minimum gas unit price.
 // The minimum consensus fee value is defined by block dApp rewards and rewards distribution parameters.
+// If the base fee is enabled, the higher of the minimum consensus fee and the base fee is used as the minimum gas unit price.
+// The fee can be paid in any of the accepted fee denoms (the base one or the governance-approved alternatives).
+// Contract flat fees (if set) are expected to be paid on top of the minimum fee.
 // CONTRACT: Tx must implement FeeTx interface to use MinFeeDecorator.
 type MinFeeDecorator struct {
 	rewardsKeeper MinConsensusFeeReaderExpected
+	msgInspector  WasmMsgInspector
 }
 
 // NewMinFeeDecorator returns a new MinFeeDecorator instance.
 func NewMinFeeDecorator(rk MinConsensusFeeReaderExpected) MinFeeDecorator {
 	return MinFeeDecorator{
 		rewardsKeeper: rk,
+		msgInspector:  DefaultWasmMsgInspector(),
 	}
 }
 
@@ -40,13 +46,11 @@ func (mfd MinFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool,
 	}
 
 	// Skip the check if min gas unit price is not defined (not yet set or is zero)
-	gasUnitPrice, found := mfd.rewardsKeeper.GetMinConsensusFee(ctx)
-	if !found || gasUnitPrice.IsZero() {
+	gasUnitPrices, found := mfd.rewardsKeeper.GetMinConsensusFees(ctx)
+	if !found || gasUnitPrices.IsZero() {
 		return next(ctx, tx, simulate)
 	}
 
-	// Estimate the minimum fee expected
-	// We use RoundInt here since minimum fee must be GTE calculated amount
 	txFees := feeTx.GetFee()
 
 	txGasLimit := pkg.NewDecFromUint64(feeTx.GetGas())
@@ -54,15 +58,49 @@ func (mfd MinFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool,
 		return ctx, sdkErrors.Wrap(sdkErrors.ErrInvalidRequest, "tx gas limit is not set")
 	}
 
-	minFeeExpected := sdk.Coin{
-		Denom:  gasUnitPrice.Denom,
-		Amount: gasUnitPrice.Amount.Mul(txGasLimit).RoundInt(),
+	// Estimate the minimum fee expected for every accepted denom (skip if the expected amount is zero)
+	minFeesExpected := estimateMinFees(gasUnitPrices, txGasLimit)
+	if minFeesExpected == nil {
+		return next(ctx, tx, simulate)
 	}
 
-	// Check (skip if the expected amount is zero)
-	if minFeeExpected.Amount.IsZero() || txFees.IsAnyGTE(sdk.Coins{minFeeExpected}) {
-		return next(ctx, tx, simulate)
+	flatFees, _, err := getTxContractFlatFees(ctx, mfd.rewardsKeeper, mfd.msgInspector, tx)
+	if err != nil {
+		return ctx, err
+	}
+	if flatFees.IsZero() {
+		if txFees.IsAnyGTE(minFeesExpected) {
+			return next(ctx, tx, simulate)
+		}
+
+		return ctx, sdkErrors.Wrapf(sdkErrors.ErrInsufficientFee, "tx fee %s is less than min fee (any of): %s", txFees, minFeesExpected)
+	}
+
+	for _, minFeeExpected := range minFeesExpected {
+		if txFees.IsAllGTE(flatFees.Add(minFeeExpected)) {
+			return next(ctx, tx, simulate)
+		}
+	}
+
+	return ctx, sdkErrors.Wrapf(sdkErrors.ErrInsufficientFee, "tx fee %s is less than contract flat fees %s and min fee (any of): %s", txFees, flatFees, minFeesExpected)
+}
+
+// estimateMinFees returns the minimum fee expected for every accepted denom for the given gas limit.
+// We use RoundInt here since minimum fee must be GTE calculated amount.
+// Nil is returned if any of the expected amounts is zero.
+func estimateMinFees(gasUnitPrices sdk.DecCoins, txGasLimit sdk.Dec) sdk.Coins {
+	minFeesExpected := make(sdk.Coins, 0, len(gasUnitPrices))
+	for _, gasUnitPrice := range gasUnitPrices {
+		minFeeExpected := sdk.Coin{
+			Denom:  gasUnitPrice.Denom,
+			Amount: gasUnitPrice.Amount.Mul(txGasLimit).RoundInt(),
+		}
+
+		if minFeeExpected.Amount.IsZero() {
+			return nil
+		}
+		minFeesExpected = append(minFeesExpected, minFeeExpected)
 	}
 
-	return ctx, sdkErrors.Wrapf(sdkErrors.ErrInsufficientFee, "tx fee %s is less than min fee: %s", txFees, minFeeExpected)
+	return minFeesExpected
 }
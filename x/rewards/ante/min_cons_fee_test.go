@@ -3,23 +3,30 @@ package ante_test
 import (
 	"testing"
 
+	wasmdTypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
 	"github.com/archway-network/archway/pkg/testutils"
 	"github.com/archway-network/archway/x/rewards/ante"
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
 )
 
 func TestRewardsMinFeeAnteHandler(t *testing.T) {
 	type testCase struct {
 		name string
 		// Inputs
-		txFees     string // transaction fees [sdk.Coins]
-		txGasLimit uint64 // transaction gas limit
-		minConsFee string // min consensus fee [sdk.DecCoin]
+		txFees          string // transaction fees [sdk.Coins]
+		txGasLimit      uint64 // transaction gas limit
+		minConsFee      string // min consensus fee [sdk.DecCoin]
+		flatFee         string // contract flat fee (optional, a contract execution msg is added to the tx if set) [sdk.Coin]
+		flatFeeMsgDepth int    // number of authz.MsgExec wrappers for the contract execution msg (optional)
+		feeDenoms       string // accepted fee denoms (optional, rate is the coin amount) [sdk.DecCoins]
+		baseFee         string // base fee (optional) [sdk.DecCoin]
 		// Output expected
 		errExpected error // concrete error expected (or nil if no error expected)
 	}
@@ -56,6 +63,130 @@ func TestRewardsMinFeeAnteHandler(t *testing.T) {
 			txGasLimit: 1000,
 			minConsFee: "0.000000000001stake",
 		},
+		{
+			name:       "OK: 150stake fee == 100stake min fee + 50stake flat fee",
+			txFees:     "150stake",
+			txGasLimit: 1000,
+			minConsFee: "0.1stake",
+			flatFee:    "50stake",
+		},
+		{
+			name:        "Fail: 149stake fee < 100stake min fee + 50stake flat fee",
+			txFees:      "149stake",
+			txGasLimit:  1000,
+			minConsFee:  "0.1stake",
+			flatFee:     "50stake",
+			errExpected: sdkErrors.ErrInsufficientFee,
+		},
+		{
+			name:       "OK: 100stake,10uarch fee == 100stake min fee + 10uarch flat fee",
+			txFees:     "100stake,10uarch",
+			txGasLimit: 1000,
+			minConsFee: "0.1stake",
+			flatFee:    "10uarch",
+		},
+		{
+			name:        "Fail: 200stake fee (10uarch flat fee is not paid)",
+			txFees:      "200stake",
+			txGasLimit:  1000,
+			minConsFee:  "0.1stake",
+			flatFee:     "10uarch",
+			errExpected: sdkErrors.ErrInsufficientFee,
+		},
+		{
+			name:       "OK: 50uusdc fee == 100stake min fee (1uusdc = 2stake)",
+			txFees:     "50uusdc",
+			txGasLimit: 1000,
+			minConsFee: "0.1stake",
+			feeDenoms:  "2uusdc",
+		},
+		{
+			name:        "Fail: 49uusdc fee < 100stake min fee (1uusdc = 2stake)",
+			txFees:      "49uusdc",
+			txGasLimit:  1000,
+			minConsFee:  "0.1stake",
+			feeDenoms:   "2uusdc",
+			errExpected: sdkErrors.ErrInsufficientFee,
+		},
+		{
+			name:       "OK: 100stake fee == 100stake min fee (alternative denoms are accepted)",
+			txFees:     "100stake",
+			txGasLimit: 1000,
+			minConsFee: "0.1stake",
+			feeDenoms:  "2uusdc",
+		},
+		{
+			name:        "Fail: 100uatom fee (denom is not accepted)",
+			txFees:      "100uatom",
+			txGasLimit:  1000,
+			minConsFee:  "0.1stake",
+			feeDenoms:   "2uusdc",
+			errExpected: sdkErrors.ErrInsufficientFee,
+		},
+		{
+			name:       "OK: 60uusdc fee == 100stake min fee (1uusdc = 2stake) + 10uusdc flat fee",
+			txFees:     "60uusdc",
+			txGasLimit: 1000,
+			minConsFee: "0.1stake",
+			flatFee:    "10uusdc",
+			feeDenoms:  "2uusdc",
+		},
+		{
+			name:       "OK: 50uusdc,50stake fee == 100stake min fee (1uusdc = 2stake) + 50stake flat fee",
+			txFees:     "50stake,50uusdc",
+			txGasLimit: 1000,
+			minConsFee: "0.1stake",
+			flatFee:    "50stake",
+			feeDenoms:  "2uusdc",
+		},
+		{
+			name:        "Fail: 59uusdc fee < 100stake min fee (1uusdc = 2stake) + 10uusdc flat fee",
+			txFees:      "59uusdc",
+			txGasLimit:  1000,
+			minConsFee:  "0.1stake",
+			flatFee:     "10uusdc",
+			feeDenoms:   "2uusdc",
+			errExpected: sdkErrors.ErrInsufficientFee,
+		},
+		{
+			name:            "Fail: 100stake flat fee contract execution wrapped into 9 nested authz.MsgExec (max nesting depth exceeded)",
+			txFees:          "200stake",
+			txGasLimit:      1000,
+			minConsFee:      "0.1stake",
+			flatFee:         "100stake",
+			flatFeeMsgDepth: ante.MaxMsgNestingDepth + 1,
+			errExpected:     sdkErrors.ErrInvalidRequest,
+		},
+		{
+			name:       "OK: 200stake fee == 200stake base fee > 100stake min fee",
+			txFees:     "200stake",
+			txGasLimit: 1000,
+			minConsFee: "0.1stake",
+			baseFee:    "0.2stake",
+		},
+		{
+			name:        "Fail: 199stake fee < 200stake base fee",
+			txFees:      "199stake",
+			txGasLimit:  1000,
+			minConsFee:  "0.1stake",
+			baseFee:     "0.2stake",
+			errExpected: sdkErrors.ErrInsufficientFee,
+		},
+		{
+			name:       "OK: 100uusdc fee == 200stake base fee (1uusdc = 2stake)",
+			txFees:     "100uusdc",
+			txGasLimit: 1000,
+			minConsFee: "0.1stake",
+			feeDenoms:  "2uusdc",
+			baseFee:    "0.2stake",
+		},
+		{
+			name:       "OK: 100stake fee == 100stake min fee > 50stake base fee",
+			txFees:     "100stake",
+			txGasLimit: 1000,
+			minConsFee: "0.1stake",
+			baseFee:    "0.05stake",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -70,14 +201,55 @@ func TestRewardsMinFeeAnteHandler(t *testing.T) {
 
 			chain.GetApp().RewardsKeeper.GetState().MinConsensusFee(ctx).SetFee(minConsFee)
 
+			// Set base fee
+			if tc.baseFee != "" {
+				baseFee, err := sdk.ParseDecCoin(tc.baseFee)
+				require.NoError(t, err)
+
+				chain.GetApp().RewardsKeeper.GetState().MinConsensusFee(ctx).SetBaseFee(baseFee)
+			}
+
+			// Set accepted fee denoms
+			if tc.feeDenoms != "" {
+				feeDenomRates, err := sdk.ParseDecCoins(tc.feeDenoms)
+				require.NoError(t, err)
+
+				params := chain.GetApp().RewardsKeeper.GetParams(ctx)
+				for _, rate := range feeDenomRates {
+					params.AcceptedFeeDenoms = append(params.AcceptedFeeDenoms, rewardsTypes.FeeDenom{Denom: rate.Denom, Rate: rate.Amount})
+				}
+				chain.GetApp().RewardsKeeper.SetParams(ctx, params)
+			}
+
 			// Build transaction
 			txFees, err := sdk.ParseCoinsNormalized(tc.txFees)
 			require.NoError(t, err)
 
-			tx := testutils.NewMockFeeTx(
+			txOpts := []testutils.MockFeeTxOption{
 				testutils.WithMockFeeTxFees(txFees),
 				testutils.WithMockFeeTxGas(tc.txGasLimit),
-			)
+			}
+
+			// Set contract flat fee
+			if tc.flatFee != "" {
+				flatFee, err := sdk.ParseCoinNormalized(tc.flatFee)
+				require.NoError(t, err)
+
+				contractAddr := e2eTesting.GenContractAddresses(1)[0]
+				chain.GetApp().RewardsKeeper.GetState().FlatFee(ctx).SetFlatFee(contractAddr, flatFee)
+
+				var msg sdk.Msg = &wasmdTypes.MsgExecuteContract{
+					Contract: contractAddr.String(),
+				}
+				for i := 0; i < tc.flatFeeMsgDepth; i++ {
+					execMsg := authz.NewMsgExec(contractAddr, []sdk.Msg{msg})
+					msg = &execMsg
+				}
+
+				txOpts = append(txOpts, testutils.WithMockFeeTxMsgs(msg))
+			}
+
+			tx := testutils.NewMockFeeTx(txOpts...)
 
 			// Call the Ante handler manually
 			anteHandler := ante.NewMinFeeDecorator(chain.GetApp().RewardsKeeper)
@@ -0,0 +1,127 @@
+package ante
+
+import (
+	wasmdTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// MaxMsgNestingDepth is the maximum depth of wrapper messages the WasmMsgInspector unwraps.
+// Transactions with messages nested deeper are rejected (CPU usage limit): skipping them would allow contract flat fees bypass.
+const MaxMsgNestingDepth = 8
+
+// MsgUnwrapperFn returns the nested messages of a wrapper message.
+// The bool return value is false if the message is not a wrapper supported by the function.
+type MsgUnwrapperFn func(msg sdk.Msg) ([]sdk.Msg, bool)
+
+// UnwrapAuthzMsgExec is a MsgUnwrapperFn for the x/authz MsgExec message.
+func UnwrapAuthzMsgExec(msg sdk.Msg) ([]sdk.Msg, bool) {
+	execMsg, ok := msg.(*authz.MsgExec)
+	if !ok {
+		return nil, false
+	}
+
+	nestedMsgs, err := execMsg.GetMessages()
+	if err != nil {
+		// Invalid nested msgs are rejected by the msg ValidateBasic
+		return nil, false
+	}
+
+	return nestedMsgs, true
+}
+
+// WasmMsgInspector searches for wasmd messages within a transaction including the ones nested into wrapper messages.
+type WasmMsgInspector struct {
+	unwrappers []MsgUnwrapperFn
+}
+
+// NewWasmMsgInspector creates a new WasmMsgInspector instance with the given wrapper message unwrappers.
+func NewWasmMsgInspector(unwrappers ...MsgUnwrapperFn) WasmMsgInspector {
+	return WasmMsgInspector{
+		unwrappers: unwrappers,
+	}
+}
+
+// DefaultWasmMsgInspector creates a new WasmMsgInspector with all the supported wrapper messages registered.
+func DefaultWasmMsgInspector() WasmMsgInspector {
+	return NewWasmMsgInspector(
+		UnwrapAuthzMsgExec,
+	)
+}
+
+// HasWasmMsgs returns true if there is at least one wasmd message that is tracked by the x/tracking module.
+// Error is returned if wrapper messages are nested deeper than MaxMsgNestingDepth (all the msgs are visited to check that).
+func (i WasmMsgInspector) HasWasmMsgs(msgs []sdk.Msg) (bool, error) {
+	found := false
+	err := i.walk(msgs, 0, func(msg sdk.Msg) {
+		found = found || IsWasmMsg(msg)
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return found, nil
+}
+
+// GetExecuteContractMsgs returns all the MsgExecuteContract messages (top-level and nested ones).
+// Error is returned if wrapper messages are nested deeper than MaxMsgNestingDepth.
+func (i WasmMsgInspector) GetExecuteContractMsgs(msgs []sdk.Msg) ([]*wasmdTypes.MsgExecuteContract, error) {
+	var executeMsgs []*wasmdTypes.MsgExecuteContract
+	err := i.walk(msgs, 0, func(msg sdk.Msg) {
+		if executeMsg, ok := msg.(*wasmdTypes.MsgExecuteContract); ok {
+			executeMsgs = append(executeMsgs, executeMsg)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return executeMsgs, nil
+}
+
+// walk traverses messages depth-first unwrapping the registered wrapper messages.
+// Callback is called for every non-wrapper message.
+// Walk fails if the MaxMsgNestingDepth is exceeded.
+func (i WasmMsgInspector) walk(msgs []sdk.Msg, depth int, fn func(msg sdk.Msg)) error {
+	if depth > MaxMsgNestingDepth {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, "wrapped msgs nesting depth exceeds the max (%d)", MaxMsgNestingDepth)
+	}
+
+	for _, msg := range msgs {
+		if nestedMsgs, ok := i.unwrap(msg); ok {
+			if err := i.walk(nestedMsgs, depth+1, fn); err != nil {
+				return err
+			}
+			continue
+		}
+
+		fn(msg)
+	}
+
+	return nil
+}
+
+// unwrap returns the nested messages if the message is a registered wrapper.
+func (i WasmMsgInspector) unwrap(msg sdk.Msg) ([]sdk.Msg, bool) {
+	for _, unwrapper := range i.unwrappers {
+		if nestedMsgs, ok := unwrapper(msg); ok {
+			return nestedMsgs, true
+		}
+	}
+
+	return nil, false
+}
+
+// IsWasmMsg returns true if the message is a wasmd message that triggers contract operations tracked by the x/tracking module.
+func IsWasmMsg(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case *wasmdTypes.MsgExecuteContract,
+		*wasmdTypes.MsgMigrateContract,
+		*wasmdTypes.MsgInstantiateContract,
+		*wasmdTypes.MsgInstantiateContract2:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,232 @@
+package ante_test
+
+import (
+	"testing"
+
+	wasmdTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/assert"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/pkg/testutils"
+	"github.com/archway-network/archway/x/rewards/ante"
+)
+
+// mockWrapperMsg is a custom wrapper msg used to test the WasmMsgInspector unwrappers registration.
+type mockWrapperMsg struct {
+	testutils.MockMsg
+	msgs []sdk.Msg
+}
+
+func unwrapMockWrapperMsg(msg sdk.Msg) ([]sdk.Msg, bool) {
+	wrapperMsg, ok := msg.(*mockWrapperMsg)
+	if !ok {
+		return nil, false
+	}
+
+	return wrapperMsg.msgs, true
+}
+
+func TestWasmMsgInspector(t *testing.T) {
+	type testCase struct {
+		name string
+		// Inputs
+		inspector ante.WasmMsgInspector
+		msgs      []sdk.Msg
+		// Output expected
+		hasWasmMsgsExpected bool
+		executeMsgsExpected int  // number of MsgExecuteContract msgs expected to be found
+		errExpected         bool // max nesting depth exceeded
+	}
+
+	accAddrs, _ := e2eTesting.GenAccounts(2)
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+
+	newExecuteMsg := func() sdk.Msg {
+		return &wasmdTypes.MsgExecuteContract{Sender: accAddrs[0].String(), Contract: contractAddr.String()}
+	}
+	newMigrateMsg := func() sdk.Msg {
+		return &wasmdTypes.MsgMigrateContract{Sender: accAddrs[0].String(), Contract: contractAddr.String()}
+	}
+	newInstantiateMsg := func() sdk.Msg {
+		return &wasmdTypes.MsgInstantiateContract{Sender: accAddrs[0].String(), CodeID: 1}
+	}
+	newInstantiate2Msg := func() sdk.Msg {
+		return &wasmdTypes.MsgInstantiateContract2{Sender: accAddrs[0].String(), CodeID: 1}
+	}
+	newBankMsg := func() sdk.Msg {
+		return bankTypes.NewMsgSend(accAddrs[0], accAddrs[1], sdk.NewCoins(sdk.NewInt64Coin("stake", 1)))
+	}
+	newAuthzExecMsg := func(msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(accAddrs[1], msgs)
+		return &msg
+	}
+	newNestedAuthzExecMsg := func(depth int, msgs ...sdk.Msg) sdk.Msg {
+		msg := newAuthzExecMsg(msgs...)
+		for i := 1; i < depth; i++ {
+			msg = newAuthzExecMsg(msg)
+		}
+		return msg
+	}
+
+	testCases := []testCase{
+		{
+			name:      "No msgs",
+			inspector: ante.DefaultWasmMsgInspector(),
+		},
+		{
+			name:      "Non-wasm msgs",
+			inspector: ante.DefaultWasmMsgInspector(),
+			msgs:      []sdk.Msg{newBankMsg(), testutils.NewMockMsg()},
+		},
+		{
+			name:                "MsgExecuteContract",
+			inspector:           ante.DefaultWasmMsgInspector(),
+			msgs:                []sdk.Msg{newBankMsg(), newExecuteMsg()},
+			hasWasmMsgsExpected: true,
+			executeMsgsExpected: 1,
+		},
+		{
+			name:                "MsgMigrateContract",
+			inspector:           ante.DefaultWasmMsgInspector(),
+			msgs:                []sdk.Msg{newMigrateMsg()},
+			hasWasmMsgsExpected: true,
+		},
+		{
+			name:                "MsgInstantiateContract",
+			inspector:           ante.DefaultWasmMsgInspector(),
+			msgs:                []sdk.Msg{newInstantiateMsg()},
+			hasWasmMsgsExpected: true,
+		},
+		{
+			name:                "MsgInstantiateContract2",
+			inspector:           ante.DefaultWasmMsgInspector(),
+			msgs:                []sdk.Msg{newInstantiate2Msg()},
+			hasWasmMsgsExpected: true,
+		},
+		{
+			name:      "authz.MsgExec with non-wasm msgs",
+			inspector: ante.DefaultWasmMsgInspector(),
+			msgs:      []sdk.Msg{newAuthzExecMsg(newBankMsg(), newBankMsg())},
+		},
+		{
+			name:                "authz.MsgExec with MsgExecuteContract",
+			inspector:           ante.DefaultWasmMsgInspector(),
+			msgs:                []sdk.Msg{newAuthzExecMsg(newBankMsg(), newExecuteMsg())},
+			hasWasmMsgsExpected: true,
+			executeMsgsExpected: 1,
+		},
+		{
+			name:                "authz.MsgExec with MsgMigrateContract",
+			inspector:           ante.DefaultWasmMsgInspector(),
+			msgs:                []sdk.Msg{newAuthzExecMsg(newMigrateMsg())},
+			hasWasmMsgsExpected: true,
+		},
+		{
+			name:                "authz.MsgExec with MsgInstantiateContract",
+			inspector:           ante.DefaultWasmMsgInspector(),
+			msgs:                []sdk.Msg{newAuthzExecMsg(newInstantiateMsg())},
+			hasWasmMsgsExpected: true,
+		},
+		{
+			name:                "authz.MsgExec with MsgInstantiateContract2",
+			inspector:           ante.DefaultWasmMsgInspector(),
+			msgs:                []sdk.Msg{newAuthzExecMsg(newInstantiate2Msg())},
+			hasWasmMsgsExpected: true,
+		},
+		{
+			name:                "authz.MsgExec nested into authz.MsgExec with MsgExecuteContract",
+			inspector:           ante.DefaultWasmMsgInspector(),
+			msgs:                []sdk.Msg{newNestedAuthzExecMsg(2, newExecuteMsg())},
+			hasWasmMsgsExpected: true,
+			executeMsgsExpected: 1,
+		},
+		{
+			name:      "authz.MsgExec nested into authz.MsgExec with non-wasm msgs",
+			inspector: ante.DefaultWasmMsgInspector(),
+			msgs:      []sdk.Msg{newNestedAuthzExecMsg(2, newBankMsg())},
+		},
+		{
+			name:      "Top-level, authz.MsgExec and nested authz.MsgExec MsgExecuteContract msgs",
+			inspector: ante.DefaultWasmMsgInspector(),
+			msgs: []sdk.Msg{
+				newExecuteMsg(),
+				newAuthzExecMsg(newExecuteMsg(), newBankMsg(), newExecuteMsg()),
+				newNestedAuthzExecMsg(3, newExecuteMsg(), newMigrateMsg()),
+			},
+			hasWasmMsgsExpected: true,
+			executeMsgsExpected: 4,
+		},
+		{
+			name:                "authz.MsgExec at the max nesting depth",
+			inspector:           ante.DefaultWasmMsgInspector(),
+			msgs:                []sdk.Msg{newNestedAuthzExecMsg(ante.MaxMsgNestingDepth, newExecuteMsg())},
+			hasWasmMsgsExpected: true,
+			executeMsgsExpected: 1,
+		},
+		{
+			name:        "Fail: authz.MsgExec exceeding the max nesting depth",
+			inspector:   ante.DefaultWasmMsgInspector(),
+			msgs:        []sdk.Msg{newNestedAuthzExecMsg(ante.MaxMsgNestingDepth+1, newExecuteMsg())},
+			errExpected: true,
+		},
+		{
+			name:      "Fail: authz.MsgExec exceeding the max nesting depth after a wasm msg",
+			inspector: ante.DefaultWasmMsgInspector(),
+			msgs: []sdk.Msg{
+				newExecuteMsg(),
+				newNestedAuthzExecMsg(ante.MaxMsgNestingDepth+1, newBankMsg()),
+			},
+			errExpected: true,
+		},
+		{
+			name:      "authz.MsgExec with no unwrappers registered",
+			inspector: ante.NewWasmMsgInspector(),
+			msgs:      []sdk.Msg{newAuthzExecMsg(newExecuteMsg())},
+		},
+		{
+			name:      "Custom wrapper with unregistered unwrapper",
+			inspector: ante.DefaultWasmMsgInspector(),
+			msgs:      []sdk.Msg{&mockWrapperMsg{msgs: []sdk.Msg{newExecuteMsg()}}},
+		},
+		{
+			name:                "Custom wrapper with MsgExecuteContract",
+			inspector:           ante.NewWasmMsgInspector(ante.UnwrapAuthzMsgExec, unwrapMockWrapperMsg),
+			msgs:                []sdk.Msg{&mockWrapperMsg{msgs: []sdk.Msg{newExecuteMsg()}}},
+			hasWasmMsgsExpected: true,
+			executeMsgsExpected: 1,
+		},
+		{
+			name:      "Custom wrapper nested into authz.MsgExec",
+			inspector: ante.NewWasmMsgInspector(ante.UnwrapAuthzMsgExec, unwrapMockWrapperMsg),
+			msgs: []sdk.Msg{
+				newAuthzExecMsg(&mockWrapperMsg{msgs: []sdk.Msg{newInstantiateMsg(), newExecuteMsg()}}),
+			},
+			hasWasmMsgsExpected: true,
+			executeMsgsExpected: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hasWasmMsgs, err := tc.inspector.HasWasmMsgs(tc.msgs)
+			if tc.errExpected {
+				assert.ErrorIs(t, err, sdkErrors.ErrInvalidRequest)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tc.hasWasmMsgsExpected, hasWasmMsgs)
+
+			executeMsgs, err := tc.inspector.GetExecuteContractMsgs(tc.msgs)
+			if tc.errExpected {
+				assert.ErrorIs(t, err, sdkErrors.ErrInvalidRequest)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Len(t, executeMsgs, tc.executeMsgsExpected)
+		})
+	}
+}
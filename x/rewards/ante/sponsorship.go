@@ -0,0 +1,70 @@
+package ante
+
+import (
+	wasmdTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/archway-network/archway/pkg"
+)
+
+// getTxSponsorCandidate returns the contract address that might sponsor the transaction fees.
+// A transaction is a sponsorship candidate only if all its msgs are non-nested wasmd MsgExecuteContract
+// messages targeting the same contract.
+func getTxSponsorCandidate(tx sdk.Tx) (sdk.AccAddress, bool) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil, false
+	}
+
+	var contractAddrBz string
+	for _, msg := range msgs {
+		executeMsg, ok := msg.(*wasmdTypes.MsgExecuteContract)
+		if !ok {
+			return nil, false
+		}
+
+		if contractAddrBz == "" {
+			contractAddrBz = executeMsg.Contract
+			continue
+		}
+		if executeMsg.Contract != contractAddrBz {
+			return nil, false
+		}
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddrBz)
+	if err != nil {
+		// Invalid contract address is handled by the msg ValidateBasic
+		return nil, false
+	}
+
+	return contractAddr, true
+}
+
+// isTxFeeSponsorable checks that the transaction fee does not exceed the required fee: contract flat fees plus
+// the minimum consensus fee for any of the accepted denoms (only flat fees if the minimum fee is not defined).
+// Overpaid fees are never sponsored, so the deposit can't be drained by a single transaction.
+func isTxFeeSponsorable(ctx sdk.Context, rk TxFeeRewardsKeeperExpected, msgInspector WasmMsgInspector, tx sdk.FeeTx) bool {
+	flatFees, _, err := getTxContractFlatFees(ctx, rk, msgInspector, tx)
+	if err != nil {
+		return false
+	}
+
+	var minFees sdk.Coins
+	if gasUnitPrices, found := rk.GetMinConsensusFees(ctx); found && !gasUnitPrices.IsZero() {
+		minFees = estimateMinFees(gasUnitPrices, pkg.NewDecFromUint64(tx.GetGas()))
+	}
+
+	fee := tx.GetFee()
+	if len(minFees) == 0 {
+		return flatFees.IsAllGTE(fee)
+	}
+
+	for _, minFee := range minFees {
+		if flatFees.Add(minFee).IsAllGTE(fee) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,57 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// TxPriorityKeeperExpected defines the expected interface for the x/rewards keeper.
+type TxPriorityKeeperExpected interface {
+	GetFlatFee(ctx sdk.Context, contractAddr sdk.AccAddress) (sdk.Coin, bool)
+	GetTxPriority(ctx sdk.Context, fees sdk.Coins, txGasLimit uint64) int64
+	SetCheckTxPriority(ctx sdk.Context, priority int64)
+}
+
+// TxPriorityDecorator estimates the transaction mempool priority defined by the x/rewards TxPriorityTiers param.
+// Contract flat fees (if set) are excluded from the tx fees as they are not paid for the gas.
+// Decorator is only active for CheckTx, the estimated priority is passed to the app via the x/rewards transient store.
+// CONTRACT: Tx must implement FeeTx interface to use TxPriorityDecorator.
+type TxPriorityDecorator struct {
+	rewardsKeeper TxPriorityKeeperExpected
+	msgInspector  WasmMsgInspector
+}
+
+// NewTxPriorityDecorator returns a new TxPriorityDecorator instance.
+func NewTxPriorityDecorator(rk TxPriorityKeeperExpected) TxPriorityDecorator {
+	return TxPriorityDecorator{
+		rewardsKeeper: rk,
+		msgInspector:  DefaultWasmMsgInspector(),
+	}
+}
+
+// AnteHandle implements the ante.AnteDecorator interface.
+func (tpd TxPriorityDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	// Priority is only used by the mempool
+	if simulate || !ctx.IsCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkErrors.Wrap(sdkErrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	flatFees, _, err := getTxContractFlatFees(ctx, tpd.rewardsKeeper, tpd.msgInspector, tx)
+	if err != nil {
+		return ctx, err
+	}
+
+	// Fees not covering flat fees get the lowest priority
+	var priority int64
+	if txFees, hasNeg := feeTx.GetFee().SafeSub(flatFees); !hasNeg {
+		priority = tpd.rewardsKeeper.GetTxPriority(ctx, txFees, feeTx.GetGas())
+	}
+	tpd.rewardsKeeper.SetCheckTxPriority(ctx, priority)
+
+	return next(ctx, tx, simulate)
+}
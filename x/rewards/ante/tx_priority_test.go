@@ -0,0 +1,129 @@
+package ante_test
+
+import (
+	"testing"
+
+	wasmdTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/pkg/testutils"
+	"github.com/archway-network/archway/x/rewards/ante"
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
+func TestRewardsTxPriorityAnteHandler(t *testing.T) {
+	type testCase struct {
+		name string
+		// Inputs
+		txFees     string // transaction fees [sdk.Coins]
+		flatFee    string // contract flat fee (optional, a contract execution msg is added to the tx if set) [sdk.Coin]
+		deliverTx  bool   // run the handler in the DeliverTx mode
+		simulation bool   // run the handler in the simulation mode
+		// Output expected
+		priorityExpected int64
+	}
+
+	// Min fee is 100stake for the 1000 gas limit
+	testCases := []testCase{
+		{
+			name:             "OK: 100stake fee (below the first tier)",
+			txFees:           "100stake",
+			priorityExpected: 0,
+		},
+		{
+			name:             "OK: 200stake fee",
+			txFees:           "200stake",
+			priorityExpected: 20,
+		},
+		{
+			name:             "OK: 1000stake fee",
+			txFees:           "1000stake",
+			priorityExpected: 100,
+		},
+		{
+			name:             "OK: 300stake fee - 100stake flat fee",
+			txFees:           "300stake",
+			flatFee:          "100stake",
+			priorityExpected: 20,
+		},
+		{
+			name:             "OK: 1000stake fee - 900stake flat fee",
+			txFees:           "1000stake",
+			flatFee:          "900stake",
+			priorityExpected: 0,
+		},
+		{
+			name:             "OK: 200stake fee (10uarch flat fee is not paid)",
+			txFees:           "200stake",
+			flatFee:          "10uarch",
+			priorityExpected: 0,
+		},
+		{
+			name:             "OK: 1000stake fee (DeliverTx is skipped)",
+			txFees:           "1000stake",
+			deliverTx:        true,
+			priorityExpected: 0,
+		},
+		{
+			name:             "OK: 1000stake fee (simulation is skipped)",
+			txFees:           "1000stake",
+			simulation:       true,
+			priorityExpected: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			// Create chain
+			chain := e2eTesting.NewTestChain(t, 1)
+			ctx := chain.GetContext().WithIsCheckTx(!tc.deliverTx)
+			keeper := chain.GetApp().RewardsKeeper
+
+			// Set min consensus fee and priority tiers
+			keeper.GetState().MinConsensusFee(ctx).SetFee(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 1)))
+
+			params := keeper.GetParams(ctx)
+			params.TxPriorityTiers = []rewardsTypes.TxPriorityTier{
+				{MinFeeRatio: sdk.NewDecWithPrec(15, 1), Priority: 10},
+				{MinFeeRatio: sdk.NewDec(2), Priority: 20},
+				{MinFeeRatio: sdk.NewDec(10), Priority: 100},
+			}
+			keeper.SetParams(ctx, params)
+
+			// Build transaction
+			txFees, err := sdk.ParseCoinsNormalized(tc.txFees)
+			require.NoError(t, err)
+
+			txOpts := []testutils.MockFeeTxOption{
+				testutils.WithMockFeeTxFees(txFees),
+				testutils.WithMockFeeTxGas(1000),
+			}
+
+			// Set contract flat fee
+			if tc.flatFee != "" {
+				flatFee, err := sdk.ParseCoinNormalized(tc.flatFee)
+				require.NoError(t, err)
+
+				contractAddr := e2eTesting.GenContractAddresses(1)[0]
+				keeper.GetState().FlatFee(ctx).SetFlatFee(contractAddr, flatFee)
+
+				txOpts = append(txOpts, testutils.WithMockFeeTxMsgs(&wasmdTypes.MsgExecuteContract{
+					Contract: contractAddr.String(),
+				}))
+			}
+
+			tx := testutils.NewMockFeeTx(txOpts...)
+
+			// Call the Ante handler manually
+			anteHandler := ante.NewTxPriorityDecorator(keeper)
+			_, err = anteHandler.AnteHandle(ctx, tx, tc.simulation, testutils.NoopAnteHandler)
+			require.NoError(t, err)
+
+			assert.Equal(t, tc.priorityExpected, keeper.PopCheckTxPriority(ctx))
+			assert.EqualValues(t, 0, keeper.PopCheckTxPriority(ctx))
+		})
+	}
+}
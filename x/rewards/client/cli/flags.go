@@ -1,14 +1,36 @@
 package cli
 
-import "github.com/spf13/cobra"
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+
+	"github.com/archway-network/archway/pkg"
+	"github.com/archway-network/archway/x/rewards/types"
+)
 
 const (
-	flagOwnerAddress   = "owner-address"
-	flagRewardsAddress = "rewards-address"
-	flagRecordsLimit   = "records-limit"
-	flagRecordIDs      = "record-ids"
+	flagOwnerAddress      = "owner-address"
+	flagRewardsAddress    = "rewards-address"
+	flagRewardsRecipients = "rewards-recipients"
+	flagRecordsLimit      = "records-limit"
+	flagRecordIDs         = "record-ids"
+	flagIBCChannel        = "ibc-channel"
+	flagIBCReceiver       = "ibc-receiver"
+	flagIBCTimeout        = "ibc-timeout"
+	flagIntervalBlocks    = "interval-blocks"
+	flagThreshold         = "threshold"
+	flagUserFeeLimit      = "user-fee-limit"
+	flagUserFeePeriod     = "user-fee-period"
+	flagBlockFeeLimit     = "block-fee-limit"
+	flagFundFromRewards   = "fund-from-rewards"
 )
 
+const defaultIBCTimeout = 10 * time.Minute
+
 func addOwnerAddressFlag(cmd *cobra.Command) {
 	cmd.Flags().String(flagOwnerAddress, "", "Address of the contract owner (bech 32)")
 }
@@ -17,6 +39,10 @@ func addRewardsAddressFlag(cmd *cobra.Command) {
 	cmd.Flags().String(flagRewardsAddress, "", "Rewards address to distribute contract rewards to (bech 32)")
 }
 
+func addRewardsRecipientsFlag(cmd *cobra.Command) {
+	cmd.Flags().StringSlice(flagRewardsRecipients, []string{}, "Weighted rewards recipients to split contract rewards between (comma separated {address}:{weight} pairs, weights must sum up to 1.0)")
+}
+
 func addRecordsLimitFlag(cmd *cobra.Command) {
 	cmd.Flags().Uint64(flagRecordsLimit, 0, "Max number of rewards records to use (value can not be higher than the MaxWithdrawRecords module param")
 }
@@ -24,3 +50,119 @@ func addRecordsLimitFlag(cmd *cobra.Command) {
 func addRecordIDsFlag(cmd *cobra.Command) {
 	cmd.Flags().StringSlice(flagRecordIDs, []string{}, "Rewards record IDs to use (number of IDs can not be higher than the MaxWithdrawRecords module param")
 }
+
+func addIBCTransferFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagIBCChannel, "", "ICS-20 transfer source channel ID to withdraw rewards to another chain (optional)")
+	cmd.Flags().String(flagIBCReceiver, "", "Rewards receiver address on the counterparty chain (required if the IBC channel is set)")
+	cmd.Flags().Duration(flagIBCTimeout, defaultIBCTimeout, "IBC transfer timeout relative to the current local time")
+}
+
+func addAutoPayoutFlags(cmd *cobra.Command) {
+	cmd.Flags().Uint64(flagIntervalBlocks, 0, "Number of blocks between automatic payouts (0 to disable)")
+	cmd.Flags().String(flagThreshold, "", "Accrued rewards amount triggering an automatic payout (empty to disable)")
+}
+
+func addSponsorshipFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagUserFeeLimit, "", "Max amount of fees sponsored for a single user within the user fee period (empty to disable the sponsorship)")
+	cmd.Flags().Uint64(flagUserFeePeriod, 0, "Number of blocks the user fee limit is applied within (required if the user fee limit is set)")
+	cmd.Flags().String(flagBlockFeeLimit, "", "Max amount of fees sponsored within a single block (empty to disable the sponsorship)")
+	cmd.Flags().Bool(flagFundFromRewards, false, "Pledge contract rewards to the sponsorship deposit instead of creating rewards records")
+}
+
+// parseCoinsFlag parses an optional sdk.Coins flag value (empty coins are returned if not set).
+func parseCoinsFlag(cmd *cobra.Command, flagName string) (sdk.Coins, error) {
+	v, err := cmd.Flags().GetString(flagName)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s flag: %w", flagName, err)
+	}
+	if v == "" {
+		return sdk.NewCoins(), nil
+	}
+
+	coins, err := sdk.ParseCoinsNormalized(v)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s flag: invalid sdk.Coins value: %w", flagName, err)
+	}
+
+	return coins, nil
+}
+
+// parseThresholdFlag parses the auto payout threshold flag value (empty coins are returned if not set).
+func parseThresholdFlag(cmd *cobra.Command) (sdk.Coins, error) {
+	v, err := cmd.Flags().GetString(flagThreshold)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s flag: %w", flagThreshold, err)
+	}
+	if v == "" {
+		return sdk.NewCoins(), nil
+	}
+
+	threshold, err := sdk.ParseCoinsNormalized(v)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s flag: invalid sdk.Coins value: %w", flagThreshold, err)
+	}
+
+	return threshold, nil
+}
+
+// parseIBCTransferFlags parses IBC transfer flags (nil is returned if the IBC channel is not set).
+func parseIBCTransferFlags(cmd *cobra.Command) (*types.MsgWithdrawRewards_IBCTransfer, error) {
+	channelID, err := cmd.Flags().GetString(flagIBCChannel)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s flag: %w", flagIBCChannel, err)
+	}
+	if channelID == "" {
+		return nil, nil
+	}
+
+	receiver, err := cmd.Flags().GetString(flagIBCReceiver)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s flag: %w", flagIBCReceiver, err)
+	}
+	if receiver == "" {
+		return nil, fmt.Errorf("%s flag: must be set", flagIBCReceiver)
+	}
+
+	timeout, err := cmd.Flags().GetDuration(flagIBCTimeout)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s flag: %w", flagIBCTimeout, err)
+	}
+	if timeout <= 0 {
+		return nil, fmt.Errorf("%s flag: must be GT 0", flagIBCTimeout)
+	}
+
+	return types.NewMsgWithdrawRewardsIBCTransfer(channelID, receiver, uint64(time.Now().Add(timeout).UnixNano())), nil
+}
+
+// parseRewardsRecipientsFlag parses the rewards recipients flag value ({address}:{weight} pairs).
+func parseRewardsRecipientsFlag(cmd *cobra.Command) ([]types.RewardsRecipient, error) {
+	values, err := pkg.GetStringSliceFlag(cmd, flagRewardsRecipients, true)
+	if err != nil {
+		return nil, err
+	}
+
+	recipients := make([]types.RewardsRecipient, 0, len(values))
+	for i, value := range values {
+		valueParts := strings.Split(value, ":")
+		if len(valueParts) != 2 {
+			return nil, fmt.Errorf("parsing %s flag: recipient [%d]: invalid format (expected {address}:{weight})", flagRewardsRecipients, i)
+		}
+
+		addr, err := sdk.AccAddressFromBech32(valueParts[0])
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s flag: recipient [%d]: address: %w", flagRewardsRecipients, i, err)
+		}
+
+		weight, err := sdk.NewDecFromStr(valueParts[1])
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s flag: recipient [%d]: weight: %w", flagRewardsRecipients, i, err)
+		}
+
+		recipients = append(recipients, types.RewardsRecipient{
+			Address: addr.String(),
+			Weight:  weight,
+		})
+	}
+
+	return recipients, nil
+}
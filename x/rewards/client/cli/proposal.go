@@ -0,0 +1,203 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govCli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/archway-network/archway/pkg"
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// NewCmdSubmitTreasurySpendProposal returns a CLI command to submit a TreasurySpendProposal.
+func NewCmdSubmitTreasurySpendProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "treasury-spend [recipient-address] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Submit a proposal to transfer treasury funds to a recipient",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recipientAddr, err := pkg.ParseAccAddressArg("recipient-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := pkg.ParseCoinsArg("amount", args[1])
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewTreasurySpendProposal(title, description, recipientAddr, amount)
+
+			msg, err := govTypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitTreasuryBurnProposal returns a CLI command to submit a TreasuryBurnProposal.
+func NewCmdSubmitTreasuryBurnProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "treasury-burn [amount]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to burn treasury funds",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			amount, err := pkg.ParseCoinsArg("amount", args[0])
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewTreasuryBurnProposal(title, description, amount)
+
+			msg, err := govTypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+
+	return cmd
+}
+
+// NewCmdSubmitSetContractMetadataProposal returns a CLI command to submit a SetContractMetadataProposal.
+func NewCmdSubmitSetContractMetadataProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-contract-metadata [contract-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to set (override) a contract metadata",
+		Long: fmt.Sprintf(`Submit a proposal to set (override) a contract metadata.
+Proposal replaces the existing metadata, so the %q flag is required and the %q (or %q) flag should be set to receive rewards.
+Could be used for contracts without an admin (the metadata can't be created via the "set-contract-metadata" transaction).`,
+			flagOwnerAddress, flagRewardsAddress, flagRewardsRecipients,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := pkg.ParseAccAddressArg("contract-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			ownerAddr, err := pkg.ParseAccAddressFlag(cmd, flagOwnerAddress, true)
+			if err != nil {
+				return err
+			}
+
+			rewardsAddr, err := pkg.ParseAccAddressFlag(cmd, flagRewardsAddress, false)
+			if err != nil {
+				return err
+			}
+
+			rewardsRecipients, err := parseRewardsRecipientsFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			metadata := types.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				OwnerAddress:    ownerAddr.String(),
+			}
+			if rewardsAddr != nil {
+				metadata.RewardsAddress = rewardsAddr.String()
+			}
+			if len(rewardsRecipients) > 0 {
+				metadata.RewardsRecipients = rewardsRecipients
+			}
+			content := types.NewSetContractMetadataProposal(title, description, metadata)
+
+			msg, err := govTypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	addOwnerAddressFlag(cmd)
+	addRewardsAddressFlag(cmd)
+	addRewardsRecipientsFlag(cmd)
+
+	return cmd
+}
+
+// addProposalFlags adds the common gov proposal flags.
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govCli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(govCli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(govCli.FlagDeposit, "", "Deposit of proposal")
+}
+
+// parseProposalFlags parses the common gov proposal flags.
+func parseProposalFlags(cmd *cobra.Command) (title, description string, deposit sdk.Coins, retErr error) {
+	title, err := cmd.Flags().GetString(govCli.FlagTitle)
+	if err != nil {
+		retErr = fmt.Errorf("parsing %s flag: %w", govCli.FlagTitle, err)
+		return
+	}
+
+	description, err = cmd.Flags().GetString(govCli.FlagDescription)
+	if err != nil {
+		retErr = fmt.Errorf("parsing %s flag: %w", govCli.FlagDescription, err)
+		return
+	}
+
+	depositStr, err := cmd.Flags().GetString(govCli.FlagDeposit)
+	if err != nil {
+		retErr = fmt.Errorf("parsing %s flag: %w", govCli.FlagDeposit, err)
+		return
+	}
+
+	deposit, err = sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		retErr = fmt.Errorf("parsing %s flag: %w", govCli.FlagDeposit, err)
+		return
+	}
+
+	return
+}
@@ -26,6 +26,15 @@ func GetQueryCmd() *cobra.Command {
 		getQueryEstimateTxFeesCmd(),
 		getQueryOutstandingRewardsCmd(),
 		getQueryRewardsRecordsCmd(),
+		getQueryFlatFeeCmd(),
+		getQueryTreasuryBalanceCmd(),
+		getQueryTreasuryHistoryCmd(),
+		getQueryCodeMetadataCmd(),
+		getQueryRewardsRecordExpiryCmd(),
+		getQueryAutoPayoutCmd(),
+		getQueryMinConsensusFeeHistoryCmd(),
+		getQueryBaseFeeCmd(),
+		getQuerySponsorshipCmd(),
 	)
 
 	return cmd
@@ -247,3 +256,297 @@ func getQueryRewardsRecordsCmd() *cobra.Command {
 
 	return cmd
 }
+
+func getQueryFlatFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "flat-fee [contract-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query contract flat fee charged for every contract execution",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			contractAddr, err := pkg.ParseAccAddressArg("contract-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.FlatFee(cmd.Context(), &types.QueryFlatFeeRequest{
+				ContractAddress: contractAddr.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.FlatFeeAmount)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryTreasuryBalanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "treasury-balance",
+		Args:  cobra.NoArgs,
+		Short: "Query the current treasury funds (could be spent / burned via governance proposals)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TreasuryBalance(cmd.Context(), &types.QueryTreasuryBalanceRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryTreasuryHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "treasury-history",
+		Args:  cobra.NoArgs,
+		Short: "Query governance-approved treasury operations (spend / burn) with pagination",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := pkg.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.TreasuryHistory(cmd.Context(), &types.QueryTreasuryHistoryRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "treasury-history")
+
+	return cmd
+}
+
+func getQueryCodeMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "code-metadata [code-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query code metadata (default rewards parameters for contracts instantiated from the code)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			codeID, err := pkg.ParseUint64Arg("code-id", args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.CodeMetadata(cmd.Context(), &types.QueryCodeMetadataRequest{
+				CodeId: codeID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Metadata)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryRewardsRecordExpiryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rewards-record-expiry [rewards-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the soonest expiring rewards record for a given address (unclaimed rewards are swept to the treasury on expiry)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			rewardsAddr, err := pkg.ParseAccAddressArg("rewards-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RewardsRecordExpiry(cmd.Context(), &types.QueryRewardsRecordExpiryRequest{
+				RewardsAddress: rewardsAddr.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryAutoPayoutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "auto-payout [contract-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the automatic rewards payout options for a given contract",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			contractAddr, err := pkg.ParseAccAddressArg("contract-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.AutoPayout(cmd.Context(), &types.QueryAutoPayoutRequest{
+				ContractAddress: contractAddr.String(),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.AutoPayout)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQueryMinConsensusFeeHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "min-consensus-fee-history",
+		Args:  cobra.NoArgs,
+		Short: "Query minimum consensus fee values set within the history window with pagination",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := pkg.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.MinConsensusFeeHistory(cmd.Context(), &types.QueryMinConsensusFeeHistoryRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "min-consensus-fee-history")
+
+	return cmd
+}
+
+func getQueryBaseFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-fee",
+		Args:  cobra.NoArgs,
+		Short: "Query the current base fee driven by the block gas utilization",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BaseFee(cmd.Context(), &types.QueryBaseFeeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getQuerySponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsorship [contract-address] [user-address (optional)]",
+		Args:  cobra.RangeArgs(1, 2),
+		Short: "Query the contract transaction fees sponsorship and the amount of fees sponsored for an optional user address",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			contractAddr, err := pkg.ParseAccAddressArg("contract-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			req := types.QuerySponsorshipRequest{
+				ContractAddress: contractAddr.String(),
+			}
+			if len(args) > 1 {
+				userAddr, err := pkg.ParseAccAddressArg("user-address", args[1])
+				if err != nil {
+					return err
+				}
+				req.UserAddress = userAddr.String()
+			}
+
+			res, err := queryClient.Sponsorship(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
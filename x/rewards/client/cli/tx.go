@@ -6,7 +6,6 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
-	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
 	"github.com/archway-network/archway/pkg"
@@ -25,6 +24,15 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		getTxSetContractMetadataCmd(),
 		getTxWithdrawRewardsCmd(),
+		getTxSetFlatFeeCmd(),
+		getTxWithdrawRewardsAndDelegateCmd(),
+		getTxAcceptContractMetadataOwnershipCmd(),
+		getTxCancelContractMetadataOwnershipCmd(),
+		getTxSetCodeMetadataCmd(),
+		getTxSetAutoPayoutCmd(),
+		getTxSetSponsorshipCmd(),
+		getTxDepositSponsorshipCmd(),
+		getTxWithdrawSponsorshipCmd(),
 	)
 
 	return cmd
@@ -36,8 +44,10 @@ func getTxSetContractMetadataCmd() *cobra.Command {
 		Args:  cobra.ExactArgs(1),
 		Short: "Create / modify contract metadata (contract rewards parameters)",
 		Long: fmt.Sprintf(`Create / modify contract metadata (contract rewards parameters).
-Use the %q and / or the %q flag to specify which metadata field to set / update.`,
-			flagOwnerAddress, flagRewardsAddress,
+Use the %q and / or the %q (or %q) flag to specify which metadata field to set / update.
+The %q flag nominates a new owner which has to accept the ownership using the "accept-contract-metadata-ownership" command.
+The %q flag splits contract rewards between weighted recipients (example: "addr1:0.7,addr2:0.3").`,
+			flagOwnerAddress, flagRewardsAddress, flagRewardsRecipients, flagOwnerAddress, flagRewardsRecipients,
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -62,7 +72,12 @@ Use the %q and / or the %q flag to specify which metadata field to set / update.
 				return err
 			}
 
-			msg := types.NewMsgSetContractMetadata(senderAddr, contractAddress, ownerAddress, rewardsAddress)
+			rewardsRecipients, err := parseRewardsRecipientsFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetContractMetadata(senderAddr, contractAddress, ownerAddress, rewardsAddress, rewardsRecipients)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -71,6 +86,7 @@ Use the %q and / or the %q flag to specify which metadata field to set / update.
 	flags.AddTxFlagsToCmd(cmd)
 	addOwnerAddressFlag(cmd)
 	addRewardsAddressFlag(cmd)
+	addRewardsRecipientsFlag(cmd)
 
 	return cmd
 }
@@ -79,7 +95,7 @@ func getTxWithdrawRewardsCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "withdraw-rewards",
 		Args:  cobra.NoArgs,
-		Short: "Withdraw current credited rewards for the transaction sender",
+		Short: "Withdraw current credited rewards for the transaction sender (optionally to an address on another chain via IBC)",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -102,12 +118,18 @@ func getTxWithdrawRewardsCmd() *cobra.Command {
 				return fmt.Errorf("one of (%q, %q) flags must be set", flagRecordIDs, flagRecordsLimit)
 			}
 
-			var msg sdk.Msg
+			ibcTransfer, err := parseIBCTransferFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			var msg *types.MsgWithdrawRewards
 			if recordsLimit > 0 {
 				msg = types.NewMsgWithdrawRewardsByLimit(senderAddr, recordsLimit)
 			} else {
 				msg = types.NewMsgWithdrawRewardsByIDs(senderAddr, recordIDs)
 			}
+			msg.IbcTransfer = ibcTransfer
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -115,6 +137,360 @@ func getTxWithdrawRewardsCmd() *cobra.Command {
 
 	addRecordsLimitFlag(cmd)
 	addRecordIDsFlag(cmd)
+	addIBCTransferFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getTxSetFlatFeeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-flat-fee [contract-address] [fee-amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Set / remove (zero amount) the contract flat fee charged for every contract execution",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			senderAddr := clientCtx.GetFromAddress()
+
+			contractAddress, err := pkg.ParseAccAddressArg("contract-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			fee, err := pkg.ParseCoinArg("fee-amount", args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetFlatFee(senderAddr, contractAddress, fee)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getTxWithdrawRewardsAndDelegateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-rewards-and-delegate [validator-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Withdraw current credited rewards for the transaction sender and delegate the staking denom rewards to a validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			senderAddr := clientCtx.GetFromAddress()
+
+			valAddress, err := pkg.ParseValAddressArg("validator-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			recordsLimit, err := pkg.GetUint64Flag(cmd, flagRecordsLimit, true)
+			if err != nil {
+				return err
+			}
+
+			recordIDs, err := pkg.GetUint64SliceFlag(cmd, flagRecordIDs, true)
+			if err != nil {
+				return err
+			}
+
+			if (len(recordIDs) > 0 && recordsLimit > 0) || (len(recordIDs) == 0 && recordsLimit == 0) {
+				return fmt.Errorf("one of (%q, %q) flags must be set", flagRecordIDs, flagRecordsLimit)
+			}
+
+			var msg *types.MsgWithdrawRewardsAndDelegate
+			if recordsLimit > 0 {
+				msg = types.NewMsgWithdrawRewardsAndDelegateByLimit(senderAddr, valAddress, recordsLimit)
+			} else {
+				msg = types.NewMsgWithdrawRewardsAndDelegateByIDs(senderAddr, valAddress, recordIDs)
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addRecordsLimitFlag(cmd)
+	addRecordIDsFlag(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getTxAcceptContractMetadataOwnershipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-contract-metadata-ownership [contract-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Accept the contract metadata ownership (the transaction sender must be the pending owner)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			senderAddr := clientCtx.GetFromAddress()
+
+			contractAddress, err := pkg.ParseAccAddressArg("contract-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAcceptContractMetadataOwnership(senderAddr, contractAddress)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getTxCancelContractMetadataOwnershipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-contract-metadata-ownership [contract-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Cancel the pending contract metadata ownership transfer (the transaction sender must be the owner or the pending owner)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			senderAddr := clientCtx.GetFromAddress()
+
+			contractAddress, err := pkg.ParseAccAddressArg("contract-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelContractMetadataOwnership(senderAddr, contractAddress)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getTxSetCodeMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-code-metadata [code-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create / modify code metadata (default rewards parameters for contracts instantiated from the code)",
+		Long: fmt.Sprintf(`Create / modify code metadata (default rewards parameters for contracts instantiated from the code).
+Code metadata is used for contracts that don't have their own contract metadata set. Only the code creator can set it.
+Use the %q (or %q) flag to specify which metadata field to set / update.
+The %q flag splits contract rewards between weighted recipients (example: "addr1:0.7,addr2:0.3").`,
+			flagRewardsAddress, flagRewardsRecipients, flagRewardsRecipients,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			senderAddr := clientCtx.GetFromAddress()
+
+			codeID, err := pkg.ParseUint64Arg("code-id", args[0])
+			if err != nil {
+				return err
+			}
+
+			rewardsAddress, err := pkg.ParseAccAddressFlag(cmd, flagRewardsAddress, false)
+			if err != nil {
+				return err
+			}
+
+			rewardsRecipients, err := parseRewardsRecipientsFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetCodeMetadata(senderAddr, codeID, rewardsAddress, rewardsRecipients)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addRewardsAddressFlag(cmd)
+	addRewardsRecipientsFlag(cmd)
+
+	return cmd
+}
+
+func getTxSetAutoPayoutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-auto-payout [contract-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Opt the contract in / out (no flags set) to the automatic rewards payout to its rewards address / recipients",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			senderAddr := clientCtx.GetFromAddress()
+
+			contractAddress, err := pkg.ParseAccAddressArg("contract-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			intervalBlocks, err := pkg.GetUint64Flag(cmd, flagIntervalBlocks, true)
+			if err != nil {
+				return err
+			}
+
+			threshold, err := parseThresholdFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetAutoPayout(senderAddr, contractAddress, intervalBlocks, threshold)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addAutoPayoutFlags(cmd)
+
+	return cmd
+}
+
+func getTxSetSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-sponsorship [contract-address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Create / modify the contract transaction fees sponsorship limits",
+		Long: fmt.Sprintf(`Create / modify the contract transaction fees sponsorship limits.
+Fees of transactions executing only the sponsored contract are paid from the sponsorship deposit.
+The sponsorship is disabled until both the user and the block fee limits are set.
+Use the "deposit-sponsorship" command to fund the deposit or the %q flag to pledge contract rewards.`,
+			flagFundFromRewards,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			senderAddr := clientCtx.GetFromAddress()
+
+			contractAddress, err := pkg.ParseAccAddressArg("contract-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			userFeeLimit, err := parseCoinsFlag(cmd, flagUserFeeLimit)
+			if err != nil {
+				return err
+			}
+
+			userFeePeriod, err := pkg.GetUint64Flag(cmd, flagUserFeePeriod, true)
+			if err != nil {
+				return err
+			}
+
+			blockFeeLimit, err := parseCoinsFlag(cmd, flagBlockFeeLimit)
+			if err != nil {
+				return err
+			}
+
+			fundFromRewards, err := cmd.Flags().GetBool(flagFundFromRewards)
+			if err != nil {
+				return fmt.Errorf("parsing %s flag: %w", flagFundFromRewards, err)
+			}
+
+			msg := types.NewMsgSetSponsorship(senderAddr, contractAddress, userFeeLimit, userFeePeriod, blockFeeLimit, fundFromRewards)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	addSponsorshipFlags(cmd)
+
+	return cmd
+}
+
+func getTxDepositSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-sponsorship [contract-address] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Fund the contract transaction fees sponsorship deposit",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			senderAddr := clientCtx.GetFromAddress()
+
+			contractAddress, err := pkg.ParseAccAddressArg("contract-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := pkg.ParseCoinsArg("amount", args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDepositSponsorship(senderAddr, contractAddress, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func getTxWithdrawSponsorshipCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-sponsorship [contract-address] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "Withdraw funds from the contract transaction fees sponsorship deposit",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			senderAddr := clientCtx.GetFromAddress()
+
+			contractAddress, err := pkg.ParseAccAddressArg("contract-address", args[0])
+			if err != nil {
+				return err
+			}
+
+			amount, err := pkg.ParseCoinsArg("amount", args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgWithdrawSponsorship(senderAddr, contractAddress, amount)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
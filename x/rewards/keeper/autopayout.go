@@ -0,0 +1,156 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// SetAutoPayout sets or removes (both triggers are empty) the automatic payout options for the contract
+// verifying the ownership.
+// Options are keyed by the contract, rewards are paid out to the current contract metadata rewards address
+// (or every weighted rewards recipient), so options can only be set if one of them is set.
+func (k Keeper) SetAutoPayout(ctx sdk.Context, senderAddr, contractAddr sdk.AccAddress, intervalBlocks uint64, threshold sdk.Coins) error {
+	// Check ownership
+	meta, found := k.state.ContractMetadataState(ctx).GetContractMetadata(contractAddr)
+	if !found {
+		return types.ErrMetadataNotFound
+	}
+	if meta.OwnerAddress != senderAddr.String() {
+		return sdkErrors.Wrap(types.ErrUnauthorized, "auto payout can only be set by the contract owner")
+	}
+
+	// Set / remove
+	autoPayout := types.AutoPayout{
+		ContractAddress:  contractAddr.String(),
+		IntervalBlocks:   intervalBlocks,
+		Threshold:        threshold,
+		LastPayoutHeight: ctx.BlockHeight(),
+	}
+	if autoPayout.IsEnabled() && len(meta.EffectiveRewardsRecipients()) == 0 {
+		return sdkErrors.Wrap(types.ErrInvalidRequest, "auto payout can only be set if the contract rewards address or rewards recipients are set")
+	}
+
+	state := k.state.AutoPayout(ctx)
+	if autoPayout.IsEnabled() {
+		state.SetAutoPayout(autoPayout)
+	} else {
+		state.DeleteAutoPayout(contractAddr)
+	}
+
+	// Emit event
+	types.EmitAutoPayoutSetEvent(ctx, contractAddr, autoPayout)
+
+	return nil
+}
+
+// GetAutoPayout returns the automatic payout options for the contract (if set).
+func (k Keeper) GetAutoPayout(ctx sdk.Context, contractAddr sdk.AccAddress) (types.AutoPayout, bool) {
+	return k.state.AutoPayout(ctx).GetAutoPayout(contractAddr)
+}
+
+// syncAutoPayout removes the contract automatic payout options (if any) once the contract metadata
+// has no rewards address and no rewards recipients left (there is no one to pay out to).
+// Options are keyed by the contract, so the rewards address / recipients change is applied to the next payout as is.
+func (k Keeper) syncAutoPayout(ctx sdk.Context, meta types.ContractMetadata) {
+	if len(meta.EffectiveRewardsRecipients()) > 0 {
+		return
+	}
+
+	contractAddr := meta.MustGetContractAddress()
+
+	state := k.state.AutoPayout(ctx)
+	if _, found := state.GetAutoPayout(contractAddr); !found {
+		return
+	}
+	state.DeleteAutoPayout(contractAddr)
+
+	types.EmitAutoPayoutSetEvent(ctx, contractAddr, types.AutoPayout{ContractAddress: contractAddr.String()})
+}
+
+// ProcessAutoPayouts checks a batch of types.AutoPayout entries (MaxAutoPayoutsPerBlock param) and withdraws
+// rewards records of the due contract rewards addresses (every weighted rewards recipient is checked separately).
+// Entries are processed in a round-robin manner, so every entry is checked within a bounded number of blocks.
+// The number of rewards records loaded per block is limited by the MaxWithdrawRecords param: once the budget is used up,
+// the batch is stopped and the rest is processed starting from the unfinished entry by the next blocks.
+// A failed payout is skipped (state changes are discarded) to be retried later.
+func (k Keeper) ProcessAutoPayouts(ctx sdk.Context) {
+	autoPayoutState := k.state.AutoPayout(ctx)
+	metadataState := k.state.ContractMetadataState(ctx)
+
+	recordsBudget := k.MaxWithdrawRecords(ctx)
+	for _, autoPayout := range autoPayoutState.GetAutoPayoutsBatch(k.MaxAutoPayoutsPerBlock(ctx)) {
+		contractAddr := autoPayout.MustGetContractAddress()
+
+		// Remove the entry if there is no one to pay out to
+		meta, _ := metadataState.GetContractMetadata(contractAddr)
+		recipients := meta.EffectiveRewardsRecipients()
+		if len(recipients) == 0 {
+			autoPayoutState.DeleteAutoPayout(contractAddr)
+			continue
+		}
+
+		paidOut, budgetExceeded := false, false
+		for _, recipient := range recipients {
+			if recordsBudget == 0 {
+				budgetExceeded = true
+				break
+			}
+
+			rewardsAddr := recipient.MustGetAddress()
+			records, err := k.getWithdrawRecordsByLimit(ctx, rewardsAddr, recordsBudget)
+			if err != nil {
+				k.Logger(ctx).Error("Auto payout records query failed (skip)", "contractAddress", autoPayout.ContractAddress, "rewardsAddress", recipient.Address, "error", err)
+				continue
+			}
+			recordsBudget -= uint64(len(records))
+
+			accruedRewards := sdk.NewCoins()
+			for _, record := range records {
+				accruedRewards = accruedRewards.Add(record.Rewards...)
+			}
+			if !autoPayout.IsDue(ctx.BlockHeight(), accruedRewards) {
+				continue
+			}
+
+			if err := k.autoPayout(ctx, rewardsAddr, records); err != nil {
+				k.Logger(ctx).Error("Auto payout failed (skip)", "contractAddress", autoPayout.ContractAddress, "rewardsAddress", recipient.Address, "error", err)
+				continue
+			}
+			paidOut = true
+		}
+
+		// Continue from the unfinished entry within the next block (the last payout height is kept,
+		// so recipients left are still due)
+		if budgetExceeded {
+			autoPayoutState.SetBatchCursor(contractAddr)
+			return
+		}
+
+		if paidOut {
+			autoPayout.LastPayoutHeight = ctx.BlockHeight()
+			autoPayoutState.SetAutoPayout(autoPayout)
+		}
+	}
+}
+
+// autoPayout withdraws the rewards records for the rewards address using a cached context.
+// State changes are committed only if the withdrawal succeeds.
+func (k Keeper) autoPayout(ctx sdk.Context, rewardsAddr sdk.AccAddress, records []types.RewardsRecord) (retErr error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	defer func() {
+		if r := recover(); r != nil {
+			retErr = fmt.Errorf("%v", r)
+		}
+	}()
+
+	k.withdrawRewardsByRecords(cacheCtx, rewardsAddr, records)
+
+	writeCache()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
+}
@@ -0,0 +1,360 @@
+package keeper_test
+
+import (
+	"bytes"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	mintTypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/pkg/testutils"
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
+func (s *KeeperTestSuite) TestSetAutoPayout() {
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+	contractAdminAcc, otherAcc := s.chain.GetAccount(0), s.chain.GetAccount(1)
+
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetContractInfoViewer(contractViewer)
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	recipientAddrs, _ := e2eTesting.GenAccounts(2)
+	threshold := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	s.Run("Fail: metadata not found", func() {
+		err := keeper.SetAutoPayout(ctx, contractAdminAcc.Address, contractAddr, 10, threshold)
+		s.Assert().ErrorIs(err, rewardsTypes.ErrMetadataNotFound)
+	})
+
+	// Create metadata without the rewards address
+	contractViewer.AddContractAdmin(contractAddr.String(), contractAdminAcc.Address.String())
+	s.Require().NoError(keeper.SetContractMetadata(ctx, contractAdminAcc.Address, contractAddr, rewardsTypes.ContractMetadata{}))
+
+	s.Run("Fail: not a contract owner", func() {
+		err := keeper.SetAutoPayout(ctx, otherAcc.Address, contractAddr, 10, threshold)
+		s.Assert().ErrorIs(err, rewardsTypes.ErrUnauthorized)
+	})
+
+	s.Run("Fail: rewards address and recipients are not set", func() {
+		err := keeper.SetAutoPayout(ctx, contractAdminAcc.Address, contractAddr, 10, threshold)
+		s.Assert().ErrorIs(err, rewardsTypes.ErrInvalidRequest)
+	})
+
+	// Set the rewards address
+	s.Require().NoError(keeper.SetContractMetadata(ctx, contractAdminAcc.Address, contractAddr, rewardsTypes.ContractMetadata{
+		RewardsAddress: otherAcc.Address.String(),
+	}))
+
+	s.Run("Fail: rewards address is not the contract owner", func() {
+		err := keeper.SetAutoPayout(ctx, otherAcc.Address, contractAddr, 10, threshold)
+		s.Assert().ErrorIs(err, rewardsTypes.ErrUnauthorized)
+	})
+
+	s.Run("OK: set auto payout", func() {
+		err := keeper.SetAutoPayout(ctx, contractAdminAcc.Address, contractAddr, 10, threshold)
+		s.Require().NoError(err)
+
+		autoPayout, found := keeper.GetAutoPayout(ctx, contractAddr)
+		s.Require().True(found)
+		s.Assert().Equal(contractAddr.String(), autoPayout.ContractAddress)
+		s.Assert().EqualValues(10, autoPayout.IntervalBlocks)
+		s.Assert().Equal(threshold.String(), autoPayout.Threshold.String())
+		s.Assert().Equal(ctx.BlockHeight(), autoPayout.LastPayoutHeight)
+
+		// Options are keyed by the contract
+		_, found = keeper.GetAutoPayout(ctx, otherAcc.Address)
+		s.Assert().False(found)
+	})
+
+	s.Run("OK: set auto payout for weighted recipients", func() {
+		s.Require().NoError(keeper.SetContractMetadata(ctx, contractAdminAcc.Address, contractAddr, rewardsTypes.ContractMetadata{
+			RewardsRecipients: []rewardsTypes.RewardsRecipient{
+				{Address: recipientAddrs[0].String(), Weight: sdk.NewDecWithPrec(5, 1)},
+				{Address: recipientAddrs[1].String(), Weight: sdk.NewDecWithPrec(5, 1)},
+			},
+		}))
+
+		err := keeper.SetAutoPayout(ctx, contractAdminAcc.Address, contractAddr, 20, nil)
+		s.Require().NoError(err)
+
+		autoPayout, found := keeper.GetAutoPayout(ctx, contractAddr)
+		s.Require().True(found)
+		s.Assert().EqualValues(20, autoPayout.IntervalBlocks)
+	})
+
+	s.Run("OK: removed once the gov metadata update leaves no rewards address / recipients", func() {
+		s.Require().NoError(keeper.SetContractMetadataByGov(ctx, contractAddr, rewardsTypes.ContractMetadata{
+			OwnerAddress: contractAdminAcc.Address.String(),
+		}))
+
+		_, found := keeper.GetAutoPayout(ctx, contractAddr)
+		s.Assert().False(found)
+	})
+
+	s.Run("OK: remove auto payout", func() {
+		s.Require().NoError(keeper.SetContractMetadata(ctx, contractAdminAcc.Address, contractAddr, rewardsTypes.ContractMetadata{
+			RewardsAddress: otherAcc.Address.String(),
+		}))
+		s.Require().NoError(keeper.SetAutoPayout(ctx, contractAdminAcc.Address, contractAddr, 10, threshold))
+
+		err := keeper.SetAutoPayout(ctx, contractAdminAcc.Address, contractAddr, 0, sdk.NewCoins())
+		s.Require().NoError(err)
+
+		_, found := keeper.GetAutoPayout(ctx, contractAddr)
+		s.Assert().False(found)
+	})
+}
+
+// TestProcessAutoPayouts tests the EndBlocker automatic payouts (triggers and the per block limit).
+func (s *KeeperTestSuite) TestProcessAutoPayouts() {
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+	bankKeeper := s.chain.GetApp().BankKeeper
+	rewardsRecordState := keeper.GetState().RewardsRecord(ctx)
+	autoPayoutState := keeper.GetState().AutoPayout(ctx)
+	contractAdminAcc := s.chain.GetAccount(0)
+
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetContractInfoViewer(contractViewer)
+
+	contractAddrs := e2eTesting.GenContractAddresses(3)
+	intervalContractAddr, thresholdContractAddr, recipientsContractAddr := contractAddrs[0], contractAddrs[1], contractAddrs[2]
+	accAddrs, _ := e2eTesting.GenAccounts(5)
+	intervalAddr, thresholdAddr, newIntervalAddr := accAddrs[0], accAddrs[1], accAddrs[2]
+	recipientAddrs := accAddrs[3:]
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	// Set contracts metadata
+	setMetadata := func(contractAddr sdk.AccAddress, metaUpdates rewardsTypes.ContractMetadata) {
+		contractViewer.AddContractAdmin(contractAddr.String(), contractAdminAcc.Address.String())
+		s.Require().NoError(keeper.SetContractMetadata(ctx, contractAdminAcc.Address, contractAddr, metaUpdates))
+	}
+	setMetadata(intervalContractAddr, rewardsTypes.ContractMetadata{RewardsAddress: intervalAddr.String()})
+	setMetadata(thresholdContractAddr, rewardsTypes.ContractMetadata{RewardsAddress: thresholdAddr.String()})
+
+	// Append records (funding the rewards pool)
+	appendRecord := func(rewardsAddr sdk.AccAddress, height int64) {
+		s.Require().NoError(s.chain.GetApp().MintKeeper.MintCoins(ctx, rewards))
+		s.Require().NoError(bankKeeper.SendCoinsFromModuleToModule(ctx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, rewards))
+
+		rewardsRecordState.AppendRewardsRecord(rewardsAddr, rewards, height, ctx.BlockTime(), 0)
+	}
+
+	// Opt-in both contracts: every 10 blocks and the 200 stake threshold
+	autoPayoutState.SetAutoPayout(rewardsTypes.AutoPayout{
+		ContractAddress:  intervalContractAddr.String(),
+		IntervalBlocks:   10,
+		LastPayoutHeight: 100,
+	})
+	autoPayoutState.SetAutoPayout(rewardsTypes.AutoPayout{
+		ContractAddress:  thresholdContractAddr.String(),
+		Threshold:        sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200)),
+		LastPayoutHeight: 100,
+	})
+
+	appendRecord(intervalAddr, 101)
+	appendRecord(thresholdAddr, 101)
+
+	s.Run("OK: nothing is due", func() {
+		keeper.ProcessAutoPayouts(ctx.WithBlockHeight(105))
+
+		s.Assert().Equal(rewards.String(), rewardsRecordState.GetRewardsBalance(intervalAddr).String())
+		s.Assert().Equal(rewards.String(), rewardsRecordState.GetRewardsBalance(thresholdAddr).String())
+	})
+
+	s.Run("OK: payouts are disabled", func() {
+		params := keeper.GetParams(ctx)
+		params.MaxAutoPayoutsPerBlock = 0
+		keeper.SetParams(ctx, params)
+
+		keeper.ProcessAutoPayouts(ctx.WithBlockHeight(110))
+		s.Assert().Equal(rewards.String(), rewardsRecordState.GetRewardsBalance(intervalAddr).String())
+	})
+
+	// Process one entry per block
+	params := keeper.GetParams(ctx)
+	params.MaxAutoPayoutsPerBlock = 1
+	keeper.SetParams(ctx, params)
+
+	appendRecord(thresholdAddr, 110)
+
+	s.Run("OK: entries are processed in a round-robin manner", func() {
+		// Entries order depends on the address bytes, so the first processed one is unknown
+		keeper.ProcessAutoPayouts(ctx.WithBlockHeight(110))
+		keeper.ProcessAutoPayouts(ctx.WithBlockHeight(110))
+
+		// Interval trigger
+		s.Assert().True(rewardsRecordState.GetRewardsBalance(intervalAddr).IsZero())
+		s.Assert().Empty(rewardsRecordState.GetRewardsRecordByRewardsAddress(intervalAddr))
+		s.Assert().Equal(rewards.String(), bankKeeper.GetAllBalances(ctx, intervalAddr).String())
+
+		autoPayout, found := keeper.GetAutoPayout(ctx, intervalContractAddr)
+		s.Require().True(found)
+		s.Assert().EqualValues(110, autoPayout.LastPayoutHeight)
+
+		// Threshold trigger
+		s.Assert().True(rewardsRecordState.GetRewardsBalance(thresholdAddr).IsZero())
+		s.Assert().Equal(rewards.Add(rewards...).String(), bankKeeper.GetAllBalances(ctx, thresholdAddr).String())
+
+		autoPayout, found = keeper.GetAutoPayout(ctx, thresholdContractAddr)
+		s.Require().True(found)
+		s.Assert().EqualValues(110, autoPayout.LastPayoutHeight)
+	})
+
+	s.Run("OK: interval is counted from the last payout", func() {
+		appendRecord(intervalAddr, 115)
+
+		keeper.ProcessAutoPayouts(ctx.WithBlockHeight(115))
+		keeper.ProcessAutoPayouts(ctx.WithBlockHeight(115))
+		s.Assert().Equal(rewards.String(), rewardsRecordState.GetRewardsBalance(intervalAddr).String())
+
+		keeper.ProcessAutoPayouts(ctx.WithBlockHeight(120))
+		keeper.ProcessAutoPayouts(ctx.WithBlockHeight(120))
+		s.Assert().True(rewardsRecordState.GetRewardsBalance(intervalAddr).IsZero())
+	})
+
+	// Process all entries per block
+	params.MaxAutoPayoutsPerBlock = 10
+	keeper.SetParams(ctx, params)
+
+	s.Run("OK: payout follows the contract rewards address change", func() {
+		setMetadata(intervalContractAddr, rewardsTypes.ContractMetadata{RewardsAddress: newIntervalAddr.String()})
+
+		appendRecord(intervalAddr, 125)
+		appendRecord(newIntervalAddr, 125)
+
+		keeper.ProcessAutoPayouts(ctx.WithBlockHeight(130))
+
+		// The previous rewards address is no longer paid out
+		s.Assert().Equal(rewards.String(), rewardsRecordState.GetRewardsBalance(intervalAddr).String())
+
+		s.Assert().True(rewardsRecordState.GetRewardsBalance(newIntervalAddr).IsZero())
+		s.Assert().Equal(rewards.String(), bankKeeper.GetAllBalances(ctx, newIntervalAddr).String())
+	})
+
+	s.Run("OK: weighted recipients are paid out separately", func() {
+		setMetadata(recipientsContractAddr, rewardsTypes.ContractMetadata{
+			RewardsRecipients: []rewardsTypes.RewardsRecipient{
+				{Address: recipientAddrs[0].String(), Weight: sdk.NewDecWithPrec(5, 1)},
+				{Address: recipientAddrs[1].String(), Weight: sdk.NewDecWithPrec(5, 1)},
+			},
+		})
+		autoPayoutState.SetAutoPayout(rewardsTypes.AutoPayout{
+			ContractAddress:  recipientsContractAddr.String(),
+			Threshold:        sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 200)),
+			LastPayoutHeight: 130,
+		})
+
+		appendRecord(recipientAddrs[0], 131)
+		appendRecord(recipientAddrs[0], 131)
+		appendRecord(recipientAddrs[1], 131)
+
+		keeper.ProcessAutoPayouts(ctx.WithBlockHeight(132))
+
+		// Only the recipient which rewards reached the threshold is paid out
+		s.Assert().True(rewardsRecordState.GetRewardsBalance(recipientAddrs[0]).IsZero())
+		s.Assert().Equal(rewards.Add(rewards...).String(), bankKeeper.GetAllBalances(ctx, recipientAddrs[0]).String())
+		s.Assert().Equal(rewards.String(), rewardsRecordState.GetRewardsBalance(recipientAddrs[1]).String())
+
+		autoPayout, found := keeper.GetAutoPayout(ctx, recipientsContractAddr)
+		s.Require().True(found)
+		s.Assert().EqualValues(132, autoPayout.LastPayoutHeight)
+	})
+
+	s.Run("OK: entry without rewards address / recipients is removed", func() {
+		contractAddr := e2eTesting.GenContractAddresses(4)[3]
+		autoPayoutState.SetAutoPayout(rewardsTypes.AutoPayout{
+			ContractAddress:  contractAddr.String(),
+			IntervalBlocks:   1,
+			LastPayoutHeight: 130,
+		})
+
+		keeper.ProcessAutoPayouts(ctx.WithBlockHeight(132))
+
+		_, found := keeper.GetAutoPayout(ctx, contractAddr)
+		s.Assert().False(found)
+	})
+}
+
+// TestProcessAutoPayoutsRecordsBudget tests the per block rewards records budget (MaxWithdrawRecords param) of automatic payouts.
+func (s *KeeperTestSuite) TestProcessAutoPayoutsRecordsBudget() {
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+	bankKeeper := s.chain.GetApp().BankKeeper
+	rewardsRecordState := keeper.GetState().RewardsRecord(ctx)
+	autoPayoutState := keeper.GetState().AutoPayout(ctx)
+	contractAdminAcc := s.chain.GetAccount(0)
+
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetContractInfoViewer(contractViewer)
+
+	// Entries are processed in the contract address bytes order
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+	sort.Slice(contractAddrs, func(i, j int) bool {
+		return bytes.Compare(contractAddrs[i], contractAddrs[j]) < 0
+	})
+	rewardsAddrs, _ := e2eTesting.GenAccounts(2)
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	// Set contracts metadata and opt-in both contracts (every block)
+	for i, contractAddr := range contractAddrs {
+		contractViewer.AddContractAdmin(contractAddr.String(), contractAdminAcc.Address.String())
+		s.Require().NoError(keeper.SetContractMetadata(ctx, contractAdminAcc.Address, contractAddr, rewardsTypes.ContractMetadata{
+			RewardsAddress: rewardsAddrs[i].String(),
+		}))
+
+		autoPayoutState.SetAutoPayout(rewardsTypes.AutoPayout{
+			ContractAddress:  contractAddr.String(),
+			IntervalBlocks:   1,
+			LastPayoutHeight: 100,
+		})
+	}
+
+	// Create records (funding the rewards pool): 3 for the 1st address, 1 for the 2nd one
+	createRecord := func(rewardsAddr sdk.AccAddress) {
+		s.Require().NoError(s.chain.GetApp().MintKeeper.MintCoins(ctx, rewards))
+		s.Require().NoError(bankKeeper.SendCoinsFromModuleToModule(ctx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, rewards))
+
+		rewardsRecordState.CreateRewardsRecord(rewardsAddr, rewards, 100, ctx.BlockTime())
+	}
+	for i := 0; i < 3; i++ {
+		createRecord(rewardsAddrs[0])
+	}
+	createRecord(rewardsAddrs[1])
+
+	// Limit records per block
+	params := keeper.GetParams(ctx)
+	params.MaxWithdrawRecords = 2
+	params.MaxAutoPayoutsPerBlock = 10
+	keeper.SetParams(ctx, params)
+
+	s.Run("OK: batch is stopped once the records budget is used up", func() {
+		keeper.ProcessAutoPayouts(ctx.WithBlockHeight(110))
+
+		// The 1st address is paid out partially (up to the MaxWithdrawRecords records)
+		s.Assert().Len(rewardsRecordState.GetRewardsRecordByRewardsAddress(rewardsAddrs[0]), 1)
+		s.Assert().Equal(rewards.Add(rewards...).String(), bankKeeper.GetAllBalances(ctx, rewardsAddrs[0]).String())
+
+		autoPayout, found := keeper.GetAutoPayout(ctx, contractAddrs[0])
+		s.Require().True(found)
+		s.Assert().EqualValues(110, autoPayout.LastPayoutHeight)
+
+		// The 2nd address is not processed
+		s.Assert().Len(rewardsRecordState.GetRewardsRecordByRewardsAddress(rewardsAddrs[1]), 1)
+		s.Assert().True(bankKeeper.GetAllBalances(ctx, rewardsAddrs[1]).IsZero())
+
+		autoPayout, found = keeper.GetAutoPayout(ctx, contractAddrs[1])
+		s.Require().True(found)
+		s.Assert().EqualValues(100, autoPayout.LastPayoutHeight)
+	})
+
+	s.Run("OK: batch continues from the unprocessed entry", func() {
+		keeper.ProcessAutoPayouts(ctx.WithBlockHeight(111))
+
+		s.Assert().Empty(rewardsRecordState.GetRewardsRecordByRewardsAddress(rewardsAddrs[1]))
+		s.Assert().Equal(rewards.String(), bankKeeper.GetAllBalances(ctx, rewardsAddrs[1]).String())
+
+		s.Assert().Empty(rewardsRecordState.GetRewardsRecordByRewardsAddress(rewardsAddrs[0]))
+		s.Assert().Equal(rewards.Add(rewards...).Add(rewards...).String(), bankKeeper.GetAllBalances(ctx, rewardsAddrs[0]).String())
+	})
+}
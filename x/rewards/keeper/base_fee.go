@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/archway-network/archway/pkg"
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// UpdateBaseFee moves the base fee towards the target block gas utilization emitting an event.
+// The inflation-derived minimum consensus fee is used as the initial value and as the lower bound.
+// The base fee is removed if disabled by the BaseFeeMaxChangeRate param.
+func (k Keeper) UpdateBaseFee(ctx sdk.Context) {
+	feeState := k.state.MinConsensusFee(ctx)
+
+	changeRate := k.BaseFeeMaxChangeRate(ctx)
+	if changeRate.IsZero() {
+		feeState.DeleteBaseFee()
+		return
+	}
+
+	// Prepare and verify inputs
+	minConsFee, found := feeState.GetFee()
+	if !found || minConsFee.IsZero() {
+		k.Logger(ctx).Info("Base fee update skipped: minimum consensus fee is not set")
+		return
+	}
+
+	blockGasLimit := ctx.BlockGasMeter().Limit()
+	if blockGasLimit == 0 {
+		k.Logger(ctx).Info("Base fee update skipped: block gas limit is not set")
+		return
+	}
+	blockGasUsed := ctx.BlockGasMeter().GasConsumedToLimit()
+
+	baseFee, found := feeState.GetBaseFee()
+	if !found || baseFee.Denom != minConsFee.Denom {
+		baseFee = minConsFee
+	}
+
+	// Calculate
+	feeAmt := calculateBaseFeeAmt(
+		baseFee.Amount,
+		pkg.NewDecFromUint64(blockGasUsed),
+		pkg.NewDecFromUint64(blockGasLimit).Mul(k.BaseFeeTargetUtilization(ctx)),
+		changeRate,
+	)
+	if feeAmt.LT(minConsFee.Amount) {
+		feeAmt = minConsFee.Amount
+	}
+	feeCoin := sdk.DecCoin{
+		Denom:  minConsFee.Denom,
+		Amount: feeAmt,
+	}
+
+	// Set and emit event
+	feeState.SetBaseFee(feeCoin)
+	k.Logger(ctx).Info("Base fee update", "fee", feeCoin, "blockGasUsed", blockGasUsed)
+
+	types.EmitBaseFeeSetEvent(ctx, feeCoin, blockGasUsed)
+}
+
+// GetBaseFee returns the base fee (not set if disabled).
+// Fee defines the gas unit price driven by the block gas utilization.
+func (k Keeper) GetBaseFee(ctx sdk.Context) (sdk.DecCoin, bool) {
+	return k.state.MinConsensusFee(ctx).GetBaseFee()
+}
+
+// GetBaseFeeBurn returns the transaction fees share to be burned defined by the BaseFeeBurnRatio param.
+// Only the base fee part of fees (BaseFee * TxGasLimit) is burned. If fees are paid in multiple accepted denoms,
+// coins are taken in the denom order until the base fee is covered.
+func (k Keeper) GetBaseFeeBurn(ctx sdk.Context, fees sdk.Coins, txGasLimit uint64) sdk.Coins {
+	burnRatio := k.BaseFeeBurnRatio(ctx)
+	if burnRatio.IsZero() || txGasLimit == 0 {
+		return sdk.NewCoins()
+	}
+
+	baseFee, found := k.GetBaseFee(ctx)
+	if !found || baseFee.IsZero() {
+		return sdk.NewCoins()
+	}
+	gasUnitPrices := k.convertToAcceptedFeeDenoms(ctx, baseFee)
+
+	burnCoins := sdk.NewCoins()
+	uncoveredShare := sdk.OneDec()
+	for _, fee := range fees {
+		gasUnitPrice := gasUnitPrices.AmountOf(fee.Denom)
+		if gasUnitPrice.IsZero() {
+			continue
+		}
+
+		baseFeeAmt := gasUnitPrice.Mul(pkg.NewDecFromUint64(txGasLimit))
+		coveredShare := sdk.MinDec(fee.Amount.ToDec().Quo(baseFeeAmt), uncoveredShare)
+
+		burnCoins = burnCoins.Add(sdk.Coin{
+			Denom:  fee.Denom,
+			Amount: baseFeeAmt.Mul(coveredShare).Mul(burnRatio).TruncateInt(),
+		})
+
+		uncoveredShare = uncoveredShare.Sub(coveredShare)
+		if !uncoveredShare.IsPositive() {
+			break
+		}
+	}
+
+	return burnCoins
+}
+
+// BurnBaseFees burns the base fee share of transaction fees emitting an event.
+// CONTRACT: coins must be transferred to the Treasury account beforehand.
+func (k Keeper) BurnBaseFees(ctx sdk.Context, coins sdk.Coins) error {
+	if err := k.bankKeeper.BurnCoins(ctx, types.TreasuryCollector, coins); err != nil {
+		return err
+	}
+
+	types.EmitBaseFeeBurnEvent(ctx, coins)
+
+	return nil
+}
+
+// calculateBaseFeeAmt calculates the next base fee amount using the formula:
+//
+//	BaseFee * (1 + ChangeRate * (BlockGasUsed - TargetGas) / TargetGas)
+func calculateBaseFeeAmt(baseFee, blockGasUsed, targetGas, changeRate sdk.Dec) sdk.Dec {
+	return baseFee.Add(
+		baseFee.Mul(changeRate).Mul(blockGasUsed.Sub(targetGas)).Quo(targetGas),
+	)
+}
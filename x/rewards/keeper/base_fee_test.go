@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
+// TestUpdateBaseFee tests the base fee adjustment to the block gas utilization.
+func (s *KeeperTestSuite) TestUpdateBaseFee() {
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+	feeState := keeper.GetState().MinConsensusFee(ctx)
+
+	const blockGasLimit = 1000
+
+	setParams := func(changeRate, target sdk.Dec) {
+		params := keeper.GetParams(ctx)
+		params.BaseFeeMaxChangeRate = changeRate
+		params.BaseFeeTargetUtilization = target
+		keeper.SetParams(ctx, params)
+	}
+
+	updateBaseFee := func(blockGasUsed uint64) {
+		blockGasMeter := sdk.NewGasMeter(blockGasLimit)
+		blockGasMeter.ConsumeGas(blockGasUsed, "test")
+
+		keeper.UpdateBaseFee(ctx.WithBlockGasMeter(blockGasMeter))
+	}
+
+	checkBaseFee := func(amtExpected sdk.Dec) {
+		fee, found := keeper.GetBaseFee(ctx)
+		s.Require().True(found)
+		s.Assert().Equal(sdk.NewDecCoinFromDec("uarch", amtExpected).String(), fee.String())
+	}
+
+	feeState.SetFee(sdk.NewDecCoin("uarch", sdk.OneInt()))
+
+	s.Run("OK: disabled by default", func() {
+		updateBaseFee(blockGasLimit)
+
+		_, found := keeper.GetBaseFee(ctx)
+		s.Assert().False(found)
+	})
+
+	s.Run("OK: full block increases the fee", func() {
+		setParams(sdk.NewDecWithPrec(125, 3), sdk.NewDecWithPrec(5, 1))
+
+		// 1.0 * (1 + 0.125 * (1000 - 500) / 500)
+		updateBaseFee(blockGasLimit)
+		checkBaseFee(sdk.NewDecWithPrec(1125, 3))
+
+		// 1.125 * (1 + 0.125 * (1000 - 500) / 500)
+		updateBaseFee(blockGasLimit)
+		checkBaseFee(sdk.NewDecWithPrec(1265625, 6))
+	})
+
+	s.Run("OK: target utilization keeps the fee", func() {
+		updateBaseFee(blockGasLimit / 2)
+		checkBaseFee(sdk.NewDecWithPrec(1265625, 6))
+	})
+
+	s.Run("OK: empty block decreases the fee", func() {
+		// 1.265625 * (1 + 0.125 * (0 - 500) / 500)
+		updateBaseFee(0)
+		checkBaseFee(sdk.NewDecWithPrec(1107421875, 9))
+	})
+
+	s.Run("OK: fee is limited by the minimum consensus fee", func() {
+		for i := 0; i < 5; i++ {
+			updateBaseFee(0)
+		}
+		checkBaseFee(sdk.OneDec())
+	})
+
+	s.Run("OK: disabling removes the fee", func() {
+		setParams(sdk.ZeroDec(), sdk.NewDecWithPrec(5, 1))
+
+		updateBaseFee(blockGasLimit)
+
+		_, found := keeper.GetBaseFee(ctx)
+		s.Assert().False(found)
+	})
+}
+
+// TestGetBaseFeeBurn tests the base fee burn share calculation.
+func (s *KeeperTestSuite) TestGetBaseFeeBurn() {
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+	feeState := keeper.GetState().MinConsensusFee(ctx)
+
+	params := keeper.GetParams(ctx)
+	params.AcceptedFeeDenoms = []rewardsTypes.FeeDenom{
+		{Denom: "uusdc", Rate: sdk.NewDec(2)},
+	}
+	keeper.SetParams(ctx, params)
+
+	s.Run("OK: burn is disabled", func() {
+		feeState.SetBaseFee(sdk.NewDecCoin("uarch", sdk.NewInt(2)))
+
+		burn := keeper.GetBaseFeeBurn(ctx, sdk.NewCoins(sdk.NewInt64Coin("uarch", 1000)), 100)
+		s.Assert().True(burn.IsZero())
+	})
+
+	params.BaseFeeBurnRatio = sdk.NewDecWithPrec(5, 1)
+	keeper.SetParams(ctx, params)
+
+	s.Run("OK: base fee is not set", func() {
+		feeState.DeleteBaseFee()
+
+		burn := keeper.GetBaseFeeBurn(ctx, sdk.NewCoins(sdk.NewInt64Coin("uarch", 1000)), 100)
+		s.Assert().True(burn.IsZero())
+	})
+
+	s.Run("OK: only the base fee part is burned", func() {
+		feeState.SetBaseFee(sdk.NewDecCoin("uarch", sdk.NewInt(2)))
+
+		// BaseFee part: 2 * 100 = 200, burn: 200 * 0.5
+		burn := keeper.GetBaseFeeBurn(ctx, sdk.NewCoins(sdk.NewInt64Coin("uarch", 1000)), 100)
+		s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin("uarch", 100)).String(), burn.String())
+	})
+
+	s.Run("OK: base fee is covered by multiple denoms", func() {
+		feeState.SetBaseFee(sdk.NewDecCoin("uarch", sdk.NewInt(2)))
+
+		// uarch covers 50 / 200 = 0.25 of the base fee, burn: 200 * 0.25 * 0.5
+		// uusdc covers the rest (0.75), burn: 100 * 0.75 * 0.5 (truncated)
+		fees := sdk.NewCoins(
+			sdk.NewInt64Coin("uarch", 50),
+			sdk.NewInt64Coin("uusdc", 100),
+		)
+		burn := keeper.GetBaseFeeBurn(ctx, fees, 100)
+		s.Assert().Equal(sdk.NewCoins(
+			sdk.NewInt64Coin("uarch", 25),
+			sdk.NewInt64Coin("uusdc", 37),
+		).String(), burn.String())
+	})
+}
@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	wasmdTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/pkg/testutils"
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
+// BenchmarkRewardsEndBlocker measures the block processing cost (the x/rewards EndBlocker rewards records creation included)
+// for a contract rewards address which is rewarded every block and never withdraws.
+// That measures the rewards records merging (a single "open" record per rewards address) cost.
+// Benchmark only uses the public API available since the baseline version to compare results between versions.
+func BenchmarkRewardsEndBlocker(b *testing.B) {
+	chain, contractAddr, _ := setupRewardsBenchmark(b)
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		trackRewardsBenchmarkContractOp(b, chain, contractAddr)
+		chain.NextBlock(0)
+	}
+}
+
+// BenchmarkWithdrawRewards measures the withdraw operation cost (the default records limit is used)
+// for a contract rewards address which was rewarded for a number of blocks.
+// With the rewards records merging, the withdraw cost should not depend on the number of blocks.
+// Benchmark only uses the public API available since the baseline version to compare results between versions.
+func BenchmarkWithdrawRewards(b *testing.B) {
+	for _, blocks := range []int{10, 100, 500} {
+		b.Run(fmt.Sprintf("blocks=%d", blocks), func(b *testing.B) {
+			chain, contractAddr, rewardsAddr := setupRewardsBenchmark(b)
+			for i := 0; i < blocks; i++ {
+				trackRewardsBenchmarkContractOp(b, chain, contractAddr)
+				chain.NextBlock(0)
+			}
+
+			ctx, keeper := chain.GetContext(), chain.GetApp().RewardsKeeper
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				cacheCtx, _ := ctx.CacheContext()
+
+				totalRewards, _, err := keeper.WithdrawRewardsByRecordsLimit(cacheCtx, rewardsAddr, 0)
+				require.NoError(b, err)
+				require.False(b, totalRewards.IsZero())
+			}
+		})
+	}
+}
+
+// setupRewardsBenchmark creates a chain with a contract which metadata has the rewards address set.
+func setupRewardsBenchmark(b *testing.B) (*e2eTesting.TestChain, sdk.AccAddress, sdk.AccAddress) {
+	chain := e2eTesting.NewTestChain(b, 1,
+		e2eTesting.WithBlockGasLimit(1_000_000),
+	)
+	ownerAddr, rewardsAddr := chain.GetAccount(0).Address, chain.GetAccount(1).Address
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+
+	// Set mock ContractViewer (to pass contract admin check for metadata setup)
+	contractViewer := testutils.NewMockContractViewer()
+	contractViewer.AddContractAdmin(contractAddr.String(), ownerAddr.String())
+	chain.GetApp().RewardsKeeper.SetContractInfoViewer(contractViewer)
+
+	metadata := rewardsTypes.ContractMetadata{
+		OwnerAddress:   ownerAddr.String(),
+		RewardsAddress: rewardsAddr.String(),
+	}
+	require.NoError(b, chain.GetApp().RewardsKeeper.SetContractMetadata(chain.GetContext(), ownerAddr, contractAddr, metadata))
+
+	return chain, contractAddr, rewardsAddr
+}
+
+// trackRewardsBenchmarkContractOp emulates a transaction with a single contract operation for the current block.
+func trackRewardsBenchmarkContractOp(b *testing.B, chain *e2eTesting.TestChain, contractAddr sdk.AccAddress) {
+	ctx, tKeeper := chain.GetContext(), chain.GetApp().TrackingKeeper
+
+	// Emulate x/tracking AnteHandler call
+	tKeeper.TrackNewTx(ctx)
+
+	require.NoError(b, tKeeper.IngestGasRecord(ctx, []wasmdTypes.ContractGasRecord{
+		{
+			OperationId:     testutils.GetRandomContractOperationType(),
+			ContractAddress: contractAddr.String(),
+			OriginalGas: wasmdTypes.GasConsumptionInfo{
+				SDKGas: 1_000_000, // the whole block gas limit, so the contract gets all the block inflation rewards
+			},
+		},
+	}))
+}
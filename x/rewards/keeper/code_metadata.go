@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// SetCodeMetadata creates or updates the code metadata (default metadata for contracts instantiated from the code).
+// Operation is authorized to the code creator.
+// Rewards address and weighted recipients are mutually exclusive: setting one resets the other.
+func (k Keeper) SetCodeMetadata(ctx sdk.Context, senderAddr sdk.AccAddress, metaUpdates types.CodeMetadata) error {
+	state := k.state.CodeMetadataState(ctx)
+
+	// Check if the code exists
+	codeInfo := k.contractInfoView.GetCodeInfo(ctx, metaUpdates.CodeId)
+	if codeInfo == nil {
+		return types.ErrCodeNotFound
+	}
+
+	// Check ownership
+	if codeInfo.Creator != senderAddr.String() {
+		return sdkErrors.Wrap(types.ErrUnauthorized, "code metadata can only be set by the code creator")
+	}
+
+	// Build the updated meta
+	metaNew, metaExists := state.GetCodeMetadata(metaUpdates.CodeId)
+	if !metaExists {
+		metaNew.CodeId = metaUpdates.CodeId
+	}
+	if metaUpdates.HasRewardsAddress() {
+		metaNew.RewardsAddress = metaUpdates.RewardsAddress
+		metaNew.RewardsRecipients = nil
+	}
+	if metaUpdates.HasRewardsRecipients() {
+		metaNew.RewardsAddress = ""
+		metaNew.RewardsRecipients = metaUpdates.RewardsRecipients
+	}
+
+	// Set
+	state.SetCodeMetadata(metaNew.CodeId, metaNew)
+
+	// Emit event
+	types.EmitCodeMetadataSetEvent(
+		ctx,
+		metaNew.CodeId,
+		metaNew,
+	)
+
+	return nil
+}
+
+// GetCodeMetadata returns the code metadata for the given code ID (if found).
+func (k Keeper) GetCodeMetadata(ctx sdk.Context, codeID uint64) *types.CodeMetadata {
+	meta, found := k.state.CodeMetadataState(ctx).GetCodeMetadata(codeID)
+	if !found {
+		return nil
+	}
+
+	return &meta
+}
+
+// GetEffectiveContractMetadata returns the contract metadata used for the rewards distribution (if found).
+// The contract metadata takes precedence, the contract code ID metadata is used as a fallback.
+func (k Keeper) GetEffectiveContractMetadata(ctx sdk.Context, contractAddr sdk.AccAddress) *types.ContractMetadata {
+	if meta := k.GetContractMetadata(ctx, contractAddr); meta != nil {
+		return meta
+	}
+
+	contractInfo := k.contractInfoView.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return nil
+	}
+
+	codeMeta, found := k.state.CodeMetadataState(ctx).GetCodeMetadata(contractInfo.CodeID)
+	if !found {
+		return nil
+	}
+	meta := codeMeta.ContractMetadata(contractAddr)
+
+	return &meta
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/pkg/testutils"
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
+func (s *KeeperTestSuite) TestSetCodeMetadata() {
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+	codeCreatorAcc, otherAcc := s.chain.GetAccount(0), s.chain.GetAccount(1)
+
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetContractInfoViewer(contractViewer)
+
+	codeID := uint64(1)
+
+	s.Run("Fail: non-existing code", func() {
+		err := keeper.SetCodeMetadata(ctx, codeCreatorAcc.Address, rewardsTypes.CodeMetadata{CodeId: codeID})
+		s.Assert().ErrorIs(err, rewardsTypes.ErrCodeNotFound)
+	})
+
+	// Set code creator
+	contractViewer.AddCodeCreator(codeID, codeCreatorAcc.Address.String())
+
+	s.Run("Fail: not a code creator", func() {
+		err := keeper.SetCodeMetadata(ctx, otherAcc.Address, rewardsTypes.CodeMetadata{CodeId: codeID})
+		s.Assert().ErrorIs(err, rewardsTypes.ErrUnauthorized)
+	})
+
+	s.Run("OK: create", func() {
+		meta := rewardsTypes.CodeMetadata{
+			CodeId:         codeID,
+			RewardsAddress: otherAcc.Address.String(),
+		}
+
+		err := keeper.SetCodeMetadata(ctx, codeCreatorAcc.Address, meta)
+		s.Require().NoError(err)
+
+		metaReceived := keeper.GetCodeMetadata(ctx, codeID)
+		s.Require().NotNil(metaReceived)
+		s.Assert().Equal(meta, *metaReceived)
+	})
+
+	s.Run("OK: set RewardsRecipients (RewardsAddr is reset)", func() {
+		metaUpdates := rewardsTypes.CodeMetadata{
+			CodeId: codeID,
+			RewardsRecipients: []rewardsTypes.RewardsRecipient{
+				{Address: codeCreatorAcc.Address.String(), Weight: sdk.NewDecWithPrec(5, 1)},
+				{Address: otherAcc.Address.String(), Weight: sdk.NewDecWithPrec(5, 1)},
+			},
+		}
+
+		err := keeper.SetCodeMetadata(ctx, codeCreatorAcc.Address, metaUpdates)
+		s.Require().NoError(err)
+
+		metaReceived := keeper.GetCodeMetadata(ctx, codeID)
+		s.Require().NotNil(metaReceived)
+		s.Assert().Equal(metaUpdates, *metaReceived)
+	})
+
+	s.Run("OK: set RewardsAddr (RewardsRecipients are reset)", func() {
+		meta := rewardsTypes.CodeMetadata{
+			CodeId:         codeID,
+			RewardsAddress: otherAcc.Address.String(),
+		}
+
+		err := keeper.SetCodeMetadata(ctx, codeCreatorAcc.Address, meta)
+		s.Require().NoError(err)
+
+		metaReceived := keeper.GetCodeMetadata(ctx, codeID)
+		s.Require().NotNil(metaReceived)
+		s.Assert().Equal(meta, *metaReceived)
+	})
+}
+
+func (s *KeeperTestSuite) TestGetEffectiveContractMetadata() {
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+	codeCreatorAcc, contractAdminAcc := s.chain.GetAccount(0), s.chain.GetAccount(1)
+
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetContractInfoViewer(contractViewer)
+
+	codeID := uint64(1)
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	contractViewer.AddCodeCreator(codeID, codeCreatorAcc.Address.String())
+	contractViewer.AddContractCodeID(contractAddr.String(), codeID)
+
+	s.Run("OK: no metadata", func() {
+		s.Assert().Nil(keeper.GetEffectiveContractMetadata(ctx, contractAddr))
+	})
+
+	s.Run("OK: code metadata fallback", func() {
+		err := keeper.SetCodeMetadata(ctx, codeCreatorAcc.Address, rewardsTypes.CodeMetadata{
+			CodeId:         codeID,
+			RewardsAddress: codeCreatorAcc.Address.String(),
+		})
+		s.Require().NoError(err)
+
+		metaReceived := keeper.GetEffectiveContractMetadata(ctx, contractAddr)
+		s.Require().NotNil(metaReceived)
+		s.Assert().Equal(contractAddr.String(), metaReceived.ContractAddress)
+		s.Assert().Equal(codeCreatorAcc.Address.String(), metaReceived.RewardsAddress)
+
+		// Code metadata is not exposed as the contract metadata
+		s.Assert().Nil(keeper.GetContractMetadata(ctx, contractAddr))
+	})
+
+	s.Run("OK: contract metadata overrides code metadata", func() {
+		contractViewer.AddContractAdmin(contractAddr.String(), contractAdminAcc.Address.String())
+
+		err := keeper.SetContractMetadata(ctx, contractAdminAcc.Address, contractAddr, rewardsTypes.ContractMetadata{
+			RewardsAddress: contractAdminAcc.Address.String(),
+		})
+		s.Require().NoError(err)
+
+		metaReceived := keeper.GetEffectiveContractMetadata(ctx, contractAddr)
+		s.Require().NotNil(metaReceived)
+		s.Assert().Equal(contractAdminAcc.Address.String(), metaReceived.RewardsAddress)
+	})
+}
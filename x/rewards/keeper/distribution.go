@@ -5,19 +5,28 @@ import (
 	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/archway-network/archway/pkg"
 	"github.com/archway-network/archway/x/rewards/types"
+	trackingTypes "github.com/archway-network/archway/x/tracking/types"
 )
 
 type (
 	// blockRewardsDistributionState is used to gather gas usage and rewards for a block on a contract basis.
 	blockRewardsDistributionState struct {
 		Height             int64                                        // block height
-		Txs                map[uint64]uint64                            // gas usage per transaction [key: txID, value: total gas]
+		Txs                map[uint64]uint64                            // gas usage per transaction (contract operations gas is weighted) [key: txID, value: total gas]
+		TxsNonContractGas  map[uint64]uint64                            // non-contract gas usage per transaction [key: txID, value: non-contract gas]
+		TxsFeePayer        map[uint64]string                            // fee payer per transaction (might be empty) [key: txID, value: fee payer address]
+		TxsFees            map[uint64]sdk.Coins                         // fees paid per transaction [key: txID, value: fees]
 		Contracts          map[string]*contractRewardsDistributionState // contract rewards state [key: contract address]
+		ContractsGasUsed   uint64                                       // total (weighted) gas used by all contracts in the block
 		RewardsTotal       sdk.Coins                                    // total rewards for the block (inflationary + txs rewards)
 		RewardsDistributed sdk.Coins                                    // total rewards distributed for the block
+		FeeCollectorReturn sdk.Coins                                    // txs rewards share for the non-contract gas usage (returned to the FeeCollector)
+		InflationEpoch     uint64                                       // epoch number for the contract inflation epoch cap
+		InflationEpochCap  sdk.Int                                      // contract inflation epoch cap (zero if not capped)
 	}
 
 	// contractRewardsDistributionState is used to gather gas usage and rewards for a contract.
@@ -25,11 +34,13 @@ type (
 		ContractAddress sdk.AccAddress          // contract address
 		Metadata        *types.ContractMetadata // metadata for this contract (might be nil if not set)
 
-		BlockGasUsed uint64            // total gas used in the block (all operations across all transaction)
-		TxGasUsed    map[uint64]uint64 // total gas used in a transaction (all operations across one transaction) [key: txID, value: gas used]
+		BlockGasConsumed uint64            // total gas consumed in the block (all operations across all transaction, not weighted)
+		BlockGasUsed     uint64            // total weighted gas used in the block (all operations across all transaction)
+		TxGasUsed        map[uint64]uint64 // total weighted gas used in a transaction (all operations across one transaction) [key: txID, value: gas used]
 
-		FeeRewards          sdk.Coins // fee rewards for this contract (for all txs)
-		InflationaryRewards sdk.Coin  // inflation rewards for this contract (for the block)
+		FeeRewards                sdk.Coins // fee rewards for this contract (for all txs)
+		InflationaryRewards       sdk.Coin  // inflation rewards for this contract (for the block)
+		InflationaryRewardsCapped sdk.Coin  // inflation rewards cut by the contract caps (transferred to the treasury)
 	}
 )
 
@@ -38,15 +49,16 @@ func (k Keeper) AllocateBlockRewards(ctx sdk.Context, height int64) {
 	blockDistrState := k.estimateBlockGasUsage(ctx, height)
 	blockDistrState = k.estimateBlockRewards(ctx, blockDistrState)
 	k.createRewardsRecords(ctx, blockDistrState)
+	k.returnNonContractFeeRewards(ctx, blockDistrState)
 	k.cleanupRewardsPool(ctx, blockDistrState)
 	k.cleanupTracking(ctx, height)
 }
 
 // estimateBlockGasUsage creates a new distribution state for the given block height.
 // Func iterates over all tracked transactions and estimates gas usage for each contract (on block and tx levels) merging operations.
+// Operations gas is weighted using the ContractOperationWeights param (transactions total gas is adjusted accordingly).
+// Contracts without metadata fall back to their code ID metadata (if set).
 func (k Keeper) estimateBlockGasUsage(ctx sdk.Context, height int64) *blockRewardsDistributionState {
-	metadataState := k.state.ContractMetadataState(ctx)
-
 	// Get all tracked transactions by the x/tracking module
 	blockGasTrackingInfo := k.trackingKeeper.GetBlockTrackingInfo(ctx, height)
 
@@ -54,15 +66,29 @@ func (k Keeper) estimateBlockGasUsage(ctx sdk.Context, height int64) *blockRewar
 	blockDistrState := &blockRewardsDistributionState{
 		Height:             height,
 		Txs:                make(map[uint64]uint64, len(blockGasTrackingInfo.Txs)),
+		TxsNonContractGas:  make(map[uint64]uint64, len(blockGasTrackingInfo.Txs)),
+		TxsFeePayer:        make(map[uint64]string, len(blockGasTrackingInfo.Txs)),
+		TxsFees:            make(map[uint64]sdk.Coins, len(blockGasTrackingInfo.Txs)),
 		Contracts:          make(map[string]*contractRewardsDistributionState, 0),
 		RewardsTotal:       sdk.NewCoins(),
 		RewardsDistributed: sdk.NewCoins(),
+		FeeCollectorReturn: sdk.NewCoins(),
+		InflationEpochCap:  sdk.ZeroInt(),
+	}
+
+	// Operations gas weights (operations without a weight set are not weighted)
+	opWeights := make(map[trackingTypes.ContractOperation]sdk.Dec)
+	for _, opWeight := range k.ContractOperationWeights(ctx) {
+		opWeights[opWeight.OperationType] = opWeight.Weight
 	}
 
 	// Fill up gas usage iterating over all tracked transactions and contract operations
 	for _, txGasTrackingInfo := range blockGasTrackingInfo.Txs {
-		// Set total gas used by the transaction
-		blockDistrState.Txs[txGasTrackingInfo.Info.Id] = txGasTrackingInfo.Info.TotalGas
+		// Set total (increased by contract operations below) and non-contract gas used by the transaction
+		blockDistrState.Txs[txGasTrackingInfo.Info.Id] = txGasTrackingInfo.Info.NonContractGas
+		blockDistrState.TxsNonContractGas[txGasTrackingInfo.Info.Id] = txGasTrackingInfo.Info.NonContractGas
+		blockDistrState.TxsFeePayer[txGasTrackingInfo.Info.Id] = txGasTrackingInfo.Info.FeePayer
+		blockDistrState.TxsFees[txGasTrackingInfo.Info.Id] = txGasTrackingInfo.Info.Fees
 
 		// Estimate contract operations total gas used for this transaction
 		for _, contractOp := range txGasTrackingInfo.ContractOperations {
@@ -77,22 +103,30 @@ func (k Keeper) estimateBlockGasUsage(ctx sdk.Context, height int64) *blockRewar
 			contractDistrState := blockDistrState.Contracts[contractOp.ContractAddress]
 			if contractDistrState == nil {
 				contractDistrState = &contractRewardsDistributionState{
-					ContractAddress:     contractOp.MustGetContractAddress(),
-					TxGasUsed:           make(map[uint64]uint64, 0),
-					InflationaryRewards: sdk.Coin{Amount: sdk.ZeroInt()}, // necessary to avoid nil pointer panic on Coins.Add call
-				}
-				if metadata, found := metadataState.GetContractMetadata(contractDistrState.ContractAddress); found {
-					contractDistrState.Metadata = &metadata
+					ContractAddress:           contractOp.MustGetContractAddress(),
+					TxGasUsed:                 make(map[uint64]uint64, 0),
+					InflationaryRewards:       sdk.Coin{Amount: sdk.ZeroInt()}, // necessary to avoid nil pointer panic on Coins.Add call
+					InflationaryRewardsCapped: sdk.Coin{Amount: sdk.ZeroInt()},
 				}
+				contractDistrState.Metadata = k.GetEffectiveContractMetadata(ctx, contractDistrState.ContractAddress)
 				blockDistrState.Contracts[contractOp.ContractAddress] = contractDistrState
 			}
 
+			// Apply the operation weight
+			opGasWeighted := opGasUsed
+			if opWeight, found := opWeights[contractOp.OperationType]; found {
+				opGasWeighted = pkg.NewDecFromUint64(opGasUsed).Mul(opWeight).TruncateInt().Uint64()
+			}
+
 			// Increase block gas usage
-			contractDistrState.BlockGasUsed += opGasUsed
+			contractDistrState.BlockGasConsumed += opGasUsed
+			contractDistrState.BlockGasUsed += opGasWeighted
+			blockDistrState.ContractsGasUsed += opGasWeighted
 
 			// Increase tx gas usage
 			txGasUsed := contractDistrState.TxGasUsed[contractOp.TxId] // 0 if not initialized
-			contractDistrState.TxGasUsed[contractOp.TxId] = txGasUsed + opGasUsed
+			contractDistrState.TxGasUsed[contractOp.TxId] = txGasUsed + opGasWeighted
+			blockDistrState.Txs[contractOp.TxId] += opGasWeighted
 		}
 	}
 
@@ -102,6 +136,8 @@ func (k Keeper) estimateBlockGasUsage(ctx sdk.Context, height int64) *blockRewar
 // estimateBlockRewards update block distribution state with tracked rewards calculating reward shares per contract.
 // Func iterates over all tracked transactions and estimates inflation (on block level) and fee rebate (merging
 // tokens for each transaction contract has operation at) rewards for each contract.
+// Fee rebate share of a transaction non-contract gas usage is estimated to be returned to the FeeCollector.
+// Contract inflation rewards are limited by the share and epoch caps (the rest stays in the pool and goes to the treasury).
 func (k Keeper) estimateBlockRewards(ctx sdk.Context, blockDistrState *blockRewardsDistributionState) *blockRewardsDistributionState {
 	txRewardsState := k.state.TxRewardsState(ctx)
 
@@ -129,18 +165,37 @@ func (k Keeper) estimateBlockRewards(ctx sdk.Context, blockDistrState *blockRewa
 		}
 	}
 
+	// Estimate non-contract gas fee rebate rewards share
+	for txID, txFees := range txsRewards {
+		gasTotal, nonContractGas := blockDistrState.Txs[txID], blockDistrState.TxsNonContractGas[txID]
+		if gasTotal == 0 || nonContractGas == 0 {
+			continue
+		}
+
+		nonContractShare := pkg.NewDecFromUint64(nonContractGas).Quo(pkg.NewDecFromUint64(gasTotal))
+		for _, feeCoin := range txFees {
+			blockDistrState.FeeCollectorReturn = blockDistrState.FeeCollectorReturn.Add(sdk.NewCoin(
+				feeCoin.Denom,
+				feeCoin.Amount.ToDec().Mul(nonContractShare).TruncateInt(),
+			))
+		}
+	}
+
+	// Contract inflation rewards caps
+	selfDealingPolicy := k.SelfDealingPolicy(ctx)
+	inflationShareCapAmt := sdk.ZeroInt()
+	if inlfationRewardsEligible {
+		inflationShareCapAmt = blockRewards.InflationRewards.Amount.ToDec().Mul(k.ContractInflationShareCap(ctx)).TruncateInt()
+		blockDistrState.InflationEpochCap = k.ContractInflationEpochCap(ctx)
+		blockDistrState.InflationEpoch = uint64(blockDistrState.Height) / k.InflationCapEpochBlocks(ctx)
+	}
+
 	// Estimate contract rewards
 	for _, contractDistrState := range blockDistrState.Contracts {
 		// Estimate contract inflation rewards
 		if inlfationRewardsEligible {
-			gasUsed := pkg.NewDecFromUint64(contractDistrState.BlockGasUsed)
-			rewardsShare := gasUsed.Quo(pkg.NewDecFromUint64(blockRewards.MaxGas))
-
-			inflationRewards := sdk.NewCoin(
-				blockRewards.InflationRewards.Denom,
-				blockRewards.InflationRewards.Amount.ToDec().Mul(rewardsShare).TruncateInt(),
-			)
-			contractDistrState.InflationaryRewards = inflationRewards
+			contractDistrState.InflationaryRewards = k.estimateContractInflationRewards(blockDistrState, contractDistrState, blockRewards, selfDealingPolicy)
+			k.applyContractInflationCaps(ctx, blockDistrState, contractDistrState, inflationShareCapAmt)
 		}
 
 		// Estimate contract tx fee rebate rewards (sum of all transactions involved)
@@ -168,6 +223,7 @@ func (k Keeper) estimateBlockRewards(ctx sdk.Context, blockDistrState *blockRewa
 
 // createRewardsRecords creates types.RewardsRecord entries for a respective reward addresses if set (otherwise, skip)
 // and emit calculation events. An actual distribution (x/bank transfer) is performed later.
+// If a contract has weighted rewards recipients set, a record is created for each recipient (dust goes to the first one).
 // Leftovers caused by Int truncation or by a tx-less block (inflation rewards are tracked even if there were no transactions)
 // stay in the pool.
 func (k Keeper) createRewardsRecords(ctx sdk.Context, blockDistrState *blockRewardsDistributionState) {
@@ -175,7 +231,7 @@ func (k Keeper) createRewardsRecords(ctx sdk.Context, blockDistrState *blockRewa
 	calculationHeight, calculationTime := ctx.BlockHeight(), ctx.BlockTime()
 
 	// Convert contract distribution states to a sorted slice preventing the consensus failure due to x/bank operations order.
-	// Filter out contracts without: rewards, metadata or rewardsAddress.
+	// Filter out contracts without: rewards, metadata or rewardsAddress / rewardsRecipients (unless rewards are pledged to the sponsorship).
 	// Emit calculation events for each contract.
 	contractStates := make([]*contractRewardsDistributionState, 0, len(blockDistrState.Contracts))
 	for _, contractDistrState := range blockDistrState.Contracts {
@@ -183,10 +239,12 @@ func (k Keeper) createRewardsRecords(ctx sdk.Context, blockDistrState *blockRewa
 		types.EmitContractRewardCalculationEvent(
 			ctx,
 			contractDistrState.ContractAddress,
+			contractDistrState.BlockGasConsumed,
 			contractDistrState.BlockGasUsed,
 			contractDistrState.InflationaryRewards,
 			contractDistrState.FeeRewards,
 			contractDistrState.Metadata,
+			contractDistrState.InflationaryRewardsCapped,
 		)
 
 		// Filter out
@@ -198,8 +256,12 @@ func (k Keeper) createRewardsRecords(ctx sdk.Context, blockDistrState *blockRewa
 			k.Logger(ctx).Debug("Contract metadata is not set (skip)", "contract", contractDistrState.ContractAddress)
 			continue
 		}
-		if !contractDistrState.Metadata.HasRewardsAddress() {
-			k.Logger(ctx).Debug("Contract rewards address is not set (skip)", "contract", contractDistrState.ContractAddress)
+		if _, pledged := k.GetPledgedSponsorship(ctx, contractDistrState.ContractAddress); pledged {
+			contractStates = append(contractStates, contractDistrState)
+			continue
+		}
+		if !contractDistrState.Metadata.HasRewardsAddress() && !contractDistrState.Metadata.HasRewardsRecipients() {
+			k.Logger(ctx).Debug("Contract rewards address / recipients are not set (skip)", "contract", contractDistrState.ContractAddress)
 			continue
 		}
 
@@ -210,19 +272,116 @@ func (k Keeper) createRewardsRecords(ctx sdk.Context, blockDistrState *blockRewa
 	})
 
 	// Distribute
+	inflationUsageState := k.state.ContractInflationUsage(ctx)
 	for _, contractDistrState := range contractStates {
-		// Transfer to the rewardsAddress
-		rewardsAddr := contractDistrState.Metadata.MustGetRewardsAddress()
+		// Track the contract inflation rewards for the epoch cap
+		if blockDistrState.InflationEpochCap.IsPositive() && !contractDistrState.InflationaryRewards.IsZero() {
+			inflationUsageState.AddEpochUsage(contractDistrState.ContractAddress, blockDistrState.InflationEpoch, contractDistrState.InflationaryRewards.Amount)
+		}
+
 		rewards := sdk.NewCoins().
 			Add(contractDistrState.InflationaryRewards).
 			Add(contractDistrState.FeeRewards...)
 
-		// Create a new record
-		rewardsRecordState.CreateRewardsRecord(rewardsAddr, rewards, calculationHeight, calculationTime)
+		// Pledge rewards to the contract sponsorship deposit (if opted in)
+		if sponsorship, pledged := k.GetPledgedSponsorship(ctx, contractDistrState.ContractAddress); pledged {
+			k.pledgeSponsorshipRewards(ctx, sponsorship, rewards)
+			blockDistrState.RewardsDistributed = blockDistrState.RewardsDistributed.Add(rewards...)
+			continue
+		}
+
+		// Split rewards between recipients (a single recipient if only the rewardsAddress is set)
+		recipients := contractDistrState.Metadata.EffectiveRewardsRecipients()
+		recipientsRewards := types.SplitRewardsByRecipients(rewards, recipients)
+		for i, recipient := range recipients {
+			recipientRewards := recipientsRewards[i]
+			if recipientRewards.IsZero() {
+				continue
+			}
+
+			// Merge into the latest record (or create a new one)
+			rewardsRecordState.AppendRewardsRecord(recipient.MustGetAddress(), recipientRewards, calculationHeight, calculationTime, types.RewardsRecordMergeWindow)
+
+			// Update the total rewards distributed counter
+			blockDistrState.RewardsDistributed = blockDistrState.RewardsDistributed.Add(recipientRewards...)
+		}
+	}
+}
+
+// estimateContractInflationRewards estimates the contract inflation rewards using the contract block gas usage share.
+// Weighted gas of all contracts might exceed the block gas limit, in that case shares are estimated using the total weighted gas.
+// Gas used by self-dealing transactions (fees are paid by an address affiliated with the contract) is handled
+// according to the policy: excluded or rewarded up to the contract share of the transaction fees.
+func (k Keeper) estimateContractInflationRewards(blockDistrState *blockRewardsDistributionState, contractDistrState *contractRewardsDistributionState, blockRewards types.BlockRewards, selfDealingPolicy types.SelfDealingPolicy) sdk.Coin {
+	rewardsDenom := blockRewards.InflationRewards.Denom
+	gasLimit := blockRewards.MaxGas
+	if blockDistrState.ContractsGasUsed > gasLimit {
+		gasLimit = blockDistrState.ContractsGasUsed
+	}
+	gasRewards := func(gasUsed uint64) sdk.Dec {
+		rewardsShare := pkg.NewDecFromUint64(gasUsed).Quo(pkg.NewDecFromUint64(gasLimit))
+		return blockRewards.InflationRewards.Amount.ToDec().Mul(rewardsShare)
+	}
+
+	eligibleGasUsed, selfDealingRewards := contractDistrState.BlockGasUsed, sdk.ZeroDec()
+	if selfDealingPolicy != types.SelfDealingPolicy_SELF_DEALING_POLICY_NONE && contractDistrState.Metadata != nil {
+		for txID, txGasUsed := range contractDistrState.TxGasUsed {
+			if !contractDistrState.Metadata.IsAffiliatedAddress(blockDistrState.TxsFeePayer[txID]) {
+				continue
+			}
+			eligibleGasUsed -= txGasUsed
+
+			if selfDealingPolicy != types.SelfDealingPolicy_SELF_DEALING_POLICY_CAP_TO_FEE {
+				continue
+			}
+			txFeeShare := pkg.NewDecFromUint64(txGasUsed).Quo(pkg.NewDecFromUint64(blockDistrState.Txs[txID]))
+			txFeeAmt := blockDistrState.TxsFees[txID].AmountOf(rewardsDenom).ToDec().Mul(txFeeShare)
+			selfDealingRewards = selfDealingRewards.Add(sdk.MinDec(gasRewards(txGasUsed), txFeeAmt))
+		}
+	}
+
+	return sdk.NewCoin(
+		rewardsDenom,
+		gasRewards(eligibleGasUsed).Add(selfDealingRewards).TruncateInt(),
+	)
+}
+
+// applyContractInflationCaps limits the contract inflation rewards by the max block share and the epoch caps.
+// The cut amount is not distributed, so it is transferred to the treasury on the pool cleanup.
+func (k Keeper) applyContractInflationCaps(ctx sdk.Context, blockDistrState *blockRewardsDistributionState, contractDistrState *contractRewardsDistributionState, shareCapAmt sdk.Int) {
+	rewardsAmt := contractDistrState.InflationaryRewards.Amount
+
+	capAmt := shareCapAmt
+	if blockDistrState.InflationEpochCap.IsPositive() {
+		epochUsage := k.state.ContractInflationUsage(ctx).GetEpochUsage(contractDistrState.ContractAddress, blockDistrState.InflationEpoch)
+
+		epochLeftAmt := sdk.ZeroInt()
+		if blockDistrState.InflationEpochCap.GT(epochUsage) {
+			epochLeftAmt = blockDistrState.InflationEpochCap.Sub(epochUsage)
+		}
+		capAmt = sdk.MinInt(capAmt, epochLeftAmt)
+	}
+
+	if rewardsAmt.LTE(capAmt) {
+		return
+	}
+
+	denom := contractDistrState.InflationaryRewards.Denom
+	contractDistrState.InflationaryRewards = sdk.NewCoin(denom, capAmt)
+	contractDistrState.InflationaryRewardsCapped = sdk.NewCoin(denom, rewardsAmt.Sub(capAmt))
+}
+
+// returnNonContractFeeRewards transfers the fee rebate rewards share of the non-contract gas usage back to the FeeCollector.
+// Those tokens are counted as distributed.
+func (k Keeper) returnNonContractFeeRewards(ctx sdk.Context, blockDistrState *blockRewardsDistributionState) {
+	if blockDistrState.FeeCollectorReturn.IsZero() {
+		return
+	}
 
-		// Update the total rewards distributed counter
-		blockDistrState.RewardsDistributed = blockDistrState.RewardsDistributed.Add(rewards...)
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ContractRewardCollector, authTypes.FeeCollectorName, blockDistrState.FeeCollectorReturn); err != nil {
+		panic(fmt.Errorf("failed to return non-contract fee rewards (%s) to %s: %w", blockDistrState.FeeCollectorReturn, authTypes.FeeCollectorName, err))
 	}
+	blockDistrState.RewardsDistributed = blockDistrState.RewardsDistributed.Add(blockDistrState.FeeCollectorReturn...)
 }
 
 // cleanupTracking prunes all tracking data for the given block height for x/tracking and x/rewards modules.
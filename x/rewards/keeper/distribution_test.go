@@ -12,6 +12,7 @@ import (
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
 	"github.com/archway-network/archway/pkg/testutils"
 	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+	trackingTypes "github.com/archway-network/archway/x/tracking/types"
 )
 
 // TestRewardsKeeper_Distribution tests rewards distribution for a single block with different edge cases.
@@ -20,15 +21,22 @@ import (
 func TestRewardsKeeper_Distribution(t *testing.T) {
 	type (
 		contractInput struct {
-			metadataExists bool           // if true, metadata is set
-			contractAddr   sdk.AccAddress // any random address to merge operations [sdk.AccAddr]
-			rewardsAddr    string         // might be empty to skip distribution (should be a real chain address) [sdk.AccAddr]
-			operations     []uint64       // list of gas consumptions per operation (opType is set randomly)
+			metadataExists bool                            // if true, metadata is set
+			contractAddr   sdk.AccAddress                  // any random address to merge operations [sdk.AccAddr]
+			rewardsAddr    string                          // might be empty to skip distribution (should be a real chain address) [sdk.AccAddr]
+			operations     []uint64                        // list of gas consumptions per operation (opType is set randomly if not set below)
+			opType         trackingTypes.ContractOperation // operations type (might be empty to set randomly)
+			// optional weighted rewards recipients (rewardsAddr must be empty if set)
+			rewardsRecipients []rewardsTypes.RewardsRecipient
+			// inflation rewards already received within the current epoch (might be 0)
+			inflationEpochUsage int64
 		}
 
 		transactionInput struct {
-			feeCoins  string          // fee coins for this transaction (might be empty to skip distribution) [sdk.Coins]
-			contracts []contractInput // list of contracts and their operations
+			feeCoins       string          // fee coins for this transaction (might be empty to skip distribution) [sdk.Coins]
+			contracts      []contractInput // list of contracts and their operations
+			nonContractGas uint64          // gas used by the transaction outside of contract operations (might be 0 to skip the tx gas usage tracking)
+			paidByOwner    bool            // if true, the contract owner is tracked as the tx fee payer (self-dealing tx)
 		}
 
 		contractOutput struct {
@@ -40,9 +48,13 @@ func TestRewardsKeeper_Distribution(t *testing.T) {
 		testCase struct {
 			name string
 			// inputs
-			blockInflationCoin string             // block inflation coin (might be empty to skip distribution) [sdk.Coin]
-			blockGasLimit      int64              // consensus parameter (might be 0 to skip inflation distribution)
-			txs                []transactionInput // block transactions input
+			blockInflationCoin string                                 // block inflation coin (might be empty to skip distribution) [sdk.Coin]
+			blockGasLimit      int64                                  // consensus parameter (might be 0 to skip inflation distribution)
+			txs                []transactionInput                     // block transactions input
+			inflationShareCap  string                                 // contract inflation share cap param (might be empty to use the default) [sdk.Dec]
+			inflationEpochCap  int64                                  // contract inflation epoch cap param (might be 0 to use the default)
+			selfDealingPolicy  rewardsTypes.SelfDealingPolicy         // self-dealing txs policy param
+			operationWeights   []rewardsTypes.ContractOperationWeight // contract operation weights param (might be nil to use the default)
 			// expected outputs
 			contractsOutput  []contractOutput // list of contracts and their expected rewards (might not include some contracts if they don't have metadata set)
 			treasuryExpected string           // rewards leftovers expected
@@ -465,7 +477,7 @@ func TestRewardsKeeper_Distribution(t *testing.T) {
 			treasuryExpected: "",
 		},
 		{
-			name:               "1 tx, 2 contracts with the same rewardsAddress (multiple records created)",
+			name:               "1 tx, 2 contracts with the same rewardsAddress (records are merged)",
 			blockInflationCoin: "1000stake",
 			blockGasLimit:      1000,
 			txs: []transactionInput{
@@ -499,7 +511,7 @@ func TestRewardsKeeper_Distribution(t *testing.T) {
 					// Tx rewards 2nd contract:  ~0.66 (200 / 300 tx gas)     = 600stake
 					// Inf rewards 2nd contract:  0.2  (200 / 1000 block gas) = 200stake
 					rewards:    "1199stake",
-					recordsNum: 2, // from 2 contracts
+					recordsNum: 1, // 2 contracts rewards are merged into one record
 				},
 			},
 			// Leftovers:
@@ -507,6 +519,508 @@ func TestRewardsKeeper_Distribution(t *testing.T) {
 			//   - Inf: 1000stake - 100stake - 200stake = 700stake
 			treasuryExpected: "701stake",
 		},
+		{
+			name:               "1 tx, 1 contract with 3 weighted rewards recipients (dust goes to the first one)",
+			blockInflationCoin: "1000stake",
+			blockGasLimit:      1000,
+			txs: []transactionInput{
+				{
+					feeCoins: "500stake",
+					contracts: []contractInput{
+						{
+							metadataExists: true,
+							contractAddr:   contractAddrs[0],
+							rewardsRecipients: []rewardsTypes.RewardsRecipient{
+								{Address: accAddrs[0].String(), Weight: sdk.NewDecWithPrec(5, 1)},
+								{Address: accAddrs[1].String(), Weight: sdk.NewDecWithPrec(3, 1)},
+								{Address: accAddrs[2].String(), Weight: sdk.NewDecWithPrec(2, 1)},
+							},
+							operations: []uint64{
+								101,
+							},
+						},
+					},
+				},
+			},
+			contractsOutput: []contractOutput{
+				// Contract rewards:
+				//   Tx rewards:  1.0   (101 / 101 tx gas)     = 500stake
+				//   Inf rewards: 0.101 (101 / 1000 block gas) = 101stake
+				{
+					rewardsAddr: accAddrs[0],
+					// 601stake - 180stake - 120stake (dust included)
+					rewards:    "301stake",
+					recordsNum: 1,
+				},
+				{
+					rewardsAddr: accAddrs[1],
+					// 601stake * 0.3 = 180.3stake
+					rewards:    "180stake",
+					recordsNum: 1,
+				},
+				{
+					rewardsAddr: accAddrs[2],
+					// 601stake * 0.2 = 120.2stake
+					rewards:    "120stake",
+					recordsNum: 1,
+				},
+			},
+			// Leftovers:
+			//   - Tx:  all distributed
+			//   - Inf: 1000stake - 101stake = 899stake
+			treasuryExpected: "899stake",
+		},
+		{
+			name:               "1 tx, 1 contract, 1 op with non-contract gas",
+			blockInflationCoin: "1000stake",
+			blockGasLimit:      1000,
+			txs: []transactionInput{
+				{
+					feeCoins: "1000stake",
+					contracts: []contractInput{
+						{
+							metadataExists: true,
+							contractAddr:   contractAddrs[0],
+							rewardsAddr:    accAddrs[0].String(),
+							operations: []uint64{
+								100,
+							},
+						},
+					},
+					nonContractGas: 300,
+				},
+			},
+			contractsOutput: []contractOutput{
+				{
+					rewardsAddr: accAddrs[0],
+					// Tx rewards:  0.25 (100 / 400 tx gas)      = 250stake
+					// Inf rewards: 0.1  (100 / 1000 block gas)  = 100stake
+					rewards:    "350stake",
+					recordsNum: 1, // from 1 contract
+				},
+			},
+			// Leftovers:
+			//   - Tx:  1000stake - 250stake - 750stake (returned to the FeeCollector) = 0stake
+			//   - Inf: 1000stake - 100stake = 900stake
+			treasuryExpected: "900stake",
+		},
+		{
+			name: "2 txs, 1 contract, 1 op for each with non-contract gas (dust)",
+			txs: []transactionInput{
+				{
+					feeCoins: "1000stake",
+					contracts: []contractInput{
+						{
+							metadataExists: true,
+							contractAddr:   contractAddrs[0],
+							rewardsAddr:    accAddrs[0].String(),
+							operations: []uint64{
+								100,
+							},
+						},
+					},
+					nonContractGas: 200,
+				},
+				{
+					feeCoins: "500stake",
+					contracts: []contractInput{
+						{
+							metadataExists: true,
+							contractAddr:   contractAddrs[0],
+							rewardsAddr:    accAddrs[0].String(),
+							operations: []uint64{
+								100,
+							},
+						},
+					},
+				},
+			},
+			contractsOutput: []contractOutput{
+				{
+					rewardsAddr: accAddrs[0],
+					// Tx rewards 1st tx: ~0.33 (100 / 300 tx gas) = 333stake
+					// Tx rewards 2nd tx:  1.0  (100 / 100 tx gas) = 500stake
+					rewards:    "833stake",
+					recordsNum: 1, // from 1 contract
+				},
+			},
+			// Leftovers:
+			//   - Tx:  1500stake - 833stake - 666stake (returned to the FeeCollector) = 1stake
+			treasuryExpected: "1stake",
+		},
+		{
+			name:               "1 tx, 2 contracts, inflation share cap",
+			blockInflationCoin: "1000stake",
+			blockGasLimit:      1000,
+			inflationShareCap:  "0.4",
+			txs: []transactionInput{
+				{
+					feeCoins: "800stake",
+					contracts: []contractInput{
+						{
+							metadataExists: true,
+							contractAddr:   contractAddrs[0],
+							rewardsAddr:    accAddrs[0].String(),
+							operations: []uint64{
+								500,
+							},
+						},
+						{
+							metadataExists: true,
+							contractAddr:   contractAddrs[1],
+							rewardsAddr:    accAddrs[1].String(),
+							operations: []uint64{
+								300,
+							},
+						},
+					},
+				},
+			},
+			contractsOutput: []contractOutput{
+				{
+					rewardsAddr: accAddrs[0],
+					// Tx rewards:  0.625 (500 / 800 tx gas)               = 500stake
+					// Inf rewards: 0.5   (500 / 1000 block gas) = 500stake -> capped to 0.4 block share = 400stake
+					rewards:    "900stake",
+					recordsNum: 1,
+				},
+				{
+					rewardsAddr: accAddrs[1],
+					// Tx rewards:  0.375 (300 / 800 tx gas)     = 300stake
+					// Inf rewards: 0.3   (300 / 1000 block gas) = 300stake
+					rewards:    "600stake",
+					recordsNum: 1,
+				},
+			},
+			// Leftovers:
+			//   - Tx:  all distributed
+			//   - Inf: 1000stake - 400stake - 300stake = 300stake
+			treasuryExpected: "300stake",
+		},
+		{
+			name:               "1 tx, 2 contracts, inflation epoch cap",
+			blockInflationCoin: "1000stake",
+			blockGasLimit:      1000,
+			inflationEpochCap:  250,
+			txs: []transactionInput{
+				{
+					feeCoins: "500stake",
+					contracts: []contractInput{
+						{
+							metadataExists:      true,
+							contractAddr:        contractAddrs[0],
+							rewardsAddr:         accAddrs[0].String(),
+							inflationEpochUsage: 200,
+							operations: []uint64{
+								200,
+							},
+						},
+						{
+							metadataExists: true,
+							contractAddr:   contractAddrs[1],
+							rewardsAddr:    accAddrs[1].String(),
+							operations: []uint64{
+								300,
+							},
+						},
+					},
+				},
+			},
+			contractsOutput: []contractOutput{
+				{
+					rewardsAddr: accAddrs[0],
+					// Tx rewards:  0.4 (200 / 500 tx gas)                 = 200stake
+					// Inf rewards: 0.2 (200 / 1000 block gas) = 200stake -> capped to 250stake - 200stake used = 50stake
+					rewards:    "250stake",
+					recordsNum: 1,
+				},
+				{
+					rewardsAddr: accAddrs[1],
+					// Tx rewards:  0.6 (300 / 500 tx gas)                 = 300stake
+					// Inf rewards: 0.3 (300 / 1000 block gas) = 300stake -> capped to 250stake
+					rewards:    "550stake",
+					recordsNum: 1,
+				},
+			},
+			// Leftovers:
+			//   - Tx:  all distributed
+			//   - Inf: 1000stake - 50stake - 250stake = 700stake
+			treasuryExpected: "700stake",
+		},
+		{
+			name:               "2 txs, 1 contract, self-dealing tx gas excluded",
+			blockInflationCoin: "1000stake",
+			blockGasLimit:      1000,
+			selfDealingPolicy:  rewardsTypes.SelfDealingPolicy_SELF_DEALING_POLICY_EXCLUDE,
+			txs: []transactionInput{
+				{
+					feeCoins:    "100stake",
+					paidByOwner: true,
+					contracts: []contractInput{
+						{
+							metadataExists: true,
+							contractAddr:   contractAddrs[0],
+							rewardsAddr:    accAddrs[0].String(),
+							operations: []uint64{
+								300,
+							},
+						},
+					},
+				},
+				{
+					feeCoins: "200stake",
+					contracts: []contractInput{
+						{
+							metadataExists: true,
+							contractAddr:   contractAddrs[0],
+							rewardsAddr:    accAddrs[0].String(),
+							operations: []uint64{
+								200,
+							},
+						},
+					},
+				},
+			},
+			contractsOutput: []contractOutput{
+				{
+					rewardsAddr: accAddrs[0],
+					// Tx rewards:  1.0 (300 / 300 tx1 gas) + 1.0 (200 / 200 tx2 gas) = 100stake + 200stake
+					// Inf rewards: 0.2 (200 / 1000 block gas, tx1 gas is excluded)   = 200stake
+					rewards:    "500stake",
+					recordsNum: 1,
+				},
+			},
+			// Leftovers:
+			//   - Tx:  all distributed
+			//   - Inf: 1000stake - 200stake = 800stake
+			treasuryExpected: "800stake",
+		},
+		{
+			name:               "2 txs, 1 contract, self-dealing tx gas capped to fee",
+			blockInflationCoin: "1000stake",
+			blockGasLimit:      1000,
+			selfDealingPolicy:  rewardsTypes.SelfDealingPolicy_SELF_DEALING_POLICY_CAP_TO_FEE,
+			txs: []transactionInput{
+				{
+					feeCoins:    "100stake",
+					paidByOwner: true,
+					contracts: []contractInput{
+						{
+							metadataExists: true,
+							contractAddr:   contractAddrs[0],
+							rewardsAddr:    accAddrs[0].String(),
+							operations: []uint64{
+								300,
+							},
+						},
+					},
+				},
+				{
+					feeCoins: "200stake",
+					contracts: []contractInput{
+						{
+							metadataExists: true,
+							contractAddr:   contractAddrs[0],
+							rewardsAddr:    accAddrs[0].String(),
+							operations: []uint64{
+								200,
+							},
+						},
+					},
+				},
+			},
+			contractsOutput: []contractOutput{
+				{
+					rewardsAddr: accAddrs[0],
+					// Tx rewards:  1.0 (300 / 300 tx1 gas) + 1.0 (200 / 200 tx2 gas) = 100stake + 200stake
+					// Inf rewards: 0.2 (200 / 1000 block gas) + 0.3 (300 / 1000 block gas) capped to the tx1 fee = 200stake + 100stake
+					rewards:    "600stake",
+					recordsNum: 1,
+				},
+			},
+			// Leftovers:
+			//   - Tx:  all distributed
+			//   - Inf: 1000stake - 300stake = 700stake
+			treasuryExpected: "700stake",
+		},
+		{
+			name:               "2 txs, 1 contract, self-dealing tx is rewarded as usual",
+			blockInflationCoin: "1000stake",
+			blockGasLimit:      1000,
+			txs: []transactionInput{
+				{
+					feeCoins:    "100stake",
+					paidByOwner: true,
+					contracts: []contractInput{
+						{
+							metadataExists: true,
+							contractAddr:   contractAddrs[0],
+							rewardsAddr:    accAddrs[0].String(),
+							operations: []uint64{
+								300,
+							},
+						},
+					},
+				},
+				{
+					feeCoins: "200stake",
+					contracts: []contractInput{
+						{
+							metadataExists: true,
+							contractAddr:   contractAddrs[0],
+							rewardsAddr:    accAddrs[0].String(),
+							operations: []uint64{
+								200,
+							},
+						},
+					},
+				},
+			},
+			contractsOutput: []contractOutput{
+				{
+					rewardsAddr: accAddrs[0],
+					// Tx rewards:  1.0 (300 / 300 tx1 gas) + 1.0 (200 / 200 tx2 gas) = 100stake + 200stake
+					// Inf rewards: 0.5 (500 / 1000 block gas)                        = 500stake
+					rewards:    "800stake",
+					recordsNum: 1,
+				},
+			},
+			// Leftovers:
+			//   - Tx:  all distributed
+			//   - Inf: 1000stake - 500stake = 500stake
+			treasuryExpected: "500stake",
+		},
+		{
+			name:               "1 tx, 2 contracts, weighted operations",
+			blockInflationCoin: "1000stake",
+			blockGasLimit:      1000,
+			operationWeights: []rewardsTypes.ContractOperationWeight{
+				{OperationType: trackingTypes.ContractOperation_CONTRACT_OPERATION_QUERY, Weight: sdk.NewDecWithPrec(5, 1)},
+				{OperationType: trackingTypes.ContractOperation_CONTRACT_OPERATION_IBC, Weight: sdk.NewDec(2)},
+			},
+			txs: []transactionInput{
+				{
+					feeCoins: "500stake",
+					contracts: []contractInput{
+						{
+							metadataExists: true,
+							contractAddr:   contractAddrs[0],
+							rewardsAddr:    accAddrs[0].String(),
+							opType:         trackingTypes.ContractOperation_CONTRACT_OPERATION_QUERY,
+							operations: []uint64{
+								600,
+							},
+						},
+						{
+							metadataExists: true,
+							contractAddr:   contractAddrs[1],
+							rewardsAddr:    accAddrs[1].String(),
+							opType:         trackingTypes.ContractOperation_CONTRACT_OPERATION_IBC,
+							operations: []uint64{
+								100,
+							},
+						},
+					},
+				},
+			},
+			contractsOutput: []contractOutput{
+				{
+					rewardsAddr: accAddrs[0],
+					// Weighted gas: 600 * 0.5 = 300
+					// Tx rewards:  0.6 (300 / 500 weighted tx gas) = 300stake
+					// Inf rewards: 0.3 (300 / 1000 block gas)      = 300stake
+					rewards:    "600stake",
+					recordsNum: 1,
+				},
+				{
+					rewardsAddr: accAddrs[1],
+					// Weighted gas: 100 * 2.0 = 200
+					// Tx rewards:  0.4 (200 / 500 weighted tx gas) = 200stake
+					// Inf rewards: 0.2 (200 / 1000 block gas)      = 200stake
+					rewards:    "400stake",
+					recordsNum: 1,
+				},
+			},
+			// Leftovers:
+			//   - Tx:  all distributed
+			//   - Inf: 1000stake - 300stake - 200stake = 500stake
+			treasuryExpected: "500stake",
+		},
+		{
+			name:               "1 tx, 2 contracts, weighted gas exceeds the block gas limit",
+			blockInflationCoin: "1000stake",
+			blockGasLimit:      1000,
+			operationWeights: []rewardsTypes.ContractOperationWeight{
+				{OperationType: trackingTypes.ContractOperation_CONTRACT_OPERATION_IBC, Weight: sdk.NewDec(4)},
+			},
+			txs: []transactionInput{
+				{
+					feeCoins: "600stake",
+					contracts: []contractInput{
+						{
+							metadataExists: true,
+							contractAddr:   contractAddrs[0],
+							rewardsAddr:    accAddrs[0].String(),
+							opType:         trackingTypes.ContractOperation_CONTRACT_OPERATION_EXECUTION,
+							operations: []uint64{
+								300,
+							},
+						},
+						{
+							metadataExists: true,
+							contractAddr:   contractAddrs[1],
+							rewardsAddr:    accAddrs[1].String(),
+							opType:         trackingTypes.ContractOperation_CONTRACT_OPERATION_IBC,
+							operations: []uint64{
+								300,
+							},
+						},
+					},
+				},
+			},
+			contractsOutput: []contractOutput{
+				{
+					rewardsAddr: accAddrs[0],
+					// Weighted gas: 300 (not weighted)
+					// Tx rewards:  0.2 (300 / 1500 weighted tx gas)                       = 120stake
+					// Inf rewards: 0.2 (300 / 1500 weighted block gas, exceeds the limit) = 200stake
+					rewards:    "320stake",
+					recordsNum: 1,
+				},
+				{
+					rewardsAddr: accAddrs[1],
+					// Weighted gas: 300 * 4.0 = 1200
+					// Tx rewards:  0.8 (1200 / 1500 weighted tx gas)    = 480stake
+					// Inf rewards: 0.8 (1200 / 1500 weighted block gas) = 800stake
+					rewards:    "1280stake",
+					recordsNum: 1,
+				},
+			},
+			// Leftovers:
+			//   - Tx:  all distributed
+			//   - Inf: all distributed
+			treasuryExpected: "",
+		},
+		{
+			name: "1 tx with non-contract gas and no contract metadata",
+			txs: []transactionInput{
+				{
+					feeCoins: "1000stake",
+					contracts: []contractInput{
+						{
+							contractAddr: contractAddrs[0],
+							operations: []uint64{
+								500,
+							},
+						},
+					},
+					nonContractGas: 500,
+				},
+			},
+			// Leftovers:
+			//   - Tx:  1000stake - 500stake (returned to the FeeCollector) = 500stake
+			treasuryExpected: "500stake",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -526,18 +1040,44 @@ func TestRewardsKeeper_Distribution(t *testing.T) {
 
 			// Setup
 			{
+				// Set the inflation caps params
+				params := rKeeper.GetParams(ctx)
+				if tc.inflationShareCap != "" {
+					params.ContractInflationShareCap = sdk.MustNewDecFromStr(tc.inflationShareCap)
+				}
+				if tc.inflationEpochCap != 0 {
+					params.ContractInflationEpochCap = sdk.NewInt(tc.inflationEpochCap)
+				}
+				params.SelfDealingPolicy = tc.selfDealingPolicy
+				if tc.operationWeights != nil {
+					params.ContractOperationWeights = tc.operationWeights
+				}
+				rKeeper.SetParams(ctx, params)
+
 				// Create transactions gas tracking and rewards tracking data for the current block
 				for _, tx := range tc.txs {
 					// Emulate x/tracking AnteHandler call
 					tKeeper.TrackNewTx(ctx)
 
+					// Emulate x/tracking AnteHandler fee payer tracking (fees are tracked as paid)
+					if tx.paidByOwner {
+						fees, err := sdk.ParseCoinsNormalized(tx.feeCoins)
+						require.NoError(t, err)
+						tKeeper.TrackTxFeePayer(ctx, acc.Address, fees)
+					}
+
 					// Contracts setup
 					for _, contract := range tx.contracts {
 						// Ingest gas tracking for each contract
 						var gasConsumptionRecords []wasmdTypes.ContractGasRecord
 						for _, op := range contract.operations {
+							opID := testutils.GetRandomContractOperationType()
+							if contract.opType != trackingTypes.ContractOperation_CONTRACT_OPERATION_UNSPECIFIED {
+								opID = testutils.RewardsContractOperationToWASM(contract.opType)
+							}
+
 							gasConsumptionRecord := wasmdTypes.ContractGasRecord{
-								OperationId:     testutils.GetRandomContractOperationType(),
+								OperationId:     opID,
 								ContractAddress: contract.contractAddr.String(),
 								OriginalGas: wasmdTypes.GasConsumptionInfo{
 									SDKGas: op,
@@ -553,12 +1093,30 @@ func TestRewardsKeeper_Distribution(t *testing.T) {
 							contractViewer.AddContractAdmin(contract.contractAddr.String(), acc.Address.String())
 
 							metadata := rewardsTypes.ContractMetadata{
-								OwnerAddress:   acc.Address.String(),
-								RewardsAddress: contract.rewardsAddr,
+								OwnerAddress:      acc.Address.String(),
+								RewardsAddress:    contract.rewardsAddr,
+								RewardsRecipients: contract.rewardsRecipients,
 							}
 
 							require.NoError(t, rKeeper.SetContractMetadata(ctx, acc.Address, contract.contractAddr, metadata))
 						}
+
+						// Set the inflation rewards received within the current epoch
+						if contract.inflationEpochUsage != 0 {
+							epoch := uint64(ctx.BlockHeight()) / params.InflationCapEpochBlocks
+							rKeeper.GetState().ContractInflationUsage(ctx).AddEpochUsage(contract.contractAddr, epoch, sdk.NewInt(contract.inflationEpochUsage))
+						}
+					}
+
+					// Emulate the tx gas usage tracking (the app DeliverTx call)
+					if tx.nonContractGas > 0 {
+						txGasUsed := tx.nonContractGas
+						for _, contract := range tx.contracts {
+							for _, op := range contract.operations {
+								txGasUsed += op
+							}
+						}
+						tKeeper.TrackTxGasUsage(ctx, txGasUsed, txGasUsed)
 					}
 
 					// Track fee rewards
@@ -567,7 +1125,7 @@ func TestRewardsKeeper_Distribution(t *testing.T) {
 						require.NoError(t, err)
 
 						// Emulate x/rewards AnteHandler call
-						rKeeper.TrackFeeRebatesRewards(ctx, feeRewards)
+						rKeeper.TrackFeeRebatesRewards(ctx, feeRewards, nil, false)
 						// Mint and transfer
 						require.NoError(t, chain.GetApp().BankKeeper.MintCoins(ctx, mintTypes.ModuleName, feeRewards))
 						require.NoError(t, chain.GetApp().BankKeeper.SendCoinsFromModuleToModule(ctx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, feeRewards))
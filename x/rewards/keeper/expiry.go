@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// ExpireRewardsRecords transfers rewards of expired (not withdrawn for RewardsRecordExpiry since the CalculatedTime)
+// types.RewardsRecord objects to the Treasury and removes those records.
+// Up to MaxExpiredRecordsPerBlock records (the oldest first) are swept per call, the rest are swept by the next blocks.
+// An event is emitted for every rewards address affected.
+func (k Keeper) ExpireRewardsRecords(ctx sdk.Context) {
+	expiry := k.RewardsRecordExpiry(ctx)
+	if expiry == 0 {
+		return
+	}
+
+	// Records with CalculatedTime LT the expiry time are expired
+	rewardsRecordState := k.state.RewardsRecord(ctx)
+	records := rewardsRecordState.GetRewardsRecordsCalculatedBefore(ctx.BlockTime().Add(-expiry), k.MaxExpiredRecordsPerBlock(ctx))
+	if len(records) == 0 {
+		return
+	}
+
+	// Group records by rewards address (sorted to keep the transfers order deterministic)
+	recordsByAddr := make(map[string][]types.RewardsRecord)
+	for _, record := range records {
+		recordsByAddr[record.RewardsAddress] = append(recordsByAddr[record.RewardsAddress], record)
+	}
+
+	rewardsAddrs := make([]string, 0, len(recordsByAddr))
+	for rewardsAddr := range recordsByAddr {
+		rewardsAddrs = append(rewardsAddrs, rewardsAddr)
+	}
+	sort.Strings(rewardsAddrs)
+
+	for _, rewardsAddrStr := range rewardsAddrs {
+		addrRecords := recordsByAddr[rewardsAddrStr]
+
+		expiredRewards := sdk.NewCoins()
+		for _, record := range addrRecords {
+			expiredRewards = expiredRewards.Add(record.Rewards...)
+		}
+
+		if !expiredRewards.IsZero() {
+			if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ContractRewardCollector, types.TreasuryCollector, expiredRewards); err != nil {
+				panic(fmt.Errorf("failed to transfer expired rewards (%s) to %s: %w", expiredRewards, types.TreasuryCollector, err))
+			}
+		}
+		rewardsRecordState.DeleteRewardsRecords(addrRecords...)
+
+		types.EmitRewardsRecordsExpiredEvent(ctx, addrRecords[0].MustGetRewardsAddress(), expiredRewards, len(addrRecords))
+	}
+}
+
+// GetRewardsRecordExpiry returns the soonest expiring types.RewardsRecord object for the rewards address
+// and the time it expires at (the record is removed by the first block with a time after that).
+// Returns nil if there are no records or records expiration is disabled.
+func (k Keeper) GetRewardsRecordExpiry(ctx sdk.Context, rewardsAddr sdk.AccAddress) (*types.RewardsRecord, time.Time) {
+	expiry := k.RewardsRecordExpiry(ctx)
+	if expiry == 0 {
+		return nil, time.Time{}
+	}
+
+	var soonestRecord *types.RewardsRecord
+	for _, record := range k.state.RewardsRecord(ctx).GetRewardsRecordByRewardsAddress(rewardsAddr) {
+		if soonestRecord == nil || record.CalculatedTime.Before(soonestRecord.CalculatedTime) {
+			r := record
+			soonestRecord = &r
+		}
+	}
+	if soonestRecord == nil {
+		return nil, time.Time{}
+	}
+
+	return soonestRecord, soonestRecord.CalculatedTime.Add(expiry)
+}
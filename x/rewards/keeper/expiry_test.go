@@ -0,0 +1,282 @@
+package keeper_test
+
+import (
+	"time"
+
+	wasmdTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	mintTypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/pkg/testutils"
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
+// TestExpireRewardsRecords tests the expired rewards records sweeping and the soonest expiry estimation.
+func (s *KeeperTestSuite) TestExpireRewardsRecords() {
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+	bankKeeper := s.chain.GetApp().BankKeeper
+	rewardsRecordState := keeper.GetState().RewardsRecord(ctx)
+
+	accAddrs, _ := e2eTesting.GenAccounts(2)
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	startTime := ctx.BlockTime()
+
+	// Create records at different heights and times (funding the rewards pool)
+	createRecord := func(rewardsAddr sdk.AccAddress, height int64) rewardsTypes.RewardsRecord {
+		s.Require().NoError(s.chain.GetApp().MintKeeper.MintCoins(ctx, rewards))
+		s.Require().NoError(bankKeeper.SendCoinsFromModuleToModule(ctx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, rewards))
+
+		return rewardsRecordState.CreateRewardsRecord(rewardsAddr, rewards, height, startTime.Add(time.Duration(height)*time.Second))
+	}
+	record1 := createRecord(accAddrs[0], 5)
+	record2 := createRecord(accAddrs[0], 20)
+	record3 := createRecord(accAddrs[1], 10)
+
+	s.Run("OK: expiration disabled", func() {
+		record, expiryTime := keeper.GetRewardsRecordExpiry(ctx, accAddrs[0])
+		s.Assert().Nil(record)
+		s.Assert().True(expiryTime.IsZero())
+
+		keeper.ExpireRewardsRecords(ctx.WithBlockTime(startTime.Add(1000 * time.Second)))
+		s.Assert().Len(rewardsRecordState.GetRewardsRecordByRewardsAddress(accAddrs[0]), 2)
+	})
+
+	// Enable expiration
+	params := keeper.GetParams(ctx)
+	params.RewardsRecordExpiry = 10 * time.Second
+	keeper.SetParams(ctx, params)
+
+	s.Run("OK: soonest expiry", func() {
+		record, expiryTime := keeper.GetRewardsRecordExpiry(ctx, accAddrs[0])
+		s.Require().NotNil(record)
+		s.Assert().Equal(record1, *record)
+		s.Assert().Equal(startTime.Add(15*time.Second), expiryTime)
+
+		record, expiryTime = keeper.GetRewardsRecordExpiry(ctx, accAddrs[1])
+		s.Require().NotNil(record)
+		s.Assert().Equal(record3, *record)
+		s.Assert().Equal(startTime.Add(20*time.Second), expiryTime)
+	})
+
+	s.Run("OK: nothing expired yet", func() {
+		keeper.ExpireRewardsRecords(ctx.WithBlockTime(startTime.Add(15 * time.Second)))
+
+		_, records := rewardsRecordState.Export()
+		s.Assert().Len(records, 3)
+	})
+
+	s.Run("OK: records expired", func() {
+		treasuryBefore := keeper.TreasuryPool(ctx)
+
+		expireCtx := ctx.WithBlockTime(startTime.Add(21 * time.Second)).WithEventManager(sdk.NewEventManager())
+		keeper.ExpireRewardsRecords(expireCtx)
+
+		// The 1st account: only the older record expired
+		records := rewardsRecordState.GetRewardsRecordByRewardsAddress(accAddrs[0])
+		s.Require().Len(records, 1)
+		s.Assert().Equal(record2, records[0])
+		s.Assert().Equal(rewards.String(), rewardsRecordState.GetRewardsBalance(accAddrs[0]).String())
+
+		// The 2nd account: all records expired
+		s.Assert().Empty(rewardsRecordState.GetRewardsRecordByRewardsAddress(accAddrs[1]))
+		s.Assert().True(rewardsRecordState.GetRewardsBalance(accAddrs[1]).IsZero())
+
+		// Rewards are swept to the treasury
+		s.Assert().Equal(
+			treasuryBefore.Add(rewards...).Add(rewards...).String(),
+			keeper.TreasuryPool(ctx).String(),
+		)
+
+		// Event per address
+		expiredEvents := 0
+		for _, event := range expireCtx.EventManager().Events() {
+			if event.Type == "archway.rewards.v1beta1.RewardsRecordsExpiredEvent" {
+				expiredEvents++
+			}
+		}
+		s.Assert().Equal(2, expiredEvents)
+	})
+}
+
+// TestExpireRewardsRecordsPerBlockLimit tests that expired records are swept oldest first within the MaxExpiredRecordsPerBlock limit
+// and the rest are swept by the following calls.
+func (s *KeeperTestSuite) TestExpireRewardsRecordsPerBlockLimit() {
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+	bankKeeper := s.chain.GetApp().BankKeeper
+	rewardsRecordState := keeper.GetState().RewardsRecord(ctx)
+
+	accAddrs, _ := e2eTesting.GenAccounts(2)
+	rewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	startTime := ctx.BlockTime()
+
+	// Create 5 records for both accounts (funding the rewards pool)
+	var recordIDs []uint64
+	for i := 0; i < 5; i++ {
+		s.Require().NoError(s.chain.GetApp().MintKeeper.MintCoins(ctx, rewards))
+		s.Require().NoError(bankKeeper.SendCoinsFromModuleToModule(ctx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, rewards))
+
+		record := rewardsRecordState.CreateRewardsRecord(accAddrs[i%2], rewards, int64(i+1), startTime.Add(time.Duration(i+1)*time.Second))
+		recordIDs = append(recordIDs, record.Id)
+	}
+
+	// Enable expiration with the limit
+	params := keeper.GetParams(ctx)
+	params.RewardsRecordExpiry = 10 * time.Second
+	params.MaxExpiredRecordsPerBlock = 2
+	keeper.SetParams(ctx, params)
+
+	expireCtx := ctx.WithBlockTime(startTime.Add(time.Hour))
+	for _, expectedLeftIDs := range [][]uint64{recordIDs[2:], recordIDs[4:], nil} {
+		treasuryBefore := keeper.TreasuryPool(ctx)
+		_, recordsBefore := rewardsRecordState.Export()
+
+		keeper.ExpireRewardsRecords(expireCtx)
+
+		_, records := rewardsRecordState.Export()
+		var leftIDs []uint64
+		for _, record := range records {
+			leftIDs = append(leftIDs, record.Id)
+		}
+		s.Assert().Equal(expectedLeftIDs, leftIDs)
+
+		expiredRewards := sdk.NewCoins()
+		for i := 0; i < len(recordsBefore)-len(records); i++ {
+			expiredRewards = expiredRewards.Add(rewards...)
+		}
+		s.Assert().Equal(treasuryBefore.Add(expiredRewards...).String(), keeper.TreasuryPool(ctx).String())
+	}
+}
+
+// TestExpireRewardsRecordsContinuousEarning tests that old rewards of an address which is rewarded every block
+// (records are merged) expire, while the recent ones stay.
+func (s *KeeperTestSuite) TestExpireRewardsRecordsContinuousEarning() {
+	const (
+		expiry   = 3 * rewardsTypes.RewardsRecordMergeWindow
+		blockDur = 6 * time.Hour // 4 blocks per merge window (the test chain block duration is skipped on top)
+		blocks   = 40            // 10 days
+	)
+
+	rKeeper := s.chain.GetApp().RewardsKeeper
+	contractAddr, rewardsAddr := s.setupExpiryTestContract()
+	feeRewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	// Enable expiration
+	ctx := s.chain.GetContext()
+
+	params := rKeeper.GetParams(ctx)
+	params.RewardsRecordExpiry = expiry
+	rKeeper.SetParams(ctx, params)
+
+	// Reward the contract every block without withdrawing
+	expiredEvents := 0
+	for i := 0; i < blocks; i++ {
+		s.trackExpiryTestContractRewards(contractAddr, feeRewards)
+
+		for _, event := range s.chain.NextBlock(blockDur) {
+			if event.Type == "archway.rewards.v1beta1.RewardsRecordsExpiredEvent" {
+				expiredEvents++
+			}
+		}
+	}
+	s.Assert().Positive(expiredEvents)
+
+	ctx = s.chain.GetContext()
+	rewardsRecordState := rKeeper.GetState().RewardsRecord(ctx)
+
+	// Recent rewards stay (records are merged within the merge window)
+	records := rewardsRecordState.GetRewardsRecordByRewardsAddress(rewardsAddr)
+	s.Require().NotEmpty(records)
+	s.Assert().LessOrEqual(len(records), int(expiry/rewardsTypes.RewardsRecordMergeWindow)+1)
+	s.Assert().True(rewardsRecordState.GetRewardsBalance(rewardsAddr).IsAllGTE(feeRewards))
+
+	// Old rewards are expired
+	expiryTime := ctx.BlockTime().Add(-expiry)
+	for _, record := range records {
+		s.Assert().False(record.CalculatedTime.Before(expiryTime), "record %d: not expired", record.Id)
+	}
+
+	record, soonestExpiryTime := rKeeper.GetRewardsRecordExpiry(ctx, rewardsAddr)
+	s.Require().NotNil(record)
+	s.Assert().False(soonestExpiryTime.Before(ctx.BlockTime()))
+}
+
+// TestExpireRewardsRecordsEnabledAfterMerge tests that enabling the expiration doesn't sweep the recent rewards
+// merged into a record while the expiration was disabled.
+func (s *KeeperTestSuite) TestExpireRewardsRecordsEnabledAfterMerge() {
+	const expiry = time.Hour
+
+	rKeeper := s.chain.GetApp().RewardsKeeper
+	contractAddr, rewardsAddr := s.setupExpiryTestContract()
+	feeRewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	getBalance := func() sdk.Coins {
+		return rKeeper.GetState().RewardsRecord(s.chain.GetContext()).GetRewardsBalance(rewardsAddr)
+	}
+
+	// Old rewards (the previous merge window)
+	s.trackExpiryTestContractRewards(contractAddr, feeRewards)
+	s.chain.NextBlock(rewardsTypes.RewardsRecordMergeWindow)
+	oldRewards := getBalance()
+	s.Require().False(oldRewards.IsZero())
+
+	// Recent rewards merged every block
+	for i := 0; i < 10; i++ {
+		s.trackExpiryTestContractRewards(contractAddr, feeRewards)
+		s.chain.NextBlock(0)
+	}
+	recentRewards := getBalance().Sub(oldRewards)
+	s.Require().True(recentRewards.IsAllGTE(feeRewards))
+
+	// Enable expiration
+	ctx := s.chain.GetContext()
+
+	params := rKeeper.GetParams(ctx)
+	params.RewardsRecordExpiry = expiry
+	rKeeper.SetParams(ctx, params)
+
+	s.chain.NextBlock(0)
+
+	// Only the old rewards are expired
+	s.Assert().Equal(recentRewards.String(), getBalance().String())
+
+	ctx = s.chain.GetContext()
+	expiryTime := ctx.BlockTime().Add(-expiry)
+	for _, record := range rKeeper.GetState().RewardsRecord(ctx).GetRewardsRecordByRewardsAddress(rewardsAddr) {
+		s.Assert().False(record.CalculatedTime.Before(expiryTime), "record %d: not expired", record.Id)
+	}
+}
+
+// setupExpiryTestContract sets a mock contract metadata with the rewards address set.
+func (s *KeeperTestSuite) setupExpiryTestContract() (contractAddr, rewardsAddr sdk.AccAddress) {
+	rKeeper := s.chain.GetApp().RewardsKeeper
+	contractAdminAcc, rewardsAcc := s.chain.GetAccount(0), s.chain.GetAccount(1)
+	contractAddr = e2eTesting.GenContractAddresses(1)[0]
+
+	contractViewer := testutils.NewMockContractViewer()
+	contractViewer.AddContractAdmin(contractAddr.String(), contractAdminAcc.Address.String())
+	rKeeper.SetContractInfoViewer(contractViewer)
+
+	s.Require().NoError(rKeeper.SetContractMetadata(s.chain.GetContext(), contractAdminAcc.Address, contractAddr, rewardsTypes.ContractMetadata{
+		RewardsAddress: rewardsAcc.Address.String(),
+	}))
+
+	return contractAddr, rewardsAcc.Address
+}
+
+// trackExpiryTestContractRewards emulates a contract operation and fee rebate rewards for the current block.
+func (s *KeeperTestSuite) trackExpiryTestContractRewards(contractAddr sdk.AccAddress, feeRewards sdk.Coins) {
+	ctx, rKeeper, tKeeper := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper, s.chain.GetApp().TrackingKeeper
+
+	tKeeper.TrackNewTx(ctx)
+	s.Require().NoError(tKeeper.IngestGasRecord(ctx, []wasmdTypes.ContractGasRecord{
+		{
+			OperationId:     testutils.GetRandomContractOperationType(),
+			ContractAddress: contractAddr.String(),
+			OriginalGas:     wasmdTypes.GasConsumptionInfo{SDKGas: 100},
+		},
+	}))
+	rKeeper.TrackFeeRebatesRewards(ctx, feeRewards, nil, false)
+	s.Require().NoError(s.chain.GetApp().MintKeeper.MintCoins(ctx, feeRewards))
+	s.Require().NoError(s.chain.GetApp().BankKeeper.SendCoinsFromModuleToModule(ctx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, feeRewards))
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// SetFlatFee sets or removes (zero amount) the contract flat fee verifying the ownership.
+// Flat fee can only be set if the contract metadata has the rewards destination set (fees are credited to it).
+func (k Keeper) SetFlatFee(ctx sdk.Context, senderAddr, contractAddr sdk.AccAddress, flatFee sdk.Coin) error {
+	// Check ownership
+	meta, found := k.state.ContractMetadataState(ctx).GetContractMetadata(contractAddr)
+	if !found {
+		return types.ErrMetadataNotFound
+	}
+	if meta.OwnerAddress != senderAddr.String() {
+		return sdkErrors.Wrap(types.ErrUnauthorized, "flat fee can only be set by the contract owner")
+	}
+
+	// Set / remove
+	state := k.state.FlatFee(ctx)
+	if flatFee.IsZero() {
+		state.RemoveFlatFee(contractAddr)
+	} else {
+		if !meta.HasRewardsAddress() && !meta.HasRewardsRecipients() {
+			return sdkErrors.Wrap(types.ErrInvalidRequest, "flat fee can only be set if the contract rewards address / recipients are set")
+		}
+		state.SetFlatFee(contractAddr, flatFee)
+	}
+
+	// Emit event
+	types.EmitContractFlatFeeSetEvent(ctx, contractAddr, flatFee)
+
+	return nil
+}
+
+// GetFlatFee returns the contract flat fee (if set).
+func (k Keeper) GetFlatFee(ctx sdk.Context, contractAddr sdk.AccAddress) (sdk.Coin, bool) {
+	return k.state.FlatFee(ctx).GetFlatFee(contractAddr)
+}
+
+// CreateFlatFeeRewardsRecords creates rewards records for a collected contract flat fee.
+// Fee is split between the contract rewards recipients (the rewards address if recipients are not set).
+// If the contract has no rewards destination set, the fee is transferred to the treasury pool.
+// CONTRACT: flat fee tokens must be transferred to the ContractRewardCollector module account beforehand.
+func (k Keeper) CreateFlatFeeRewardsRecords(ctx sdk.Context, contractAddr sdk.AccAddress, flatFee sdk.Coin) {
+	types.EmitContractFlatFeeCollectedEvent(ctx, contractAddr, flatFee)
+
+	var recipients []types.RewardsRecipient
+	if meta, found := k.state.ContractMetadataState(ctx).GetContractMetadata(contractAddr); found {
+		recipients = meta.EffectiveRewardsRecipients()
+	}
+
+	if len(recipients) == 0 {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ContractRewardCollector, types.TreasuryCollector, sdk.NewCoins(flatFee)); err != nil {
+			panic(fmt.Errorf("failed to transfer undistributed flat fee (%s) to %s: %w", flatFee, types.TreasuryCollector, err))
+		}
+		return
+	}
+
+	rewardsRecordState := k.state.RewardsRecord(ctx)
+	recipientsRewards := types.SplitRewardsByRecipients(sdk.NewCoins(flatFee), recipients)
+	for i, recipient := range recipients {
+		if recipientsRewards[i].IsZero() {
+			continue
+		}
+		rewardsRecordState.AppendRewardsRecord(recipient.MustGetAddress(), recipientsRewards[i], ctx.BlockHeight(), ctx.BlockTime(), types.RewardsRecordMergeWindow)
+	}
+}
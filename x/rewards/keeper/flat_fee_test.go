@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	mintTypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/pkg/testutils"
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
+func (s *KeeperTestSuite) TestSetFlatFee() {
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+	contractAdminAcc, otherAcc := s.chain.GetAccount(0), s.chain.GetAccount(1)
+
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetContractInfoViewer(contractViewer)
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	flatFee := sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)
+
+	s.Run("Fail: metadata not found", func() {
+		err := keeper.SetFlatFee(ctx, contractAdminAcc.Address, contractAddr, flatFee)
+		s.Assert().ErrorIs(err, rewardsTypes.ErrMetadataNotFound)
+	})
+
+	// Create metadata without the rewards address
+	contractViewer.AddContractAdmin(contractAddr.String(), contractAdminAcc.Address.String())
+	s.Require().NoError(keeper.SetContractMetadata(ctx, contractAdminAcc.Address, contractAddr, rewardsTypes.ContractMetadata{}))
+
+	s.Run("Fail: not a contract owner", func() {
+		err := keeper.SetFlatFee(ctx, otherAcc.Address, contractAddr, flatFee)
+		s.Assert().ErrorIs(err, rewardsTypes.ErrUnauthorized)
+	})
+
+	s.Run("Fail: rewards address is not set", func() {
+		err := keeper.SetFlatFee(ctx, contractAdminAcc.Address, contractAddr, flatFee)
+		s.Assert().ErrorIs(err, rewardsTypes.ErrInvalidRequest)
+	})
+
+	// Set the rewards address
+	s.Require().NoError(keeper.SetContractMetadata(ctx, contractAdminAcc.Address, contractAddr, rewardsTypes.ContractMetadata{
+		RewardsAddress: otherAcc.Address.String(),
+	}))
+
+	s.Run("OK: set flat fee", func() {
+		err := keeper.SetFlatFee(ctx, contractAdminAcc.Address, contractAddr, flatFee)
+		s.Require().NoError(err)
+
+		feeReceived, found := keeper.GetFlatFee(ctx, contractAddr)
+		s.Require().True(found)
+		s.Assert().Equal(flatFee.String(), feeReceived.String())
+	})
+
+	s.Run("OK: flat fee records are created for the rewards address", func() {
+		s.Require().NoError(s.chain.GetApp().MintKeeper.MintCoins(ctx, sdk.NewCoins(flatFee)))
+		s.Require().NoError(s.chain.GetApp().BankKeeper.SendCoinsFromModuleToModule(ctx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, sdk.NewCoins(flatFee)))
+
+		keeper.CreateFlatFeeRewardsRecords(ctx, contractAddr, flatFee)
+
+		records := keeper.GetState().RewardsRecord(ctx).GetRewardsRecordByRewardsAddress(otherAcc.Address)
+		s.Require().Len(records, 1)
+		s.Assert().Equal(sdk.NewCoins(flatFee).String(), sdk.Coins(records[0].Rewards).String())
+		s.Assert().Equal(ctx.BlockHeight(), records[0].CalculatedHeight)
+	})
+
+	s.Run("OK: remove flat fee", func() {
+		err := keeper.SetFlatFee(ctx, contractAdminAcc.Address, contractAddr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0))
+		s.Require().NoError(err)
+
+		_, found := keeper.GetFlatFee(ctx, contractAddr)
+		s.Assert().False(found)
+	})
+}
@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/archway-network/archway/pkg"
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// RefundUnusedGasFees refunds a share of the fees paid for the unused transaction gas for the given block height.
+// Refund share is defined as (GasLimit - GasUsed) / GasLimit * UnusedGasRefundRatio param and is taken from
+// the tracked fee rebate rewards (the ContractRewardCollector) and the FeeCollector fees. Contract flat fees and
+// burned base fees are not refunded. Refunds are sent to the tracked fee payer (the fee granter if set).
+// Refunds of sponsored transactions are returned to the fee payer contract sponsorship deposit.
+// Transactions are processed in the tracking tx ID order, fee rebate rewards are adjusted accordingly.
+// Gas limit, gas used and fee payer are read from the x/tracking TxInfo state.
+// CONTRACT: must be called before the block rewards allocation.
+func (k Keeper) RefundUnusedGasFees(ctx sdk.Context, height int64) {
+	refundRatio := k.UnusedGasRefundRatio(ctx)
+	if refundRatio.IsZero() {
+		return
+	}
+
+	txRewardsState := k.state.TxRewardsState(ctx)
+	for _, txTracking := range k.trackingKeeper.GetBlockTrackingInfo(ctx, height).Txs {
+		txInfo := txTracking.Info
+
+		unusedGas := txInfo.UnusedGas()
+		if unusedGas == 0 || txInfo.FeePayer == "" {
+			continue
+		}
+
+		txRewards, found := txRewardsState.GetTxRewards(txInfo.Id)
+		if !found {
+			continue
+		}
+
+		// Estimate refunds
+		refundShare := pkg.NewDecFromUint64(unusedGas).Quo(pkg.NewDecFromUint64(txInfo.GasLimit)).Mul(refundRatio)
+		rewardsRefund, feeRewardsLeft := pkg.SplitCoins(txRewards.FeeRewards, refundShare)
+		feeCollectorRefund, feeCollectorFeesLeft := pkg.SplitCoins(txRewards.FeeCollectorFees, refundShare)
+
+		refund := rewardsRefund.Add(feeCollectorRefund...)
+		if refund.IsZero() {
+			continue
+		}
+
+		// Transfer refunds (the tx is skipped if the fee payer can't receive funds)
+		feePayer, err := sdk.AccAddressFromBech32(txInfo.FeePayer)
+		if err != nil {
+			k.Logger(ctx).Error("Unused gas refund skipped: invalid fee payer", "txID", txInfo.Id, "error", err)
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if txRewards.Sponsored {
+			err = k.refundSponsorship(cacheCtx, feePayer, rewardsRefund, feeCollectorRefund)
+		} else {
+			if !rewardsRefund.IsZero() {
+				err = k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ContractRewardCollector, feePayer, rewardsRefund)
+			}
+			if err == nil && !feeCollectorRefund.IsZero() {
+				err = k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, authTypes.FeeCollectorName, feePayer, feeCollectorRefund)
+			}
+		}
+		if err != nil {
+			k.Logger(ctx).Error("Unused gas refund skipped: transfer failed", "txID", txInfo.Id, "feePayer", feePayer, "refund", refund, "error", err)
+			continue
+		}
+		writeCache()
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+		// Adjust the tracked fees
+		txRewards.FeeRewards = feeRewardsLeft
+		txRewards.FeeCollectorFees = feeCollectorFeesLeft
+		txRewardsState.SetTxRewards(txRewards)
+
+		types.EmitTxFeeRefundEvent(ctx, txInfo.Id, feePayer, refund)
+	}
+}
@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	bankTypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	mintTypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
+// TestRefundUnusedGasFees tests the unused gas fees refund amounts, tracked fees adjustments and determinism.
+func (s *KeeperTestSuite) TestRefundUnusedGasFees() {
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+	bankKeeper := s.chain.GetApp().BankKeeper
+	txInfoState := s.chain.GetApp().TrackingKeeper.GetState().TxInfoState(ctx)
+	txRewardsState := keeper.GetState().TxRewardsState(ctx)
+
+	payer1Addr, payer2Addr := s.chain.GetAccount(0).Address, s.chain.GetAccount(1).Address
+
+	// Sponsoring contract (refunds are returned to the deposit)
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	keeper.GetState().Sponsorship(ctx).SetSponsorship(rewardsTypes.Sponsorship{
+		ContractAddress: contractAddr.String(),
+		Deposit:         sdk.NewCoins(sdk.NewInt64Coin("stake", 10)),
+	})
+
+	type testTx struct {
+		feePayer         sdk.AccAddress
+		gasLimit         uint64
+		gasUsed          uint64
+		feeRewards       sdk.Coins
+		feeCollectorFees sdk.Coins
+		sponsored        bool
+	}
+
+	// Fund the collectors and track transactions
+	trackTx := func(tx testTx) uint64 {
+		txInfo := txInfoState.CreateEmptyTxInfo()
+		txInfo.FeePayer = tx.feePayer.String()
+		txInfo.GasLimit = tx.gasLimit
+		txInfo.GasUsed = tx.gasUsed
+		txInfoState.SetTxInfo(txInfo)
+
+		txRewardsState.CreateTxRewards(txInfo.Id, ctx.BlockHeight(), tx.feeRewards, tx.feeCollectorFees, tx.sponsored)
+
+		fees := tx.feeRewards.Add(tx.feeCollectorFees...)
+		s.Require().NoError(s.chain.GetApp().MintKeeper.MintCoins(ctx, fees))
+		if !tx.feeRewards.IsZero() {
+			s.Require().NoError(bankKeeper.SendCoinsFromModuleToModule(ctx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, tx.feeRewards))
+		}
+		if !tx.feeCollectorFees.IsZero() {
+			s.Require().NoError(bankKeeper.SendCoinsFromModuleToModule(ctx, mintTypes.ModuleName, authTypes.FeeCollectorName, tx.feeCollectorFees))
+		}
+
+		return txInfo.Id
+	}
+
+	tx1ID := trackTx(testTx{
+		feePayer:         payer1Addr,
+		gasLimit:         1000,
+		gasUsed:          500,
+		feeRewards:       sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		feeCollectorFees: sdk.NewCoins(sdk.NewInt64Coin("stake", 200)),
+	})
+	tx2ID := trackTx(testTx{
+		feePayer:         payer1Addr,
+		gasLimit:         1000,
+		gasUsed:          1000,
+		feeRewards:       sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		feeCollectorFees: sdk.NewCoins(sdk.NewInt64Coin("stake", 200)),
+	})
+	tx3ID := trackTx(testTx{
+		feePayer:         payer2Addr,
+		gasLimit:         1000,
+		gasUsed:          0,
+		feeRewards:       sdk.NewCoins(),
+		feeCollectorFees: sdk.NewCoins(sdk.NewInt64Coin("stake", 40)),
+	})
+	tx4ID := trackTx(testTx{
+		feePayer:         contractAddr,
+		gasLimit:         1000,
+		gasUsed:          800,
+		feeRewards:       sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		feeCollectorFees: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+		sponsored:        true,
+	})
+
+	payer1BalanceBefore, payer2BalanceBefore := bankKeeper.GetAllBalances(ctx, payer1Addr), bankKeeper.GetAllBalances(ctx, payer2Addr)
+	sponsorshipPoolBefore := keeper.SponsorshipPool(ctx)
+
+	checkTxRewards := func(ctx sdk.Context, txID uint64, feeRewardsExpected, feeCollectorFeesExpected sdk.Coins) {
+		txRewards, found := keeper.GetState().TxRewardsState(ctx).GetTxRewards(txID)
+		s.Require().True(found)
+		s.Assert().Equal(feeRewardsExpected.String(), sdk.Coins(txRewards.FeeRewards).String())
+		s.Assert().Equal(feeCollectorFeesExpected.String(), sdk.Coins(txRewards.FeeCollectorFees).String())
+	}
+
+	s.Run("OK: disabled by default", func() {
+		cacheCtx, _ := ctx.CacheContext()
+		keeper.RefundUnusedGasFees(cacheCtx, ctx.BlockHeight())
+
+		s.Assert().Equal(payer1BalanceBefore.String(), bankKeeper.GetAllBalances(cacheCtx, payer1Addr).String())
+		s.Assert().Empty(cacheCtx.EventManager().Events())
+		checkTxRewards(cacheCtx, tx1ID, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), sdk.NewCoins(sdk.NewInt64Coin("stake", 200)))
+	})
+
+	params := keeper.GetParams(ctx)
+	params.UnusedGasRefundRatio = sdk.NewDecWithPrec(5, 1)
+	keeper.SetParams(ctx, params)
+
+	s.Run("OK: refunds are deterministic", func() {
+		cacheCtx1, _ := ctx.CacheContext()
+		cacheCtx2, _ := ctx.CacheContext()
+
+		keeper.RefundUnusedGasFees(cacheCtx1, ctx.BlockHeight())
+		keeper.RefundUnusedGasFees(cacheCtx2, ctx.BlockHeight())
+
+		s.Assert().Equal(cacheCtx1.EventManager().Events(), cacheCtx2.EventManager().Events())
+		s.Assert().Equal(
+			keeper.GetState().TxRewardsState(cacheCtx1).GetTxRewardsByBlock(ctx.BlockHeight()),
+			keeper.GetState().TxRewardsState(cacheCtx2).GetTxRewardsByBlock(ctx.BlockHeight()),
+		)
+		s.Assert().Equal(bankKeeper.GetAllBalances(cacheCtx1, payer1Addr), bankKeeper.GetAllBalances(cacheCtx2, payer1Addr))
+		s.Assert().Equal(bankKeeper.GetAllBalances(cacheCtx1, payer2Addr), bankKeeper.GetAllBalances(cacheCtx2, payer2Addr))
+	})
+
+	s.Run("OK: unused gas fees are refunded", func() {
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		keeper.RefundUnusedGasFees(ctx, ctx.BlockHeight())
+
+		// Transfer events are emitted (tx1: 2 transfers, tx3: 1 transfer, tx4: 2 transfers)
+		var transferEvents int
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == bankTypes.EventTypeTransfer {
+				transferEvents++
+			}
+		}
+		s.Assert().Equal(5, transferEvents)
+
+		// tx1: share is 500 / 1000 * 0.5 = 0.25 (25stake rebate rewards + 50stake fee collector fees)
+		// tx2: no unused gas
+		// tx3: share is 1000 / 1000 * 0.5 = 0.5 (20stake fee collector fees)
+		// tx4: share is 200 / 1000 * 0.5 = 0.1 (10stake rebate rewards + 10stake fee collector fees) returned to the deposit
+		s.Assert().Equal(payer1BalanceBefore.Add(sdk.NewInt64Coin("stake", 75)).String(), bankKeeper.GetAllBalances(ctx, payer1Addr).String())
+		s.Assert().Equal(payer2BalanceBefore.Add(sdk.NewInt64Coin("stake", 20)).String(), bankKeeper.GetAllBalances(ctx, payer2Addr).String())
+		s.Assert().True(bankKeeper.GetAllBalances(ctx, contractAddr).IsZero())
+		s.Assert().Equal(sponsorshipPoolBefore.Add(sdk.NewInt64Coin("stake", 20)).String(), keeper.SponsorshipPool(ctx).String())
+
+		sponsorship, found := keeper.GetSponsorship(ctx, contractAddr)
+		s.Require().True(found)
+		s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 30)).String(), sponsorship.Deposit.String())
+
+		checkTxRewards(ctx, tx1ID, sdk.NewCoins(sdk.NewInt64Coin("stake", 75)), sdk.NewCoins(sdk.NewInt64Coin("stake", 150)))
+		checkTxRewards(ctx, tx2ID, sdk.NewCoins(sdk.NewInt64Coin("stake", 100)), sdk.NewCoins(sdk.NewInt64Coin("stake", 200)))
+		checkTxRewards(ctx, tx3ID, sdk.NewCoins(), sdk.NewCoins(sdk.NewInt64Coin("stake", 20)))
+		checkTxRewards(ctx, tx4ID, sdk.NewCoins(sdk.NewInt64Coin("stake", 90)), sdk.NewCoins(sdk.NewInt64Coin("stake", 90)))
+	})
+}
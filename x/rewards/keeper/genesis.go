@@ -9,8 +9,11 @@ import (
 
 // ExportGenesis exports the module genesis for the current block.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
-	minConsFee, _ := k.state.MinConsensusFee(ctx).GetFee() // default sdk.Coin value is ok
+	minConsFee, _ := k.state.MinConsensusFee(ctx).GetFee()  // default sdk.Coin value is ok
+	baseFee, _ := k.state.MinConsensusFee(ctx).GetBaseFee() // default sdk.Coin value is ok
 	rewardsRecordLastID, rewardsRecords := k.state.RewardsRecord(ctx).Export()
+	treasuryOperationLastID, treasuryOperations := k.state.TreasuryOperation(ctx).Export()
+	sponsorships, sponsorshipUsages := k.state.Sponsorship(ctx).Export()
 
 	return types.NewGenesisState(
 		k.GetParams(ctx),
@@ -20,6 +23,16 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		minConsFee,
 		rewardsRecordLastID,
 		rewardsRecords,
+		k.state.FlatFee(ctx).Export(),
+		treasuryOperationLastID,
+		treasuryOperations,
+		k.state.CodeMetadataState(ctx).Export(),
+		k.state.AutoPayout(ctx).Export(),
+		k.state.ContractInflationUsage(ctx).Export(),
+		k.state.MinConsensusFee(ctx).ExportHistory(),
+		baseFee,
+		sponsorships,
+		sponsorshipUsages,
 	)
 }
 
@@ -30,8 +43,18 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) {
 	k.state.BlockRewardsState(ctx).Import(state.BlockRewards)
 	k.state.TxRewardsState(ctx).Import(state.TxRewards)
 	k.state.RewardsRecord(ctx).Import(state.RewardsRecordLastId, state.RewardsRecords)
+	k.state.FlatFee(ctx).Import(state.FlatFees)
+	k.state.TreasuryOperation(ctx).Import(state.TreasuryOperationLastId, state.TreasuryOperations)
+	k.state.CodeMetadataState(ctx).Import(state.CodesMetadata)
+	k.state.AutoPayout(ctx).Import(state.AutoPayouts)
+	k.state.ContractInflationUsage(ctx).Import(state.ContractsInflationUsage)
+	k.state.MinConsensusFee(ctx).ImportHistory(state.MinConsensusFeeHistory)
+	k.state.Sponsorship(ctx).Import(state.Sponsorships, state.SponsorshipUsages)
 
 	if !pkg.DecCoinIsZero(state.MinConsensusFee) && !pkg.DecCoinIsNegative(state.MinConsensusFee) {
 		k.state.MinConsensusFee(ctx).SetFee(state.MinConsensusFee)
 	}
+	if !pkg.DecCoinIsZero(state.BaseFee) && !pkg.DecCoinIsNegative(state.BaseFee) {
+		k.state.MinConsensusFee(ctx).SetBaseFee(state.BaseFee)
+	}
 }
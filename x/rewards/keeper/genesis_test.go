@@ -7,6 +7,7 @@ import (
 
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
 	"github.com/archway-network/archway/x/rewards/types"
+	trackingTypes "github.com/archway-network/archway/x/tracking/types"
 )
 
 // TestGenesisImportExport check genesis import/export.
@@ -28,6 +29,14 @@ func (s *KeeperTestSuite) TestGenesisImportExport() {
 		s.Assert().Empty(genesisState.TxRewards)
 		s.Assert().Empty(genesisState.RewardsRecordLastId)
 		s.Assert().Empty(genesisState.RewardsRecords)
+		s.Assert().Empty(genesisState.FlatFees)
+		s.Assert().Empty(genesisState.TreasuryOperationLastId)
+		s.Assert().Empty(genesisState.TreasuryOperations)
+		s.Assert().Empty(genesisState.CodesMetadata)
+		s.Assert().Empty(genesisState.AutoPayouts)
+		s.Assert().Empty(genesisState.ContractsInflationUsage)
+		s.Assert().Empty(genesisState.Sponsorships)
+		s.Assert().Empty(genesisState.SponsorshipUsages)
 
 		genesisStateInitial = *genesisState
 	})
@@ -36,6 +45,29 @@ func (s *KeeperTestSuite) TestGenesisImportExport() {
 		sdk.NewDecWithPrec(99, 2),
 		sdk.NewDecWithPrec(98, 2),
 		1001,
+		100,
+		50,
+		10,
+		sdk.NewDecWithPrec(5, 1),
+		sdk.NewInt(1000),
+		100,
+		types.SelfDealingPolicy_SELF_DEALING_POLICY_EXCLUDE,
+		[]types.ContractOperationWeight{
+			{OperationType: trackingTypes.ContractOperation_CONTRACT_OPERATION_QUERY, Weight: sdk.NewDecWithPrec(5, 1)},
+		},
+		[]types.FeeDenom{
+			{Denom: "uusdc", Rate: sdk.NewDecWithPrec(15, 1)},
+		},
+		types.MinConsensusFeeMode_MIN_CONSENSUS_FEE_MODE_EMA,
+		50,
+		1000,
+		sdk.NewDecWithPrec(125, 3),
+		sdk.NewDecWithPrec(5, 1),
+		sdk.NewDecWithPrec(5, 1),
+		[]types.TxPriorityTier{
+			{MinFeeRatio: sdk.NewDec(2), Priority: 10},
+		},
+		sdk.NewDecWithPrec(5, 1),
 	)
 
 	newMetadata := []types.ContractMetadata{
@@ -70,6 +102,9 @@ func (s *KeeperTestSuite) TestGenesisImportExport() {
 			FeeRewards: []sdk.Coin{
 				{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(150)},
 			},
+			FeeCollectorFees: []sdk.Coin{
+				{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(50)},
+			},
 		},
 		{
 			TxId:   210,
@@ -99,6 +134,100 @@ func (s *KeeperTestSuite) TestGenesisImportExport() {
 		},
 	}
 
+	newFlatFees := []types.FlatFee{
+		{
+			ContractAddress: contractAddrs[1].String(),
+			FlatFee:         sdk.NewCoin("uarch", sdk.NewInt(10)),
+		},
+	}
+
+	newTreasuryOperations := []types.TreasuryOperation{
+		{
+			Id:        1,
+			Type:      types.TreasuryOperationType_TREASURY_OPERATION_TYPE_SPEND,
+			Recipient: accAddrs[0].String(),
+			Amount:    sdk.NewCoins(sdk.NewCoin("uarch", sdk.NewInt(50))),
+			Height:    ctx.BlockHeight(),
+			Time:      ctx.BlockTime(),
+		},
+		{
+			Id:     2,
+			Type:   types.TreasuryOperationType_TREASURY_OPERATION_TYPE_BURN,
+			Amount: sdk.NewCoins(sdk.NewCoin("uarch", sdk.NewInt(25))),
+			Height: ctx.BlockHeight() + 1,
+			Time:   ctx.BlockTime().Add(5 * time.Second),
+		},
+	}
+
+	newCodesMetadata := []types.CodeMetadata{
+		{
+			CodeId:         1,
+			RewardsAddress: accAddrs[0].String(),
+		},
+		{
+			CodeId: 2,
+			RewardsRecipients: []types.RewardsRecipient{
+				{Address: accAddrs[0].String(), Weight: sdk.NewDecWithPrec(5, 1)},
+				{Address: accAddrs[1].String(), Weight: sdk.NewDecWithPrec(5, 1)},
+			},
+		},
+	}
+
+	newAutoPayouts := []types.AutoPayout{
+		{
+			ContractAddress:  contractAddrs[0].String(),
+			IntervalBlocks:   10,
+			LastPayoutHeight: ctx.BlockHeight(),
+		},
+		{
+			ContractAddress:  contractAddrs[1].String(),
+			Threshold:        sdk.NewCoins(sdk.NewCoin("uarch", sdk.NewInt(1000))),
+			LastPayoutHeight: ctx.BlockHeight(),
+		},
+	}
+
+	newContractsInflationUsage := []types.ContractInflationUsage{
+		{
+			ContractAddress: contractAddrs[0].String(),
+			Epoch:           1,
+			Amount:          sdk.NewInt(500),
+		},
+	}
+
+	newMinConsFeeHistory := []types.MinConsensusFeeRecord{
+		{
+			Height: 100,
+			Fee:    sdk.NewDecCoinFromDec("uarch", sdk.NewDecWithPrec(1, 2)),
+		},
+		{
+			Height: 101,
+			Fee:    sdk.NewDecCoinFromDec("uarch", sdk.NewDecWithPrec(2, 2)),
+		},
+	}
+
+	newBaseFee := sdk.NewDecCoin("uarch", sdk.NewInt(120))
+
+	newSponsorships := []types.Sponsorship{
+		{
+			ContractAddress:    contractAddrs[0].String(),
+			Deposit:            sdk.NewCoins(sdk.NewCoin("uarch", sdk.NewInt(1000))),
+			UserFeeLimit:       sdk.NewCoins(sdk.NewCoin("uarch", sdk.NewInt(100))),
+			FundFromRewards:    true,
+			BlockHeight:        ctx.BlockHeight(),
+			BlockFees:          sdk.NewCoins(sdk.NewCoin("uarch", sdk.NewInt(10))),
+			UserFeeLimitPeriod: 100,
+		},
+	}
+
+	newSponsorshipUsages := []types.SponsorshipUsage{
+		{
+			ContractAddress: contractAddrs[0].String(),
+			UserAddress:     accAddrs[0].String(),
+			Fees:            sdk.NewCoins(sdk.NewCoin("uarch", sdk.NewInt(10))),
+			Period:          1,
+		},
+	}
+
 	genesisStateImported := types.NewGenesisState(
 		newParams,
 		newMetadata,
@@ -107,18 +236,38 @@ func (s *KeeperTestSuite) TestGenesisImportExport() {
 		newMinConsFee,
 		newRewardsRecords[len(newRewardsRecords)-1].Id,
 		newRewardsRecords,
+		newFlatFees,
+		newTreasuryOperations[len(newTreasuryOperations)-1].Id,
+		newTreasuryOperations,
+		newCodesMetadata,
+		newAutoPayouts,
+		newContractsInflationUsage,
+		newMinConsFeeHistory,
+		newBaseFee,
+		newSponsorships,
+		newSponsorshipUsages,
 	)
 	s.Run("Check import of an updated genesis", func() {
 		keeper.InitGenesis(ctx, genesisStateImported)
 
 		genesisStateExpected := types.GenesisState{
-			Params:              newParams,
-			ContractsMetadata:   append(genesisStateInitial.ContractsMetadata, newMetadata...),
-			BlockRewards:        append(genesisStateInitial.BlockRewards, newBlockRewards...),
-			TxRewards:           append(genesisStateInitial.TxRewards, newTxRewards...),
-			MinConsensusFee:     newMinConsFee,
-			RewardsRecordLastId: newRewardsRecords[len(newRewardsRecords)-1].Id,
-			RewardsRecords:      append(genesisStateInitial.RewardsRecords, newRewardsRecords...),
+			Params:                  newParams,
+			ContractsMetadata:       append(genesisStateInitial.ContractsMetadata, newMetadata...),
+			BlockRewards:            append(genesisStateInitial.BlockRewards, newBlockRewards...),
+			TxRewards:               append(genesisStateInitial.TxRewards, newTxRewards...),
+			MinConsensusFee:         newMinConsFee,
+			RewardsRecordLastId:     newRewardsRecords[len(newRewardsRecords)-1].Id,
+			RewardsRecords:          append(genesisStateInitial.RewardsRecords, newRewardsRecords...),
+			FlatFees:                append(genesisStateInitial.FlatFees, newFlatFees...),
+			TreasuryOperationLastId: newTreasuryOperations[len(newTreasuryOperations)-1].Id,
+			TreasuryOperations:      append(genesisStateInitial.TreasuryOperations, newTreasuryOperations...),
+			CodesMetadata:           append(genesisStateInitial.CodesMetadata, newCodesMetadata...),
+			AutoPayouts:             append(genesisStateInitial.AutoPayouts, newAutoPayouts...),
+			ContractsInflationUsage: append(genesisStateInitial.ContractsInflationUsage, newContractsInflationUsage...),
+			MinConsensusFeeHistory:  append(genesisStateInitial.MinConsensusFeeHistory, newMinConsFeeHistory...),
+			BaseFee:                 newBaseFee,
+			Sponsorships:            append(genesisStateInitial.Sponsorships, newSponsorships...),
+			SponsorshipUsages:       append(genesisStateInitial.SponsorshipUsages, newSponsorshipUsages...),
 		}
 
 		genesisStateReceived := keeper.ExportGenesis(ctx)
@@ -130,5 +279,15 @@ func (s *KeeperTestSuite) TestGenesisImportExport() {
 		s.Assert().Equal(genesisStateExpected.MinConsensusFee.String(), genesisStateReceived.MinConsensusFee.String())
 		s.Assert().Equal(genesisStateExpected.RewardsRecordLastId, genesisStateReceived.RewardsRecordLastId)
 		s.Assert().ElementsMatch(genesisStateExpected.RewardsRecords, genesisStateReceived.RewardsRecords)
+		s.Assert().ElementsMatch(genesisStateExpected.FlatFees, genesisStateReceived.FlatFees)
+		s.Assert().Equal(genesisStateExpected.TreasuryOperationLastId, genesisStateReceived.TreasuryOperationLastId)
+		s.Assert().ElementsMatch(genesisStateExpected.TreasuryOperations, genesisStateReceived.TreasuryOperations)
+		s.Assert().ElementsMatch(genesisStateExpected.CodesMetadata, genesisStateReceived.CodesMetadata)
+		s.Assert().ElementsMatch(genesisStateExpected.AutoPayouts, genesisStateReceived.AutoPayouts)
+		s.Assert().ElementsMatch(genesisStateExpected.ContractsInflationUsage, genesisStateReceived.ContractsInflationUsage)
+		s.Assert().Equal(genesisStateExpected.MinConsensusFeeHistory, genesisStateReceived.MinConsensusFeeHistory)
+		s.Assert().Equal(genesisStateExpected.BaseFee.String(), genesisStateReceived.BaseFee.String())
+		s.Assert().ElementsMatch(genesisStateExpected.Sponsorships, genesisStateReceived.Sponsorships)
+		s.Assert().ElementsMatch(genesisStateExpected.SponsorshipUsages, genesisStateReceived.SponsorshipUsages)
 	})
 }
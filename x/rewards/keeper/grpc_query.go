@@ -105,17 +105,29 @@ func (s *QueryServer) EstimateTxFees(c context.Context, request *types.QueryEsti
 
 	ctx := sdk.UnwrapSDKContext(c)
 
-	minConsFee, found := s.keeper.GetMinConsensusFee(ctx)
+	gasUnitPrice, found := s.keeper.GetMinGasUnitPrice(ctx)
 	if !found {
 		return nil, status.Errorf(codes.NotFound, "min consensus fee: not found")
 	}
+	gasUnitPrices, _ := s.keeper.GetMinConsensusFees(ctx)
+
+	estimateFee := func(gasUnitPrice sdk.DecCoin) sdk.Coin {
+		return sdk.Coin{
+			Denom:  gasUnitPrice.Denom,
+			Amount: gasUnitPrice.Amount.MulInt64(int64(request.GasLimit)).RoundInt(),
+		}
+	}
+
+	estimatedFees := make(sdk.Coins, 0, len(gasUnitPrices))
+	for _, price := range gasUnitPrices {
+		estimatedFees = append(estimatedFees, estimateFee(price))
+	}
 
 	return &types.QueryEstimateTxFeesResponse{
-		GasUnitPrice: minConsFee,
-		EstimatedFee: sdk.Coin{
-			Denom:  minConsFee.Denom,
-			Amount: minConsFee.Amount.MulInt64(int64(request.GasLimit)).RoundInt(),
-		},
+		GasUnitPrice:  gasUnitPrice,
+		EstimatedFee:  estimateFee(gasUnitPrice),
+		GasUnitPrices: gasUnitPrices,
+		EstimatedFees: estimatedFees,
 	}, nil
 }
 
@@ -167,3 +179,193 @@ func (s *QueryServer) RewardsRecords(c context.Context, request *types.QueryRewa
 		Pagination: pageResp,
 	}, nil
 }
+
+// FlatFee implements the types.QueryServer interface.
+func (s *QueryServer) FlatFee(c context.Context, request *types.QueryFlatFeeRequest) (*types.QueryFlatFeeResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid contract address: "+err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	flatFee, found := s.keeper.GetFlatFee(ctx, contractAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "flat fee for the contract: not found")
+	}
+
+	return &types.QueryFlatFeeResponse{
+		FlatFeeAmount: flatFee,
+	}, nil
+}
+
+// TreasuryBalance implements the types.QueryServer interface.
+func (s *QueryServer) TreasuryBalance(c context.Context, request *types.QueryTreasuryBalanceRequest) (*types.QueryTreasuryBalanceResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryTreasuryBalanceResponse{
+		Funds: s.keeper.TreasuryPool(ctx),
+	}, nil
+}
+
+// TreasuryHistory implements the types.QueryServer interface.
+func (s *QueryServer) TreasuryHistory(c context.Context, request *types.QueryTreasuryHistoryRequest) (*types.QueryTreasuryHistoryResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	operations, pageResp, err := s.keeper.GetTreasuryOperations(ctx, request.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "pagination request: "+err.Error())
+	}
+
+	return &types.QueryTreasuryHistoryResponse{
+		Operations: operations,
+		Pagination: pageResp,
+	}, nil
+}
+
+// CodeMetadata implements the types.QueryServer interface.
+func (s *QueryServer) CodeMetadata(c context.Context, request *types.QueryCodeMetadataRequest) (*types.QueryCodeMetadataResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	meta := s.keeper.GetCodeMetadata(ctx, request.CodeId)
+	if meta == nil {
+		return nil, status.Errorf(codes.NotFound, "metadata for the code: not found")
+	}
+
+	return &types.QueryCodeMetadataResponse{
+		Metadata: *meta,
+	}, nil
+}
+
+// RewardsRecordExpiry implements the types.QueryServer interface.
+func (s *QueryServer) RewardsRecordExpiry(c context.Context, request *types.QueryRewardsRecordExpiryRequest) (*types.QueryRewardsRecordExpiryResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	rewardsAddr, err := sdk.AccAddressFromBech32(request.RewardsAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid rewards address: "+err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	record, expiryTime := s.keeper.GetRewardsRecordExpiry(ctx, rewardsAddr)
+
+	return &types.QueryRewardsRecordExpiryResponse{
+		Record:     record,
+		ExpiryTime: expiryTime,
+	}, nil
+}
+
+// AutoPayout implements the types.QueryServer interface.
+func (s *QueryServer) AutoPayout(c context.Context, request *types.QueryAutoPayoutRequest) (*types.QueryAutoPayoutResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid contract address: "+err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	autoPayout, found := s.keeper.GetAutoPayout(ctx, contractAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "auto payout for the contract: not found")
+	}
+
+	return &types.QueryAutoPayoutResponse{
+		AutoPayout: autoPayout,
+	}, nil
+}
+
+// MinConsensusFeeHistory implements the types.QueryServer interface.
+func (s *QueryServer) MinConsensusFeeHistory(c context.Context, request *types.QueryMinConsensusFeeHistoryRequest) (*types.QueryMinConsensusFeeHistoryResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	records, pageResp, err := s.keeper.GetMinConsensusFeeHistory(ctx, request.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "pagination request: "+err.Error())
+	}
+
+	return &types.QueryMinConsensusFeeHistoryResponse{
+		Records:    records,
+		Pagination: pageResp,
+	}, nil
+}
+
+// BaseFee implements the types.QueryServer interface.
+func (s *QueryServer) BaseFee(c context.Context, request *types.QueryBaseFeeRequest) (*types.QueryBaseFeeResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	baseFee, found := s.keeper.GetBaseFee(ctx)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "base fee: not found")
+	}
+
+	return &types.QueryBaseFeeResponse{
+		BaseFee: baseFee,
+	}, nil
+}
+
+// Sponsorship implements the types.QueryServer interface.
+func (s *QueryServer) Sponsorship(c context.Context, request *types.QuerySponsorshipRequest) (*types.QuerySponsorshipResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid contract address: "+err.Error())
+	}
+
+	var userAddr sdk.AccAddress
+	if request.UserAddress != "" {
+		if userAddr, err = sdk.AccAddressFromBech32(request.UserAddress); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid user address: "+err.Error())
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	sponsorship, found := s.keeper.GetSponsorship(ctx, contractAddr)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "sponsorship for the contract: not found")
+	}
+
+	userFees := sdk.NewCoins()
+	if userAddr != nil {
+		userFees = s.keeper.GetSponsoredUserFees(ctx, contractAddr, userAddr)
+	}
+
+	return &types.QuerySponsorshipResponse{
+		Sponsorship: sponsorship,
+		UserFees:    userFees,
+	}, nil
+}
@@ -1,10 +1,13 @@
 package keeper_test
 
 import (
+	"time"
+
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
 	"github.com/archway-network/archway/pkg/testutils"
@@ -15,11 +18,10 @@ import (
 func (s *KeeperTestSuite) TestGRPC_Params() {
 	ctx, k := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
 	querySrvr := keeper.NewQueryServer(k)
-	params := rewardsTypes.Params{
-		InflationRewardsRatio: sdk.MustNewDecFromStr("0.1"),
-		TxFeeRebateRatio:      sdk.MustNewDecFromStr("0.1"),
-		MaxWithdrawRecords:    uint64(2),
-	}
+	params := rewardsTypes.DefaultParams()
+	params.InflationRewardsRatio = sdk.MustNewDecFromStr("0.1")
+	params.TxFeeRebateRatio = sdk.MustNewDecFromStr("0.1")
+	params.MaxWithdrawRecords = uint64(2)
 	k.SetParams(ctx, params)
 
 	s.Run("err: empty request", func() {
@@ -130,6 +132,24 @@ func (s *KeeperTestSuite) TestGRPC_EstimateTxFees() {
 		s.Require().NoError(err)
 		s.Require().NotNil(res)
 	})
+
+	s.Run("ok: gets estimated tx fees for accepted fee denoms", func() {
+		k.GetState().MinConsensusFee(ctx).SetFee(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 1)))
+
+		params := k.GetParams(ctx)
+		params.AcceptedFeeDenoms = []rewardsTypes.FeeDenom{
+			{Denom: "uusdc", Rate: sdk.NewDec(2)},
+		}
+		k.SetParams(ctx, params)
+
+		res, err := querySrvr.EstimateTxFees(sdk.WrapSDKContext(ctx), &rewardsTypes.QueryEstimateTxFeesRequest{GasLimit: 1000})
+		s.Require().NoError(err)
+		s.Require().NotNil(res)
+		s.Assert().Equal("0.100000000000000000stake", res.GasUnitPrice.String())
+		s.Assert().Equal("100stake", res.EstimatedFee.String())
+		s.Assert().Equal("0.100000000000000000stake,0.050000000000000000uusdc", res.GasUnitPrices.String())
+		s.Assert().Equal("100stake,50uusdc", res.EstimatedFees.String())
+	})
 }
 
 func (s *KeeperTestSuite) TestGRPC_OutstandingRewards() {
@@ -187,3 +207,275 @@ func (s *KeeperTestSuite) TestGRPC_RewardsRecords() {
 		s.Require().EqualValues(0, len(res.Records))
 	})
 }
+
+func (s *KeeperTestSuite) TestGRPC_FlatFee() {
+	ctx, k := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+	querySrvr := keeper.NewQueryServer(k)
+	contractAddr := e2eTesting.GenContractAddresses(2)
+	flatFee := sdk.NewInt64Coin("uarch", 10)
+	k.GetState().FlatFee(ctx).SetFlatFee(contractAddr[0], flatFee)
+
+	s.Run("err: empty request", func() {
+		_, err := querySrvr.FlatFee(sdk.WrapSDKContext(ctx), nil)
+		s.Require().Error(err)
+		s.Require().Equal(status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	s.Run("err: invalid contract address", func() {
+		_, err := querySrvr.FlatFee(sdk.WrapSDKContext(ctx), &rewardsTypes.QueryFlatFeeRequest{ContractAddress: "👻"})
+		s.Require().Error(err)
+		s.Require().Equal(status.Error(codes.InvalidArgument, "invalid contract address: decoding bech32 failed: invalid bech32 string length 4"), err)
+	})
+
+	s.Run("err: flat fee not found", func() {
+		_, err := querySrvr.FlatFee(sdk.WrapSDKContext(ctx), &rewardsTypes.QueryFlatFeeRequest{ContractAddress: contractAddr[1].String()})
+		s.Require().Error(err)
+		s.Require().Equal(status.Errorf(codes.NotFound, "flat fee for the contract: not found"), err)
+	})
+
+	s.Run("ok: gets flat fee", func() {
+		res, err := querySrvr.FlatFee(sdk.WrapSDKContext(ctx), &rewardsTypes.QueryFlatFeeRequest{ContractAddress: contractAddr[0].String()})
+		s.Require().NoError(err)
+		s.Require().Equal(flatFee, res.FlatFeeAmount)
+	})
+}
+
+func (s *KeeperTestSuite) TestGRPC_TreasuryBalance() {
+	ctx, k := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+	querySrvr := keeper.NewQueryServer(k)
+
+	s.Run("err: empty request", func() {
+		_, err := querySrvr.TreasuryBalance(sdk.WrapSDKContext(ctx), nil)
+		s.Require().Error(err)
+		s.Require().Equal(status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	s.Run("ok: gets treasury balance", func() {
+		res, err := querySrvr.TreasuryBalance(sdk.WrapSDKContext(ctx), &rewardsTypes.QueryTreasuryBalanceRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(k.TreasuryPool(ctx).String(), sdk.Coins(res.Funds).String())
+	})
+}
+
+func (s *KeeperTestSuite) TestGRPC_TreasuryHistory() {
+	ctx, k := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+	querySrvr := keeper.NewQueryServer(k)
+	accAddrs, _ := e2eTesting.GenAccounts(1)
+	k.GetState().TreasuryOperation(ctx).CreateTreasuryOperation(
+		rewardsTypes.TreasuryOperationType_TREASURY_OPERATION_TYPE_SPEND,
+		accAddrs[0],
+		sdk.NewCoins(sdk.NewInt64Coin("uarch", 10)),
+		ctx.BlockHeight(),
+		ctx.BlockTime(),
+	)
+	k.GetState().TreasuryOperation(ctx).CreateTreasuryOperation(
+		rewardsTypes.TreasuryOperationType_TREASURY_OPERATION_TYPE_BURN,
+		nil,
+		sdk.NewCoins(sdk.NewInt64Coin("uarch", 5)),
+		ctx.BlockHeight(),
+		ctx.BlockTime(),
+	)
+
+	s.Run("err: empty request", func() {
+		_, err := querySrvr.TreasuryHistory(sdk.WrapSDKContext(ctx), nil)
+		s.Require().Error(err)
+		s.Require().Equal(status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	s.Run("err: page limit exceeded", func() {
+		_, err := querySrvr.TreasuryHistory(sdk.WrapSDKContext(ctx), &rewardsTypes.QueryTreasuryHistoryRequest{
+			Pagination: &query.PageRequest{Limit: rewardsTypes.MaxRecordsQueryLimit + 1},
+		})
+		s.Require().Error(err)
+		s.Assert().Equal(codes.InvalidArgument, status.Code(err))
+	})
+
+	s.Run("ok: gets treasury history", func() {
+		res, err := querySrvr.TreasuryHistory(sdk.WrapSDKContext(ctx), &rewardsTypes.QueryTreasuryHistoryRequest{
+			Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+		})
+		s.Require().NoError(err)
+		s.Require().Len(res.Operations, 1)
+		s.Assert().EqualValues(1, res.Operations[0].Id)
+		s.Assert().Equal(accAddrs[0].String(), res.Operations[0].Recipient)
+		s.Assert().EqualValues(2, res.Pagination.Total)
+	})
+}
+
+func (s *KeeperTestSuite) TestGRPC_CodeMetadata() {
+	ctx, k := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+	querySrvr := keeper.NewQueryServer(k)
+	contractViewer := testutils.NewMockContractViewer()
+	k.SetContractInfoViewer(contractViewer)
+	codeCreatorAcc := s.chain.GetAccount(0)
+	contractViewer.AddCodeCreator(1, codeCreatorAcc.Address.String())
+	codeMeta := rewardsTypes.CodeMetadata{
+		CodeId:         1,
+		RewardsAddress: codeCreatorAcc.Address.String(),
+	}
+	err := k.SetCodeMetadata(ctx, codeCreatorAcc.Address, codeMeta)
+	s.Require().NoError(err)
+
+	s.Run("err: empty request", func() {
+		_, err := querySrvr.CodeMetadata(sdk.WrapSDKContext(ctx), nil)
+		s.Require().Error(err)
+		s.Require().Equal(status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	s.Run("err: code metadata not found", func() {
+		_, err := querySrvr.CodeMetadata(sdk.WrapSDKContext(ctx), &rewardsTypes.QueryCodeMetadataRequest{CodeId: 2})
+		s.Require().Error(err)
+		s.Require().Equal(status.Errorf(codes.NotFound, "metadata for the code: not found"), err)
+	})
+
+	s.Run("ok: gets code metadata", func() {
+		res, err := querySrvr.CodeMetadata(sdk.WrapSDKContext(ctx), &rewardsTypes.QueryCodeMetadataRequest{CodeId: 1})
+		s.Require().NoError(err)
+		s.Require().Equal(codeMeta, res.Metadata)
+	})
+}
+
+func (s *KeeperTestSuite) TestGRPC_RewardsRecordExpiry() {
+	ctx, k := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+	querySrvr := keeper.NewQueryServer(k)
+	rewardsAddr := s.chain.GetAccount(0).Address
+
+	params := k.GetParams(ctx)
+	params.RewardsRecordExpiry = 100 * time.Second
+	k.SetParams(ctx, params)
+
+	s.Run("err: empty request", func() {
+		_, err := querySrvr.RewardsRecordExpiry(sdk.WrapSDKContext(ctx), nil)
+		s.Require().Error(err)
+		s.Require().Equal(status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	s.Run("err: invalid rewards address", func() {
+		_, err := querySrvr.RewardsRecordExpiry(sdk.WrapSDKContext(ctx), &rewardsTypes.QueryRewardsRecordExpiryRequest{RewardsAddress: "invalid"})
+		s.Require().Error(err)
+		s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	})
+
+	s.Run("ok: no records", func() {
+		res, err := querySrvr.RewardsRecordExpiry(sdk.WrapSDKContext(ctx), &rewardsTypes.QueryRewardsRecordExpiryRequest{RewardsAddress: rewardsAddr.String()})
+		s.Require().NoError(err)
+		s.Assert().Nil(res.Record)
+		s.Assert().True(res.ExpiryTime.IsZero())
+	})
+
+	s.Run("ok: gets the soonest expiring record", func() {
+		record := k.GetState().RewardsRecord(ctx).CreateRewardsRecord(rewardsAddr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)), 10, ctx.BlockTime())
+
+		res, err := querySrvr.RewardsRecordExpiry(sdk.WrapSDKContext(ctx), &rewardsTypes.QueryRewardsRecordExpiryRequest{RewardsAddress: rewardsAddr.String()})
+		s.Require().NoError(err)
+		s.Require().NotNil(res.Record)
+		s.Assert().Equal(record, *res.Record)
+		s.Assert().Equal(ctx.BlockTime().Add(100*time.Second), res.ExpiryTime)
+	})
+}
+
+func (s *KeeperTestSuite) TestGRPC_AutoPayout() {
+	ctx, k := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+	querySrvr := keeper.NewQueryServer(k)
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+
+	s.Run("err: empty request", func() {
+		_, err := querySrvr.AutoPayout(sdk.WrapSDKContext(ctx), nil)
+		s.Require().Error(err)
+		s.Require().Equal(status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	s.Run("err: invalid contract address", func() {
+		_, err := querySrvr.AutoPayout(sdk.WrapSDKContext(ctx), &rewardsTypes.QueryAutoPayoutRequest{ContractAddress: "invalid"})
+		s.Require().Error(err)
+		s.Require().Equal(codes.InvalidArgument, status.Code(err))
+	})
+
+	s.Run("err: auto payout not found", func() {
+		_, err := querySrvr.AutoPayout(sdk.WrapSDKContext(ctx), &rewardsTypes.QueryAutoPayoutRequest{ContractAddress: contractAddr.String()})
+		s.Require().Error(err)
+		s.Require().Equal(codes.NotFound, status.Code(err))
+	})
+
+	s.Run("ok: gets auto payout", func() {
+		autoPayout := rewardsTypes.AutoPayout{
+			ContractAddress:  contractAddr.String(),
+			IntervalBlocks:   10,
+			LastPayoutHeight: ctx.BlockHeight(),
+		}
+		k.GetState().AutoPayout(ctx).SetAutoPayout(autoPayout)
+
+		res, err := querySrvr.AutoPayout(sdk.WrapSDKContext(ctx), &rewardsTypes.QueryAutoPayoutRequest{ContractAddress: contractAddr.String()})
+		s.Require().NoError(err)
+		s.Assert().Equal(autoPayout, res.AutoPayout)
+	})
+}
+
+func (s *KeeperTestSuite) TestGRPC_MinConsensusFeeHistory() {
+	ctx, k := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+
+	querySrvr := keeper.NewQueryServer(k)
+
+	s.Run("err: empty request", func() {
+		_, err := querySrvr.MinConsensusFeeHistory(sdk.WrapSDKContext(ctx), nil)
+		s.Require().Error(err)
+		s.Require().Equal(status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	s.Run("err: page limit exceeded", func() {
+		_, err := querySrvr.MinConsensusFeeHistory(sdk.WrapSDKContext(ctx), &rewardsTypes.QueryMinConsensusFeeHistoryRequest{
+			Pagination: &query.PageRequest{Limit: rewardsTypes.MaxRecordsQueryLimit + 1},
+		})
+		s.Require().Error(err)
+		s.Assert().Equal(codes.InvalidArgument, status.Code(err))
+	})
+
+	s.Run("ok: gets fee history", func() {
+		k.GetState().MinConsensusFee(ctx).CreateHistoryRecord(ctx.BlockHeight()+1, sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 1)))
+
+		res, err := querySrvr.MinConsensusFeeHistory(sdk.WrapSDKContext(ctx), &rewardsTypes.QueryMinConsensusFeeHistoryRequest{})
+		s.Require().NoError(err)
+		s.Require().NotEmpty(res.Records)
+
+		lastRecord := res.Records[len(res.Records)-1]
+		s.Assert().Equal(ctx.BlockHeight()+1, lastRecord.Height)
+		s.Assert().Equal("0.100000000000000000stake", lastRecord.Fee.String())
+	})
+}
+
+func (s *KeeperTestSuite) TestGRPC_BaseFee() {
+	ctx, k := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+
+	querySrvr := keeper.NewQueryServer(k)
+
+	s.Run("err: empty request", func() {
+		_, err := querySrvr.BaseFee(sdk.WrapSDKContext(ctx), nil)
+		s.Require().Error(err)
+		s.Require().Equal(status.Error(codes.InvalidArgument, "empty request"), err)
+	})
+
+	s.Run("err: base fee not found", func() {
+		k.GetState().MinConsensusFee(ctx).DeleteBaseFee()
+
+		_, err := querySrvr.BaseFee(sdk.WrapSDKContext(ctx), &rewardsTypes.QueryBaseFeeRequest{})
+		s.Require().Error(err)
+		s.Require().Equal(status.Errorf(codes.NotFound, "base fee: not found"), err)
+	})
+
+	s.Run("ok: gets base fee", func() {
+		k.GetState().MinConsensusFee(ctx).SetBaseFee(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(2, 1)))
+
+		res, err := querySrvr.BaseFee(sdk.WrapSDKContext(ctx), &rewardsTypes.QueryBaseFeeRequest{})
+		s.Require().NoError(err)
+		s.Assert().Equal("0.200000000000000000stake", res.BaseFee.String())
+	})
+
+	s.Run("ok: estimated tx fees use the higher base fee", func() {
+		k.GetState().MinConsensusFee(ctx).SetFee(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 1)))
+
+		res, err := querySrvr.EstimateTxFees(sdk.WrapSDKContext(ctx), &rewardsTypes.QueryEstimateTxFeesRequest{GasLimit: 1000})
+		s.Require().NoError(err)
+		s.Assert().Equal("0.200000000000000000stake", res.GasUnitPrice.String())
+		s.Assert().Equal("200stake", res.EstimatedFee.String())
+	})
+}
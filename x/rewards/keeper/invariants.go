@@ -11,6 +11,7 @@ import (
 // RegisterInvariants registers all module invariants.
 func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, "module-account-balance", ModuleAccountBalanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "sponsorship-account-balance", SponsorshipAccountBalanceInvariant(k))
 }
 
 // ModuleAccountBalanceInvariant checks that the current ModuleAccount pool funds are GTE type.RewardsRecord entries.
@@ -35,3 +36,26 @@ func ModuleAccountBalanceInvariant(k Keeper) sdk.Invariant {
 		), broken
 	}
 }
+
+// SponsorshipAccountBalanceInvariant checks that the SponsorshipCollector ModuleAccount funds are GTE types.Sponsorship deposits.
+// If that one fails, sponsorship deposits are not "supported" by real tokens.
+func SponsorshipAccountBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		poolCurrent := k.SponsorshipPool(ctx)
+
+		poolExpected := sdk.NewCoins()
+		sponsorships, _ := k.state.Sponsorship(ctx).Export()
+		for _, sponsorship := range sponsorships {
+			poolExpected = poolExpected.Add(sponsorship.Deposit...)
+		}
+
+		broken := !poolCurrent.IsAllGTE(poolExpected)
+
+		return sdk.FormatInvariant(types.ModuleName, "sponsorship module account and total sponsorship deposits coins", fmt.Sprintf(
+			"\tPool's tokens: %v\n"+
+				"\tSum of sponsorship deposits tokens expected: %v\n"+
+				"\tHeight: %d\n",
+			poolCurrent, poolExpected, ctx.BlockHeight()),
+		), broken
+	}
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	paramTypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	clientTypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/archway-network/archway/x/rewards/types"
@@ -17,6 +19,7 @@ import (
 // ContractInfoReaderExpected defines the interface for the x/wasmd module dependency.
 type ContractInfoReaderExpected interface {
 	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmTypes.ContractInfo
+	GetCodeInfo(ctx sdk.Context, codeID uint64) *wasmTypes.CodeInfo
 }
 
 // TrackingKeeperExpected defines the interface for the x/tracking module dependency.
@@ -24,6 +27,7 @@ type TrackingKeeperExpected interface {
 	GetCurrentTxID(ctx sdk.Context) uint64
 	GetBlockTrackingInfo(ctx sdk.Context, height int64) trackingTypes.BlockTracking
 	RemoveBlockTrackingInfo(ctx sdk.Context, height int64)
+	TrackTxFeePayer(ctx sdk.Context, feePayer sdk.AccAddress, fees sdk.Coins)
 }
 
 // AuthKeeperExpected defines the interface for the x/auth module dependency.
@@ -36,6 +40,20 @@ type BankKeeperExpected interface {
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
 	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx sdk.Context, senderModule string, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
+
+// TransferKeeperExpected defines the interface for the IBC x/transfer module dependency.
+type TransferKeeperExpected interface {
+	SendTransfer(ctx sdk.Context, sourcePort, sourceChannel string, token sdk.Coin, sender sdk.AccAddress, receiver string, timeoutHeight clientTypes.Height, timeoutTimestamp uint64) error
+}
+
+// StakingKeeperExpected defines the interface for the x/staking module dependency.
+type StakingKeeperExpected interface {
+	BondDenom(ctx sdk.Context) string
+	GetValidator(ctx sdk.Context, addr sdk.ValAddress) (stakingTypes.Validator, bool)
+	Delegate(ctx sdk.Context, delAddr sdk.AccAddress, bondAmt sdk.Int, tokenSrc stakingTypes.BondStatus, validator stakingTypes.Validator, subtractAccount bool) (sdk.Dec, error)
 }
 
 // Keeper provides module state operations.
@@ -43,14 +61,17 @@ type Keeper struct {
 	cdc              codec.Codec
 	paramStore       paramTypes.Subspace
 	state            State
+	tStoreKey        sdk.StoreKey
 	contractInfoView ContractInfoReaderExpected
 	trackingKeeper   TrackingKeeperExpected
 	authKeeper       AuthKeeperExpected
 	bankKeeper       BankKeeperExpected
+	transferKeeper   TransferKeeperExpected
+	stakingKeeper    StakingKeeperExpected
 }
 
 // NewKeeper creates a new Keeper instance.
-func NewKeeper(cdc codec.Codec, key sdk.StoreKey, contractInfoReader ContractInfoReaderExpected, trackingKeeper TrackingKeeperExpected, ak AuthKeeperExpected, bk BankKeeperExpected, ps paramTypes.Subspace) Keeper {
+func NewKeeper(cdc codec.Codec, key, tKey sdk.StoreKey, contractInfoReader ContractInfoReaderExpected, trackingKeeper TrackingKeeperExpected, ak AuthKeeperExpected, bk BankKeeperExpected, tk TransferKeeperExpected, sk StakingKeeperExpected, ps paramTypes.Subspace) Keeper {
 	if !ps.HasKeyTable() {
 		ps = ps.WithKeyTable(types.ParamKeyTable())
 	}
@@ -59,10 +80,13 @@ func NewKeeper(cdc codec.Codec, key sdk.StoreKey, contractInfoReader ContractInf
 		cdc:              cdc,
 		paramStore:       ps,
 		state:            NewState(cdc, key),
+		tStoreKey:        tKey,
 		contractInfoView: contractInfoReader,
 		trackingKeeper:   trackingKeeper,
 		authKeeper:       ak,
 		bankKeeper:       bk,
+		transferKeeper:   tk,
+		stakingKeeper:    sk,
 	}
 }
 
@@ -89,6 +113,12 @@ func (k Keeper) TreasuryPool(ctx sdk.Context) sdk.Coins {
 	return k.bankKeeper.GetAllBalances(ctx, poolAcc.GetAddress())
 }
 
+// SponsorshipPool returns the current contract sponsorship deposits funds.
+func (k Keeper) SponsorshipPool(ctx sdk.Context) sdk.Coins {
+	poolAcc := k.authKeeper.GetModuleAccount(ctx, types.SponsorshipCollector)
+	return k.bankKeeper.GetAllBalances(ctx, poolAcc.GetAddress())
+}
+
 // GetRewardsRecords returns all the rewards records for a given rewards address paginated.
 // Query checks the page limit and uses the default limit if not provided.
 func (k Keeper) GetRewardsRecords(ctx sdk.Context, rewardsAddr sdk.AccAddress, pageReq *query.PageRequest) ([]types.RewardsRecord, *query.PageResponse, error) {
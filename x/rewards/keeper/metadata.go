@@ -10,6 +10,7 @@ import (
 // SetContractMetadata creates or updates the contract metadata verifying the ownership:
 //   - Meta could be created by the contract admin (if set);
 //   - Meta could be modified by the contract owner;
+//   - Owner change nominates a pending owner (AcceptContractMetadataOwnership completes the transfer);
 func (k Keeper) SetContractMetadata(ctx sdk.Context, senderAddr, contractAddr sdk.AccAddress, metaUpdates types.ContractMetadata) error {
 	state := k.state.ContractMetadataState(ctx)
 
@@ -37,11 +38,24 @@ func (k Keeper) SetContractMetadata(ctx sdk.Context, senderAddr, contractAddr sd
 		metaNew.ContractAddress = contractAddr.String()
 		metaNew.OwnerAddress = senderAddr.String()
 	}
-	if metaUpdates.HasOwnerAddress() {
-		metaNew.OwnerAddress = metaUpdates.OwnerAddress
+	// Ownership transfer to the contract itself is applied immediately (contract existence is checked above),
+	// any other address is nominated as the pending owner and has to accept the ownership
+	if metaUpdates.HasOwnerAddress() && metaUpdates.OwnerAddress != metaNew.OwnerAddress {
+		if metaUpdates.OwnerAddress == metaNew.ContractAddress {
+			metaNew.OwnerAddress = metaUpdates.OwnerAddress
+			metaNew.PendingOwnerAddress = ""
+		} else {
+			metaNew.PendingOwnerAddress = metaUpdates.OwnerAddress
+		}
 	}
+	// Rewards address and weighted recipients are mutually exclusive: setting one resets the other
 	if metaUpdates.HasRewardsAddress() {
 		metaNew.RewardsAddress = metaUpdates.RewardsAddress
+		metaNew.RewardsRecipients = nil
+	}
+	if metaUpdates.HasRewardsRecipients() {
+		metaNew.RewardsAddress = ""
+		metaNew.RewardsRecipients = metaUpdates.RewardsRecipients
 	}
 
 	// Set
@@ -57,6 +71,87 @@ func (k Keeper) SetContractMetadata(ctx sdk.Context, senderAddr, contractAddr sd
 	return nil
 }
 
+// SetContractMetadataByGov sets the contract metadata replacing the existing one (gov proposal).
+// Unlike SetContractMetadata, the ownership is not checked, so metadata can be set for admin-less contracts.
+// The pending ownership transfer (if any) is canceled.
+func (k Keeper) SetContractMetadataByGov(ctx sdk.Context, contractAddr sdk.AccAddress, meta types.ContractMetadata) error {
+	if contractInfo := k.contractInfoView.GetContractInfo(ctx, contractAddr); contractInfo == nil {
+		return types.ErrContractNotFound
+	}
+
+	meta.ContractAddress = contractAddr.String()
+	meta.PendingOwnerAddress = ""
+	k.state.ContractMetadataState(ctx).SetContractMetadata(contractAddr, meta)
+	k.syncAutoPayout(ctx, meta)
+
+	types.EmitContractMetadataGovSetEvent(
+		ctx,
+		contractAddr,
+		meta,
+	)
+
+	return nil
+}
+
+// AcceptContractMetadataOwnership completes the contract metadata ownership transfer.
+// Operation is authorized to the pending owner.
+func (k Keeper) AcceptContractMetadataOwnership(ctx sdk.Context, senderAddr, contractAddr sdk.AccAddress) error {
+	state := k.state.ContractMetadataState(ctx)
+
+	meta, found := state.GetContractMetadata(contractAddr)
+	if !found {
+		return types.ErrMetadataNotFound
+	}
+
+	if !meta.HasPendingOwnerAddress() {
+		return sdkErrors.Wrap(types.ErrInvalidRequest, "no pending ownership transfer")
+	}
+	if meta.PendingOwnerAddress != senderAddr.String() {
+		return sdkErrors.Wrap(types.ErrUnauthorized, "ownership can only be accepted by the pending owner")
+	}
+
+	meta.OwnerAddress = meta.PendingOwnerAddress
+	meta.PendingOwnerAddress = ""
+	state.SetContractMetadata(contractAddr, meta)
+
+	types.EmitContractMetadataSetEvent(
+		ctx,
+		contractAddr,
+		meta,
+	)
+
+	return nil
+}
+
+// CancelContractMetadataOwnership cancels the pending contract metadata ownership transfer.
+// Operation is authorized to the contract owner and the pending owner (nomination decline).
+func (k Keeper) CancelContractMetadataOwnership(ctx sdk.Context, senderAddr, contractAddr sdk.AccAddress) error {
+	state := k.state.ContractMetadataState(ctx)
+
+	meta, found := state.GetContractMetadata(contractAddr)
+	if !found {
+		return types.ErrMetadataNotFound
+	}
+
+	if !meta.HasPendingOwnerAddress() {
+		return sdkErrors.Wrap(types.ErrInvalidRequest, "no pending ownership transfer")
+	}
+	if senderAddrStr := senderAddr.String(); meta.OwnerAddress != senderAddrStr && meta.PendingOwnerAddress != senderAddrStr {
+		return sdkErrors.Wrap(types.ErrUnauthorized, "ownership transfer can only be canceled by the contract owner or the pending owner")
+	}
+
+	meta.PendingOwnerAddress = ""
+	state.SetContractMetadata(contractAddr, meta)
+
+	types.EmitContractMetadataSetEvent(
+		ctx,
+		contractAddr,
+		meta,
+	)
+
+	return nil
+}
+
 // GetContractMetadata returns the contract metadata for the given contract address (if found).
 func (k Keeper) GetContractMetadata(ctx sdk.Context, contractAddr sdk.AccAddress) *types.ContractMetadata {
 	meta, found := k.state.ContractMetadataState(ctx).GetContractMetadata(contractAddr)
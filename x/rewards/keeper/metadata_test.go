@@ -1,8 +1,11 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
 	"github.com/archway-network/archway/pkg/testutils"
+	"github.com/archway-network/archway/x/rewards"
 	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
 )
 
@@ -52,12 +55,77 @@ func (s *KeeperTestSuite) TestSetContractMetadata() {
 		s.Assert().Equal(metaCurrent, *metaReceived)
 	})
 
-	s.Run("OK: update OwnerAddr (change ownership)", func() {
+	s.Run("OK: set RewardsRecipients (RewardsAddr is reset)", func() {
+		metaUpdates := rewardsTypes.ContractMetadata{
+			RewardsRecipients: []rewardsTypes.RewardsRecipient{
+				{Address: contractAdminAcc.Address.String(), Weight: sdk.NewDecWithPrec(5, 1)},
+				{Address: otherAcc.Address.String(), Weight: sdk.NewDecWithPrec(5, 1)},
+			},
+		}
+
+		err := keeper.SetContractMetadata(ctx, contractAdminAcc.Address, contractAddr, metaUpdates)
+		s.Require().NoError(err)
+
+		metaReceived := keeper.GetContractMetadata(ctx, contractAddr)
+		s.Require().NotNil(metaReceived)
+		s.Assert().Empty(metaReceived.RewardsAddress)
+		s.Assert().Equal(metaUpdates.RewardsRecipients, metaReceived.RewardsRecipients)
+	})
+
+	s.Run("OK: set RewardsAddr (RewardsRecipients are reset)", func() {
+		err := keeper.SetContractMetadata(ctx, contractAdminAcc.Address, contractAddr, metaCurrent)
+		s.Require().NoError(err)
+
+		metaReceived := keeper.GetContractMetadata(ctx, contractAddr)
+		s.Require().NotNil(metaReceived)
+		s.Assert().Equal(metaCurrent, *metaReceived)
+	})
+
+	s.Run("OK: update OwnerAddr (nominate pending owner)", func() {
+		metaUpdates := rewardsTypes.ContractMetadata{
+			OwnerAddress: otherAcc.Address.String(),
+		}
+
+		err := keeper.SetContractMetadata(ctx, contractAdminAcc.Address, contractAddr, metaUpdates)
+		s.Require().NoError(err)
+
+		metaReceived := keeper.GetContractMetadata(ctx, contractAddr)
+		s.Require().NotNil(metaReceived)
+		s.Assert().Equal(contractAdminAcc.Address.String(), metaReceived.OwnerAddress)
+		s.Assert().Equal(otherAcc.Address.String(), metaReceived.PendingOwnerAddress)
+	})
+
+	s.Run("Fail: accept ownership by non-pending owner", func() {
+		err := keeper.AcceptContractMetadataOwnership(ctx, contractAdminAcc.Address, contractAddr)
+		s.Assert().ErrorIs(err, rewardsTypes.ErrUnauthorized)
+	})
+
+	s.Run("OK: cancel ownership transfer", func() {
+		err := keeper.CancelContractMetadataOwnership(ctx, contractAdminAcc.Address, contractAddr)
+		s.Require().NoError(err)
+
+		metaReceived := keeper.GetContractMetadata(ctx, contractAddr)
+		s.Require().NotNil(metaReceived)
+		s.Assert().Equal(metaCurrent, *metaReceived)
+	})
+
+	s.Run("Fail: accept / cancel without ownership transfer", func() {
+		err := keeper.AcceptContractMetadataOwnership(ctx, otherAcc.Address, contractAddr)
+		s.Assert().ErrorIs(err, rewardsTypes.ErrInvalidRequest)
+
+		err = keeper.CancelContractMetadataOwnership(ctx, contractAdminAcc.Address, contractAddr)
+		s.Assert().ErrorIs(err, rewardsTypes.ErrInvalidRequest)
+	})
+
+	s.Run("OK: accept ownership (change ownership)", func() {
 		metaCurrent.OwnerAddress = otherAcc.Address.String()
 
 		err := keeper.SetContractMetadata(ctx, contractAdminAcc.Address, contractAddr, metaCurrent)
 		s.Require().NoError(err)
 
+		err = keeper.AcceptContractMetadataOwnership(ctx, otherAcc.Address, contractAddr)
+		s.Require().NoError(err)
+
 		metaReceived := keeper.GetContractMetadata(ctx, contractAddr)
 		s.Require().NotNil(metaReceived)
 		s.Assert().Equal(metaCurrent, *metaReceived)
@@ -69,4 +137,86 @@ func (s *KeeperTestSuite) TestSetContractMetadata() {
 		err := keeper.SetContractMetadata(ctx, contractAdminAcc.Address, contractAddr, metaCurrent)
 		s.Require().ErrorIs(err, rewardsTypes.ErrUnauthorized)
 	})
+
+	s.Run("OK: update OwnerAddr to the contract itself (no pending owner)", func() {
+		metaCurrent.OwnerAddress = contractAddr.String()
+
+		err := keeper.SetContractMetadata(ctx, otherAcc.Address, contractAddr, metaCurrent)
+		s.Require().NoError(err)
+
+		metaReceived := keeper.GetContractMetadata(ctx, contractAddr)
+		s.Require().NotNil(metaReceived)
+		s.Assert().Equal(metaCurrent, *metaReceived)
+	})
+}
+
+// TestSetContractMetadataProposal checks the set contract metadata gov proposal handling.
+func (s *KeeperTestSuite) TestSetContractMetadataProposal() {
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+	contractAdminAcc, govOwnerAcc := s.chain.GetAccount(0), s.chain.GetAccount(1)
+
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetContractInfoViewer(contractViewer)
+	handler := rewards.NewProposalHandler(keeper)
+
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+	adminlessContractAddr, contractAddr := contractAddrs[0], contractAddrs[1]
+
+	s.Run("Fail: non-existing contract", func() {
+		err := handler(ctx, rewardsTypes.NewSetContractMetadataProposal("Title", "Description", rewardsTypes.ContractMetadata{
+			ContractAddress: adminlessContractAddr.String(),
+			OwnerAddress:    govOwnerAcc.Address.String(),
+		}))
+		s.Assert().ErrorIs(err, rewardsTypes.ErrContractNotFound)
+	})
+
+	s.Run("OK: set metadata for an admin-less contract", func() {
+		contractViewer.AddContractCodeID(adminlessContractAddr.String(), 1)
+
+		meta := rewardsTypes.ContractMetadata{
+			ContractAddress: adminlessContractAddr.String(),
+			OwnerAddress:    govOwnerAcc.Address.String(),
+			RewardsAddress:  govOwnerAcc.Address.String(),
+		}
+
+		err := handler(ctx, rewardsTypes.NewSetContractMetadataProposal("Title", "Description", meta))
+		s.Require().NoError(err)
+
+		metaReceived := keeper.GetContractMetadata(ctx, adminlessContractAddr)
+		s.Require().NotNil(metaReceived)
+		s.Assert().Equal(meta, *metaReceived)
+
+		// New owner is able to manage the metadata
+		err = keeper.SetContractMetadata(ctx, govOwnerAcc.Address, adminlessContractAddr, rewardsTypes.ContractMetadata{
+			RewardsAddress: contractAdminAcc.Address.String(),
+		})
+		s.Require().NoError(err)
+	})
+
+	s.Run("OK: override metadata (pending ownership transfer is canceled)", func() {
+		contractViewer.AddContractAdmin(contractAddr.String(), contractAdminAcc.Address.String())
+
+		err := keeper.SetContractMetadata(ctx, contractAdminAcc.Address, contractAddr, rewardsTypes.ContractMetadata{
+			OwnerAddress:   govOwnerAcc.Address.String(),
+			RewardsAddress: contractAdminAcc.Address.String(),
+		})
+		s.Require().NoError(err)
+		s.Require().True(keeper.GetContractMetadata(ctx, contractAddr).HasPendingOwnerAddress())
+
+		meta := rewardsTypes.ContractMetadata{
+			ContractAddress: contractAddr.String(),
+			OwnerAddress:    contractAdminAcc.Address.String(),
+			RewardsRecipients: []rewardsTypes.RewardsRecipient{
+				{Address: govOwnerAcc.Address.String(), Weight: sdk.OneDec()},
+			},
+		}
+
+		err = handler(ctx, rewardsTypes.NewSetContractMetadataProposal("Title", "Description", meta))
+		s.Require().NoError(err)
+
+		metaReceived := keeper.GetContractMetadata(ctx, contractAddr)
+		s.Require().NotNil(metaReceived)
+		s.Assert().Equal(meta, *metaReceived)
+		s.Assert().False(metaReceived.HasPendingOwnerAddress())
+	})
 }
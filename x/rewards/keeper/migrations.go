@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator instance.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{
+		keeper: keeper,
+	}
+}
+
+// Migrate1to2 migrates the module state from version 1 to 2.
+// Params introduced since version 1 are set to their default values (new features are disabled,
+// so the chain keeps the previous behaviour until they are enabled by governance).
+// The types.RewardsRecord's CalculatedTime index is created and existing records are compacted
+// (merged into the latest one per rewards address), since the EndBlocker now appends new rewards
+// to the latest record instead of creating a new one each block.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	paramStore := m.keeper.paramStore
+
+	// Rewards records expiration and automatic payouts
+	paramStore.Set(ctx, types.RecordExpiryParamKey, types.DefaultRecordExpiry)
+	paramStore.Set(ctx, types.MaxExpiredRecordsParamKey, types.DefaultMaxExpiredRecords)
+	paramStore.Set(ctx, types.MaxAutoPayoutsParamKey, types.DefaultMaxAutoPayouts)
+
+	// Contract inflation rewards caps, self-dealing policy and operation weights
+	paramStore.Set(ctx, types.InflationShareCapParamKey, types.DefaultInflationShareCap)
+	paramStore.Set(ctx, types.InflationEpochCapParamKey, types.DefaultInflationEpochCap)
+	paramStore.Set(ctx, types.InflationCapEpochParamKey, types.DefaultInflationCapEpoch)
+	paramStore.Set(ctx, types.SelfDealingPolicyParamKey, types.DefaultSelfDealingPolicy)
+	paramStore.Set(ctx, types.OperationWeightsParamKey, types.DefaultOperationWeights)
+
+	// Fees: accepted denoms, minimum consensus fee update, base fee, tx priority and gas refunds
+	paramStore.Set(ctx, types.AcceptedFeeDenomsParamKey, types.DefaultAcceptedFeeDenoms)
+	paramStore.Set(ctx, types.MinConsFeeModeParamKey, types.DefaultMinConsFeeMode)
+	paramStore.Set(ctx, types.MinConsFeeWindowParamKey, types.DefaultMinConsFeeWindow)
+	paramStore.Set(ctx, types.MinConsFeeHistoryParamKey, types.DefaultMinConsFeeHistory)
+	paramStore.Set(ctx, types.BaseFeeChangeRateParamKey, types.DefaultBaseFeeChangeRate)
+	paramStore.Set(ctx, types.BaseFeeTargetParamKey, types.DefaultBaseFeeTarget)
+	paramStore.Set(ctx, types.BaseFeeBurnRatioParamKey, types.DefaultBaseFeeBurnRatio)
+	paramStore.Set(ctx, types.TxPriorityTiersParamKey, types.DefaultTxPriorityTiers)
+	paramStore.Set(ctx, types.GasRefundRatioParamKey, types.DefaultGasRefundRatio)
+
+	// Rewards records
+	rewardsRecordState := m.keeper.state.RewardsRecord(ctx)
+	rewardsRecordState.RebuildTimeIndex()
+	for _, rewardsAddr := range rewardsRecordState.GetRewardsAddresses() {
+		rewardsRecordState.CompactRewardsRecords(rewardsAddr)
+	}
+
+	return nil
+}
@@ -2,13 +2,19 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/archway-network/archway/pkg"
 	"github.com/archway-network/archway/x/rewards/types"
 )
 
 // UpdateMinConsensusFee calculates and updates the minimum consensus fee if eligible emitting an event.
+// Depending on the MinConsensusFeeMode param, the fee is either set to the current block estimation or smoothed.
+// The fee is also appended to the history which is pruned according to the MinConsensusFeeHistoryBlocks param.
 func (k Keeper) UpdateMinConsensusFee(ctx sdk.Context, inflationRewards sdk.Coin) {
+	k.pruneMinConsensusFeeHistory(ctx)
+
 	// Prepare and verify inputs
 	if inflationRewards.IsZero() {
 		k.Logger(ctx).Info("Minimum consensus fee update skipped: inflation rewards are zero")
@@ -35,8 +41,19 @@ func (k Keeper) UpdateMinConsensusFee(ctx sdk.Context, inflationRewards sdk.Coin
 		Amount: feeAmt,
 	}
 
-	// Set and emit event
-	k.state.MinConsensusFee(ctx).SetFee(feeCoin)
+	// Smooth the estimation (the previous value is skipped if the denom has changed)
+	feeState := k.state.MinConsensusFee(ctx)
+	if k.MinConsensusFeeMode(ctx) == types.MinConsensusFeeMode_MIN_CONSENSUS_FEE_MODE_EMA {
+		if prevFee, found := feeState.GetFee(); found && prevFee.Denom == feeCoin.Denom {
+			feeCoin.Amount = calculateMinConsensusFeeEMA(prevFee.Amount, feeAmt, k.MinConsensusFeeWindow(ctx))
+		}
+	}
+
+	// Set, save the history and emit event
+	feeState.SetFee(feeCoin)
+	if k.MinConsensusFeeHistoryBlocks(ctx) > 0 {
+		feeState.CreateHistoryRecord(ctx.BlockHeight(), feeCoin)
+	}
 	k.Logger(ctx).Info("Minimum consensus fee update", "fee", feeCoin)
 
 	types.EmitMinConsensusFeeSetEvent(ctx, feeCoin)
@@ -53,6 +70,88 @@ func (k Keeper) GetMinConsensusFee(ctx sdk.Context) (sdk.DecCoin, bool) {
 	return fee, true
 }
 
+// GetMinGasUnitPrice returns the minimum gas unit price a transaction must pay.
+// That is the higher of the minimum consensus fee and the base fee (if enabled).
+func (k Keeper) GetMinGasUnitPrice(ctx sdk.Context) (sdk.DecCoin, bool) {
+	minConsFee, minConsFeeFound := k.GetMinConsensusFee(ctx)
+	baseFee, baseFeeFound := k.GetBaseFee(ctx)
+
+	switch {
+	case minConsFeeFound && baseFeeFound:
+		if baseFee.Denom == minConsFee.Denom && baseFee.Amount.GT(minConsFee.Amount) {
+			return baseFee, true
+		}
+		return minConsFee, true
+	case minConsFeeFound:
+		return minConsFee, true
+	case baseFeeFound:
+		return baseFee, true
+	}
+
+	return sdk.DecCoin{}, false
+}
+
+// GetMinConsensusFees returns the minimum gas unit price (refer to GetMinGasUnitPrice) for every accepted fee denom.
+// The base denom fee is converted to alternative denoms using the AcceptedFeeDenoms param rates.
+// Paying the fee in any of the returned denoms satisfies the minimum consensus fee.
+func (k Keeper) GetMinConsensusFees(ctx sdk.Context) (sdk.DecCoins, bool) {
+	gasUnitPrice, found := k.GetMinGasUnitPrice(ctx)
+	if !found {
+		return nil, false
+	}
+
+	return k.convertToAcceptedFeeDenoms(ctx, gasUnitPrice), true
+}
+
+// convertToAcceptedFeeDenoms converts the base denom gas unit price to all accepted fee denoms (the base one included).
+func (k Keeper) convertToAcceptedFeeDenoms(ctx sdk.Context, gasUnitPrice sdk.DecCoin) sdk.DecCoins {
+	prices := sdk.DecCoins{gasUnitPrice}
+	for _, feeDenom := range k.AcceptedFeeDenoms(ctx) {
+		if feeDenom.Denom == gasUnitPrice.Denom {
+			continue
+		}
+		prices = append(prices, feeDenom.ConvertGasUnitPrice(gasUnitPrice))
+	}
+
+	return prices.Sort()
+}
+
+// GetMinConsensusFeeHistory returns the minimum consensus fee history paginated (ordered by height).
+// Query checks the page limit and uses the default limit if not provided.
+func (k Keeper) GetMinConsensusFeeHistory(ctx sdk.Context, pageReq *query.PageRequest) ([]types.MinConsensusFeeRecord, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{
+			Limit: types.MaxRecordsQueryLimit,
+		}
+	}
+	if pageReq.Limit > types.MaxRecordsQueryLimit {
+		return nil, nil, sdkErrors.Wrapf(types.ErrInvalidRequest, "max records (%d) query limit exceeded", types.MaxRecordsQueryLimit)
+	}
+
+	return k.state.MinConsensusFee(ctx).GetHistoryRecordsPaginated(pageReq)
+}
+
+// pruneMinConsensusFeeHistory removes the history records that are out of the MinConsensusFeeHistoryBlocks window.
+func (k Keeper) pruneMinConsensusFeeHistory(ctx sdk.Context) {
+	pruneHeight := ctx.BlockHeight() - int64(k.MinConsensusFeeHistoryBlocks(ctx))
+	if pruneHeight <= 0 {
+		return
+	}
+
+	k.state.MinConsensusFee(ctx).DeleteHistoryRecordsUpTo(pruneHeight)
+}
+
+// calculateMinConsensusFeeEMA calculates the exponential moving average of the minimum consensus fee amount:
+//
+//	PrevFee + Alpha * (CurFee - PrevFee), where Alpha = 2 / (Window + 1)
+func calculateMinConsensusFeeEMA(prevFee, curFee sdk.Dec, window uint64) sdk.Dec {
+	alpha := sdk.NewDec(2).Quo(pkg.NewDecFromUint64(window).Add(sdk.OneDec()))
+
+	return prevFee.Add(
+		alpha.Mul(curFee.Sub(prevFee)),
+	)
+}
+
 // calculateMinConsensusFee calculates the minimum consensus fee amount using the formula:
 //
 //	-1 * ( BlockRewards / ( GasLimit * (TxFeeRatio - 1) ) )
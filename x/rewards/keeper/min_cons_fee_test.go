@@ -0,0 +1,95 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
+// TestUpdateMinConsensusFee tests the minimum consensus fee update modes and the fee history.
+func (s *KeeperTestSuite) TestUpdateMinConsensusFee() {
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+	feeState := keeper.GetState().MinConsensusFee(ctx)
+
+	inflationRewards := sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000)
+	startHeight := ctx.BlockHeight() + 1
+
+	getHistory := func() []rewardsTypes.MinConsensusFeeRecord {
+		records, _, err := keeper.GetMinConsensusFeeHistory(ctx, nil)
+		s.Require().NoError(err)
+		return records
+	}
+
+	setParams := func(mode rewardsTypes.MinConsensusFeeMode, window, historyBlocks uint64) {
+		params := keeper.GetParams(ctx)
+		params.MinConsensusFeeMode = mode
+		params.MinConsensusFeeWindow = window
+		params.MinConsensusFeeHistoryBlocks = historyBlocks
+		keeper.SetParams(ctx, params)
+	}
+
+	var instantFee sdk.DecCoin
+	s.Run("OK: instant mode", func() {
+		keeper.UpdateMinConsensusFee(ctx.WithBlockHeight(startHeight), inflationRewards)
+
+		fee, found := feeState.GetFee()
+		s.Require().True(found)
+		s.Require().True(fee.IsPositive())
+		instantFee = fee
+
+		history := getHistory()
+		s.Require().NotEmpty(history)
+		s.Assert().Equal(startHeight, history[len(history)-1].Height)
+		s.Assert().Equal(instantFee.String(), history[len(history)-1].Fee.String())
+	})
+
+	s.Run("OK: EMA mode", func() {
+		// Alpha = 2 / (3 + 1) = 0.5
+		setParams(rewardsTypes.MinConsensusFeeMode_MIN_CONSENSUS_FEE_MODE_EMA, 3, rewardsTypes.DefaultMinConsFeeHistory)
+
+		prevFee := sdk.NewDecCoinFromDec(instantFee.Denom, instantFee.Amount.MulInt64(3))
+		feeState.SetFee(prevFee)
+
+		keeper.UpdateMinConsensusFee(ctx.WithBlockHeight(startHeight+1), inflationRewards)
+
+		// 3 * Fee + 0.5 * (Fee - 3 * Fee) = 2 * Fee
+		feeExpected := sdk.NewDecCoinFromDec(instantFee.Denom, instantFee.Amount.MulInt64(2))
+
+		fee, found := feeState.GetFee()
+		s.Require().True(found)
+		s.Assert().Equal(feeExpected.String(), fee.String())
+
+		history := getHistory()
+		s.Assert().Equal(startHeight+1, history[len(history)-1].Height)
+		s.Assert().Equal(feeExpected.String(), history[len(history)-1].Fee.String())
+	})
+
+	s.Run("OK: EMA mode with the window of 1 block equals to the instant mode", func() {
+		setParams(rewardsTypes.MinConsensusFeeMode_MIN_CONSENSUS_FEE_MODE_EMA, 1, rewardsTypes.DefaultMinConsFeeHistory)
+
+		keeper.UpdateMinConsensusFee(ctx.WithBlockHeight(startHeight+2), inflationRewards)
+
+		fee, found := feeState.GetFee()
+		s.Require().True(found)
+		s.Assert().Equal(instantFee.String(), fee.String())
+	})
+
+	s.Run("OK: history is pruned", func() {
+		setParams(rewardsTypes.MinConsensusFeeMode_MIN_CONSENSUS_FEE_MODE_INSTANT, 1, 2)
+
+		keeper.UpdateMinConsensusFee(ctx.WithBlockHeight(startHeight+3), inflationRewards)
+
+		history := getHistory()
+		s.Require().Len(history, 2)
+		s.Assert().Equal(startHeight+2, history[0].Height)
+		s.Assert().Equal(startHeight+3, history[1].Height)
+	})
+
+	s.Run("OK: history is disabled", func() {
+		setParams(rewardsTypes.MinConsensusFeeMode_MIN_CONSENSUS_FEE_MODE_INSTANT, 1, 0)
+
+		keeper.UpdateMinConsensusFee(ctx.WithBlockHeight(startHeight+4), inflationRewards)
+
+		s.Assert().Empty(getHistory())
+	})
+}
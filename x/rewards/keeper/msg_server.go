@@ -67,8 +67,16 @@ func (s MsgServer) WithdrawRewards(c context.Context, request *types.MsgWithdraw
 
 	switch modeReq := request.Mode.(type) {
 	case *types.MsgWithdrawRewards_RecordsLimit_:
+		if request.IbcTransfer != nil {
+			totalRewards, recordsUsed, err = s.keeper.IBCWithdrawRewardsByRecordsLimit(ctx, rewardsAddr, modeReq.RecordsLimit.Limit, *request.IbcTransfer)
+			break
+		}
 		totalRewards, recordsUsed, err = s.keeper.WithdrawRewardsByRecordsLimit(ctx, rewardsAddr, modeReq.RecordsLimit.Limit)
 	case *types.MsgWithdrawRewards_RecordIds:
+		if request.IbcTransfer != nil {
+			totalRewards, recordsUsed, err = s.keeper.IBCWithdrawRewardsByRecordIDs(ctx, rewardsAddr, modeReq.RecordIds.Ids, *request.IbcTransfer)
+			break
+		}
 		totalRewards, recordsUsed, err = s.keeper.WithdrawRewardsByRecordIDs(ctx, rewardsAddr, modeReq.RecordIds.Ids)
 	default:
 		// Should never happen since the BasicValidate function checks this case
@@ -84,3 +92,241 @@ func (s MsgServer) WithdrawRewards(c context.Context, request *types.MsgWithdraw
 		TotalRewards: totalRewards,
 	}, nil
 }
+
+// SetFlatFee implements the types.MsgServer interface.
+func (s MsgServer) SetFlatFee(c context.Context, request *types.MsgSetFlatFee) (*types.MsgSetFlatFeeResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	senderAddr, err := sdk.AccAddressFromBech32(request.SenderAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	if err := s.keeper.SetFlatFee(ctx, senderAddr, contractAddr, request.FlatFeeAmount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetFlatFeeResponse{}, nil
+}
+
+// WithdrawRewardsAndDelegate implements the types.MsgServer interface.
+func (s MsgServer) WithdrawRewardsAndDelegate(c context.Context, request *types.MsgWithdrawRewardsAndDelegate) (*types.MsgWithdrawRewardsAndDelegateResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rewardsAddr, err := sdk.AccAddressFromBech32(request.RewardsAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	valAddr, err := sdk.ValAddressFromBech32(request.ValidatorAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	var totalRewards sdk.Coins
+	var recordsUsed int
+	var delegatedAmt sdk.Coin
+
+	switch modeReq := request.Mode.(type) {
+	case *types.MsgWithdrawRewardsAndDelegate_RecordsLimit:
+		totalRewards, recordsUsed, delegatedAmt, err = s.keeper.WithdrawRewardsAndDelegateByRecordsLimit(ctx, rewardsAddr, modeReq.RecordsLimit.Limit, valAddr)
+	case *types.MsgWithdrawRewardsAndDelegate_RecordIds:
+		totalRewards, recordsUsed, delegatedAmt, err = s.keeper.WithdrawRewardsAndDelegateByRecordIDs(ctx, rewardsAddr, modeReq.RecordIds.Ids, valAddr)
+	default:
+		// Should never happen since the BasicValidate function checks this case
+		return nil, status.Error(codes.InvalidArgument, "invalid request mode")
+	}
+
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return &types.MsgWithdrawRewardsAndDelegateResponse{
+		RecordsNum:      uint64(recordsUsed),
+		TotalRewards:    totalRewards,
+		DelegatedAmount: delegatedAmt,
+	}, nil
+}
+
+// AcceptContractMetadataOwnership implements the types.MsgServer interface.
+func (s MsgServer) AcceptContractMetadataOwnership(c context.Context, request *types.MsgAcceptContractMetadataOwnership) (*types.MsgAcceptContractMetadataOwnershipResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	senderAddr, err := sdk.AccAddressFromBech32(request.SenderAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	if err := s.keeper.AcceptContractMetadataOwnership(ctx, senderAddr, contractAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAcceptContractMetadataOwnershipResponse{}, nil
+}
+
+// CancelContractMetadataOwnership implements the types.MsgServer interface.
+func (s MsgServer) CancelContractMetadataOwnership(c context.Context, request *types.MsgCancelContractMetadataOwnership) (*types.MsgCancelContractMetadataOwnershipResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	senderAddr, err := sdk.AccAddressFromBech32(request.SenderAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	if err := s.keeper.CancelContractMetadataOwnership(ctx, senderAddr, contractAddr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelContractMetadataOwnershipResponse{}, nil
+}
+
+// SetCodeMetadata implements the types.MsgServer interface.
+func (s MsgServer) SetCodeMetadata(c context.Context, request *types.MsgSetCodeMetadata) (*types.MsgSetCodeMetadataResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	senderAddr, err := sdk.AccAddressFromBech32(request.SenderAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	if err := s.keeper.SetCodeMetadata(ctx, senderAddr, request.Metadata); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetCodeMetadataResponse{}, nil
+}
+
+// SetAutoPayout implements the types.MsgServer interface.
+func (s MsgServer) SetAutoPayout(c context.Context, request *types.MsgSetAutoPayout) (*types.MsgSetAutoPayoutResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	senderAddr, err := sdk.AccAddressFromBech32(request.SenderAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	if err := s.keeper.SetAutoPayout(ctx, senderAddr, contractAddr, request.IntervalBlocks, request.Threshold); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAutoPayoutResponse{}, nil
+}
+
+// SetSponsorship implements the types.MsgServer interface.
+func (s MsgServer) SetSponsorship(c context.Context, request *types.MsgSetSponsorship) (*types.MsgSetSponsorshipResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	senderAddr, err := sdk.AccAddressFromBech32(request.SenderAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	if err := s.keeper.SetSponsorship(ctx, senderAddr, contractAddr, request.UserFeeLimit, request.UserFeeLimitPeriod, request.BlockFeeLimit, request.FundFromRewards); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetSponsorshipResponse{}, nil
+}
+
+// DepositSponsorship implements the types.MsgServer interface.
+func (s MsgServer) DepositSponsorship(c context.Context, request *types.MsgDepositSponsorship) (*types.MsgDepositSponsorshipResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	senderAddr, err := sdk.AccAddressFromBech32(request.SenderAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	if err := s.keeper.DepositSponsorship(ctx, senderAddr, contractAddr, request.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDepositSponsorshipResponse{}, nil
+}
+
+// WithdrawSponsorship implements the types.MsgServer interface.
+func (s MsgServer) WithdrawSponsorship(c context.Context, request *types.MsgWithdrawSponsorship) (*types.MsgWithdrawSponsorshipResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	senderAddr, err := sdk.AccAddressFromBech32(request.SenderAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	contractAddr, err := sdk.AccAddressFromBech32(request.ContractAddress)
+	if err != nil {
+		return nil, err // returning error "as is" since this should not happen due to the earlier ValidateBasic call
+	}
+
+	if err := s.keeper.WithdrawSponsorship(ctx, senderAddr, contractAddr, request.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgWithdrawSponsorshipResponse{}, nil
+}
@@ -124,6 +124,87 @@ func (s *KeeperTestSuite) TestMsgServer_SetContractMetadata() {
 	}
 }
 
+func (s *KeeperTestSuite) TestMsgServer_AcceptContractMetadataOwnership() {
+	ctx, k := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+	contractAdminAcc, otherAcc := s.chain.GetAccount(0), s.chain.GetAccount(1)
+	contractViewer := testutils.NewMockContractViewer()
+	k.SetContractInfoViewer(contractViewer)
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+
+	server := keeper.NewMsgServer(k)
+
+	testCases := []struct {
+		testCase    string
+		prepare     func() *rewardstypes.MsgAcceptContractMetadataOwnership
+		expectError bool
+		errorType   error
+	}{
+		{
+			testCase: "err: empty request",
+			prepare: func() *rewardstypes.MsgAcceptContractMetadataOwnership {
+				return nil
+			},
+			expectError: true,
+			errorType:   status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			testCase: "err: invalid sender address",
+			prepare: func() *rewardstypes.MsgAcceptContractMetadataOwnership {
+				return &rewardstypes.MsgAcceptContractMetadataOwnership{
+					SenderAddress:   "👻",
+					ContractAddress: contractAddr.String(),
+				}
+			},
+			expectError: true,
+			errorType:   fmt.Errorf("decoding bech32 failed: invalid bech32 string length 4"),
+		},
+		{
+			testCase: "err: metadata does not exist",
+			prepare: func() *rewardstypes.MsgAcceptContractMetadataOwnership {
+				return rewardstypes.NewMsgAcceptContractMetadataOwnership(otherAcc.Address, contractAddr)
+			},
+			expectError: true,
+			errorType:   rewardstypes.ErrMetadataNotFound,
+		},
+		{
+			testCase: "err: the message sender is not the pending owner",
+			prepare: func() *rewardstypes.MsgAcceptContractMetadataOwnership {
+				contractViewer.AddContractAdmin(contractAddr.String(), contractAdminAcc.Address.String())
+				err := k.SetContractMetadata(ctx, contractAdminAcc.Address, contractAddr, rewardstypes.ContractMetadata{
+					OwnerAddress: otherAcc.Address.String(),
+				})
+				s.Require().NoError(err)
+
+				return rewardstypes.NewMsgAcceptContractMetadataOwnership(contractAdminAcc.Address, contractAddr)
+			},
+			expectError: true,
+			errorType:   sdkErrors.Wrap(rewardstypes.ErrUnauthorized, "ownership can only be accepted by the pending owner"),
+		},
+		{
+			testCase: "ok: all good",
+			prepare: func() *rewardstypes.MsgAcceptContractMetadataOwnership {
+				return rewardstypes.NewMsgAcceptContractMetadataOwnership(otherAcc.Address, contractAddr)
+			},
+			expectError: false,
+			errorType:   nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case: %s", tc.testCase), func() {
+			req := tc.prepare()
+			res, err := server.AcceptContractMetadataOwnership(sdk.WrapSDKContext(ctx), req)
+			if tc.expectError {
+				s.Require().Error(err)
+				s.Require().Equal(tc.errorType.Error(), err.Error())
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(&rewardstypes.MsgAcceptContractMetadataOwnershipResponse{}, res)
+			}
+		})
+	}
+}
+
 func (s *KeeperTestSuite) TestMsgServer_WithdrawRewards() {
 	ctx, k := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
 	acc := s.chain.GetAccount(0).Address
@@ -227,3 +308,78 @@ func (s *KeeperTestSuite) TestMsgServer_WithdrawRewards() {
 		})
 	}
 }
+
+func (s *KeeperTestSuite) TestMsgServer_SetCodeMetadata() {
+	ctx, k := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+	codeCreatorAcc, otherAcc := s.chain.GetAccount(0), s.chain.GetAccount(1)
+	contractViewer := testutils.NewMockContractViewer()
+	k.SetContractInfoViewer(contractViewer)
+	contractViewer.AddCodeCreator(1, codeCreatorAcc.Address.String())
+
+	server := keeper.NewMsgServer(k)
+
+	testCases := []struct {
+		testCase    string
+		prepare     func() *rewardstypes.MsgSetCodeMetadata
+		expectError bool
+		errorType   error
+	}{
+		{
+			testCase: "err: empty request",
+			prepare: func() *rewardstypes.MsgSetCodeMetadata {
+				return nil
+			},
+			expectError: true,
+			errorType:   status.Error(codes.InvalidArgument, "empty request"),
+		},
+		{
+			testCase: "err: invalid sender address",
+			prepare: func() *rewardstypes.MsgSetCodeMetadata {
+				return &rewardstypes.MsgSetCodeMetadata{
+					SenderAddress: "👻",
+					Metadata:      rewardstypes.CodeMetadata{CodeId: 1},
+				}
+			},
+			expectError: true,
+			errorType:   fmt.Errorf("decoding bech32 failed: invalid bech32 string length 4"),
+		},
+		{
+			testCase: "err: code does not exist",
+			prepare: func() *rewardstypes.MsgSetCodeMetadata {
+				return rewardstypes.NewMsgSetCodeMetadata(codeCreatorAcc.Address, 2, &codeCreatorAcc.Address, nil)
+			},
+			expectError: true,
+			errorType:   rewardstypes.ErrCodeNotFound,
+		},
+		{
+			testCase: "err: the message sender is not the code creator",
+			prepare: func() *rewardstypes.MsgSetCodeMetadata {
+				return rewardstypes.NewMsgSetCodeMetadata(otherAcc.Address, 1, &otherAcc.Address, nil)
+			},
+			expectError: true,
+			errorType:   sdkErrors.Wrap(rewardstypes.ErrUnauthorized, "code metadata can only be set by the code creator"),
+		},
+		{
+			testCase: "ok: all good",
+			prepare: func() *rewardstypes.MsgSetCodeMetadata {
+				return rewardstypes.NewMsgSetCodeMetadata(codeCreatorAcc.Address, 1, &otherAcc.Address, nil)
+			},
+			expectError: false,
+			errorType:   nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(fmt.Sprintf("Case: %s", tc.testCase), func() {
+			req := tc.prepare()
+			res, err := server.SetCodeMetadata(sdk.WrapSDKContext(ctx), req)
+			if tc.expectError {
+				s.Require().Error(err)
+				s.Require().Equal(tc.errorType.Error(), err.Error())
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(&rewardstypes.MsgSetCodeMetadataResponse{}, res)
+			}
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/archway-network/archway/x/rewards/types"
@@ -24,12 +26,131 @@ func (k Keeper) MaxWithdrawRecords(ctx sdk.Context) (res uint64) {
 	return
 }
 
+// RewardsRecordExpiry return the period after which a not withdrawn types.RewardsRecord expires (0 if disabled).
+func (k Keeper) RewardsRecordExpiry(ctx sdk.Context) (res time.Duration) {
+	k.paramStore.Get(ctx, types.RecordExpiryParamKey, &res)
+	return
+}
+
+// MaxExpiredRecordsPerBlock return the maximum number of expired types.RewardsRecord objects swept by the EndBlocker per block.
+func (k Keeper) MaxExpiredRecordsPerBlock(ctx sdk.Context) (res uint64) {
+	k.paramStore.Get(ctx, types.MaxExpiredRecordsParamKey, &res)
+	return
+}
+
+// MaxAutoPayoutsPerBlock return the maximum number of types.AutoPayout entries checked by the EndBlocker per block (0 if disabled).
+func (k Keeper) MaxAutoPayoutsPerBlock(ctx sdk.Context) (res uint64) {
+	k.paramStore.Get(ctx, types.MaxAutoPayoutsParamKey, &res)
+	return
+}
+
+// ContractInflationShareCap return the maximum share of block inflation rewards a single contract can receive.
+func (k Keeper) ContractInflationShareCap(ctx sdk.Context) (res sdk.Dec) {
+	k.paramStore.Get(ctx, types.InflationShareCapParamKey, &res)
+	return
+}
+
+// ContractInflationEpochCap return the maximum amount of inflation rewards a single contract can receive per epoch (0 if not capped).
+func (k Keeper) ContractInflationEpochCap(ctx sdk.Context) (res sdk.Int) {
+	k.paramStore.Get(ctx, types.InflationEpochCapParamKey, &res)
+	return
+}
+
+// InflationCapEpochBlocks return the epoch length (in blocks) for the ContractInflationEpochCap param.
+func (k Keeper) InflationCapEpochBlocks(ctx sdk.Context) (res uint64) {
+	k.paramStore.Get(ctx, types.InflationCapEpochParamKey, &res)
+	return
+}
+
+// SelfDealingPolicy return the contract inflation rewards policy for self-dealing transactions.
+func (k Keeper) SelfDealingPolicy(ctx sdk.Context) (res types.SelfDealingPolicy) {
+	k.paramStore.Get(ctx, types.SelfDealingPolicyParamKey, &res)
+	return
+}
+
+// ContractOperationWeights return the gas weights per contract operation type.
+func (k Keeper) ContractOperationWeights(ctx sdk.Context) (res []types.ContractOperationWeight) {
+	k.paramStore.Get(ctx, types.OperationWeightsParamKey, &res)
+	return
+}
+
+// AcceptedFeeDenoms return the alternative denoms accepted for the minimum consensus fee.
+func (k Keeper) AcceptedFeeDenoms(ctx sdk.Context) (res []types.FeeDenom) {
+	k.paramStore.Get(ctx, types.AcceptedFeeDenomsParamKey, &res)
+	return
+}
+
+// MinConsensusFeeMode return the minimum consensus fee update mode.
+func (k Keeper) MinConsensusFeeMode(ctx sdk.Context) (res types.MinConsensusFeeMode) {
+	k.paramStore.Get(ctx, types.MinConsFeeModeParamKey, &res)
+	return
+}
+
+// MinConsensusFeeWindow return the minimum consensus fee smoothing window (in blocks).
+func (k Keeper) MinConsensusFeeWindow(ctx sdk.Context) (res uint64) {
+	k.paramStore.Get(ctx, types.MinConsFeeWindowParamKey, &res)
+	return
+}
+
+// MinConsensusFeeHistoryBlocks return the number of blocks the minimum consensus fee history is kept for (0 if disabled).
+func (k Keeper) MinConsensusFeeHistoryBlocks(ctx sdk.Context) (res uint64) {
+	k.paramStore.Get(ctx, types.MinConsFeeHistoryParamKey, &res)
+	return
+}
+
+// BaseFeeMaxChangeRate return the maximum base fee change per block (0 if the base fee is disabled).
+func (k Keeper) BaseFeeMaxChangeRate(ctx sdk.Context) (res sdk.Dec) {
+	k.paramStore.Get(ctx, types.BaseFeeChangeRateParamKey, &res)
+	return
+}
+
+// BaseFeeTargetUtilization return the target block gas limit utilization for the base fee.
+func (k Keeper) BaseFeeTargetUtilization(ctx sdk.Context) (res sdk.Dec) {
+	k.paramStore.Get(ctx, types.BaseFeeTargetParamKey, &res)
+	return
+}
+
+// BaseFeeBurnRatio return the share of the base fee paid by a transaction that is burned.
+func (k Keeper) BaseFeeBurnRatio(ctx sdk.Context) (res sdk.Dec) {
+	k.paramStore.Get(ctx, types.BaseFeeBurnRatioParamKey, &res)
+	return
+}
+
+// TxPriorityTiers return the mempool priority rules for transactions.
+func (k Keeper) TxPriorityTiers(ctx sdk.Context) (res []types.TxPriorityTier) {
+	k.paramStore.Get(ctx, types.TxPriorityTiersParamKey, &res)
+	return
+}
+
+// UnusedGasRefundRatio return the share of fees paid for the unused transaction gas that is refunded.
+func (k Keeper) UnusedGasRefundRatio(ctx sdk.Context) (res sdk.Dec) {
+	k.paramStore.Get(ctx, types.GasRefundRatioParamKey, &res)
+	return
+}
+
 // GetParams return all module parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 	return types.NewParams(
 		k.InflationRewardsRatio(ctx),
 		k.TxFeeRebateRatio(ctx),
 		k.MaxWithdrawRecords(ctx),
+		k.RewardsRecordExpiry(ctx),
+		k.MaxExpiredRecordsPerBlock(ctx),
+		k.MaxAutoPayoutsPerBlock(ctx),
+		k.ContractInflationShareCap(ctx),
+		k.ContractInflationEpochCap(ctx),
+		k.InflationCapEpochBlocks(ctx),
+		k.SelfDealingPolicy(ctx),
+		k.ContractOperationWeights(ctx),
+		k.AcceptedFeeDenoms(ctx),
+		k.MinConsensusFeeMode(ctx),
+		k.MinConsensusFeeWindow(ctx),
+		k.MinConsensusFeeHistoryBlocks(ctx),
+		k.BaseFeeMaxChangeRate(ctx),
+		k.BaseFeeTargetUtilization(ctx),
+		k.BaseFeeBurnRatio(ctx),
+		k.TxPriorityTiers(ctx),
+		k.UnusedGasRefundRatio(ctx),
 	)
 }
 
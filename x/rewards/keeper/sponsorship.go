@@ -0,0 +1,231 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// SetSponsorship creates or updates the contract sponsorship limits verifying the ownership.
+// Method is authorized to the contract metadata owner and the contract itself (WASM bindings).
+// Empty limits disable the sponsorship, the user fee limit is reset every userFeeLimitPeriod blocks.
+func (k Keeper) SetSponsorship(ctx sdk.Context, senderAddr, contractAddr sdk.AccAddress, userFeeLimit sdk.Coins, userFeeLimitPeriod uint64, blockFeeLimit sdk.Coins, fundFromRewards bool) error {
+	// Check ownership
+	if err := k.checkSponsorshipOwnership(ctx, senderAddr, contractAddr); err != nil {
+		return err
+	}
+
+	// Create / update
+	state := k.state.Sponsorship(ctx)
+	sponsorship, found := state.GetSponsorship(contractAddr)
+	if !found {
+		sponsorship = types.Sponsorship{
+			ContractAddress: contractAddr.String(),
+			Deposit:         sdk.NewCoins(),
+			BlockFees:       sdk.NewCoins(),
+		}
+	}
+	sponsorship.UserFeeLimit = userFeeLimit
+	sponsorship.UserFeeLimitPeriod = userFeeLimitPeriod
+	sponsorship.BlockFeeLimit = blockFeeLimit
+	sponsorship.FundFromRewards = fundFromRewards
+
+	state.SetSponsorship(sponsorship)
+
+	// Emit event
+	types.EmitSponsorshipSetEvent(ctx, sponsorship)
+
+	return nil
+}
+
+// DepositSponsorship transfers funds from the sender to the existing contract sponsorship deposit.
+func (k Keeper) DepositSponsorship(ctx sdk.Context, senderAddr, contractAddr sdk.AccAddress, amount sdk.Coins) error {
+	state := k.state.Sponsorship(ctx)
+	sponsorship, found := state.GetSponsorship(contractAddr)
+	if !found {
+		return types.ErrSponsorshipNotFound
+	}
+
+	// Transfer funds (the module account is created on the first deposit)
+	k.authKeeper.GetModuleAccount(ctx, types.SponsorshipCollector)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, types.SponsorshipCollector, amount); err != nil {
+		return err
+	}
+
+	sponsorship.Deposit = sponsorship.Deposit.Add(amount...)
+	state.SetSponsorship(sponsorship)
+
+	// Emit event
+	types.EmitSponsorshipDepositEvent(ctx, contractAddr, senderAddr, amount)
+
+	return nil
+}
+
+// WithdrawSponsorship transfers funds from the contract sponsorship deposit to the sender verifying the ownership.
+func (k Keeper) WithdrawSponsorship(ctx sdk.Context, senderAddr, contractAddr sdk.AccAddress, amount sdk.Coins) error {
+	// Check ownership
+	if err := k.checkSponsorshipOwnership(ctx, senderAddr, contractAddr); err != nil {
+		return err
+	}
+
+	state := k.state.Sponsorship(ctx)
+	sponsorship, found := state.GetSponsorship(contractAddr)
+	if !found {
+		return types.ErrSponsorshipNotFound
+	}
+	if !sponsorship.Deposit.IsAllGTE(amount) {
+		return sdkErrors.Wrapf(types.ErrInsufficientSponsorship, "deposit %s is less than the withdraw amount %s", sponsorship.Deposit, amount)
+	}
+
+	// Transfer funds
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.SponsorshipCollector, senderAddr, amount); err != nil {
+		return err
+	}
+
+	sponsorship.Deposit = sponsorship.Deposit.Sub(amount)
+	state.SetSponsorship(sponsorship)
+
+	// Emit event
+	types.EmitSponsorshipWithdrawEvent(ctx, contractAddr, senderAddr, amount)
+
+	return nil
+}
+
+// GetSponsorship returns the contract sponsorship (if set).
+func (k Keeper) GetSponsorship(ctx sdk.Context, contractAddr sdk.AccAddress) (types.Sponsorship, bool) {
+	return k.state.Sponsorship(ctx).GetSponsorship(contractAddr)
+}
+
+// GetSponsoredUserFees returns the amount of fees sponsored by the contract for the user within the current user fee limit period.
+func (k Keeper) GetSponsoredUserFees(ctx sdk.Context, contractAddr, userAddr sdk.AccAddress) sdk.Coins {
+	state := k.state.Sponsorship(ctx)
+	sponsorship, found := state.GetSponsorship(contractAddr)
+	if !found || sponsorship.UserFeeLimitPeriod == 0 {
+		return sdk.NewCoins()
+	}
+
+	return state.GetUserFees(contractAddr, userAddr, sponsorship.UserFeeLimitPeriodAt(ctx.BlockHeight()))
+}
+
+// UseSponsorship reserves the transaction fees from the contract sponsorship deposit checking the user and the block limits.
+// The sponsorship is disabled if any of the limits is empty. The user limit applies within the current user fee limit period.
+// Reserved funds are kept by the SponsorshipCollector module account, so the caller must deduct fees from it.
+// The contract is tracked as the transaction fee payer: gas used is treated as self-dealing and unused gas refunds
+// are returned to the sponsorship deposit.
+// State is not modified if an error is returned.
+func (k Keeper) UseSponsorship(ctx sdk.Context, contractAddr, userAddr sdk.AccAddress, fees sdk.Coins) error {
+	state := k.state.Sponsorship(ctx)
+	sponsorship, found := state.GetSponsorship(contractAddr)
+	if !found {
+		return types.ErrSponsorshipNotFound
+	}
+
+	// Check limits
+	if !sponsorship.IsEnabled() {
+		return sdkErrors.Wrap(types.ErrInsufficientSponsorship, "sponsorship is disabled (limits are not set)")
+	}
+
+	if !sponsorship.Deposit.IsAllGTE(fees) {
+		return sdkErrors.Wrapf(types.ErrInsufficientSponsorship, "deposit %s is less than fees %s", sponsorship.Deposit, fees)
+	}
+
+	period := sponsorship.UserFeeLimitPeriodAt(ctx.BlockHeight())
+	userFees := state.GetUserFees(contractAddr, userAddr, period).Add(fees...)
+	if !sponsorship.UserFeeLimit.IsAllGTE(userFees) {
+		return sdkErrors.Wrapf(types.ErrInsufficientSponsorship, "user fees %s exceed the user limit %s", userFees, sponsorship.UserFeeLimit)
+	}
+
+	blockFees := sponsorship.BlockFeesAt(ctx.BlockHeight()).Add(fees...)
+	if !sponsorship.BlockFeeLimit.IsAllGTE(blockFees) {
+		return sdkErrors.Wrapf(types.ErrInsufficientSponsorship, "block fees %s exceed the block limit %s", blockFees, sponsorship.BlockFeeLimit)
+	}
+
+	// Update the usage
+	sponsorship.Deposit = sponsorship.Deposit.Sub(fees)
+	sponsorship.BlockHeight = ctx.BlockHeight()
+	sponsorship.BlockFees = blockFees
+	state.SetSponsorship(sponsorship)
+	state.AddUserFees(contractAddr, userAddr, period, fees)
+
+	k.trackingKeeper.TrackTxFeePayer(ctx, contractAddr, fees)
+
+	// Emit event
+	types.EmitTxFeeSponsoredEvent(ctx, contractAddr, userAddr, fees)
+
+	return nil
+}
+
+// GetPledgedSponsorship returns the contract sponsorship if the contract rewards are pledged to its deposit.
+func (k Keeper) GetPledgedSponsorship(ctx sdk.Context, contractAddr sdk.AccAddress) (types.Sponsorship, bool) {
+	sponsorship, found := k.state.Sponsorship(ctx).GetSponsorship(contractAddr)
+	if !found || !sponsorship.FundFromRewards {
+		return types.Sponsorship{}, false
+	}
+
+	return sponsorship, true
+}
+
+// pledgeSponsorshipRewards transfers the contract rewards to its sponsorship deposit.
+// CONTRACT: rewards tokens must be held by the ContractRewardCollector module account.
+func (k Keeper) pledgeSponsorshipRewards(ctx sdk.Context, sponsorship types.Sponsorship, rewards sdk.Coins) {
+	k.authKeeper.GetModuleAccount(ctx, types.SponsorshipCollector)
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ContractRewardCollector, types.SponsorshipCollector, rewards); err != nil {
+		panic(fmt.Errorf("failed to transfer pledged rewards (%s) to %s: %w", rewards, types.SponsorshipCollector, err))
+	}
+
+	sponsorship.Deposit = sponsorship.Deposit.Add(rewards...)
+	k.state.Sponsorship(ctx).SetSponsorship(sponsorship)
+
+	contractAddr := sponsorship.MustGetContractAddress()
+	types.EmitSponsorshipDepositEvent(ctx, contractAddr, contractAddr, rewards)
+}
+
+// refundSponsorship returns the unused gas refund of a sponsored transaction to the contract sponsorship deposit.
+func (k Keeper) refundSponsorship(ctx sdk.Context, contractAddr sdk.AccAddress, rewardsRefund, feeCollectorRefund sdk.Coins) error {
+	state := k.state.Sponsorship(ctx)
+	sponsorship, found := state.GetSponsorship(contractAddr)
+	if !found {
+		return types.ErrSponsorshipNotFound
+	}
+
+	k.authKeeper.GetModuleAccount(ctx, types.SponsorshipCollector)
+	if !rewardsRefund.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ContractRewardCollector, types.SponsorshipCollector, rewardsRefund); err != nil {
+			return err
+		}
+	}
+	if !feeCollectorRefund.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authTypes.FeeCollectorName, types.SponsorshipCollector, feeCollectorRefund); err != nil {
+			return err
+		}
+	}
+
+	refund := rewardsRefund.Add(feeCollectorRefund...)
+	sponsorship.Deposit = sponsorship.Deposit.Add(refund...)
+	state.SetSponsorship(sponsorship)
+
+	types.EmitSponsorshipDepositEvent(ctx, contractAddr, contractAddr, refund)
+
+	return nil
+}
+
+// checkSponsorshipOwnership checks that the sender is the contract itself or the contract metadata owner.
+func (k Keeper) checkSponsorshipOwnership(ctx sdk.Context, senderAddr, contractAddr sdk.AccAddress) error {
+	if senderAddr.Equals(contractAddr) {
+		return nil
+	}
+
+	meta, found := k.state.ContractMetadataState(ctx).GetContractMetadata(contractAddr)
+	if !found {
+		return types.ErrMetadataNotFound
+	}
+	if meta.OwnerAddress != senderAddr.String() {
+		return sdkErrors.Wrap(types.ErrUnauthorized, "sponsorship can only be managed by the contract owner or the contract itself")
+	}
+
+	return nil
+}
@@ -0,0 +1,209 @@
+package keeper_test
+
+import (
+	wasmdTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	mintTypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/pkg/testutils"
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
+func (s *KeeperTestSuite) TestSponsorship() {
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+	contractAdminAcc, otherAcc, userAcc := s.chain.GetAccount(0), s.chain.GetAccount(1), s.chain.GetAccount(2)
+
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetContractInfoViewer(contractViewer)
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, amount))
+	}
+
+	s.Run("Fail: metadata not found", func() {
+		err := keeper.SetSponsorship(ctx, contractAdminAcc.Address, contractAddr, nil, 0, nil, false)
+		s.Assert().ErrorIs(err, rewardsTypes.ErrMetadataNotFound)
+	})
+
+	s.Run("Fail: deposit to a non-existing sponsorship", func() {
+		err := keeper.DepositSponsorship(ctx, otherAcc.Address, contractAddr, coins(100))
+		s.Assert().ErrorIs(err, rewardsTypes.ErrSponsorshipNotFound)
+	})
+
+	contractViewer.AddContractAdmin(contractAddr.String(), contractAdminAcc.Address.String())
+	s.Require().NoError(keeper.SetContractMetadata(ctx, contractAdminAcc.Address, contractAddr, rewardsTypes.ContractMetadata{}))
+
+	s.Run("Fail: not a contract owner", func() {
+		err := keeper.SetSponsorship(ctx, otherAcc.Address, contractAddr, nil, 0, nil, false)
+		s.Assert().ErrorIs(err, rewardsTypes.ErrUnauthorized)
+	})
+
+	s.Run("OK: set sponsorship by the contract itself", func() {
+		s.Require().NoError(keeper.SetSponsorship(ctx, contractAddr, contractAddr, nil, 0, nil, false))
+
+		sponsorship, found := keeper.GetSponsorship(ctx, contractAddr)
+		s.Require().True(found)
+		s.Assert().True(sponsorship.Deposit.Empty())
+	})
+
+	s.Run("Fail: sponsorship is disabled (limits are not set)", func() {
+		err := keeper.UseSponsorship(ctx, contractAddr, userAcc.Address, sdk.NewCoins())
+		s.Assert().ErrorIs(err, rewardsTypes.ErrInsufficientSponsorship)
+	})
+
+	s.Run("OK: set sponsorship limits by the owner", func() {
+		s.Require().NoError(keeper.SetSponsorship(ctx, contractAdminAcc.Address, contractAddr, coins(30), 1000, coins(50), false))
+
+		sponsorship, found := keeper.GetSponsorship(ctx, contractAddr)
+		s.Require().True(found)
+		s.Assert().Equal(coins(30).String(), sponsorship.UserFeeLimit.String())
+		s.Assert().EqualValues(1000, sponsorship.UserFeeLimitPeriod)
+		s.Assert().Equal(coins(50).String(), sponsorship.BlockFeeLimit.String())
+	})
+
+	s.Run("OK: deposit by anyone", func() {
+		balanceBefore := s.chain.GetBalance(otherAcc.Address)
+
+		s.Require().NoError(keeper.DepositSponsorship(ctx, otherAcc.Address, contractAddr, coins(100)))
+
+		sponsorship, _ := keeper.GetSponsorship(ctx, contractAddr)
+		s.Assert().Equal(coins(100).String(), sponsorship.Deposit.String())
+		s.Assert().Equal(coins(100).String(), keeper.SponsorshipPool(ctx).String())
+		s.Assert().Equal(coins(100).String(), balanceBefore.Sub(s.chain.GetBalance(otherAcc.Address)).String())
+	})
+
+	s.Run("Fail: withdraw by not a contract owner", func() {
+		err := keeper.WithdrawSponsorship(ctx, otherAcc.Address, contractAddr, coins(10))
+		s.Assert().ErrorIs(err, rewardsTypes.ErrUnauthorized)
+	})
+
+	s.Run("Fail: withdraw more than deposited", func() {
+		err := keeper.WithdrawSponsorship(ctx, contractAdminAcc.Address, contractAddr, coins(101))
+		s.Assert().ErrorIs(err, rewardsTypes.ErrInsufficientSponsorship)
+	})
+
+	s.Run("OK: withdraw by the owner", func() {
+		balanceBefore := s.chain.GetBalance(contractAdminAcc.Address)
+
+		s.Require().NoError(keeper.WithdrawSponsorship(ctx, contractAdminAcc.Address, contractAddr, coins(10)))
+
+		sponsorship, _ := keeper.GetSponsorship(ctx, contractAddr)
+		s.Assert().Equal(coins(90).String(), sponsorship.Deposit.String())
+		s.Assert().Equal(coins(10).String(), s.chain.GetBalance(contractAdminAcc.Address).Sub(balanceBefore).String())
+	})
+
+	s.Run("OK: use sponsorship", func() {
+		s.Require().NoError(keeper.UseSponsorship(ctx, contractAddr, userAcc.Address, coins(20)))
+
+		sponsorship, _ := keeper.GetSponsorship(ctx, contractAddr)
+		s.Assert().Equal(coins(70).String(), sponsorship.Deposit.String())
+		s.Assert().Equal(coins(20).String(), sponsorship.BlockFeesAt(ctx.BlockHeight()).String())
+		s.Assert().Equal(coins(20).String(), keeper.GetSponsoredUserFees(ctx, contractAddr, userAcc.Address).String())
+	})
+
+	s.Run("Fail: user limit exceeded (state is not changed)", func() {
+		err := keeper.UseSponsorship(ctx, contractAddr, userAcc.Address, coins(11))
+		s.Assert().ErrorIs(err, rewardsTypes.ErrInsufficientSponsorship)
+
+		sponsorship, _ := keeper.GetSponsorship(ctx, contractAddr)
+		s.Assert().Equal(coins(70).String(), sponsorship.Deposit.String())
+		s.Assert().Equal(coins(20).String(), sponsorship.BlockFeesAt(ctx.BlockHeight()).String())
+		s.Assert().Equal(coins(20).String(), keeper.GetSponsoredUserFees(ctx, contractAddr, userAcc.Address).String())
+	})
+
+	s.Run("Fail: block limit exceeded", func() {
+		s.Require().NoError(keeper.UseSponsorship(ctx, contractAddr, otherAcc.Address, coins(30)))
+
+		err := keeper.UseSponsorship(ctx, contractAddr, contractAdminAcc.Address, coins(1))
+		s.Assert().ErrorIs(err, rewardsTypes.ErrInsufficientSponsorship)
+	})
+
+	s.Run("OK: block limit is reset for the next block", func() {
+		nextBlockCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+		s.Require().NoError(keeper.UseSponsorship(nextBlockCtx, contractAddr, contractAdminAcc.Address, coins(30)))
+
+		sponsorship, _ := keeper.GetSponsorship(nextBlockCtx, contractAddr)
+		s.Assert().Equal(coins(10).String(), sponsorship.Deposit.String())
+		s.Assert().Equal(coins(30).String(), sponsorship.BlockFeesAt(nextBlockCtx.BlockHeight()).String())
+	})
+
+	s.Run("Fail: deposit is insufficient", func() {
+		nextBlockCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 2)
+		err := keeper.UseSponsorship(nextBlockCtx, contractAddr, userAcc.Address, coins(11))
+		s.Assert().ErrorIs(err, rewardsTypes.ErrInsufficientSponsorship)
+	})
+
+	s.Run("OK: user limit is reset for the next period", func() {
+		s.Require().NoError(keeper.DepositSponsorship(ctx, otherAcc.Address, contractAddr, coins(20)))
+
+		err := keeper.UseSponsorship(ctx, contractAddr, userAcc.Address, coins(11))
+		s.Assert().ErrorIs(err, rewardsTypes.ErrInsufficientSponsorship)
+
+		nextPeriodCtx := ctx.WithBlockHeight((ctx.BlockHeight()/1000 + 1) * 1000)
+		s.Require().NoError(keeper.UseSponsorship(nextPeriodCtx, contractAddr, userAcc.Address, coins(11)))
+
+		sponsorship, _ := keeper.GetSponsorship(nextPeriodCtx, contractAddr)
+		s.Assert().Equal(coins(19).String(), sponsorship.Deposit.String())
+		s.Assert().Equal(coins(11).String(), keeper.GetSponsoredUserFees(nextPeriodCtx, contractAddr, userAcc.Address).String())
+	})
+
+	s.Run("Fail: sponsorship is disabled (limits are removed)", func() {
+		s.Require().NoError(keeper.SetSponsorship(ctx, contractAdminAcc.Address, contractAddr, nil, 0, nil, false))
+
+		nextBlockCtx := ctx.WithBlockHeight(ctx.BlockHeight() + 3)
+		err := keeper.UseSponsorship(nextBlockCtx, contractAddr, userAcc.Address, coins(1))
+		s.Assert().ErrorIs(err, rewardsTypes.ErrInsufficientSponsorship)
+
+		sponsorship, _ := keeper.GetSponsorship(ctx, contractAddr)
+		s.Assert().Equal(coins(19).String(), sponsorship.Deposit.String())
+	})
+
+	s.Run("Fail: sponsorship not found", func() {
+		err := keeper.UseSponsorship(ctx, e2eTesting.GenContractAddresses(2)[1], userAcc.Address, coins(1))
+		s.Assert().ErrorIs(err, rewardsTypes.ErrSponsorshipNotFound)
+	})
+}
+
+func (s *KeeperTestSuite) TestSponsorshipFundFromRewards() {
+	ctx := s.chain.GetContext()
+	rKeeper, tKeeper := s.chain.GetApp().RewardsKeeper, s.chain.GetApp().TrackingKeeper
+	contractAdminAcc, rewardsAcc := s.chain.GetAccount(0), s.chain.GetAccount(1)
+
+	contractViewer := testutils.NewMockContractViewer()
+	rKeeper.SetContractInfoViewer(contractViewer)
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	feeRewards := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	// Create metadata and pledge the contract rewards
+	contractViewer.AddContractAdmin(contractAddr.String(), contractAdminAcc.Address.String())
+	s.Require().NoError(rKeeper.SetContractMetadata(ctx, contractAdminAcc.Address, contractAddr, rewardsTypes.ContractMetadata{
+		RewardsAddress: rewardsAcc.Address.String(),
+	}))
+	s.Require().NoError(rKeeper.SetSponsorship(ctx, contractAdminAcc.Address, contractAddr, nil, 0, nil, true))
+
+	// Emulate a tx with the contract gas consumption and fee rebate rewards
+	tKeeper.TrackNewTx(ctx)
+	s.Require().NoError(tKeeper.IngestGasRecord(ctx, []wasmdTypes.ContractGasRecord{
+		{
+			OperationId:     testutils.GetRandomContractOperationType(),
+			ContractAddress: contractAddr.String(),
+			OriginalGas:     wasmdTypes.GasConsumptionInfo{SDKGas: 100},
+		},
+	}))
+	rKeeper.TrackFeeRebatesRewards(ctx, feeRewards, nil, false)
+	s.Require().NoError(s.chain.GetApp().MintKeeper.MintCoins(ctx, feeRewards))
+	s.Require().NoError(s.chain.GetApp().BankKeeper.SendCoinsFromModuleToModule(ctx, mintTypes.ModuleName, rewardsTypes.ContractRewardCollector, feeRewards))
+
+	s.chain.NextBlock(0)
+	ctx = s.chain.GetContext()
+
+	// Rewards (fee rebates and inflation) are deposited instead of creating records
+	sponsorship, found := rKeeper.GetSponsorship(ctx, contractAddr)
+	s.Require().True(found)
+	s.Assert().True(sponsorship.Deposit.IsAllGTE(feeRewards))
+	s.Assert().Equal(sponsorship.Deposit.String(), rKeeper.SponsorshipPool(ctx).String())
+	s.Assert().Empty(rKeeper.GetState().RewardsRecord(ctx).GetRewardsRecordByRewardsAddress(rewardsAcc.Address))
+}
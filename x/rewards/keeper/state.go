@@ -79,6 +79,66 @@ func (s State) RewardsRecord(ctx sdk.Context) RewardsRecordState {
 	}
 }
 
+// FlatFee returns the contract flat fee repository.
+func (s State) FlatFee(ctx sdk.Context) FlatFeeState {
+	baseStore := ctx.KVStore(s.key)
+	return FlatFeeState{
+		stateStore: prefix.NewStore(baseStore, types.FlatFeeStatePrefix),
+		cdc:        s.cdc,
+		ctx:        ctx,
+	}
+}
+
+// TreasuryOperation returns types.TreasuryOperation repository.
+func (s State) TreasuryOperation(ctx sdk.Context) TreasuryOperationState {
+	baseStore := ctx.KVStore(s.key)
+	return TreasuryOperationState{
+		stateStore: prefix.NewStore(baseStore, types.TreasuryOperationStatePrefix),
+		cdc:        s.cdc,
+		ctx:        ctx,
+	}
+}
+
+// CodeMetadataState returns types.CodeMetadata repository.
+func (s State) CodeMetadataState(ctx sdk.Context) CodeMetadataState {
+	baseStore := ctx.KVStore(s.key)
+	return CodeMetadataState{
+		stateStore: prefix.NewStore(baseStore, types.CodeMetadataStatePrefix),
+		cdc:        s.cdc,
+		ctx:        ctx,
+	}
+}
+
+// AutoPayout returns types.AutoPayout repository.
+func (s State) AutoPayout(ctx sdk.Context) AutoPayoutState {
+	baseStore := ctx.KVStore(s.key)
+	return AutoPayoutState{
+		stateStore: prefix.NewStore(baseStore, types.AutoPayoutStatePrefix),
+		cdc:        s.cdc,
+		ctx:        ctx,
+	}
+}
+
+// ContractInflationUsage returns types.ContractInflationUsage repository.
+func (s State) ContractInflationUsage(ctx sdk.Context) ContractInflationUsageState {
+	baseStore := ctx.KVStore(s.key)
+	return ContractInflationUsageState{
+		stateStore: prefix.NewStore(baseStore, types.ContractInflationUsageStatePrefix),
+		cdc:        s.cdc,
+		ctx:        ctx,
+	}
+}
+
+// Sponsorship returns types.Sponsorship and types.SponsorshipUsage repository.
+func (s State) Sponsorship(ctx sdk.Context) SponsorshipState {
+	baseStore := ctx.KVStore(s.key)
+	return SponsorshipState{
+		stateStore: prefix.NewStore(baseStore, types.SponsorshipStatePrefix),
+		cdc:        s.cdc,
+		ctx:        ctx,
+	}
+}
+
 // GetState returns the module storage state.
 // Only for testing purposes.
 func (k Keeper) GetState() State {
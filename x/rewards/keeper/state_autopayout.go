@@ -0,0 +1,148 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storeTypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// AutoPayoutState provides access to the types.AutoPayout objects storage operations.
+type AutoPayoutState struct {
+	stateStore storeTypes.KVStore
+	cdc        codec.Codec
+	ctx        sdk.Context
+}
+
+// SetAutoPayout creates or modifies a types.AutoPayout object.
+func (s AutoPayoutState) SetAutoPayout(obj types.AutoPayout) {
+	store := prefix.NewStore(s.stateStore, types.AutoPayoutPrefix)
+	store.Set(
+		s.buildAutoPayoutKey(obj.MustGetContractAddress()),
+		s.cdc.MustMarshal(&obj),
+	)
+}
+
+// GetAutoPayout returns a types.AutoPayout object by contract address.
+func (s AutoPayoutState) GetAutoPayout(contractAddr sdk.AccAddress) (types.AutoPayout, bool) {
+	store := prefix.NewStore(s.stateStore, types.AutoPayoutPrefix)
+
+	bz := store.Get(s.buildAutoPayoutKey(contractAddr))
+	if bz == nil {
+		return types.AutoPayout{}, false
+	}
+
+	var obj types.AutoPayout
+	s.cdc.MustUnmarshal(bz, &obj)
+
+	return obj, true
+}
+
+// DeleteAutoPayout removes a types.AutoPayout object.
+func (s AutoPayoutState) DeleteAutoPayout(contractAddr sdk.AccAddress) {
+	store := prefix.NewStore(s.stateStore, types.AutoPayoutPrefix)
+	store.Delete(s.buildAutoPayoutKey(contractAddr))
+}
+
+// GetAutoPayoutsBatch returns up to limit types.AutoPayout objects starting from the current cursor position
+// and moves the cursor to the next unprocessed object.
+// Iteration wraps around the end of the collection, an object is returned only once per batch.
+func (s AutoPayoutState) GetAutoPayoutsBatch(limit uint64) []types.AutoPayout {
+	if limit == 0 {
+		return nil
+	}
+
+	store := prefix.NewStore(s.stateStore, types.AutoPayoutPrefix)
+	cursor := s.getCursor()
+
+	var objs []types.AutoPayout
+	var nextCursor []byte
+	collect := func(start, end []byte) (limitReached bool) {
+		iterator := store.Iterator(start, end)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			if uint64(len(objs)) == limit {
+				nextCursor = append([]byte{}, iterator.Key()...)
+				return true
+			}
+
+			var obj types.AutoPayout
+			s.cdc.MustUnmarshal(iterator.Value(), &obj)
+
+			objs = append(objs, obj)
+		}
+
+		return false
+	}
+
+	if !collect(cursor, nil) && cursor != nil {
+		collect(nil, cursor)
+	}
+	s.setCursor(nextCursor)
+
+	return objs
+}
+
+// SetBatchCursor moves the batch cursor to the types.AutoPayout object of the contract,
+// so the next batch starts from it (used to continue an unfinished batch).
+func (s AutoPayoutState) SetBatchCursor(contractAddr sdk.AccAddress) {
+	s.setCursor(s.buildAutoPayoutKey(contractAddr))
+}
+
+// Import initializes state from the module genesis data.
+func (s AutoPayoutState) Import(objs []types.AutoPayout) {
+	for _, obj := range objs {
+		s.SetAutoPayout(obj)
+	}
+}
+
+// Export returns the module genesis data for the state.
+func (s AutoPayoutState) Export() (objs []types.AutoPayout) {
+	store := prefix.NewStore(s.stateStore, types.AutoPayoutPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var obj types.AutoPayout
+		s.cdc.MustUnmarshal(iterator.Value(), &obj)
+
+		objs = append(objs, obj)
+	}
+
+	return
+}
+
+// getCursor returns the key to continue the batch iteration from (nil to start from the beginning).
+func (s AutoPayoutState) getCursor() []byte {
+	bz := s.stateStore.Get(types.AutoPayoutCursorKey)
+	if bz == nil {
+		return nil
+	}
+
+	if err := sdk.VerifyAddressFormat(bz); err != nil {
+		panic(fmt.Errorf("invalid autoPayout cursor value: %w", err))
+	}
+
+	return bz
+}
+
+// setCursor sets the key to continue the batch iteration from (nil to start from the beginning).
+func (s AutoPayoutState) setCursor(key []byte) {
+	if key == nil {
+		s.stateStore.Delete(types.AutoPayoutCursorKey)
+		return
+	}
+
+	s.stateStore.Set(types.AutoPayoutCursorKey, key)
+}
+
+// buildAutoPayoutKey returns the key used to store a types.AutoPayout object.
+func (s AutoPayoutState) buildAutoPayoutKey(contractAddr sdk.AccAddress) []byte {
+	return contractAddr.Bytes()
+}
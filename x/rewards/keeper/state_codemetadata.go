@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storeTypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// CodeMetadataState provides access to the types.CodeMetadata objects storage operations.
+type CodeMetadataState struct {
+	stateStore storeTypes.KVStore
+	cdc        codec.Codec
+	ctx        sdk.Context
+}
+
+// SetCodeMetadata creates or modifies a types.CodeMetadata object.
+func (s CodeMetadataState) SetCodeMetadata(codeID uint64, obj types.CodeMetadata) {
+	store := prefix.NewStore(s.stateStore, types.CodeMetadataPrefix)
+	store.Set(
+		s.buildCodeMetadataKey(codeID),
+		s.cdc.MustMarshal(&obj),
+	)
+}
+
+// GetCodeMetadata returns a types.CodeMetadata object by code ID.
+func (s CodeMetadataState) GetCodeMetadata(codeID uint64) (types.CodeMetadata, bool) {
+	store := prefix.NewStore(s.stateStore, types.CodeMetadataPrefix)
+
+	bz := store.Get(s.buildCodeMetadataKey(codeID))
+	if bz == nil {
+		return types.CodeMetadata{}, false
+	}
+
+	var obj types.CodeMetadata
+	s.cdc.MustUnmarshal(bz, &obj)
+
+	return obj, true
+}
+
+// Import initializes state from the module genesis data.
+func (s CodeMetadataState) Import(objs []types.CodeMetadata) {
+	for _, obj := range objs {
+		s.SetCodeMetadata(obj.CodeId, obj)
+	}
+}
+
+// Export returns the module genesis data for the state.
+func (s CodeMetadataState) Export() (objs []types.CodeMetadata) {
+	store := prefix.NewStore(s.stateStore, types.CodeMetadataPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var obj types.CodeMetadata
+		s.cdc.MustUnmarshal(iterator.Value(), &obj)
+
+		objs = append(objs, obj)
+	}
+
+	return
+}
+
+// buildCodeMetadataKey returns the key used to store a types.CodeMetadata object.
+func (s CodeMetadataState) buildCodeMetadataKey(codeID uint64) []byte {
+	return sdk.Uint64ToBigEndian(codeID)
+}
@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storeTypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// FlatFeeState provides access to the contract flat fee objects storage operations.
+type FlatFeeState struct {
+	stateStore storeTypes.KVStore
+	cdc        codec.Codec
+	ctx        sdk.Context
+}
+
+// SetFlatFee creates or modifies a contract flat fee coin.
+func (s FlatFeeState) SetFlatFee(contractAddr sdk.AccAddress, coin sdk.Coin) {
+	store := prefix.NewStore(s.stateStore, types.FlatFeePrefix)
+	store.Set(
+		s.buildFlatFeeKey(contractAddr),
+		s.cdc.MustMarshal(&coin),
+	)
+}
+
+// GetFlatFee returns a contract flat fee coin if exists.
+func (s FlatFeeState) GetFlatFee(contractAddr sdk.AccAddress) (sdk.Coin, bool) {
+	store := prefix.NewStore(s.stateStore, types.FlatFeePrefix)
+
+	bz := store.Get(s.buildFlatFeeKey(contractAddr))
+	if bz == nil {
+		return sdk.Coin{}, false
+	}
+
+	var coin sdk.Coin
+	s.cdc.MustUnmarshal(bz, &coin)
+
+	return coin, true
+}
+
+// RemoveFlatFee removes a contract flat fee coin.
+func (s FlatFeeState) RemoveFlatFee(contractAddr sdk.AccAddress) {
+	store := prefix.NewStore(s.stateStore, types.FlatFeePrefix)
+	store.Delete(s.buildFlatFeeKey(contractAddr))
+}
+
+// Import initializes state from the module genesis data.
+func (s FlatFeeState) Import(objs []types.FlatFee) {
+	for _, obj := range objs {
+		s.SetFlatFee(obj.MustGetContractAddress(), obj.FlatFee)
+	}
+}
+
+// Export returns the module genesis data for the state.
+func (s FlatFeeState) Export() (objs []types.FlatFee) {
+	store := prefix.NewStore(s.stateStore, types.FlatFeePrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var coin sdk.Coin
+		s.cdc.MustUnmarshal(iterator.Value(), &coin)
+
+		objs = append(objs, types.FlatFee{
+			ContractAddress: s.parseFlatFeeKey(iterator.Key()).String(),
+			FlatFee:         coin,
+		})
+	}
+
+	return
+}
+
+// buildFlatFeeKey returns the key used to store a contract flat fee coin.
+func (s FlatFeeState) buildFlatFeeKey(contractAddr sdk.AccAddress) []byte {
+	return contractAddr.Bytes()
+}
+
+// parseFlatFeeKey parses and validates a contract flat fee storage key.
+func (s FlatFeeState) parseFlatFeeKey(key []byte) sdk.AccAddress {
+	addr := sdk.AccAddress(key)
+	if err := sdk.VerifyAddressFormat(addr); err != nil {
+		panic(fmt.Errorf("invalid contract address key: %w", err))
+	}
+
+	return addr
+}
@@ -2,13 +2,15 @@ package keeper
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	storeTypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/archway-network/archway/x/rewards/types"
 )
 
-// MinConsFeeState provides access to the Minimum Consensus Fee object storage operations.
+// MinConsFeeState provides access to the Minimum Consensus Fee and types.MinConsensusFeeRecord objects storage operations.
 type MinConsFeeState struct {
 	stateStore storeTypes.KVStore
 	cdc        codec.Codec
@@ -35,3 +37,116 @@ func (s MinConsFeeState) GetFee() (sdk.DecCoin, bool) {
 
 	return coin, true
 }
+
+// SetBaseFee creates or modifies the base fee coin.
+func (s MinConsFeeState) SetBaseFee(feeCoin sdk.DecCoin) {
+	s.stateStore.Set(
+		types.BaseFeeKey,
+		s.cdc.MustMarshal(&feeCoin),
+	)
+}
+
+// GetBaseFee returns the base fee coin if exists.
+func (s MinConsFeeState) GetBaseFee() (sdk.DecCoin, bool) {
+	coinBz := s.stateStore.Get(types.BaseFeeKey)
+	if coinBz == nil {
+		return sdk.DecCoin{}, false
+	}
+
+	var coin sdk.DecCoin
+	s.cdc.MustUnmarshal(coinBz, &coin)
+
+	return coin, true
+}
+
+// DeleteBaseFee removes the base fee coin.
+func (s MinConsFeeState) DeleteBaseFee() {
+	s.stateStore.Delete(types.BaseFeeKey)
+}
+
+// CreateHistoryRecord creates a types.MinConsensusFeeRecord object.
+func (s MinConsFeeState) CreateHistoryRecord(height int64, feeCoin sdk.DecCoin) types.MinConsensusFeeRecord {
+	obj := types.MinConsensusFeeRecord{
+		Height: height,
+		Fee:    feeCoin,
+	}
+	s.setHistoryRecord(&obj)
+
+	return obj
+}
+
+// GetHistoryRecordsPaginated returns a list of types.MinConsensusFeeRecord objects paginated (ordered by height).
+func (s MinConsFeeState) GetHistoryRecordsPaginated(pageReq *query.PageRequest) ([]types.MinConsensusFeeRecord, *query.PageResponse, error) {
+	store := prefix.NewStore(s.stateStore, types.MinConsFeeHistoryPrefix)
+
+	var objs []types.MinConsensusFeeRecord
+	pageRes, err := query.Paginate(store, pageReq, func(_, value []byte) error {
+		var obj types.MinConsensusFeeRecord
+		if err := s.cdc.Unmarshal(value, &obj); err != nil {
+			return err
+		}
+		objs = append(objs, obj)
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return objs, pageRes, nil
+}
+
+// DeleteHistoryRecordsUpTo deletes all types.MinConsensusFeeRecord objects with height LTE the given one.
+func (s MinConsFeeState) DeleteHistoryRecordsUpTo(height int64) {
+	store := prefix.NewStore(s.stateStore, types.MinConsFeeHistoryPrefix)
+
+	iterator := store.Iterator(nil, sdk.PrefixEndBytes(s.buildHistoryRecordKey(height)))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// ImportHistory initializes the history state from the module genesis data.
+func (s MinConsFeeState) ImportHistory(objs []types.MinConsensusFeeRecord) {
+	for _, obj := range objs {
+		s.setHistoryRecord(&obj)
+	}
+}
+
+// ExportHistory returns the module genesis data for the history state.
+func (s MinConsFeeState) ExportHistory() (objs []types.MinConsensusFeeRecord) {
+	store := prefix.NewStore(s.stateStore, types.MinConsFeeHistoryPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var obj types.MinConsensusFeeRecord
+		s.cdc.MustUnmarshal(iterator.Value(), &obj)
+
+		objs = append(objs, obj)
+	}
+
+	return
+}
+
+// buildHistoryRecordKey returns the key used to store a types.MinConsensusFeeRecord object.
+func (s MinConsFeeState) buildHistoryRecordKey(height int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(height))
+}
+
+// setHistoryRecord sets a types.MinConsensusFeeRecord object.
+func (s MinConsFeeState) setHistoryRecord(obj *types.MinConsensusFeeRecord) {
+	store := prefix.NewStore(s.stateStore, types.MinConsFeeHistoryPrefix)
+	store.Set(
+		s.buildHistoryRecordKey(obj.Height),
+		s.cdc.MustMarshal(obj),
+	)
+}
@@ -33,11 +33,121 @@ func (s RewardsRecordState) CreateRewardsRecord(rewardsAddr sdk.AccAddress, rewa
 
 	s.setRewardsRecord(&obj)
 	s.setAddressIndex(obj.Id, rewardsAddr)
+	s.setTimeIndex(obj.Id, calculatedTime)
 	s.setLastID(obj.Id)
 
 	return obj
 }
 
+// AppendRewardsRecord merges rewards into the latest types.RewardsRecord object of the rewardsAddress (if any)
+// calculated within the same mergeWindow time slot (0 means no limit). Otherwise, a new record is created.
+// That keeps the number of records per rewardsAddress low (the latest record is an "open" one).
+// The merged record calculation height and time are moved to the newest rewards, so merged rewards never expire
+// earlier than the RewardsRecordExpiry period since they were calculated. Time slots are fixed (not related to the param),
+// so records of an address rewarded every block are still closed and expire one by one.
+func (s RewardsRecordState) AppendRewardsRecord(rewardsAddr sdk.AccAddress, rewards sdk.Coins, calculatedHeight int64, calculatedTime time.Time, mergeWindow time.Duration) types.RewardsRecord {
+	obj, found := s.getLastRewardsRecordByRewardsAddress(rewardsAddr)
+	if !found || (mergeWindow > 0 && !calculatedTime.Truncate(mergeWindow).Equal(obj.CalculatedTime.Truncate(mergeWindow))) {
+		return s.CreateRewardsRecord(rewardsAddr, rewards, calculatedHeight, calculatedTime)
+	}
+
+	obj.Rewards = sdk.Coins(obj.Rewards).Add(rewards...)
+	if calculatedTime.After(obj.CalculatedTime) {
+		s.deleteTimeIndexEntry(obj.Id, obj.CalculatedTime)
+		obj.CalculatedHeight = calculatedHeight
+		obj.CalculatedTime = calculatedTime
+		s.setTimeIndex(obj.Id, obj.CalculatedTime)
+	}
+	s.setRewardsRecord(&obj)
+
+	return obj
+}
+
+// CompactRewardsRecords merges all types.RewardsRecord objects of the rewardsAddress into the latest one.
+// Merged records are removed, the latest record ID and the newest record calculation height and time are kept,
+// so compacted rewards are not aged (expired) earlier than the most recent ones within.
+// Returns false if there are no records for the rewardsAddress.
+func (s RewardsRecordState) CompactRewardsRecords(rewardsAddr sdk.AccAddress) (types.RewardsRecord, bool) {
+	objs := s.GetRewardsRecordByRewardsAddress(rewardsAddr)
+	if len(objs) == 0 {
+		return types.RewardsRecord{}, false
+	}
+
+	// Records are sorted by ID (address index order), so the last one is the latest
+	lastObj, mergedObjs := objs[len(objs)-1], objs[:len(objs)-1]
+	if len(mergedObjs) == 0 {
+		return lastObj, true
+	}
+
+	newestObj := objs[0]
+	for _, obj := range objs[1:] {
+		if !obj.CalculatedTime.Before(newestObj.CalculatedTime) {
+			newestObj = obj
+		}
+	}
+
+	for _, obj := range mergedObjs {
+		lastObj.Rewards = sdk.Coins(lastObj.Rewards).Add(obj.Rewards...)
+
+		s.deleteRewardsRecord(obj.Id)
+		s.deleteAddressIndexEntry(obj.Id, rewardsAddr)
+		s.deleteTimeIndexEntry(obj.Id, obj.CalculatedTime)
+	}
+
+	s.deleteTimeIndexEntry(lastObj.Id, lastObj.CalculatedTime)
+	lastObj.CalculatedHeight = newestObj.CalculatedHeight
+	lastObj.CalculatedTime = newestObj.CalculatedTime
+	s.setTimeIndex(lastObj.Id, lastObj.CalculatedTime)
+
+	s.setRewardsRecord(&lastObj)
+
+	return lastObj, true
+}
+
+// SplitRewardsRecord takes the amount out of the types.RewardsRecord object rewards.
+// The record ID, calculation height and time are kept, the amount must be less than the record rewards.
+func (s RewardsRecordState) SplitRewardsRecord(obj types.RewardsRecord, amount sdk.Coins) types.RewardsRecord {
+	rewardsLeft, hasNeg := sdk.Coins(obj.Rewards).SafeSub(amount)
+	if hasNeg || rewardsLeft.IsZero() {
+		panic(fmt.Errorf("invalid RewardsRecord (%d) split amount: %s (rewards: %s)", obj.Id, amount, sdk.Coins(obj.Rewards)))
+	}
+
+	obj.Rewards = rewardsLeft
+	s.setRewardsRecord(&obj)
+
+	return obj
+}
+
+// GetRewardsAddresses returns a list of unique rewards addresses having types.RewardsRecord objects.
+func (s RewardsRecordState) GetRewardsAddresses() (addrs []sdk.AccAddress) {
+	store := prefix.NewStore(s.stateStore, types.RewardsRecordAddressIndexPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	// Index keys are sorted by address, so duplicates are sequential
+	for ; iterator.Valid(); iterator.Next() {
+		rewardsAddr, _ := s.parseAddressIndexKey(iterator.Key())
+		if len(addrs) > 0 && addrs[len(addrs)-1].Equals(rewardsAddr) {
+			continue
+		}
+		addrs = append(addrs, rewardsAddr)
+	}
+
+	return
+}
+
+// GetRewardsBalance returns the total rewards of all types.RewardsRecord objects for the rewardsAddress.
+// Records are merged by the EndBlocker, so the number of records per rewardsAddress is low.
+func (s RewardsRecordState) GetRewardsBalance(rewardsAddr sdk.AccAddress) sdk.Coins {
+	balance := sdk.NewCoins()
+	for _, obj := range s.GetRewardsRecordByRewardsAddress(rewardsAddr) {
+		balance = balance.Add(obj.Rewards...)
+	}
+
+	return balance
+}
+
 // GetRewardsRecord returns a types.RewardsRecord object by ID.
 func (s RewardsRecordState) GetRewardsRecord(id uint64) (types.RewardsRecord, bool) {
 	store := prefix.NewStore(s.stateStore, types.RewardsRecordPrefix)
@@ -73,6 +183,27 @@ func (s RewardsRecordState) GetRewardsRecordByRewardsAddress(rewardsAddr sdk.Acc
 	return
 }
 
+// GetRewardsRecordsCalculatedBefore returns a list of up to limit types.RewardsRecord objects with the CalculatedTime LT the given time.
+// Records are sorted by CalculatedTime and ID (the oldest first).
+func (s RewardsRecordState) GetRewardsRecordsCalculatedBefore(t time.Time, limit uint64) (objs []types.RewardsRecord) {
+	store := prefix.NewStore(s.stateStore, types.RewardsRecordTimeIndexPrefix)
+
+	iterator := store.Iterator(nil, s.buildTimeIndexPrefix(t))
+	defer iterator.Close()
+
+	for ; iterator.Valid() && uint64(len(objs)) < limit; iterator.Next() {
+		_, id := s.parseTimeIndexKey(iterator.Key())
+
+		obj, found := s.GetRewardsRecord(id)
+		if !found {
+			panic(fmt.Errorf("invalid RewardsRecord CalculatedTime index state: id (%d): not found", id))
+		}
+		objs = append(objs, obj)
+	}
+
+	return
+}
+
 // GetRewardsRecordByRewardsAddressPaginated returns a list of types.RewardsRecord objects by rewardsAddress paginated.
 func (s RewardsRecordState) GetRewardsRecordByRewardsAddressPaginated(rewardsAddr sdk.AccAddress, pageReq *query.PageRequest) ([]types.RewardsRecord, *query.PageResponse, error) {
 	store := prefix.NewStore(
@@ -102,16 +233,43 @@ func (s RewardsRecordState) GetRewardsRecordByRewardsAddressPaginated(rewardsAdd
 // DeleteRewardsRecords deletes a list of types.RewardsRecord objects updating indexes.
 func (s RewardsRecordState) DeleteRewardsRecords(objs ...types.RewardsRecord) {
 	for _, obj := range objs {
+		rewardsAddr := obj.MustGetRewardsAddress()
+
 		s.deleteRewardsRecord(obj.Id)
-		s.deleteAddressIndexEntry(obj.Id, obj.MustGetRewardsAddress())
+		s.deleteAddressIndexEntry(obj.Id, rewardsAddr)
+		s.deleteTimeIndexEntry(obj.Id, obj.CalculatedTime)
+	}
+}
+
+// RebuildTimeIndex (re)creates the types.RewardsRecord's CalculatedTime index using the existing types.RewardsRecord objects.
+func (s RewardsRecordState) RebuildTimeIndex() {
+	store := prefix.NewStore(s.stateStore, types.RewardsRecordTimeIndexPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	_, objs := s.Export()
+	for _, obj := range objs {
+		s.setTimeIndex(obj.Id, obj.CalculatedTime)
 	}
 }
 
 // Import initializes state from the module genesis data.
 func (s RewardsRecordState) Import(lastID uint64, objs []types.RewardsRecord) {
 	for _, obj := range objs {
+		rewardsAddr := obj.MustGetRewardsAddress()
+
 		s.setRewardsRecord(&obj)
-		s.setAddressIndex(obj.Id, obj.MustGetRewardsAddress())
+		s.setAddressIndex(obj.Id, rewardsAddr)
+		s.setTimeIndex(obj.Id, obj.CalculatedTime)
 	}
 	s.setLastID(lastID)
 }
@@ -133,6 +291,26 @@ func (s RewardsRecordState) Export() (lastID uint64, objs []types.RewardsRecord)
 	return
 }
 
+// getLastRewardsRecordByRewardsAddress returns the latest (max ID) types.RewardsRecord object of the rewardsAddress.
+func (s RewardsRecordState) getLastRewardsRecordByRewardsAddress(rewardsAddr sdk.AccAddress) (types.RewardsRecord, bool) {
+	store := prefix.NewStore(s.stateStore, types.RewardsRecordAddressIndexPrefix)
+
+	iterator := sdk.KVStoreReversePrefixIterator(store, s.buildAddressIndexPrefix(rewardsAddr))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return types.RewardsRecord{}, false
+	}
+
+	_, id := s.parseAddressIndexKey(iterator.Key())
+	obj, found := s.GetRewardsRecord(id)
+	if !found {
+		panic(fmt.Errorf("invalid RewardsRecord RewardsAddress index state: id (%d): not found", id))
+	}
+
+	return obj, true
+}
+
 // setLastID sets the last types.RewardsRecord unique ID.
 func (s RewardsRecordState) setLastID(id uint64) {
 	s.stateStore.Set(
@@ -232,3 +410,47 @@ func (s RewardsRecordState) deleteAddressIndexEntry(id uint64, rewardsAddr sdk.A
 	store := prefix.NewStore(s.stateStore, types.RewardsRecordAddressIndexPrefix)
 	store.Delete(s.buildAddressIndexKey(id, rewardsAddr))
 }
+
+// buildTimeIndexPrefix returns the key prefix used to maintain types.RewardsRecord's CalculatedTime index.
+func (s RewardsRecordState) buildTimeIndexPrefix(t time.Time) []byte {
+	return sdk.FormatTimeBytes(t)
+}
+
+// buildTimeIndexKey returns the key used to maintain types.RewardsRecord's CalculatedTime index.
+func (s RewardsRecordState) buildTimeIndexKey(id uint64, t time.Time) []byte {
+	return append(
+		s.buildTimeIndexPrefix(t),
+		sdk.Uint64ToBigEndian(id)...,
+	)
+}
+
+// parseTimeIndexKey parses the types.RewardsRecord's CalculatedTime index key.
+func (s RewardsRecordState) parseTimeIndexKey(key []byte) (t time.Time, id uint64) {
+	// Check min length: 1 time byte + 8 uint64 ID
+	if len(key) <= 8 {
+		panic(fmt.Errorf("invalid RewardsRecord CalculatedTime index key min length: %d", len(key)))
+	}
+
+	t, err := sdk.ParseTimeBytes(key[:len(key)-8])
+	if err != nil {
+		panic(fmt.Errorf("invalid RewardsRecord CalculatedTime index key (time): %w", err))
+	}
+	id = sdk.BigEndianToUint64(key[len(key)-8:])
+
+	return
+}
+
+// setTimeIndex adds the types.RewardsRecord's CalculatedTime index entry.
+func (s RewardsRecordState) setTimeIndex(id uint64, t time.Time) {
+	store := prefix.NewStore(s.stateStore, types.RewardsRecordTimeIndexPrefix)
+	store.Set(
+		s.buildTimeIndexKey(id, t),
+		[]byte{},
+	)
+}
+
+// deleteTimeIndexEntry deletes the types.RewardsRecord's CalculatedTime index entry.
+func (s RewardsRecordState) deleteTimeIndexEntry(id uint64, t time.Time) {
+	store := prefix.NewStore(s.stateStore, types.RewardsRecordTimeIndexPrefix)
+	store.Delete(s.buildTimeIndexKey(id, t))
+}
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storeTypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// SponsorshipState provides access to the types.Sponsorship and types.SponsorshipUsage objects storage operations.
+type SponsorshipState struct {
+	stateStore storeTypes.KVStore
+	cdc        codec.Codec
+	ctx        sdk.Context
+}
+
+// GetSponsorship returns a types.Sponsorship object by contract address.
+func (s SponsorshipState) GetSponsorship(contractAddr sdk.AccAddress) (types.Sponsorship, bool) {
+	store := prefix.NewStore(s.stateStore, types.SponsorshipPrefix)
+
+	bz := store.Get(s.buildSponsorshipKey(contractAddr))
+	if bz == nil {
+		return types.Sponsorship{}, false
+	}
+
+	var obj types.Sponsorship
+	s.cdc.MustUnmarshal(bz, &obj)
+
+	return obj, true
+}
+
+// SetSponsorship creates or modifies a types.Sponsorship object.
+func (s SponsorshipState) SetSponsorship(obj types.Sponsorship) {
+	store := prefix.NewStore(s.stateStore, types.SponsorshipPrefix)
+	store.Set(
+		s.buildSponsorshipKey(obj.MustGetContractAddress()),
+		s.cdc.MustMarshal(&obj),
+	)
+}
+
+// GetUserFees returns the amount of fees sponsored by a contract for a user within the given period.
+// Empty coins are returned if there is no usage tracked for the period.
+func (s SponsorshipState) GetUserFees(contractAddr, userAddr sdk.AccAddress, period uint64) sdk.Coins {
+	store := prefix.NewStore(s.stateStore, types.SponsorshipUsagePrefix)
+
+	bz := store.Get(s.buildSponsorshipUsageKey(contractAddr, userAddr))
+	if bz == nil {
+		return sdk.NewCoins()
+	}
+
+	var obj types.SponsorshipUsage
+	s.cdc.MustUnmarshal(bz, &obj)
+
+	if obj.Period != period {
+		return sdk.NewCoins()
+	}
+
+	return obj.Fees
+}
+
+// AddUserFees increases the amount of fees sponsored by a contract for a user within the given period.
+// Usage tracked for a previous period is reset.
+func (s SponsorshipState) AddUserFees(contractAddr, userAddr sdk.AccAddress, period uint64, fees sdk.Coins) {
+	s.setSponsorshipUsage(types.SponsorshipUsage{
+		ContractAddress: contractAddr.String(),
+		UserAddress:     userAddr.String(),
+		Fees:            s.GetUserFees(contractAddr, userAddr, period).Add(fees...),
+		Period:          period,
+	})
+}
+
+// Import initializes state from the module genesis data.
+func (s SponsorshipState) Import(sponsorships []types.Sponsorship, usages []types.SponsorshipUsage) {
+	for _, obj := range sponsorships {
+		s.SetSponsorship(obj)
+	}
+	for _, obj := range usages {
+		s.setSponsorshipUsage(obj)
+	}
+}
+
+// Export returns the module genesis data for the state.
+func (s SponsorshipState) Export() (sponsorships []types.Sponsorship, usages []types.SponsorshipUsage) {
+	sponsorshipStore := prefix.NewStore(s.stateStore, types.SponsorshipPrefix)
+
+	sponsorshipIterator := sponsorshipStore.Iterator(nil, nil)
+	defer sponsorshipIterator.Close()
+
+	for ; sponsorshipIterator.Valid(); sponsorshipIterator.Next() {
+		var obj types.Sponsorship
+		s.cdc.MustUnmarshal(sponsorshipIterator.Value(), &obj)
+
+		sponsorships = append(sponsorships, obj)
+	}
+
+	usageStore := prefix.NewStore(s.stateStore, types.SponsorshipUsagePrefix)
+
+	usageIterator := usageStore.Iterator(nil, nil)
+	defer usageIterator.Close()
+
+	for ; usageIterator.Valid(); usageIterator.Next() {
+		var obj types.SponsorshipUsage
+		s.cdc.MustUnmarshal(usageIterator.Value(), &obj)
+
+		usages = append(usages, obj)
+	}
+
+	return
+}
+
+// setSponsorshipUsage creates or modifies a types.SponsorshipUsage object.
+func (s SponsorshipState) setSponsorshipUsage(obj types.SponsorshipUsage) {
+	store := prefix.NewStore(s.stateStore, types.SponsorshipUsagePrefix)
+	store.Set(
+		s.buildSponsorshipUsageKey(obj.MustGetContractAddress(), obj.MustGetUserAddress()),
+		s.cdc.MustMarshal(&obj),
+	)
+}
+
+// buildSponsorshipKey returns the key used to store a types.Sponsorship object.
+func (s SponsorshipState) buildSponsorshipKey(contractAddr sdk.AccAddress) []byte {
+	return contractAddr.Bytes()
+}
+
+// buildSponsorshipUsageKey returns the key used to store a types.SponsorshipUsage object.
+func (s SponsorshipState) buildSponsorshipUsageKey(contractAddr, userAddr sdk.AccAddress) []byte {
+	return append(address.MustLengthPrefix(contractAddr), userAddr.Bytes()...)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	rewardsKeeper "github.com/archway-network/archway/x/rewards/keeper"
 	"github.com/archway-network/archway/x/rewards/types"
 )
 
@@ -125,7 +126,7 @@ func (s *KeeperTestSuite) TestStates() {
 		blockState.CreateBlockRewards(blockRewards.Height, blockRewards.InflationRewards, blockRewards.MaxGas)
 
 		for _, txRewards := range blockData.TxRewards {
-			txState.CreateTxRewards(txRewards.TxId, txRewards.Height, txRewards.FeeRewards)
+			txState.CreateTxRewards(txRewards.TxId, txRewards.Height, txRewards.FeeRewards, txRewards.FeeCollectorFees, txRewards.Sponsored)
 		}
 	}
 	rewardsRecordState.Import(
@@ -344,3 +345,112 @@ func (s *KeeperTestSuite) TestStates() {
 		}
 	})
 }
+
+// TestRewardsRecordsCompaction tests the RewardsRecord state append and compaction operations.
+func (s *KeeperTestSuite) TestRewardsRecordsCompaction() {
+	chain := s.chain
+	ctx, keeper := chain.GetContext(), chain.GetApp().RewardsKeeper
+	rewardsRecordState := keeper.GetState().RewardsRecord(ctx)
+
+	accAddrs, _ := e2eTesting.GenAccounts(2)
+	coins1 := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	coins2 := sdk.NewCoins(sdk.NewInt64Coin("uarch", 200))
+	blockTime := ctx.BlockTime()
+	slotStartTime := blockTime.Truncate(time.Minute)
+
+	s.Run("Append: new record is created", func() {
+		record := rewardsRecordState.AppendRewardsRecord(accAddrs[0], coins1, 1, slotStartTime, 0)
+		s.Assert().EqualValues(1, record.Id)
+		s.Assert().Len(rewardsRecordState.GetRewardsRecordByRewardsAddress(accAddrs[0]), 1)
+	})
+
+	s.Run("Append: latest record is updated (calculation height and time are moved to the newest rewards)", func() {
+		record := rewardsRecordState.AppendRewardsRecord(accAddrs[0], coins2, 2, slotStartTime.Add(30*time.Second), time.Minute)
+		s.Assert().EqualValues(1, record.Id)
+		s.Assert().Equal(coins1.Add(coins2...).String(), sdk.Coins(record.Rewards).String())
+		s.Assert().EqualValues(2, record.CalculatedHeight)
+		s.Assert().Equal(slotStartTime.Add(30*time.Second), record.CalculatedTime)
+
+		records := rewardsRecordState.GetRewardsRecordByRewardsAddress(accAddrs[0])
+		s.Require().Len(records, 1)
+		s.Assert().Equal(record, records[0])
+
+		s.Assert().Equal(coins1.Add(coins2...).String(), rewardsRecordState.GetRewardsBalance(accAddrs[0]).String())
+
+		// CalculatedTime index is updated
+		s.Assert().Empty(rewardsRecordState.GetRewardsRecordsCalculatedBefore(slotStartTime.Add(30*time.Second), 10))
+		s.Assert().Len(rewardsRecordState.GetRewardsRecordsCalculatedBefore(slotStartTime.Add(31*time.Second), 10), 1)
+	})
+
+	s.Run("Append: new record is created for the next merge window slot", func() {
+		record := rewardsRecordState.AppendRewardsRecord(accAddrs[0], coins2, 3, slotStartTime.Add(time.Minute), time.Minute)
+		s.Assert().EqualValues(2, record.Id)
+		s.Assert().EqualValues(3, record.CalculatedHeight)
+		s.Assert().Equal(slotStartTime.Add(time.Minute), record.CalculatedTime)
+
+		s.Assert().Len(rewardsRecordState.GetRewardsRecordByRewardsAddress(accAddrs[0]), 2)
+		s.Assert().Len(rewardsRecordState.GetRewardsRecordsCalculatedBefore(slotStartTime.Add(time.Minute), 10), 1)
+	})
+
+	// Create multiple records for both accounts (the pre-compaction state)
+	for i := 0; i < 3; i++ {
+		rewardsRecordState.CreateRewardsRecord(accAddrs[0], coins1, 3, blockTime)
+		rewardsRecordState.CreateRewardsRecord(accAddrs[1], coins2, 3, blockTime)
+	}
+
+	s.Run("Migrate: records are compacted", func() {
+		s.Require().NoError(rewardsKeeper.NewMigrator(keeper).Migrate1to2(ctx))
+
+		s.Assert().ElementsMatch([]sdk.AccAddress{accAddrs[0], accAddrs[1]}, rewardsRecordState.GetRewardsAddresses())
+
+		records1 := rewardsRecordState.GetRewardsRecordByRewardsAddress(accAddrs[0])
+		s.Require().Len(records1, 1)
+		s.Assert().EqualValues(7, records1[0].Id)
+		s.Assert().Equal(
+			sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 400), sdk.NewInt64Coin("uarch", 400)).String(),
+			sdk.Coins(records1[0].Rewards).String(),
+		)
+		// The newest calculation height and time are kept
+		s.Assert().EqualValues(3, records1[0].CalculatedHeight)
+		s.Assert().Equal(slotStartTime.Add(time.Minute), records1[0].CalculatedTime)
+		s.Assert().Len(rewardsRecordState.GetRewardsRecordsCalculatedBefore(blockTime.Add(time.Second), 10), 1)
+
+		records2 := rewardsRecordState.GetRewardsRecordByRewardsAddress(accAddrs[1])
+		s.Require().Len(records2, 1)
+		s.Assert().EqualValues(8, records2[0].Id)
+		s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin("uarch", 600)).String(), sdk.Coins(records2[0].Rewards).String())
+
+		// Merged records are removed
+		_, found := rewardsRecordState.GetRewardsRecord(1)
+		s.Assert().False(found)
+	})
+}
+
+// TestContractInflationUsageState tests the contract inflation usage tracking within epochs.
+func (s *KeeperTestSuite) TestContractInflationUsageState() {
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+	usageState := keeper.GetState().ContractInflationUsage(ctx)
+
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+
+	s.Run("OK: no usage", func() {
+		s.Assert().True(usageState.GetEpochUsage(contractAddrs[0], 1).IsZero())
+	})
+
+	s.Run("OK: usage is accumulated within the epoch", func() {
+		usageState.AddEpochUsage(contractAddrs[0], 1, sdk.NewInt(100))
+		usageState.AddEpochUsage(contractAddrs[0], 1, sdk.NewInt(50))
+		usageState.AddEpochUsage(contractAddrs[1], 1, sdk.NewInt(10))
+
+		s.Assert().Equal(sdk.NewInt(150).String(), usageState.GetEpochUsage(contractAddrs[0], 1).String())
+		s.Assert().Equal(sdk.NewInt(10).String(), usageState.GetEpochUsage(contractAddrs[1], 1).String())
+	})
+
+	s.Run("OK: usage is reset for the next epoch", func() {
+		s.Assert().True(usageState.GetEpochUsage(contractAddrs[0], 2).IsZero())
+
+		usageState.AddEpochUsage(contractAddrs[0], 2, sdk.NewInt(25))
+		s.Assert().Equal(sdk.NewInt(25).String(), usageState.GetEpochUsage(contractAddrs[0], 2).String())
+		s.Assert().Len(usageState.Export(), 2)
+	})
+}
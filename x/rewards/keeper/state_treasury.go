@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storeTypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// TreasuryOperationState provides access to the types.TreasuryOperation objects storage operations.
+type TreasuryOperationState struct {
+	stateStore storeTypes.KVStore
+	cdc        codec.Codec
+	ctx        sdk.Context
+}
+
+// CreateTreasuryOperation creates a new types.TreasuryOperation object with unique ID.
+func (s TreasuryOperationState) CreateTreasuryOperation(opType types.TreasuryOperationType, recipient sdk.AccAddress, amount sdk.Coins, height int64, time time.Time) types.TreasuryOperation {
+	obj := types.TreasuryOperation{
+		Id:     s.getNextID(),
+		Type:   opType,
+		Amount: amount,
+		Height: height,
+		Time:   time,
+	}
+	if !recipient.Empty() {
+		obj.Recipient = recipient.String()
+	}
+
+	s.setTreasuryOperation(&obj)
+	s.setLastID(obj.Id)
+
+	return obj
+}
+
+// GetTreasuryOperation returns a types.TreasuryOperation object by ID.
+func (s TreasuryOperationState) GetTreasuryOperation(id uint64) (types.TreasuryOperation, bool) {
+	store := prefix.NewStore(s.stateStore, types.TreasuryOperationPrefix)
+
+	bz := store.Get(s.buildTreasuryOperationKey(id))
+	if bz == nil {
+		return types.TreasuryOperation{}, false
+	}
+
+	var obj types.TreasuryOperation
+	s.cdc.MustUnmarshal(bz, &obj)
+
+	return obj, true
+}
+
+// GetTreasuryOperationsPaginated returns a list of types.TreasuryOperation objects paginated.
+func (s TreasuryOperationState) GetTreasuryOperationsPaginated(pageReq *query.PageRequest) ([]types.TreasuryOperation, *query.PageResponse, error) {
+	store := prefix.NewStore(s.stateStore, types.TreasuryOperationPrefix)
+
+	var objs []types.TreasuryOperation
+	pageRes, err := query.Paginate(store, pageReq, func(_, value []byte) error {
+		var obj types.TreasuryOperation
+		if err := s.cdc.Unmarshal(value, &obj); err != nil {
+			return err
+		}
+		objs = append(objs, obj)
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return objs, pageRes, nil
+}
+
+// Import initializes state from the module genesis data.
+func (s TreasuryOperationState) Import(lastID uint64, objs []types.TreasuryOperation) {
+	for _, obj := range objs {
+		s.setTreasuryOperation(&obj)
+	}
+	s.setLastID(lastID)
+}
+
+// Export returns the module genesis data for the state.
+func (s TreasuryOperationState) Export() (lastID uint64, objs []types.TreasuryOperation) {
+	store := prefix.NewStore(s.stateStore, types.TreasuryOperationPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var obj types.TreasuryOperation
+		s.cdc.MustUnmarshal(iterator.Value(), &obj)
+		objs = append(objs, obj)
+	}
+	lastID = s.getNextID() - 1
+
+	return
+}
+
+// setLastID sets the last types.TreasuryOperation unique ID.
+func (s TreasuryOperationState) setLastID(id uint64) {
+	s.stateStore.Set(
+		types.TreasuryOperationIDKey,
+		sdk.Uint64ToBigEndian(id),
+	)
+}
+
+// getNextID returns the next types.TreasuryOperation unique ID.
+func (s TreasuryOperationState) getNextID() uint64 {
+	lastIDBz := s.stateStore.Get(types.TreasuryOperationIDKey)
+	lastID := sdk.BigEndianToUint64(lastIDBz) // returns 0 if nil
+
+	return lastID + 1
+}
+
+// buildTreasuryOperationKey returns the key used to store a types.TreasuryOperation object.
+func (s TreasuryOperationState) buildTreasuryOperationKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
+// setTreasuryOperation sets a types.TreasuryOperation object.
+func (s TreasuryOperationState) setTreasuryOperation(obj *types.TreasuryOperation) {
+	store := prefix.NewStore(s.stateStore, types.TreasuryOperationPrefix)
+	store.Set(
+		s.buildTreasuryOperationKey(obj.Id),
+		s.cdc.MustMarshal(obj),
+	)
+}
@@ -20,11 +20,13 @@ type TxRewardsState struct {
 }
 
 // CreateTxRewards creates a new types.TxRewards object.
-func (s TxRewardsState) CreateTxRewards(txID uint64, height int64, rewards sdk.Coins) types.TxRewards {
+func (s TxRewardsState) CreateTxRewards(txID uint64, height int64, rewards, feeCollectorFees sdk.Coins, sponsored bool) types.TxRewards {
 	obj := types.TxRewards{
-		TxId:       txID,
-		Height:     height,
-		FeeRewards: rewards,
+		TxId:             txID,
+		Height:           height,
+		FeeRewards:       rewards,
+		FeeCollectorFees: feeCollectorFees,
+		Sponsored:        sponsored,
 	}
 
 	s.setTxRewards(&obj)
@@ -33,6 +35,11 @@ func (s TxRewardsState) CreateTxRewards(txID uint64, height int64, rewards sdk.C
 	return obj
 }
 
+// SetTxRewards updates an existing types.TxRewards object (the block index is not changed).
+func (s TxRewardsState) SetTxRewards(obj types.TxRewards) {
+	s.setTxRewards(&obj)
+}
+
 // GetTxRewards returns a types.TxRewards object by txID.
 func (s TxRewardsState) GetTxRewards(txID uint64) (types.TxRewards, bool) {
 	obj := s.getTxRewards(txID)
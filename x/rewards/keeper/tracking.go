@@ -5,14 +5,18 @@ import (
 )
 
 // TrackFeeRebatesRewards creates a new transaction fee rebate reward record for the current transaction.
+// The FeeCollector fees share is tracked as well to refund the unused gas fees later (could be empty).
+// The sponsored flag defines whether the fees were paid from a sponsorship deposit (refunds are returned to it).
 // Unique transaction ID is taken from the tracking module.
 // CONTRACT: tracking Ante handler must be called before this module's Ante handler (tracking provides the primary key).
-func (k Keeper) TrackFeeRebatesRewards(ctx sdk.Context, rewards sdk.Coins) {
+func (k Keeper) TrackFeeRebatesRewards(ctx sdk.Context, rewards, feeCollectorFees sdk.Coins, sponsored bool) {
 	txID := k.trackingKeeper.GetCurrentTxID(ctx)
 	k.state.TxRewardsState(ctx).CreateTxRewards(
 		txID,
 		ctx.BlockHeight(),
 		rewards,
+		feeCollectorFees,
+		sponsored,
 	)
 }
 
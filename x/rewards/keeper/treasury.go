@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// SpendTreasuryFunds transfers treasury funds to the recipient (gov proposal).
+func (k Keeper) SpendTreasuryFunds(ctx sdk.Context, recipient sdk.AccAddress, amount sdk.Coins) error {
+	if err := k.checkTreasuryFunds(ctx, amount); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.TreasuryCollector, recipient, amount); err != nil {
+		return err
+	}
+
+	op := k.state.TreasuryOperation(ctx).CreateTreasuryOperation(
+		types.TreasuryOperationType_TREASURY_OPERATION_TYPE_SPEND,
+		recipient,
+		amount,
+		ctx.BlockHeight(),
+		ctx.BlockTime(),
+	)
+
+	types.EmitTreasurySpendEvent(ctx, op.Id, recipient, amount)
+
+	return nil
+}
+
+// BurnTreasuryFunds burns treasury funds (gov proposal).
+func (k Keeper) BurnTreasuryFunds(ctx sdk.Context, amount sdk.Coins) error {
+	if err := k.checkTreasuryFunds(ctx, amount); err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.TreasuryCollector, amount); err != nil {
+		return err
+	}
+
+	op := k.state.TreasuryOperation(ctx).CreateTreasuryOperation(
+		types.TreasuryOperationType_TREASURY_OPERATION_TYPE_BURN,
+		nil,
+		amount,
+		ctx.BlockHeight(),
+		ctx.BlockTime(),
+	)
+
+	types.EmitTreasuryBurnEvent(ctx, op.Id, amount)
+
+	return nil
+}
+
+// GetTreasuryOperations returns the governance-approved treasury operations history paginated.
+// Query checks the page limit and uses the default limit if not provided.
+func (k Keeper) GetTreasuryOperations(ctx sdk.Context, pageReq *query.PageRequest) ([]types.TreasuryOperation, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{
+			Limit: types.MaxRecordsQueryLimit,
+		}
+	}
+	if pageReq.Limit > types.MaxRecordsQueryLimit {
+		return nil, nil, sdkErrors.Wrapf(types.ErrInvalidRequest, "max records (%d) query limit exceeded", types.MaxRecordsQueryLimit)
+	}
+
+	return k.state.TreasuryOperation(ctx).GetTreasuryOperationsPaginated(pageReq)
+}
+
+// checkTreasuryFunds checks that the treasury has enough funds for the operation.
+func (k Keeper) checkTreasuryFunds(ctx sdk.Context, amount sdk.Coins) error {
+	treasuryFunds := k.TreasuryPool(ctx)
+	if !treasuryFunds.IsAllGTE(amount) {
+		return sdkErrors.Wrapf(types.ErrInsufficientTreasuryFunds, "requested %s, available %s", amount, treasuryFunds)
+	}
+
+	return nil
+}
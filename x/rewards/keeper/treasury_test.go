@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	mintTypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/x/rewards"
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
+// TestTreasuryProposals checks the treasury spend / burn gov proposals handling.
+func (s *KeeperTestSuite) TestTreasuryProposals() {
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+	bankKeeper := s.chain.GetApp().BankKeeper
+	handler := rewards.NewProposalHandler(keeper)
+
+	recipientAddr, _ := e2eTesting.GenAccounts(1)
+	treasuryAddr := s.chain.GetApp().AccountKeeper.GetModuleAddress(rewardsTypes.TreasuryCollector)
+
+	// Fund the treasury
+	funds := sdk.NewCoins(sdk.NewInt64Coin("uarch", 1000))
+	s.Require().NoError(s.chain.GetApp().MintKeeper.MintCoins(ctx, funds))
+	s.Require().NoError(bankKeeper.SendCoinsFromModuleToModule(ctx, mintTypes.ModuleName, rewardsTypes.TreasuryCollector, funds))
+	treasuryInitial := keeper.TreasuryPool(ctx)
+
+	s.Run("Fail: spend: insufficient funds", func() {
+		amount := treasuryInitial.Add(sdk.NewInt64Coin("uarch", 1))
+		err := handler(ctx, rewardsTypes.NewTreasurySpendProposal("Spend", "Spend it all", recipientAddr[0], amount))
+		s.Assert().ErrorIs(err, rewardsTypes.ErrInsufficientTreasuryFunds)
+	})
+
+	s.Run("Fail: spend: blocked recipient", func() {
+		feeCollectorAddr := s.chain.GetApp().AccountKeeper.GetModuleAddress(authTypes.FeeCollectorName)
+		err := handler(ctx, rewardsTypes.NewTreasurySpendProposal("Spend", "Spend to module", feeCollectorAddr, sdk.NewCoins(sdk.NewInt64Coin("uarch", 1))))
+		s.Assert().ErrorIs(err, sdkErrors.ErrUnauthorized)
+	})
+
+	s.Run("Fail: burn: insufficient funds", func() {
+		amount := treasuryInitial.Add(sdk.NewInt64Coin("uarch", 1))
+		err := handler(ctx, rewardsTypes.NewTreasuryBurnProposal("Burn", "Burn it all", amount))
+		s.Assert().ErrorIs(err, rewardsTypes.ErrInsufficientTreasuryFunds)
+	})
+
+	spendAmount := sdk.NewCoins(sdk.NewInt64Coin("uarch", 600))
+	s.Run("OK: spend", func() {
+		err := handler(ctx, rewardsTypes.NewTreasurySpendProposal("Spend", "Spend some", recipientAddr[0], spendAmount))
+		s.Require().NoError(err)
+
+		s.Assert().Equal(spendAmount.String(), bankKeeper.GetAllBalances(ctx, recipientAddr[0]).String())
+		s.Assert().Equal(treasuryInitial.Sub(spendAmount).String(), bankKeeper.GetAllBalances(ctx, treasuryAddr).String())
+	})
+
+	burnAmount := sdk.NewCoins(sdk.NewInt64Coin("uarch", 400))
+	s.Run("OK: burn", func() {
+		supplyBefore := bankKeeper.GetSupply(ctx, "uarch")
+
+		err := handler(ctx, rewardsTypes.NewTreasuryBurnProposal("Burn", "Burn some", burnAmount))
+		s.Require().NoError(err)
+
+		s.Assert().Equal(treasuryInitial.Sub(spendAmount).Sub(burnAmount).String(), bankKeeper.GetAllBalances(ctx, treasuryAddr).String())
+		s.Assert().Equal(supplyBefore.Sub(burnAmount[0]).String(), bankKeeper.GetSupply(ctx, "uarch").String())
+	})
+
+	s.Run("Check treasury history", func() {
+		ops, _, err := keeper.GetTreasuryOperations(ctx, nil)
+		s.Require().NoError(err)
+		s.Require().Len(ops, 2)
+
+		s.Assert().EqualValues(1, ops[0].Id)
+		s.Assert().Equal(rewardsTypes.TreasuryOperationType_TREASURY_OPERATION_TYPE_SPEND, ops[0].Type)
+		s.Assert().Equal(recipientAddr[0].String(), ops[0].Recipient)
+		s.Assert().Equal(spendAmount.String(), ops[0].Amount.String())
+		s.Assert().Equal(ctx.BlockHeight(), ops[0].Height)
+
+		s.Assert().EqualValues(2, ops[1].Id)
+		s.Assert().Equal(rewardsTypes.TreasuryOperationType_TREASURY_OPERATION_TYPE_BURN, ops[1].Type)
+		s.Assert().Empty(ops[1].Recipient)
+		s.Assert().Equal(burnAmount.String(), ops[1].Amount.String())
+	})
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/archway-network/archway/pkg"
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// GetTxPriority returns the mempool priority of a transaction defined by the TxPriorityTiers param.
+// The transaction gas unit price is compared to the minimum one (refer to GetMinConsensusFees):
+// fees paid in multiple accepted denoms are summed up using the denom conversion rates.
+// Priority is 0 if no tier matches or the minimum gas unit price is not set.
+func (k Keeper) GetTxPriority(ctx sdk.Context, fees sdk.Coins, txGasLimit uint64) int64 {
+	tiers := k.TxPriorityTiers(ctx)
+	if len(tiers) == 0 || txGasLimit == 0 {
+		return 0
+	}
+
+	gasUnitPrices, found := k.GetMinConsensusFees(ctx)
+	if !found {
+		return 0
+	}
+
+	// Estimate the tx fees to the minimum fees ratio
+	txGasLimitDec := pkg.NewDecFromUint64(txGasLimit)
+	feeRatio := sdk.ZeroDec()
+	for _, fee := range fees {
+		gasUnitPrice := gasUnitPrices.AmountOf(fee.Denom)
+		if !gasUnitPrice.IsPositive() {
+			continue
+		}
+
+		feeRatio = feeRatio.Add(fee.Amount.ToDec().Quo(gasUnitPrice.Mul(txGasLimitDec)))
+	}
+
+	// Pick the highest tier matched (tiers are sorted)
+	var priority int64
+	for _, tier := range tiers {
+		if feeRatio.LT(tier.MinFeeRatio) {
+			break
+		}
+		priority = tier.Priority
+	}
+
+	return priority
+}
+
+// SetCheckTxPriority sets the current CheckTx transaction priority to the transient store.
+// The value is read by the app once the CheckTx AnteHandler is done (refer to PopCheckTxPriority and the app CheckTx).
+// The write is committed to the check state only if the whole AnteHandler succeeds.
+func (k Keeper) SetCheckTxPriority(ctx sdk.Context, priority int64) {
+	// Transient write should not affect the tx gas consumption
+	tStore := ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).TransientStore(k.tStoreKey)
+	tStore.Set(types.CheckTxPriorityKey, sdk.Uint64ToBigEndian(uint64(priority)))
+}
+
+// PopCheckTxPriority returns the current CheckTx transaction priority removing it from the transient store.
+// Priority is 0 if not set (the AnteHandler has failed before the SetCheckTxPriority call or its state was reverted).
+func (k Keeper) PopCheckTxPriority(ctx sdk.Context) int64 {
+	tStore := ctx.TransientStore(k.tStoreKey)
+
+	priorityBz := tStore.Get(types.CheckTxPriorityKey)
+	if priorityBz == nil {
+		return 0
+	}
+	tStore.Delete(types.CheckTxPriorityKey)
+
+	return int64(sdk.BigEndianToUint64(priorityBz))
+}
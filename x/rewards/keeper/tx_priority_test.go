@@ -0,0 +1,91 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
+// TestGetTxPriority tests the transaction priority ordering for multiple fee levels.
+func (s *KeeperTestSuite) TestGetTxPriority() {
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().RewardsKeeper
+	feeState := keeper.GetState().MinConsensusFee(ctx)
+
+	const txGasLimit = 1000
+
+	// 1uusdc = 2stake, min fee is 100stake (50uusdc) for the txGasLimit
+	feeState.SetFee(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 1)))
+	feeState.DeleteBaseFee()
+
+	params := keeper.GetParams(ctx)
+	params.AcceptedFeeDenoms = []rewardsTypes.FeeDenom{
+		{Denom: "uusdc", Rate: sdk.NewDec(2)},
+	}
+	keeper.SetParams(ctx, params)
+
+	getPriority := func(fees string) int64 {
+		coins, err := sdk.ParseCoinsNormalized(fees)
+		s.Require().NoError(err)
+
+		return keeper.GetTxPriority(ctx, coins, txGasLimit)
+	}
+
+	s.Run("OK: tiers are not set", func() {
+		s.Assert().EqualValues(0, getPriority("100stake"))
+		s.Assert().EqualValues(0, getPriority("10000stake"))
+	})
+
+	params.TxPriorityTiers = []rewardsTypes.TxPriorityTier{
+		{MinFeeRatio: sdk.NewDecWithPrec(15, 1), Priority: 10},
+		{MinFeeRatio: sdk.NewDec(2), Priority: 20},
+		{MinFeeRatio: sdk.NewDec(10), Priority: 100},
+	}
+	keeper.SetParams(ctx, params)
+
+	s.Run("OK: priority grows with the fee level", func() {
+		type feeLevel struct {
+			fees             string
+			priorityExpected int64
+		}
+
+		feeLevels := []feeLevel{
+			{fees: "100stake", priorityExpected: 0},
+			{fees: "149stake", priorityExpected: 0},
+			{fees: "150stake", priorityExpected: 10},
+			{fees: "199stake", priorityExpected: 10},
+			{fees: "200stake", priorityExpected: 20},
+			{fees: "999stake", priorityExpected: 20},
+			{fees: "1000stake", priorityExpected: 100},
+			{fees: "100000stake", priorityExpected: 100},
+		}
+
+		var prevPriority int64
+		for _, level := range feeLevels {
+			priority := getPriority(level.fees)
+			s.Assert().Equal(level.priorityExpected, priority, level.fees)
+			s.Assert().GreaterOrEqual(priority, prevPriority, level.fees)
+			prevPriority = priority
+		}
+	})
+
+	s.Run("OK: alternative denoms are converted", func() {
+		s.Assert().EqualValues(0, getPriority("50uusdc"))
+		s.Assert().EqualValues(20, getPriority("100uusdc"))
+		s.Assert().EqualValues(20, getPriority("100stake,50uusdc"))
+		s.Assert().EqualValues(0, getPriority("1000uatom"))
+	})
+
+	s.Run("OK: base fee raises the minimum", func() {
+		feeState.SetBaseFee(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(2, 1)))
+		defer feeState.DeleteBaseFee()
+
+		s.Assert().EqualValues(0, getPriority("200stake"))
+		s.Assert().EqualValues(20, getPriority("400stake"))
+	})
+
+	s.Run("OK: minimum fee is not set", func() {
+		feeState.SetFee(sdk.NewDecCoinFromDec("stake", sdk.ZeroDec()))
+
+		s.Assert().EqualValues(0, getPriority("1000stake"))
+	})
+}
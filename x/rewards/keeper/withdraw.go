@@ -6,12 +6,143 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	stakingTypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	ibcTransferTypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clientTypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 
 	"github.com/archway-network/archway/x/rewards/types"
 )
 
 // WithdrawRewardsByRecordsLimit performs the rewards distribution for the given rewards address and the number of record to use.
 func (k Keeper) WithdrawRewardsByRecordsLimit(ctx sdk.Context, rewardsAddr sdk.AccAddress, recordsLimit uint64) (sdk.Coins, int, error) {
+	records, err := k.getWithdrawRecordsByLimit(ctx, rewardsAddr, recordsLimit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return k.withdrawRewardsByRecords(ctx, rewardsAddr, records), len(records), nil
+}
+
+// WithdrawRewardsByRecordIDs performs the rewards distribution for the given rewards address and record IDs.
+func (k Keeper) WithdrawRewardsByRecordIDs(ctx sdk.Context, rewardsAddr sdk.AccAddress, recordIDs []uint64) (sdk.Coins, int, error) {
+	records, err := k.getWithdrawRecordsByIDs(ctx, rewardsAddr, recordIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return k.withdrawRewardsByRecords(ctx, rewardsAddr, records), len(records), nil
+}
+
+// IBCWithdrawRewardsByRecordsLimit performs the rewards distribution for the given rewards address and the number of record to use
+// transferring rewards to the receiver on another chain via IBC.
+func (k Keeper) IBCWithdrawRewardsByRecordsLimit(ctx sdk.Context, rewardsAddr sdk.AccAddress, recordsLimit uint64, ibcTransfer types.MsgWithdrawRewards_IBCTransfer) (sdk.Coins, int, error) {
+	records, err := k.getWithdrawRecordsByLimit(ctx, rewardsAddr, recordsLimit)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	totalRewards, err := k.ibcWithdrawRewardsByRecords(ctx, rewardsAddr, records, ibcTransfer)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return totalRewards, len(records), nil
+}
+
+// IBCWithdrawRewardsByRecordIDs performs the rewards distribution for the given rewards address and record IDs
+// transferring rewards to the receiver on another chain via IBC.
+func (k Keeper) IBCWithdrawRewardsByRecordIDs(ctx sdk.Context, rewardsAddr sdk.AccAddress, recordIDs []uint64, ibcTransfer types.MsgWithdrawRewards_IBCTransfer) (sdk.Coins, int, error) {
+	records, err := k.getWithdrawRecordsByIDs(ctx, rewardsAddr, recordIDs)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	totalRewards, err := k.ibcWithdrawRewardsByRecords(ctx, rewardsAddr, records, ibcTransfer)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return totalRewards, len(records), nil
+}
+
+// WithdrawRewardsAndDelegateByRecordsLimit performs the rewards distribution for the given rewards address and the number of record to use
+// delegating the staking denom rewards to the validator.
+// Returns total rewards withdrawn, the number of records used and the delegated amount.
+func (k Keeper) WithdrawRewardsAndDelegateByRecordsLimit(ctx sdk.Context, rewardsAddr sdk.AccAddress, recordsLimit uint64, valAddr sdk.ValAddress) (sdk.Coins, int, sdk.Coin, error) {
+	records, err := k.getWithdrawRecordsByLimit(ctx, rewardsAddr, recordsLimit)
+	if err != nil {
+		return nil, 0, sdk.Coin{}, err
+	}
+
+	totalRewards, delegatedAmt, err := k.withdrawAndDelegateRewardsByRecords(ctx, rewardsAddr, records, valAddr)
+	if err != nil {
+		return nil, 0, sdk.Coin{}, err
+	}
+
+	return totalRewards, len(records), delegatedAmt, nil
+}
+
+// WithdrawRewardsAndDelegateByRecordIDs performs the rewards distribution for the given rewards address and record IDs
+// delegating the staking denom rewards to the validator.
+// Returns total rewards withdrawn, the number of records used and the delegated amount.
+func (k Keeper) WithdrawRewardsAndDelegateByRecordIDs(ctx sdk.Context, rewardsAddr sdk.AccAddress, recordIDs []uint64, valAddr sdk.ValAddress) (sdk.Coins, int, sdk.Coin, error) {
+	records, err := k.getWithdrawRecordsByIDs(ctx, rewardsAddr, recordIDs)
+	if err != nil {
+		return nil, 0, sdk.Coin{}, err
+	}
+
+	totalRewards, delegatedAmt, err := k.withdrawAndDelegateRewardsByRecords(ctx, rewardsAddr, records, valAddr)
+	if err != nil {
+		return nil, 0, sdk.Coin{}, err
+	}
+
+	return totalRewards, len(records), delegatedAmt, nil
+}
+
+// WithdrawRewardsForFees performs the rewards distribution for the given rewards address withdrawing exactly
+// the transaction fees amount from the minimum number of records (oldest first), so the rewards address can pay them
+// (used by the Ante handler).
+// Fully used records are pruned, the last record used is split: the amount left stays in the record (its ID,
+// calculation height and time are kept).
+// The rewards address is tracked as the transaction fee payer to receive unused gas refunds.
+// Returns total rewards withdrawn (equal to fees) and the number of records used.
+func (k Keeper) WithdrawRewardsForFees(ctx sdk.Context, rewardsAddr sdk.AccAddress, fees sdk.Coins) (sdk.Coins, int, error) {
+	records, err := k.getWithdrawRecordsByAmount(ctx, rewardsAddr, fees)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	// Take the fees amount out of records (the oldest first)
+	rewardsRecordState := k.state.RewardsRecord(ctx)
+	amountLeft := fees
+	for _, record := range records {
+		recordAmount := getCoinsIntersection(record.Rewards, amountLeft)
+		if recordAmount.IsZero() {
+			continue
+		}
+		amountLeft = amountLeft.Sub(recordAmount)
+
+		if recordAmount.IsEqual(record.Rewards) {
+			rewardsRecordState.DeleteRewardsRecords(record)
+			continue
+		}
+		rewardsRecordState.SplitRewardsRecord(record, recordAmount)
+	}
+
+	// Transfer rewards
+	if !fees.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ContractRewardCollector, rewardsAddr, fees); err != nil {
+			panic(fmt.Errorf("sending rewards (%s) to the rewards address (%s): %w", fees, rewardsAddr, err))
+		}
+		types.EmitRewardsWithdrawEvent(ctx, rewardsAddr, fees)
+	}
+	k.trackingKeeper.TrackTxFeePayer(ctx, rewardsAddr, fees)
+
+	return fees, len(records), nil
+}
+
+// getWithdrawRecordsByLimit returns rewards records for the given rewards address using the records limit.
+func (k Keeper) getWithdrawRecordsByLimit(ctx sdk.Context, rewardsAddr sdk.AccAddress, recordsLimit uint64) ([]types.RewardsRecord, error) {
 	recordsLimitMax := k.MaxWithdrawRecords(ctx)
 
 	// Use the default limit if not specified
@@ -21,24 +152,47 @@ func (k Keeper) WithdrawRewardsByRecordsLimit(ctx sdk.Context, rewardsAddr sdk.A
 
 	// Msg post-validateBasic check
 	if recordsLimit > recordsLimitMax {
-		return nil, 0, sdkErrors.Wrapf(types.ErrInvalidRequest, "max withdraw records (%d) exceeded", recordsLimitMax)
+		return nil, sdkErrors.Wrapf(types.ErrInvalidRequest, "max withdraw records (%d) exceeded", recordsLimitMax)
 	}
 
 	// Get all rewards records for the given address by limit
 	pageReq := &query.PageRequest{Limit: recordsLimit}
 	records, _, err := k.state.RewardsRecord(ctx).GetRewardsRecordByRewardsAddressPaginated(rewardsAddr, pageReq)
 	if err != nil {
-		return nil, 0, sdkErrors.Wrap(types.ErrInternal, err.Error())
+		return nil, sdkErrors.Wrap(types.ErrInternal, err.Error())
 	}
 
-	return k.withdrawRewardsByRecords(ctx, rewardsAddr, records), len(records), nil
+	return records, nil
 }
 
-// WithdrawRewardsByRecordIDs performs the rewards distribution for the given rewards address and record IDs.
-func (k Keeper) WithdrawRewardsByRecordIDs(ctx sdk.Context, rewardsAddr sdk.AccAddress, recordIDs []uint64) (sdk.Coins, int, error) {
+// getWithdrawRecordsByAmount returns the minimum number of rewards records for the given rewards address (oldest first)
+// covering the amount. The number of records is limited by the MaxWithdrawRecords param.
+func (k Keeper) getWithdrawRecordsByAmount(ctx sdk.Context, rewardsAddr sdk.AccAddress, amount sdk.Coins) ([]types.RewardsRecord, error) {
+	pageReq := &query.PageRequest{Limit: k.MaxWithdrawRecords(ctx)}
+	recordsAvailable, _, err := k.state.RewardsRecord(ctx).GetRewardsRecordByRewardsAddressPaginated(rewardsAddr, pageReq)
+	if err != nil {
+		return nil, sdkErrors.Wrap(types.ErrInternal, err.Error())
+	}
+
+	totalRewards := sdk.NewCoins()
+	for i, record := range recordsAvailable {
+		if totalRewards.IsAllGTE(amount) {
+			return recordsAvailable[:i], nil
+		}
+		totalRewards = totalRewards.Add(record.Rewards...)
+	}
+	if !totalRewards.IsAllGTE(amount) {
+		return nil, sdkErrors.Wrapf(types.ErrInsufficientRewards, "rewards records (%d) total %s is less than %s", len(recordsAvailable), totalRewards, amount)
+	}
+
+	return recordsAvailable, nil
+}
+
+// getWithdrawRecordsByIDs returns rewards records for the given rewards address and record IDs.
+func (k Keeper) getWithdrawRecordsByIDs(ctx sdk.Context, rewardsAddr sdk.AccAddress, recordIDs []uint64) ([]types.RewardsRecord, error) {
 	// Msg post-validateBasic check
 	if maxRecords := k.MaxWithdrawRecords(ctx); uint64(len(recordIDs)) > maxRecords {
-		return nil, 0, sdkErrors.Wrapf(types.ErrInvalidRequest, "max withdraw records (%d) exceeded", maxRecords)
+		return nil, sdkErrors.Wrapf(types.ErrInvalidRequest, "max withdraw records (%d) exceeded", maxRecords)
 	}
 
 	rewardsState := k.state.RewardsRecord(ctx)
@@ -49,34 +203,107 @@ func (k Keeper) WithdrawRewardsByRecordIDs(ctx sdk.Context, rewardsAddr sdk.AccA
 	for _, id := range recordIDs {
 		record, found := rewardsState.GetRewardsRecord(id)
 		if !found {
-			return nil, 0, sdkErrors.Wrapf(types.ErrInvalidRequest, "rewards record (%d): not found", id)
+			return nil, sdkErrors.Wrapf(types.ErrInvalidRequest, "rewards record (%d): not found", id)
 		}
 		if record.RewardsAddress != rewardsAddrStr {
-			return nil, 0, sdkErrors.Wrapf(types.ErrInvalidRequest, "rewards record (%d): address mismatch", id)
+			return nil, sdkErrors.Wrapf(types.ErrInvalidRequest, "rewards record (%d): address mismatch", id)
 		}
 
 		records = append(records, record)
 	}
 
-	return k.withdrawRewardsByRecords(ctx, rewardsAddr, records), len(records), nil
+	return records, nil
 }
 
 // withdrawRewardsByRecords performs the rewards distribution for the given rewards address and records.
 // Handler emits the distribution event and prunes the used records.
 func (k Keeper) withdrawRewardsByRecords(ctx sdk.Context, rewardsAddr sdk.AccAddress, records []types.RewardsRecord) sdk.Coins {
+	totalRewards := k.sendRecordsRewards(ctx, rewardsAddr, records)
+	if !totalRewards.IsZero() {
+		types.EmitRewardsWithdrawEvent(ctx, rewardsAddr, totalRewards)
+	}
+
+	return totalRewards
+}
+
+// ibcWithdrawRewardsByRecords performs the rewards distribution for the given rewards address and records
+// transferring rewards to the receiver on another chain (ICS-20 transfer per rewards denom).
+// The rewards address is the transfer sender, so refunds (transfer timeout / failure) are credited to it.
+// Handler emits the IBC distribution event and prunes the used records.
+func (k Keeper) ibcWithdrawRewardsByRecords(ctx sdk.Context, rewardsAddr sdk.AccAddress, records []types.RewardsRecord, ibcTransfer types.MsgWithdrawRewards_IBCTransfer) (sdk.Coins, error) {
+	totalRewards := k.sendRecordsRewards(ctx, rewardsAddr, records)
+	if totalRewards.IsZero() {
+		return totalRewards, nil
+	}
+
+	for _, coin := range totalRewards {
+		err := k.transferKeeper.SendTransfer(
+			ctx,
+			ibcTransferTypes.PortID, ibcTransfer.SourceChannel,
+			coin,
+			rewardsAddr, ibcTransfer.Receiver,
+			clientTypes.ZeroHeight(), ibcTransfer.TimeoutTimestamp,
+		)
+		if err != nil {
+			return nil, sdkErrors.Wrapf(types.ErrInvalidRequest, "IBC transfer of %s: %v", coin, err)
+		}
+	}
+
+	types.EmitRewardsIBCWithdrawEvent(ctx, rewardsAddr, totalRewards, ibcTransfer)
+
+	return totalRewards, nil
+}
+
+// withdrawAndDelegateRewardsByRecords performs the rewards distribution for the given rewards address and records
+// and delegates the staking denom part of rewards to the validator (other denoms are kept by the rewards address).
+// Handler emits the distribution event and prunes the used records.
+func (k Keeper) withdrawAndDelegateRewardsByRecords(ctx sdk.Context, rewardsAddr sdk.AccAddress, records []types.RewardsRecord, valAddr sdk.ValAddress) (sdk.Coins, sdk.Coin, error) {
+	validator, found := k.stakingKeeper.GetValidator(ctx, valAddr)
+	if !found {
+		return nil, sdk.Coin{}, sdkErrors.Wrapf(types.ErrInvalidRequest, "validator (%s): not found", valAddr)
+	}
+
+	totalRewards := k.withdrawRewardsByRecords(ctx, rewardsAddr, records)
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	delegatedAmt := sdk.NewCoin(bondDenom, totalRewards.AmountOf(bondDenom))
+	if delegatedAmt.IsZero() {
+		return totalRewards, delegatedAmt, nil
+	}
+
+	if _, err := k.stakingKeeper.Delegate(ctx, rewardsAddr, delegatedAmt.Amount, stakingTypes.Unbonded, validator, true); err != nil {
+		return nil, sdk.Coin{}, sdkErrors.Wrapf(types.ErrInvalidRequest, "delegating %s to validator (%s): %v", delegatedAmt, valAddr, err)
+	}
+
+	return totalRewards, delegatedAmt, nil
+}
+
+// getCoinsIntersection returns the coins part of the amount: the min amount for every denom both of them have.
+func getCoinsIntersection(coins, amount sdk.Coins) sdk.Coins {
+	res := sdk.NewCoins()
+	for _, coin := range coins {
+		amt := sdk.MinInt(coin.Amount, amount.AmountOf(coin.Denom))
+		if amt.IsPositive() {
+			res = res.Add(sdk.NewCoin(coin.Denom, amt))
+		}
+	}
+
+	return res
+}
+
+// sendRecordsRewards transfers aggregated records rewards to the rewards address and prunes the used records.
+func (k Keeper) sendRecordsRewards(ctx sdk.Context, rewardsAddr sdk.AccAddress, records []types.RewardsRecord) sdk.Coins {
 	// Aggregate total rewards to distribute
 	totalRewards := sdk.NewCoins()
 	for _, record := range records {
 		totalRewards = totalRewards.Add(record.Rewards...)
 	}
 
-	// Transfer rewards and emit distribution event
+	// Transfer rewards
 	if !totalRewards.IsZero() {
 		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ContractRewardCollector, rewardsAddr, totalRewards); err != nil {
 			panic(fmt.Errorf("sending rewards (%s) to the rewards address (%s): %w", totalRewards, rewardsAddr, err))
 		}
-
-		types.EmitRewardsWithdrawEvent(ctx, rewardsAddr, totalRewards)
 	}
 
 	// Clean up (safe if there were no rewards)
@@ -48,6 +48,21 @@ func (s *KeeperTestSuite) TestWithdrawRewardsByLimit() {
 	// Setup environment
 	s.SetupWithdrawTest(testData)
 
+	// IBC transfer over a non-existing channel
+	s.Run("Fail: IBC transfer via non-existing channel", func() {
+		ctx, _ := s.chain.GetContext().CacheContext()
+		ibcTransfer := rewardsTypes.NewMsgWithdrawRewardsIBCTransfer("channel-100", "cosmos1receiver", uint64(ctx.BlockTime().UnixNano()+1))
+		_, _, err := keeper.IBCWithdrawRewardsByRecordsLimit(ctx, accAddr, 2, *ibcTransfer)
+		s.Assert().ErrorIs(err, rewardsTypes.ErrInvalidRequest)
+	})
+
+	// Delegation to a non-existing validator
+	s.Run("Fail: withdraw and delegate to non-existing validator", func() {
+		ctx, _ := s.chain.GetContext().CacheContext()
+		_, _, _, err := keeper.WithdrawRewardsAndDelegateByRecordsLimit(ctx, accAddr, 2, sdk.ValAddress(accAddr))
+		s.Assert().ErrorIs(err, rewardsTypes.ErrInvalidRequest)
+	})
+
 	// Withdraw the 1st half
 	s.Run("OK: withdraw 1st half", func() {
 		s.CheckWithdrawResults(
@@ -170,3 +185,103 @@ func (s *KeeperTestSuite) TestWithdrawRewardsByIDs() {
 		)
 	})
 }
+
+// TestWithdrawRewardsForFees tests the withdraw operation used to pay transaction fees.
+func (s *KeeperTestSuite) TestWithdrawRewardsForFees() {
+	keeper := s.chain.GetApp().RewardsKeeper
+	accAddr := s.chain.GetAccount(0).Address
+
+	testData := []withdrawTestRecordData{
+		{
+			RecordID:    1,
+			RewardsAddr: accAddr,
+			Rewards:     sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 50)),
+		},
+		{
+			RecordID:    2,
+			RewardsAddr: accAddr,
+			Rewards:     sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)),
+		},
+		{
+			RecordID:    3,
+			RewardsAddr: accAddr,
+			Rewards:     sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 150)),
+		},
+	}
+
+	s.Run("Fail: no rewards records", func() {
+		ctx := s.chain.GetContext()
+		_, _, err := keeper.WithdrawRewardsForFees(ctx, accAddr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+		s.Assert().ErrorIs(err, rewardsTypes.ErrInsufficientRewards)
+	})
+
+	// Setup environment
+	s.SetupWithdrawTest(testData)
+
+	s.Run("Fail: records total is less than fees", func() {
+		ctx := s.chain.GetContext()
+		_, _, err := keeper.WithdrawRewardsForFees(ctx, accAddr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 301)))
+		s.Assert().ErrorIs(err, rewardsTypes.ErrInsufficientRewards)
+	})
+
+	s.Run("Fail: fees denom is not covered", func() {
+		ctx := s.chain.GetContext()
+		_, _, err := keeper.WithdrawRewardsForFees(ctx, accAddr, sdk.NewCoins(sdk.NewInt64Coin("uarch", 1)))
+		s.Assert().ErrorIs(err, rewardsTypes.ErrInsufficientRewards)
+	})
+
+	s.Run("Fail: records are limited by MaxWithdrawRecords", func() {
+		ctx, _ := s.chain.GetContext().CacheContext()
+
+		params := keeper.GetParams(ctx)
+		params.MaxWithdrawRecords = 2
+		keeper.SetParams(ctx, params)
+
+		_, _, err := keeper.WithdrawRewardsForFees(ctx, accAddr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 151)))
+		s.Assert().ErrorIs(err, rewardsTypes.ErrInsufficientRewards)
+	})
+
+	// Oldest records are used first: 50 + 70 (out of 100) covers 120
+	s.Run("OK: withdraw 120 fees splitting the 2nd record", func() {
+		ctx := s.chain.GetContext()
+		recordsState := keeper.GetState().RewardsRecord(ctx)
+		fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 120))
+		accBalanceBefore := s.chain.GetBalance(accAddr)
+
+		totalRewards, recordsUsed, err := keeper.WithdrawRewardsForFees(ctx, accAddr, fees)
+		s.Require().NoError(err)
+		s.Assert().Equal(fees.String(), totalRewards.String())
+		s.Assert().Equal(2, recordsUsed)
+
+		// Only the fees amount is withdrawn
+		s.Assert().Equal(fees.String(), s.chain.GetBalance(accAddr).Sub(accBalanceBefore).String())
+
+		// The 1st record is pruned
+		_, found := recordsState.GetRewardsRecord(1)
+		s.Assert().False(found)
+
+		// The 2nd record keeps the amount left
+		record, found := recordsState.GetRewardsRecord(2)
+		s.Require().True(found)
+		s.Assert().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30)).String(), sdk.Coins(record.Rewards).String())
+
+		// The 3rd record is not used
+		record, found = recordsState.GetRewardsRecord(3)
+		s.Require().True(found)
+		s.Assert().Equal(testData[2].Rewards.String(), sdk.Coins(record.Rewards).String())
+	})
+
+	s.Run("OK: withdraw the records left for the exact fees", func() {
+		s.CheckWithdrawResults(
+			accAddr,
+			[]withdrawTestRecordData{
+				{RecordID: 2, RewardsAddr: accAddr, Rewards: sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 30))},
+				testData[2],
+			},
+			func() (sdk.Coins, int, error) {
+				ctx := s.chain.GetContext()
+				return keeper.WithdrawRewardsForFees(ctx, accAddr, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 180)))
+			},
+		)
+	})
+}
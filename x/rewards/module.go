@@ -124,6 +124,11 @@ func (a AppModule) LegacyQuerierHandler(_ *codec.LegacyAmino) sdk.Querier {
 func (a AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServer(a.keeper))
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServer(a.keeper))
+
+	m := keeper.NewMigrator(a.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Errorf("registering %s module migration from version 1 to 2: %w", types.ModuleName, err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the module. It returns no validator updates.
@@ -144,7 +149,7 @@ func (a AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawM
 
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (a AppModule) ConsensusVersion() uint64 {
-	return 1
+	return 2
 }
 
 // BeginBlock returns the begin blocker for the module.
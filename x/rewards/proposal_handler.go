@@ -0,0 +1,26 @@
+package rewards
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/archway-network/archway/x/rewards/keeper"
+	"github.com/archway-network/archway/x/rewards/types"
+)
+
+// NewProposalHandler creates a new x/gov proposal handler for the module's proposal types.
+func NewProposalHandler(k keeper.Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, content govTypes.Content) error {
+		switch c := content.(type) {
+		case *types.TreasurySpendProposal:
+			return k.SpendTreasuryFunds(ctx, c.MustGetRecipient(), c.Amount)
+		case *types.TreasuryBurnProposal:
+			return k.BurnTreasuryFunds(ctx, c.Amount)
+		case *types.SetContractMetadataProposal:
+			return k.SetContractMetadataByGov(ctx, c.Metadata.MustGetContractAddress(), c.Metadata)
+		default:
+			return sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "unrecognized rewards proposal content type: %T", c)
+		}
+	}
+}
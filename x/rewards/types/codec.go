@@ -6,6 +6,7 @@ import (
 	cryptoCodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary interfaces and concrete types on the provided LegacyAmino codec.
@@ -13,6 +14,18 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetContractMetadata{}, "rewards/MsgSetContractMetadata", nil)
 	cdc.RegisterConcrete(&MsgWithdrawRewards{}, "rewards/MsgWithdrawRewards", nil)
+	cdc.RegisterConcrete(&MsgSetFlatFee{}, "rewards/MsgSetFlatFee", nil)
+	cdc.RegisterConcrete(&MsgWithdrawRewardsAndDelegate{}, "rewards/MsgWithdrawRewardsAndDelegate", nil)
+	cdc.RegisterConcrete(&MsgAcceptContractMetadataOwnership{}, "rewards/MsgAcceptContractMetadataOwnership", nil)
+	cdc.RegisterConcrete(&MsgCancelContractMetadataOwnership{}, "rewards/MsgCancelContractMetadataOwnership", nil)
+	cdc.RegisterConcrete(&MsgSetCodeMetadata{}, "rewards/MsgSetCodeMetadata", nil)
+	cdc.RegisterConcrete(&MsgSetAutoPayout{}, "rewards/MsgSetAutoPayout", nil)
+	cdc.RegisterConcrete(&MsgSetSponsorship{}, "rewards/MsgSetSponsorship", nil)
+	cdc.RegisterConcrete(&MsgDepositSponsorship{}, "rewards/MsgDepositSponsorship", nil)
+	cdc.RegisterConcrete(&MsgWithdrawSponsorship{}, "rewards/MsgWithdrawSponsorship", nil)
+	cdc.RegisterConcrete(&TreasurySpendProposal{}, "rewards/TreasurySpendProposal", nil)
+	cdc.RegisterConcrete(&TreasuryBurnProposal{}, "rewards/TreasuryBurnProposal", nil)
+	cdc.RegisterConcrete(&SetContractMetadataProposal{}, "rewards/SetContractMetadataProposal", nil)
 }
 
 // RegisterInterfaces registers interfaces types with the interface registry.
@@ -20,6 +33,20 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSetContractMetadata{},
 		&MsgWithdrawRewards{},
+		&MsgSetFlatFee{},
+		&MsgWithdrawRewardsAndDelegate{},
+		&MsgAcceptContractMetadataOwnership{},
+		&MsgCancelContractMetadataOwnership{},
+		&MsgSetCodeMetadata{},
+		&MsgSetAutoPayout{},
+		&MsgSetSponsorship{},
+		&MsgDepositSponsorship{},
+		&MsgWithdrawSponsorship{},
+	)
+	registry.RegisterImplementations((*govTypes.Content)(nil),
+		&TreasurySpendProposal{},
+		&TreasuryBurnProposal{},
+		&SetContractMetadataProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -9,4 +9,10 @@ var (
 	ErrMetadataNotFound = sdkErrors.Register(DefaultCodespace, 2, "metadata not found")     // contract metadata not found
 	ErrUnauthorized     = sdkErrors.Register(DefaultCodespace, 3, "unauthorized operation") // contract ownership issue
 	ErrInvalidRequest   = sdkErrors.Register(DefaultCodespace, 4, "invalid request")        // request parsing issue
+
+	ErrInsufficientTreasuryFunds = sdkErrors.Register(DefaultCodespace, 5, "insufficient treasury funds") // treasury spend / burn amount exceeds the balance
+	ErrCodeNotFound              = sdkErrors.Register(DefaultCodespace, 6, "code not found")              // code info not found
+	ErrSponsorshipNotFound       = sdkErrors.Register(DefaultCodespace, 7, "sponsorship not found")       // contract sponsorship not found
+	ErrInsufficientSponsorship   = sdkErrors.Register(DefaultCodespace, 8, "insufficient sponsorship")    // sponsorship deposit or limits don't cover the amount
+	ErrInsufficientRewards       = sdkErrors.Register(DefaultCodespace, 9, "insufficient rewards")        // rewards records don't cover the amount
 )
@@ -16,13 +16,15 @@ func EmitContractMetadataSetEvent(ctx sdk.Context, contractAddr sdk.AccAddress,
 	}
 }
 
-func EmitContractRewardCalculationEvent(ctx sdk.Context, contractAddr sdk.AccAddress, gasConsumed uint64, inflationRewards sdk.Coin, feeRebateRewards sdk.Coins, metadata *ContractMetadata) {
+func EmitContractRewardCalculationEvent(ctx sdk.Context, contractAddr sdk.AccAddress, gasConsumed, weightedGasConsumed uint64, inflationRewards sdk.Coin, feeRebateRewards sdk.Coins, metadata *ContractMetadata, inflationRewardsCapped sdk.Coin) {
 	err := ctx.EventManager().EmitTypedEvent(&ContractRewardCalculationEvent{
-		ContractAddress:  contractAddr.String(),
-		GasConsumed:      gasConsumed,
-		InflationRewards: inflationRewards,
-		FeeRebateRewards: feeRebateRewards,
-		Metadata:         metadata,
+		ContractAddress:        contractAddr.String(),
+		GasConsumed:            gasConsumed,
+		InflationRewards:       inflationRewards,
+		FeeRebateRewards:       feeRebateRewards,
+		Metadata:               metadata,
+		InflationRewardsCapped: inflationRewardsCapped,
+		WeightedGasConsumed:    weightedGasConsumed,
 	})
 	if err != nil {
 		panic(fmt.Errorf("sending ContractRewardCalculationEvent event: %w", err))
@@ -39,6 +41,19 @@ func EmitRewardsWithdrawEvent(ctx sdk.Context, rewardAddress sdk.AccAddress, rew
 	}
 }
 
+func EmitRewardsIBCWithdrawEvent(ctx sdk.Context, rewardAddress sdk.AccAddress, rewards sdk.Coins, ibcTransfer MsgWithdrawRewards_IBCTransfer) {
+	err := ctx.EventManager().EmitTypedEvent(&RewardsIBCWithdrawEvent{
+		RewardAddress:    rewardAddress.String(),
+		Rewards:          rewards,
+		SourceChannel:    ibcTransfer.SourceChannel,
+		Receiver:         ibcTransfer.Receiver,
+		TimeoutTimestamp: ibcTransfer.TimeoutTimestamp,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending RewardsIBCWithdrawEvent event: %w", err))
+	}
+}
+
 func EmitMinConsensusFeeSetEvent(ctx sdk.Context, fee sdk.DecCoin) {
 	err := ctx.EventManager().EmitTypedEvent(&MinConsensusFeeSetEvent{
 		Fee: fee,
@@ -47,3 +62,157 @@ func EmitMinConsensusFeeSetEvent(ctx sdk.Context, fee sdk.DecCoin) {
 		panic(fmt.Errorf("sending MinConsensusFeeSetEvent event: %w", err))
 	}
 }
+
+func EmitContractFlatFeeSetEvent(ctx sdk.Context, contractAddr sdk.AccAddress, flatFee sdk.Coin) {
+	err := ctx.EventManager().EmitTypedEvent(&ContractFlatFeeSetEvent{
+		ContractAddress: contractAddr.String(),
+		FlatFee:         flatFee,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending ContractFlatFeeSetEvent event: %w", err))
+	}
+}
+
+func EmitContractFlatFeeCollectedEvent(ctx sdk.Context, contractAddr sdk.AccAddress, flatFee sdk.Coin) {
+	err := ctx.EventManager().EmitTypedEvent(&ContractFlatFeeCollectedEvent{
+		ContractAddress: contractAddr.String(),
+		FlatFee:         flatFee,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending ContractFlatFeeCollectedEvent event: %w", err))
+	}
+}
+
+func EmitTreasurySpendEvent(ctx sdk.Context, operationID uint64, recipient sdk.AccAddress, amount sdk.Coins) {
+	err := ctx.EventManager().EmitTypedEvent(&TreasurySpendEvent{
+		OperationId: operationID,
+		Recipient:   recipient.String(),
+		Amount:      amount,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending TreasurySpendEvent event: %w", err))
+	}
+}
+
+func EmitTreasuryBurnEvent(ctx sdk.Context, operationID uint64, amount sdk.Coins) {
+	err := ctx.EventManager().EmitTypedEvent(&TreasuryBurnEvent{
+		OperationId: operationID,
+		Amount:      amount,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending TreasuryBurnEvent event: %w", err))
+	}
+}
+
+func EmitCodeMetadataSetEvent(ctx sdk.Context, codeID uint64, metadata CodeMetadata) {
+	err := ctx.EventManager().EmitTypedEvent(&CodeMetadataSetEvent{
+		CodeId:   codeID,
+		Metadata: metadata,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending CodeMetadataSetEvent event: %w", err))
+	}
+}
+
+func EmitContractMetadataGovSetEvent(ctx sdk.Context, contractAddr sdk.AccAddress, metadata ContractMetadata) {
+	err := ctx.EventManager().EmitTypedEvent(&ContractMetadataGovSetEvent{
+		ContractAddress: contractAddr.String(),
+		Metadata:        metadata,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending ContractMetadataGovSetEvent event: %w", err))
+	}
+}
+
+func EmitRewardsRecordsExpiredEvent(ctx sdk.Context, rewardsAddr sdk.AccAddress, rewards sdk.Coins, recordsNum int) {
+	err := ctx.EventManager().EmitTypedEvent(&RewardsRecordsExpiredEvent{
+		RewardsAddress: rewardsAddr.String(),
+		Rewards:        rewards,
+		RecordsNum:     uint64(recordsNum),
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending RewardsRecordsExpiredEvent event: %w", err))
+	}
+}
+
+func EmitAutoPayoutSetEvent(ctx sdk.Context, contractAddr sdk.AccAddress, autoPayout AutoPayout) {
+	err := ctx.EventManager().EmitTypedEvent(&AutoPayoutSetEvent{
+		ContractAddress: contractAddr.String(),
+		AutoPayout:      autoPayout,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending AutoPayoutSetEvent event: %w", err))
+	}
+}
+
+func EmitBaseFeeSetEvent(ctx sdk.Context, fee sdk.DecCoin, blockGasUsed uint64) {
+	err := ctx.EventManager().EmitTypedEvent(&BaseFeeSetEvent{
+		Fee:          fee,
+		BlockGasUsed: blockGasUsed,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending BaseFeeSetEvent event: %w", err))
+	}
+}
+
+func EmitBaseFeeBurnEvent(ctx sdk.Context, burned sdk.Coins) {
+	err := ctx.EventManager().EmitTypedEvent(&BaseFeeBurnEvent{
+		Burned: burned,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending BaseFeeBurnEvent event: %w", err))
+	}
+}
+
+func EmitTxFeeRefundEvent(ctx sdk.Context, txID uint64, feePayer sdk.AccAddress, refund sdk.Coins) {
+	err := ctx.EventManager().EmitTypedEvent(&TxFeeRefundEvent{
+		TxId:     txID,
+		FeePayer: feePayer.String(),
+		Refund:   refund,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending TxFeeRefundEvent event: %w", err))
+	}
+}
+
+func EmitSponsorshipSetEvent(ctx sdk.Context, sponsorship Sponsorship) {
+	err := ctx.EventManager().EmitTypedEvent(&SponsorshipSetEvent{
+		Sponsorship: sponsorship,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending SponsorshipSetEvent event: %w", err))
+	}
+}
+
+func EmitSponsorshipDepositEvent(ctx sdk.Context, contractAddr, senderAddr sdk.AccAddress, amount sdk.Coins) {
+	err := ctx.EventManager().EmitTypedEvent(&SponsorshipDepositEvent{
+		ContractAddress: contractAddr.String(),
+		SenderAddress:   senderAddr.String(),
+		Amount:          amount,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending SponsorshipDepositEvent event: %w", err))
+	}
+}
+
+func EmitSponsorshipWithdrawEvent(ctx sdk.Context, contractAddr, recipientAddr sdk.AccAddress, amount sdk.Coins) {
+	err := ctx.EventManager().EmitTypedEvent(&SponsorshipWithdrawEvent{
+		ContractAddress:  contractAddr.String(),
+		RecipientAddress: recipientAddr.String(),
+		Amount:           amount,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending SponsorshipWithdrawEvent event: %w", err))
+	}
+}
+
+func EmitTxFeeSponsoredEvent(ctx sdk.Context, contractAddr, userAddr sdk.AccAddress, fees sdk.Coins) {
+	err := ctx.EventManager().EmitTypedEvent(&TxFeeSponsoredEvent{
+		ContractAddress: contractAddr.String(),
+		UserAddress:     userAddr.String(),
+		Fees:            fees,
+	})
+	if err != nil {
+		panic(fmt.Errorf("sending TxFeeSponsoredEvent event: %w", err))
+	}
+}
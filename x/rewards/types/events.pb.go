@@ -92,6 +92,10 @@ type ContractRewardCalculationEvent struct {
 	FeeRebateRewards []types.Coin `protobuf:"bytes,4,rep,name=fee_rebate_rewards,json=feeRebateRewards,proto3" json:"fee_rebate_rewards"`
 	// metadata defines the contract metadata (if set).
 	Metadata *ContractMetadata `protobuf:"bytes,5,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// inflation_rewards_capped defines the inflation rewards amount cut by the contract inflation caps (transferred to the Treasury).
+	InflationRewardsCapped types.Coin `protobuf:"bytes,6,opt,name=inflation_rewards_capped,json=inflationRewardsCapped,proto3" json:"inflation_rewards_capped"`
+	// weighted_gas_consumed defines the gas consumption weighted by the contract operation weights (used for the rewards estimation).
+	WeightedGasConsumed uint64 `protobuf:"varint,7,opt,name=weighted_gas_consumed,json=weightedGasConsumed,proto3" json:"weighted_gas_consumed,omitempty"`
 }
 
 func (m *ContractRewardCalculationEvent) Reset()         { *m = ContractRewardCalculationEvent{} }
@@ -162,6 +166,20 @@ func (m *ContractRewardCalculationEvent) GetMetadata() *ContractMetadata {
 	return nil
 }
 
+func (m *ContractRewardCalculationEvent) GetInflationRewardsCapped() types.Coin {
+	if m != nil {
+		return m.InflationRewardsCapped
+	}
+	return types.Coin{}
+}
+
+func (m *ContractRewardCalculationEvent) GetWeightedGasConsumed() uint64 {
+	if m != nil {
+		return m.WeightedGasConsumed
+	}
+	return 0
+}
+
 // RewardsWithdrawEvent is emitted when credited rewards for a specific rewards_address are distributed.
 // Event could be triggered by a transaction (via CLI for example) or by a contract via WASM bindings.
 type RewardsWithdrawEvent struct {
@@ -218,6 +236,89 @@ func (m *RewardsWithdrawEvent) GetRewards() []types.Coin {
 	return nil
 }
 
+// RewardsIBCWithdrawEvent is emitted when credited rewards for a specific rewards_address are distributed to
+// an address on another chain via IBC.
+type RewardsIBCWithdrawEvent struct {
+	// rewards_address defines the rewards address rewards are distributed for (IBC transfer sender).
+	RewardAddress string `protobuf:"bytes,1,opt,name=reward_address,json=rewardAddress,proto3" json:"reward_address,omitempty"`
+	// rewards defines the total rewards being distributed.
+	Rewards []types.Coin `protobuf:"bytes,2,rep,name=rewards,proto3" json:"rewards"`
+	// source_channel defines the ICS-20 transfer channel ID.
+	SourceChannel string `protobuf:"bytes,3,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	// receiver defines the rewards receiver address on the counterparty chain.
+	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// timeout_timestamp defines the transfer timeout timestamp (UNIX time in nanoseconds).
+	TimeoutTimestamp uint64 `protobuf:"varint,5,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+}
+
+func (m *RewardsIBCWithdrawEvent) Reset()         { *m = RewardsIBCWithdrawEvent{} }
+func (m *RewardsIBCWithdrawEvent) String() string { return proto.CompactTextString(m) }
+func (*RewardsIBCWithdrawEvent) ProtoMessage()    {}
+func (*RewardsIBCWithdrawEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad2689b4f7dc3cd8, []int{3}
+}
+func (m *RewardsIBCWithdrawEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardsIBCWithdrawEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardsIBCWithdrawEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardsIBCWithdrawEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardsIBCWithdrawEvent.Merge(m, src)
+}
+func (m *RewardsIBCWithdrawEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardsIBCWithdrawEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardsIBCWithdrawEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardsIBCWithdrawEvent proto.InternalMessageInfo
+
+func (m *RewardsIBCWithdrawEvent) GetRewardAddress() string {
+	if m != nil {
+		return m.RewardAddress
+	}
+	return ""
+}
+
+func (m *RewardsIBCWithdrawEvent) GetRewards() []types.Coin {
+	if m != nil {
+		return m.Rewards
+	}
+	return nil
+}
+
+func (m *RewardsIBCWithdrawEvent) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
+	}
+	return ""
+}
+
+func (m *RewardsIBCWithdrawEvent) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *RewardsIBCWithdrawEvent) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
 // MinConsensusFeeSetEvent is emitted when the minimum consensus fee is updated.
 type MinConsensusFeeSetEvent struct {
 	// fee defines the updated minimum gas unit price.
@@ -228,7 +329,7 @@ func (m *MinConsensusFeeSetEvent) Reset()         { *m = MinConsensusFeeSetEvent
 func (m *MinConsensusFeeSetEvent) String() string { return proto.CompactTextString(m) }
 func (*MinConsensusFeeSetEvent) ProtoMessage()    {}
 func (*MinConsensusFeeSetEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_ad2689b4f7dc3cd8, []int{3}
+	return fileDescriptor_ad2689b4f7dc3cd8, []int{4}
 }
 func (m *MinConsensusFeeSetEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -264,329 +365,4161 @@ func (m *MinConsensusFeeSetEvent) GetFee() types.DecCoin {
 	return types.DecCoin{}
 }
 
-func init() {
-	proto.RegisterType((*ContractMetadataSetEvent)(nil), "archway.rewards.v1beta1.ContractMetadataSetEvent")
-	proto.RegisterType((*ContractRewardCalculationEvent)(nil), "archway.rewards.v1beta1.ContractRewardCalculationEvent")
-	proto.RegisterType((*RewardsWithdrawEvent)(nil), "archway.rewards.v1beta1.RewardsWithdrawEvent")
-	proto.RegisterType((*MinConsensusFeeSetEvent)(nil), "archway.rewards.v1beta1.MinConsensusFeeSetEvent")
+// ContractFlatFeeSetEvent is emitted when the contract flat fee is updated.
+type ContractFlatFeeSetEvent struct {
+	// contract_address defines the contract address.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// flat_fee defines the updated flat fee (zero amount if removed).
+	FlatFee types.Coin `protobuf:"bytes,2,opt,name=flat_fee,json=flatFee,proto3" json:"flat_fee"`
 }
 
-func init() {
-	proto.RegisterFile("archway/rewards/v1beta1/events.proto", fileDescriptor_ad2689b4f7dc3cd8)
+func (m *ContractFlatFeeSetEvent) Reset()         { *m = ContractFlatFeeSetEvent{} }
+func (m *ContractFlatFeeSetEvent) String() string { return proto.CompactTextString(m) }
+func (*ContractFlatFeeSetEvent) ProtoMessage()    {}
+func (*ContractFlatFeeSetEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad2689b4f7dc3cd8, []int{5}
+}
+func (m *ContractFlatFeeSetEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractFlatFeeSetEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractFlatFeeSetEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractFlatFeeSetEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractFlatFeeSetEvent.Merge(m, src)
+}
+func (m *ContractFlatFeeSetEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractFlatFeeSetEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractFlatFeeSetEvent.DiscardUnknown(m)
 }
 
-var fileDescriptor_ad2689b4f7dc3cd8 = []byte{
-	// 454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4d, 0x6f, 0x13, 0x31,
-	0x10, 0x86, 0xb3, 0x49, 0xf8, 0x72, 0xf8, 0x28, 0xab, 0x4a, 0x0d, 0x15, 0x32, 0x21, 0xa2, 0x52,
-	0x7b, 0xc0, 0xab, 0x06, 0x2e, 0x1c, 0x69, 0x28, 0x17, 0x1a, 0x21, 0x2d, 0x07, 0x24, 0x2e, 0xd1,
-	0xac, 0x77, 0xb2, 0x59, 0xd1, 0xd8, 0x95, 0xed, 0x34, 0xed, 0xbf, 0x40, 0xfc, 0xaa, 0x1e, 0x2b,
-	0x4e, 0x9c, 0x10, 0x4a, 0xfe, 0x08, 0x5a, 0x7f, 0xac, 0xa2, 0x8a, 0x4a, 0xed, 0x6d, 0x77, 0xfc,
-	0xce, 0x33, 0xef, 0xbc, 0xb2, 0xc9, 0x2b, 0x50, 0x7c, 0xba, 0x80, 0xf3, 0x44, 0xe1, 0x02, 0x54,
-	0xae, 0x93, 0xd3, 0xfd, 0x0c, 0x0d, 0xec, 0x27, 0x78, 0x8a, 0xc2, 0x68, 0x76, 0xa2, 0xa4, 0x91,
-	0xf1, 0x96, 0x57, 0x31, 0xaf, 0x62, 0x5e, 0xb5, 0xbd, 0x59, 0xc8, 0x42, 0x5a, 0x4d, 0x52, 0x7d,
-	0x39, 0xf9, 0x36, 0xe5, 0x52, 0xcf, 0xa4, 0x4e, 0x32, 0xd0, 0x58, 0x03, 0xb9, 0x2c, 0x85, 0x3f,
-	0xdf, 0xb9, 0x6e, 0x68, 0xc0, 0x5b, 0x59, 0xff, 0x67, 0x44, 0xba, 0x43, 0x29, 0x8c, 0x02, 0x6e,
-	0x46, 0x68, 0x20, 0x07, 0x03, 0x5f, 0xd0, 0x1c, 0x56, 0xce, 0xe2, 0x3d, 0xb2, 0xc1, 0xfd, 0xd9,
-	0x18, 0xf2, 0x5c, 0xa1, 0xd6, 0xdd, 0xa8, 0x17, 0xed, 0x3e, 0x48, 0x9f, 0x84, 0xfa, 0x7b, 0x57,
-	0x8e, 0x3f, 0x91, 0xfb, 0x33, 0xdf, 0xde, 0x6d, 0xf6, 0xa2, 0xdd, 0xce, 0x60, 0x8f, 0x5d, 0xb3,
-	0x10, 0xbb, 0x3a, 0xef, 0xa0, 0x7d, 0xf1, 0xe7, 0x45, 0x23, 0xad, 0x01, 0xfd, 0x5f, 0x4d, 0x42,
-	0x83, 0x28, 0xb5, 0xcd, 0x43, 0x38, 0xe6, 0xf3, 0x63, 0x30, 0xa5, 0x14, 0xb7, 0xb6, 0xf6, 0x92,
-	0x3c, 0x2c, 0x40, 0x8f, 0xb9, 0x14, 0x7a, 0x3e, 0xc3, 0xdc, 0xda, 0x6b, 0xa7, 0x9d, 0x02, 0xf4,
-	0xd0, 0x97, 0xe2, 0x23, 0xf2, 0xb4, 0x14, 0x13, 0xc7, 0x1f, 0x7b, 0xbb, 0xdd, 0x96, 0x5d, 0xe3,
-	0x19, 0x73, 0x41, 0xb3, 0x2a, 0xe8, 0xb5, 0x15, 0x4a, 0xe1, 0x6d, 0x6f, 0xd4, 0x9d, 0xce, 0xaa,
-	0x8e, 0x47, 0x24, 0x9e, 0x20, 0x8e, 0x15, 0x66, 0x60, 0xb0, 0xc6, 0xb5, 0x7b, 0xad, 0x1b, 0xe1,
-	0x26, 0x88, 0xa9, 0xed, 0x0c, 0xb8, 0xc3, 0xb5, 0x68, 0xef, 0xdc, 0x32, 0xda, 0xb5, 0x50, 0xcf,
-	0xc8, 0xa6, 0x27, 0x7e, 0x2d, 0xcd, 0x34, 0x57, 0xb0, 0x70, 0x49, 0xee, 0x90, 0xc7, 0x8e, 0x72,
-	0x25, 0xc7, 0x47, 0xae, 0x1a, 0x52, 0x7c, 0x47, 0xee, 0x85, 0x4d, 0x9a, 0x37, 0xdb, 0x24, 0xe8,
-	0xfb, 0x9f, 0xc9, 0xd6, 0xa8, 0x14, 0x55, 0xd8, 0x28, 0xf4, 0x5c, 0x7f, 0x44, 0xac, 0x6f, 0xd8,
-	0x5b, 0xd2, 0x9a, 0x20, 0xda, 0x89, 0x9d, 0xc1, 0xf3, 0xff, 0x12, 0x3f, 0x20, 0x5f, 0x83, 0x56,
-	0xf2, 0x83, 0xa3, 0x8b, 0x25, 0x8d, 0x2e, 0x97, 0x34, 0xfa, 0xbb, 0xa4, 0xd1, 0x8f, 0x15, 0x6d,
-	0x5c, 0xae, 0x68, 0xe3, 0xf7, 0x8a, 0x36, 0xbe, 0x0d, 0x8a, 0xd2, 0x4c, 0xe7, 0x19, 0xe3, 0x72,
-	0x96, 0xf8, 0x8c, 0x5e, 0x0b, 0x34, 0x0b, 0xa9, 0xbe, 0x87, 0xff, 0xe4, 0xac, 0x7e, 0x12, 0xe6,
-	0xfc, 0x04, 0x75, 0x76, 0xd7, 0xbe, 0x84, 0x37, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x16, 0x23,
-	0x8f, 0xc8, 0xa7, 0x03, 0x00, 0x00,
+var xxx_messageInfo_ContractFlatFeeSetEvent proto.InternalMessageInfo
+
+func (m *ContractFlatFeeSetEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
 }
 
-func (m *ContractMetadataSetEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ContractFlatFeeSetEvent) GetFlatFee() types.Coin {
+	if m != nil {
+		return m.FlatFee
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *ContractMetadataSetEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// ContractFlatFeeCollectedEvent is emitted when the contract flat fee is charged by a transaction.
+type ContractFlatFeeCollectedEvent struct {
+	// contract_address defines the contract address.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// flat_fee defines the collected fee (credited to the contract rewards recipients).
+	FlatFee types.Coin `protobuf:"bytes,2,opt,name=flat_fee,json=flatFee,proto3" json:"flat_fee"`
 }
 
-func (m *ContractMetadataSetEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+func (m *ContractFlatFeeCollectedEvent) Reset()         { *m = ContractFlatFeeCollectedEvent{} }
+func (m *ContractFlatFeeCollectedEvent) String() string { return proto.CompactTextString(m) }
+func (*ContractFlatFeeCollectedEvent) ProtoMessage()    {}
+func (*ContractFlatFeeCollectedEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad2689b4f7dc3cd8, []int{6}
+}
+func (m *ContractFlatFeeCollectedEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractFlatFeeCollectedEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractFlatFeeCollectedEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ContractFlatFeeCollectedEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractFlatFeeCollectedEvent.Merge(m, src)
+}
+func (m *ContractFlatFeeCollectedEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractFlatFeeCollectedEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractFlatFeeCollectedEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractFlatFeeCollectedEvent proto.InternalMessageInfo
+
+func (m *ContractFlatFeeCollectedEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ContractRewardCalculationEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ContractFlatFeeCollectedEvent) GetFlatFee() types.Coin {
+	if m != nil {
+		return m.FlatFee
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *ContractRewardCalculationEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TreasurySpendEvent is emitted when treasury funds are transferred to a recipient (gov proposal).
+type TreasurySpendEvent struct {
+	// operation_id defines the TreasuryOperation unique ID.
+	OperationId uint64 `protobuf:"varint,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	// recipient defines the address funds are transferred to.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount defines the transferred coins.
+	Amount []types.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount"`
 }
 
-func (m *ContractRewardCalculationEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Metadata != nil {
-		{
-			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintEvents(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.FeeRebateRewards) > 0 {
-		for iNdEx := len(m.FeeRebateRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeeRebateRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	{
-		size, err := m.InflationRewards.MarshalToSizedBuffer(dAtA[:i])
+func (m *TreasurySpendEvent) Reset()         { *m = TreasurySpendEvent{} }
+func (m *TreasurySpendEvent) String() string { return proto.CompactTextString(m) }
+func (*TreasurySpendEvent) ProtoMessage()    {}
+func (*TreasurySpendEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad2689b4f7dc3cd8, []int{7}
+}
+func (m *TreasurySpendEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreasurySpendEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreasurySpendEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if m.GasConsumed != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.GasConsumed))
-		i--
-		dAtA[i] = 0x10
+}
+func (m *TreasurySpendEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreasurySpendEvent.Merge(m, src)
+}
+func (m *TreasurySpendEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreasurySpendEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreasurySpendEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreasurySpendEvent proto.InternalMessageInfo
+
+func (m *TreasurySpendEvent) GetOperationId() uint64 {
+	if m != nil {
+		return m.OperationId
 	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *TreasurySpendEvent) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *RewardsWithdrawEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TreasurySpendEvent) GetAmount() []types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *RewardsWithdrawEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// TreasuryBurnEvent is emitted when treasury funds are burned (gov proposal).
+type TreasuryBurnEvent struct {
+	// operation_id defines the TreasuryOperation unique ID.
+	OperationId uint64 `protobuf:"varint,1,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	// amount defines the burned coins.
+	Amount []types.Coin `protobuf:"bytes,2,rep,name=amount,proto3" json:"amount"`
 }
 
-func (m *RewardsWithdrawEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintEvents(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *TreasuryBurnEvent) Reset()         { *m = TreasuryBurnEvent{} }
+func (m *TreasuryBurnEvent) String() string { return proto.CompactTextString(m) }
+func (*TreasuryBurnEvent) ProtoMessage()    {}
+func (*TreasuryBurnEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad2689b4f7dc3cd8, []int{8}
+}
+func (m *TreasuryBurnEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TreasuryBurnEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreasuryBurnEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.RewardAddress) > 0 {
-		i -= len(m.RewardAddress)
-		copy(dAtA[i:], m.RewardAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RewardAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *TreasuryBurnEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreasuryBurnEvent.Merge(m, src)
+}
+func (m *TreasuryBurnEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreasuryBurnEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreasuryBurnEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TreasuryBurnEvent proto.InternalMessageInfo
+
+func (m *TreasuryBurnEvent) GetOperationId() uint64 {
+	if m != nil {
+		return m.OperationId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MinConsensusFeeSetEvent) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TreasuryBurnEvent) GetAmount() []types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MinConsensusFeeSetEvent) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CodeMetadataSetEvent is emitted when the code metadata is created or updated.
+type CodeMetadataSetEvent struct {
+	// code_id defines the contract code ID.
+	CodeId uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// metadata defines the new code metadata state.
+	Metadata CodeMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
 }
 
-func (m *MinConsensusFeeSetEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+func (m *CodeMetadataSetEvent) Reset()         { *m = CodeMetadataSetEvent{} }
+func (m *CodeMetadataSetEvent) String() string { return proto.CompactTextString(m) }
+func (*CodeMetadataSetEvent) ProtoMessage()    {}
+func (*CodeMetadataSetEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad2689b4f7dc3cd8, []int{9}
+}
+func (m *CodeMetadataSetEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CodeMetadataSetEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeMetadataSetEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *CodeMetadataSetEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeMetadataSetEvent.Merge(m, src)
+}
+func (m *CodeMetadataSetEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *CodeMetadataSetEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeMetadataSetEvent.DiscardUnknown(m)
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_CodeMetadataSetEvent proto.InternalMessageInfo
+
+func (m *CodeMetadataSetEvent) GetCodeId() uint64 {
+	if m != nil {
+		return m.CodeId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return 0
 }
-func (m *ContractMetadataSetEvent) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+
+func (m *CodeMetadataSetEvent) GetMetadata() CodeMetadata {
+	if m != nil {
+		return m.Metadata
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	return CodeMetadata{}
 }
 
-func (m *ContractRewardCalculationEvent) Size() (n int) {
-	if m == nil {
-		return 0
+// ContractMetadataGovSetEvent is emitted when the contract metadata is set by a governance proposal.
+type ContractMetadataGovSetEvent struct {
+	// contract_address defines the contract address.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// metadata defines the new contract metadata state.
+	Metadata ContractMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
+}
+
+func (m *ContractMetadataGovSetEvent) Reset()         { *m = ContractMetadataGovSetEvent{} }
+func (m *ContractMetadataGovSetEvent) String() string { return proto.CompactTextString(m) }
+func (*ContractMetadataGovSetEvent) ProtoMessage()    {}
+func (*ContractMetadataGovSetEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad2689b4f7dc3cd8, []int{10}
+}
+func (m *ContractMetadataGovSetEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractMetadataGovSetEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractMetadataGovSetEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *ContractMetadataGovSetEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractMetadataGovSetEvent.Merge(m, src)
+}
+func (m *ContractMetadataGovSetEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractMetadataGovSetEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractMetadataGovSetEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractMetadataGovSetEvent proto.InternalMessageInfo
+
+func (m *ContractMetadataGovSetEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	if m.GasConsumed != 0 {
-		n += 1 + sovEvents(uint64(m.GasConsumed))
+	return ""
+}
+
+func (m *ContractMetadataGovSetEvent) GetMetadata() ContractMetadata {
+	if m != nil {
+		return m.Metadata
 	}
-	l = m.InflationRewards.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	if len(m.FeeRebateRewards) > 0 {
-		for _, e := range m.FeeRebateRewards {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	return ContractMetadata{}
+}
+
+// RewardsRecordsExpiredEvent is emitted when unclaimed rewards records of a rewards address are expired and swept to the Treasury.
+type RewardsRecordsExpiredEvent struct {
+	// rewards_address is the rewards address of the expired records (bech32 encoded).
+	RewardsAddress string `protobuf:"bytes,1,opt,name=rewards_address,json=rewardsAddress,proto3" json:"rewards_address,omitempty"`
+	// rewards are the total expired records rewards transferred to the Treasury.
+	Rewards []types.Coin `protobuf:"bytes,2,rep,name=rewards,proto3" json:"rewards"`
+	// records_num is the number of RewardsRecord objects expired.
+	RecordsNum uint64 `protobuf:"varint,3,opt,name=records_num,json=recordsNum,proto3" json:"records_num,omitempty"`
+}
+
+func (m *RewardsRecordsExpiredEvent) Reset()         { *m = RewardsRecordsExpiredEvent{} }
+func (m *RewardsRecordsExpiredEvent) String() string { return proto.CompactTextString(m) }
+func (*RewardsRecordsExpiredEvent) ProtoMessage()    {}
+func (*RewardsRecordsExpiredEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad2689b4f7dc3cd8, []int{11}
+}
+func (m *RewardsRecordsExpiredEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardsRecordsExpiredEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardsRecordsExpiredEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Metadata != nil {
-		l = m.Metadata.Size()
-		n += 1 + l + sovEvents(uint64(l))
+}
+func (m *RewardsRecordsExpiredEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardsRecordsExpiredEvent.Merge(m, src)
+}
+func (m *RewardsRecordsExpiredEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardsRecordsExpiredEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardsRecordsExpiredEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardsRecordsExpiredEvent proto.InternalMessageInfo
+
+func (m *RewardsRecordsExpiredEvent) GetRewardsAddress() string {
+	if m != nil {
+		return m.RewardsAddress
 	}
-	return n
+	return ""
 }
 
-func (m *RewardsWithdrawEvent) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RewardsRecordsExpiredEvent) GetRewards() []types.Coin {
+	if m != nil {
+		return m.Rewards
 	}
-	var l int
-	_ = l
-	l = len(m.RewardAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	return nil
+}
+
+func (m *RewardsRecordsExpiredEvent) GetRecordsNum() uint64 {
+	if m != nil {
+		return m.RecordsNum
 	}
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+	return 0
+}
+
+// AutoPayoutSetEvent is emitted when the contract automatic payout options are set or removed.
+type AutoPayoutSetEvent struct {
+	// contract_address defines the contract address.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// auto_payout defines the new options state (both triggers are empty if removed).
+	AutoPayout AutoPayout `protobuf:"bytes,2,opt,name=auto_payout,json=autoPayout,proto3" json:"auto_payout"`
+}
+
+func (m *AutoPayoutSetEvent) Reset()         { *m = AutoPayoutSetEvent{} }
+func (m *AutoPayoutSetEvent) String() string { return proto.CompactTextString(m) }
+func (*AutoPayoutSetEvent) ProtoMessage()    {}
+func (*AutoPayoutSetEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad2689b4f7dc3cd8, []int{12}
+}
+func (m *AutoPayoutSetEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoPayoutSetEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoPayoutSetEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *AutoPayoutSetEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoPayoutSetEvent.Merge(m, src)
+}
+func (m *AutoPayoutSetEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoPayoutSetEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoPayoutSetEvent.DiscardUnknown(m)
 }
 
-func (m *MinConsensusFeeSetEvent) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_AutoPayoutSetEvent proto.InternalMessageInfo
+
+func (m *AutoPayoutSetEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	var l int
-	_ = l
-	l = m.Fee.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	return ""
 }
 
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *AutoPayoutSetEvent) GetAutoPayout() AutoPayout {
+	if m != nil {
+		return m.AutoPayout
+	}
+	return AutoPayout{}
 }
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+// BaseFeeSetEvent is emitted when the base fee is updated.
+type BaseFeeSetEvent struct {
+	// fee defines the updated base gas unit price.
+	Fee types.DecCoin `protobuf:"bytes,1,opt,name=fee,proto3" json:"fee"`
+	// block_gas_used defines the block gas used the fee was adjusted for.
+	BlockGasUsed uint64 `protobuf:"varint,2,opt,name=block_gas_used,json=blockGasUsed,proto3" json:"block_gas_used,omitempty"`
+}
+
+func (m *BaseFeeSetEvent) Reset()         { *m = BaseFeeSetEvent{} }
+func (m *BaseFeeSetEvent) String() string { return proto.CompactTextString(m) }
+func (*BaseFeeSetEvent) ProtoMessage()    {}
+func (*BaseFeeSetEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad2689b4f7dc3cd8, []int{13}
+}
+func (m *BaseFeeSetEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseFeeSetEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseFeeSetEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseFeeSetEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseFeeSetEvent.Merge(m, src)
+}
+func (m *BaseFeeSetEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseFeeSetEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseFeeSetEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseFeeSetEvent proto.InternalMessageInfo
+
+func (m *BaseFeeSetEvent) GetFee() types.DecCoin {
+	if m != nil {
+		return m.Fee
+	}
+	return types.DecCoin{}
+}
+
+func (m *BaseFeeSetEvent) GetBlockGasUsed() uint64 {
+	if m != nil {
+		return m.BlockGasUsed
+	}
+	return 0
+}
+
+// BaseFeeBurnEvent is emitted when the base fee share of transaction fees is burned.
+type BaseFeeBurnEvent struct {
+	// burned defines the burned coins.
+	Burned []types.Coin `protobuf:"bytes,1,rep,name=burned,proto3" json:"burned"`
+}
+
+func (m *BaseFeeBurnEvent) Reset()         { *m = BaseFeeBurnEvent{} }
+func (m *BaseFeeBurnEvent) String() string { return proto.CompactTextString(m) }
+func (*BaseFeeBurnEvent) ProtoMessage()    {}
+func (*BaseFeeBurnEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad2689b4f7dc3cd8, []int{14}
+}
+func (m *BaseFeeBurnEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseFeeBurnEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseFeeBurnEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseFeeBurnEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseFeeBurnEvent.Merge(m, src)
+}
+func (m *BaseFeeBurnEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseFeeBurnEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseFeeBurnEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseFeeBurnEvent proto.InternalMessageInfo
+
+func (m *BaseFeeBurnEvent) GetBurned() []types.Coin {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+// TxFeeRefundEvent is emitted when fees paid for the unused transaction gas are refunded.
+type TxFeeRefundEvent struct {
+	// tx_id is the tracking transaction ID.
+	TxId uint64 `protobuf:"varint,1,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	// fee_payer is the refund recipient: the transaction fee payer or the fee granter if set (bech32 encoded).
+	FeePayer string `protobuf:"bytes,2,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// refund defines the refunded coins.
+	Refund []types.Coin `protobuf:"bytes,3,rep,name=refund,proto3" json:"refund"`
+}
+
+func (m *TxFeeRefundEvent) Reset()         { *m = TxFeeRefundEvent{} }
+func (m *TxFeeRefundEvent) String() string { return proto.CompactTextString(m) }
+func (*TxFeeRefundEvent) ProtoMessage()    {}
+func (*TxFeeRefundEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad2689b4f7dc3cd8, []int{15}
+}
+func (m *TxFeeRefundEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxFeeRefundEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxFeeRefundEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxFeeRefundEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxFeeRefundEvent.Merge(m, src)
+}
+func (m *TxFeeRefundEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxFeeRefundEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxFeeRefundEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxFeeRefundEvent proto.InternalMessageInfo
+
+func (m *TxFeeRefundEvent) GetTxId() uint64 {
+	if m != nil {
+		return m.TxId
+	}
+	return 0
+}
+
+func (m *TxFeeRefundEvent) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
+	}
+	return ""
+}
+
+func (m *TxFeeRefundEvent) GetRefund() []types.Coin {
+	if m != nil {
+		return m.Refund
+	}
+	return nil
+}
+
+// SponsorshipSetEvent is emitted when the contract sponsorship is created or updated.
+type SponsorshipSetEvent struct {
+	// sponsorship defines the new sponsorship state.
+	Sponsorship Sponsorship `protobuf:"bytes,1,opt,name=sponsorship,proto3" json:"sponsorship"`
+}
+
+func (m *SponsorshipSetEvent) Reset()         { *m = SponsorshipSetEvent{} }
+func (m *SponsorshipSetEvent) String() string { return proto.CompactTextString(m) }
+func (*SponsorshipSetEvent) ProtoMessage()    {}
+func (*SponsorshipSetEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad2689b4f7dc3cd8, []int{16}
+}
+func (m *SponsorshipSetEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SponsorshipSetEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SponsorshipSetEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SponsorshipSetEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsorshipSetEvent.Merge(m, src)
+}
+func (m *SponsorshipSetEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SponsorshipSetEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsorshipSetEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsorshipSetEvent proto.InternalMessageInfo
+
+func (m *SponsorshipSetEvent) GetSponsorship() Sponsorship {
+	if m != nil {
+		return m.Sponsorship
+	}
+	return Sponsorship{}
+}
+
+// SponsorshipDepositEvent is emitted when funds are added to the contract sponsorship deposit.
+type SponsorshipDepositEvent struct {
+	// contract_address defines the sponsoring contract address.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// sender_address defines the funds sender (the contract itself for pledged rewards).
+	SenderAddress string `protobuf:"bytes,2,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// amount defines the deposited coins.
+	Amount []types.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount"`
+}
+
+func (m *SponsorshipDepositEvent) Reset()         { *m = SponsorshipDepositEvent{} }
+func (m *SponsorshipDepositEvent) String() string { return proto.CompactTextString(m) }
+func (*SponsorshipDepositEvent) ProtoMessage()    {}
+func (*SponsorshipDepositEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad2689b4f7dc3cd8, []int{17}
+}
+func (m *SponsorshipDepositEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SponsorshipDepositEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SponsorshipDepositEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SponsorshipDepositEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsorshipDepositEvent.Merge(m, src)
+}
+func (m *SponsorshipDepositEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SponsorshipDepositEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsorshipDepositEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsorshipDepositEvent proto.InternalMessageInfo
+
+func (m *SponsorshipDepositEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *SponsorshipDepositEvent) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
+	}
+	return ""
+}
+
+func (m *SponsorshipDepositEvent) GetAmount() []types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// SponsorshipWithdrawEvent is emitted when funds are withdrawn from the contract sponsorship deposit.
+type SponsorshipWithdrawEvent struct {
+	// contract_address defines the sponsoring contract address.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// recipient_address defines the funds receiver.
+	RecipientAddress string `protobuf:"bytes,2,opt,name=recipient_address,json=recipientAddress,proto3" json:"recipient_address,omitempty"`
+	// amount defines the withdrawn coins.
+	Amount []types.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount"`
+}
+
+func (m *SponsorshipWithdrawEvent) Reset()         { *m = SponsorshipWithdrawEvent{} }
+func (m *SponsorshipWithdrawEvent) String() string { return proto.CompactTextString(m) }
+func (*SponsorshipWithdrawEvent) ProtoMessage()    {}
+func (*SponsorshipWithdrawEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad2689b4f7dc3cd8, []int{18}
+}
+func (m *SponsorshipWithdrawEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SponsorshipWithdrawEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SponsorshipWithdrawEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SponsorshipWithdrawEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsorshipWithdrawEvent.Merge(m, src)
+}
+func (m *SponsorshipWithdrawEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *SponsorshipWithdrawEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsorshipWithdrawEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsorshipWithdrawEvent proto.InternalMessageInfo
+
+func (m *SponsorshipWithdrawEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *SponsorshipWithdrawEvent) GetRecipientAddress() string {
+	if m != nil {
+		return m.RecipientAddress
+	}
+	return ""
+}
+
+func (m *SponsorshipWithdrawEvent) GetAmount() []types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// TxFeeSponsoredEvent is emitted when transaction fees are paid from the contract sponsorship deposit.
+type TxFeeSponsoredEvent struct {
+	// contract_address defines the sponsoring contract address.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// user_address defines the sponsored transaction fee payer.
+	UserAddress string `protobuf:"bytes,2,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	// fees defines the sponsored fees.
+	Fees []types.Coin `protobuf:"bytes,3,rep,name=fees,proto3" json:"fees"`
+}
+
+func (m *TxFeeSponsoredEvent) Reset()         { *m = TxFeeSponsoredEvent{} }
+func (m *TxFeeSponsoredEvent) String() string { return proto.CompactTextString(m) }
+func (*TxFeeSponsoredEvent) ProtoMessage()    {}
+func (*TxFeeSponsoredEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ad2689b4f7dc3cd8, []int{19}
+}
+func (m *TxFeeSponsoredEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxFeeSponsoredEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxFeeSponsoredEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TxFeeSponsoredEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxFeeSponsoredEvent.Merge(m, src)
+}
+func (m *TxFeeSponsoredEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxFeeSponsoredEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxFeeSponsoredEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxFeeSponsoredEvent proto.InternalMessageInfo
+
+func (m *TxFeeSponsoredEvent) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *TxFeeSponsoredEvent) GetUserAddress() string {
+	if m != nil {
+		return m.UserAddress
+	}
+	return ""
+}
+
+func (m *TxFeeSponsoredEvent) GetFees() []types.Coin {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ContractMetadataSetEvent)(nil), "archway.rewards.v1beta1.ContractMetadataSetEvent")
+	proto.RegisterType((*ContractRewardCalculationEvent)(nil), "archway.rewards.v1beta1.ContractRewardCalculationEvent")
+	proto.RegisterType((*RewardsWithdrawEvent)(nil), "archway.rewards.v1beta1.RewardsWithdrawEvent")
+	proto.RegisterType((*RewardsIBCWithdrawEvent)(nil), "archway.rewards.v1beta1.RewardsIBCWithdrawEvent")
+	proto.RegisterType((*MinConsensusFeeSetEvent)(nil), "archway.rewards.v1beta1.MinConsensusFeeSetEvent")
+	proto.RegisterType((*ContractFlatFeeSetEvent)(nil), "archway.rewards.v1beta1.ContractFlatFeeSetEvent")
+	proto.RegisterType((*ContractFlatFeeCollectedEvent)(nil), "archway.rewards.v1beta1.ContractFlatFeeCollectedEvent")
+	proto.RegisterType((*TreasurySpendEvent)(nil), "archway.rewards.v1beta1.TreasurySpendEvent")
+	proto.RegisterType((*TreasuryBurnEvent)(nil), "archway.rewards.v1beta1.TreasuryBurnEvent")
+	proto.RegisterType((*CodeMetadataSetEvent)(nil), "archway.rewards.v1beta1.CodeMetadataSetEvent")
+	proto.RegisterType((*ContractMetadataGovSetEvent)(nil), "archway.rewards.v1beta1.ContractMetadataGovSetEvent")
+	proto.RegisterType((*RewardsRecordsExpiredEvent)(nil), "archway.rewards.v1beta1.RewardsRecordsExpiredEvent")
+	proto.RegisterType((*AutoPayoutSetEvent)(nil), "archway.rewards.v1beta1.AutoPayoutSetEvent")
+	proto.RegisterType((*BaseFeeSetEvent)(nil), "archway.rewards.v1beta1.BaseFeeSetEvent")
+	proto.RegisterType((*BaseFeeBurnEvent)(nil), "archway.rewards.v1beta1.BaseFeeBurnEvent")
+	proto.RegisterType((*TxFeeRefundEvent)(nil), "archway.rewards.v1beta1.TxFeeRefundEvent")
+	proto.RegisterType((*SponsorshipSetEvent)(nil), "archway.rewards.v1beta1.SponsorshipSetEvent")
+	proto.RegisterType((*SponsorshipDepositEvent)(nil), "archway.rewards.v1beta1.SponsorshipDepositEvent")
+	proto.RegisterType((*SponsorshipWithdrawEvent)(nil), "archway.rewards.v1beta1.SponsorshipWithdrawEvent")
+	proto.RegisterType((*TxFeeSponsoredEvent)(nil), "archway.rewards.v1beta1.TxFeeSponsoredEvent")
+}
+
+func init() {
+	proto.RegisterFile("archway/rewards/v1beta1/events.proto", fileDescriptor_ad2689b4f7dc3cd8)
+}
+
+var fileDescriptor_ad2689b4f7dc3cd8 = []byte{
+	// 1055 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0x26, 0x6e, 0x7e, 0x3c, 0xa7, 0x8d, 0xb3, 0xc9, 0xf7, 0x6b, 0x93, 0x16, 0x37, 0x59,
+	0x12, 0xd1, 0xaa, 0xc2, 0x56, 0x53, 0xa4, 0x0a, 0x6e, 0x8d, 0x9b, 0x44, 0xa1, 0x09, 0x54, 0x9b,
+	0x20, 0x04, 0x97, 0xd5, 0x78, 0xf7, 0xd9, 0x5e, 0xd5, 0x3b, 0xb3, 0x9a, 0x99, 0x4d, 0xec, 0x03,
+	0x12, 0x27, 0x2e, 0x48, 0x08, 0x21, 0x21, 0x71, 0x45, 0xe2, 0xc6, 0x3f, 0xd2, 0x63, 0x8f, 0x9c,
+	0x10, 0x24, 0x47, 0xfe, 0x09, 0xb4, 0xb3, 0xb3, 0xbb, 0x8e, 0x4d, 0x90, 0x9d, 0xa2, 0x72, 0x4a,
+	0xe6, 0xcd, 0x67, 0xde, 0xfb, 0xbc, 0xcf, 0xbc, 0x79, 0xcf, 0x0b, 0x9b, 0x84, 0xbb, 0x9d, 0x33,
+	0xd2, 0xaf, 0x73, 0x3c, 0x23, 0xdc, 0x13, 0xf5, 0xd3, 0x87, 0x4d, 0x94, 0xe4, 0x61, 0x1d, 0x4f,
+	0x91, 0x4a, 0x51, 0x0b, 0x39, 0x93, 0xcc, 0x2c, 0x6b, 0x54, 0x4d, 0xa3, 0x6a, 0x1a, 0xb5, 0xb6,
+	0xda, 0x66, 0x6d, 0xa6, 0x30, 0xf5, 0xf8, 0xbf, 0x04, 0xbe, 0x56, 0x75, 0x99, 0x08, 0x98, 0xa8,
+	0x37, 0x89, 0xc0, 0xcc, 0xa1, 0xcb, 0x7c, 0xaa, 0xf7, 0xb7, 0xae, 0x0a, 0x9a, 0xba, 0x57, 0x30,
+	0xeb, 0x7b, 0x03, 0x2a, 0x0d, 0x46, 0x25, 0x27, 0xae, 0x3c, 0x42, 0x49, 0x3c, 0x22, 0xc9, 0x31,
+	0xca, 0xdd, 0x98, 0x99, 0x79, 0x1f, 0x4a, 0xae, 0xde, 0x73, 0x88, 0xe7, 0x71, 0x14, 0xa2, 0x62,
+	0xac, 0x1b, 0xf7, 0x16, 0xec, 0xa5, 0xd4, 0xfe, 0x24, 0x31, 0x9b, 0xcf, 0x60, 0x3e, 0xd0, 0xc7,
+	0x2b, 0xd3, 0xeb, 0xc6, 0xbd, 0xe2, 0xf6, 0xfd, 0xda, 0x15, 0x09, 0xd5, 0x86, 0xe3, 0xed, 0x14,
+	0x5e, 0xfe, 0x76, 0x77, 0xca, 0xce, 0x1c, 0x58, 0x7f, 0xcc, 0x40, 0x35, 0x05, 0xd9, 0xea, 0x70,
+	0x83, 0x74, 0xdd, 0xa8, 0x4b, 0xa4, 0xcf, 0xe8, 0xc4, 0xd4, 0x36, 0x60, 0xb1, 0x4d, 0x84, 0xe3,
+	0x32, 0x2a, 0xa2, 0x00, 0x3d, 0x45, 0xaf, 0x60, 0x17, 0xdb, 0x44, 0x34, 0xb4, 0xc9, 0x3c, 0x84,
+	0x65, 0x9f, 0xb6, 0x12, 0xff, 0x8e, 0xa6, 0x5b, 0x99, 0x51, 0x69, 0xbc, 0x55, 0x4b, 0x84, 0xae,
+	0xc5, 0x42, 0x0f, 0xa4, 0xe0, 0x53, 0x4d, 0xbb, 0x94, 0x9d, 0x4c, 0xa8, 0x0a, 0xf3, 0x08, 0xcc,
+	0x16, 0xa2, 0xc3, 0xb1, 0x49, 0x24, 0x66, 0xee, 0x0a, 0xeb, 0x33, 0x63, 0xb9, 0x6b, 0x21, 0xda,
+	0xea, 0x64, 0xea, 0x6e, 0x77, 0x40, 0xda, 0x1b, 0x13, 0x4a, 0x9b, 0x8b, 0x6a, 0x7e, 0x0e, 0x95,
+	0x91, 0x1c, 0x1d, 0x97, 0x84, 0x21, 0x7a, 0x95, 0xd9, 0xf1, 0x52, 0xfd, 0xff, 0x70, 0xaa, 0x0d,
+	0x75, 0xdc, 0xdc, 0x86, 0xff, 0x9d, 0xa1, 0xdf, 0xee, 0x48, 0xf4, 0x9c, 0x4b, 0x52, 0xcf, 0x29,
+	0xa9, 0x57, 0xd2, 0xcd, 0xfd, 0x5c, 0x72, 0xab, 0x07, 0xab, 0xda, 0xc9, 0x67, 0xbe, 0xec, 0x78,
+	0x9c, 0x9c, 0x25, 0x17, 0xbb, 0x05, 0xb7, 0x12, 0x72, 0x43, 0xd7, 0x7a, 0x33, 0xb1, 0xa6, 0x97,
+	0xfa, 0x01, 0xcc, 0xa5, 0xc2, 0x4e, 0x8f, 0x27, 0x6c, 0x8a, 0xb7, 0xfe, 0x34, 0xa0, 0xac, 0x43,
+	0x1f, 0xec, 0x34, 0xde, 0x70, 0xf4, 0x38, 0x82, 0x60, 0x11, 0x77, 0xd1, 0x71, 0x3b, 0x84, 0x52,
+	0xec, 0xaa, 0x3a, 0x5b, 0xb0, 0x6f, 0x26, 0xd6, 0x46, 0x62, 0x34, 0xd7, 0x60, 0x9e, 0xa3, 0x8b,
+	0xfe, 0x29, 0xf2, 0x4a, 0x41, 0x01, 0xb2, 0xb5, 0xf9, 0x00, 0x96, 0xa5, 0x1f, 0x20, 0x8b, 0xa4,
+	0x13, 0xff, 0x15, 0x92, 0x04, 0xa1, 0xaa, 0x8c, 0x82, 0x5d, 0xd2, 0x1b, 0x27, 0xa9, 0xdd, 0xfa,
+	0x04, 0xca, 0x47, 0x3e, 0x8d, 0x65, 0x47, 0x2a, 0x22, 0xb1, 0x87, 0x98, 0x3d, 0xef, 0xf7, 0x61,
+	0xa6, 0x85, 0xa8, 0x32, 0x2c, 0x6e, 0xdf, 0xf9, 0xdb, 0x0c, 0x9e, 0xa2, 0x3b, 0x90, 0x44, 0x0c,
+	0xb7, 0xbe, 0x32, 0xa0, 0x9c, 0x96, 0xd9, 0x5e, 0x97, 0xc8, 0x41, 0x8f, 0x13, 0xbc, 0xca, 0x0f,
+	0x61, 0x3e, 0xae, 0x25, 0x27, 0x66, 0x30, 0x3d, 0x5e, 0xf9, 0xcd, 0xb5, 0x92, 0x70, 0xd6, 0xd7,
+	0x06, 0xbc, 0x3d, 0x44, 0xa1, 0xc1, 0xba, 0x5d, 0x74, 0x25, 0x7a, 0x6f, 0x94, 0xc8, 0xb7, 0x06,
+	0x98, 0x27, 0x1c, 0x89, 0x88, 0x78, 0xff, 0x38, 0x44, 0xaa, 0xa3, 0x6f, 0xc0, 0x22, 0x0b, 0x91,
+	0x27, 0x4f, 0xcd, 0xf7, 0x54, 0xe4, 0x82, 0x5d, 0xcc, 0x6c, 0x07, 0x9e, 0x79, 0x07, 0x16, 0x38,
+	0xba, 0x7e, 0xe8, 0x23, 0x95, 0x2a, 0xec, 0x82, 0x9d, 0x1b, 0xcc, 0xc7, 0x30, 0x4b, 0x02, 0x16,
+	0x51, 0x59, 0x99, 0x19, 0xaf, 0xbc, 0x34, 0xdc, 0x62, 0xb0, 0x9c, 0xf2, 0xd9, 0x89, 0x38, 0x1d,
+	0x9b, 0x4e, 0x1e, 0x70, 0x7a, 0xb2, 0x80, 0x3d, 0x58, 0x6d, 0x30, 0x0f, 0x47, 0x46, 0x47, 0x19,
+	0xe6, 0x5c, 0xe6, 0x61, 0x1e, 0x6e, 0x36, 0x5e, 0x1e, 0x78, 0xe6, 0xfe, 0xc8, 0xa0, 0xd8, 0xfa,
+	0x87, 0x6e, 0x96, 0x7b, 0x1e, 0x19, 0x12, 0x3f, 0x18, 0x70, 0x7b, 0xb8, 0xdd, 0xed, 0xb3, 0xd3,
+	0xff, 0x7c, 0x78, 0xfd, 0x64, 0xc0, 0x9a, 0x6e, 0x2f, 0x36, 0xba, 0x8c, 0x7b, 0x62, 0xb7, 0x17,
+	0xfa, 0x3c, 0xad, 0xcc, 0x77, 0x61, 0x29, 0x6d, 0xbe, 0x97, 0x59, 0xe9, 0xc6, 0x23, 0xfe, 0x85,
+	0x1e, 0x73, 0x17, 0x8a, 0x3c, 0x09, 0xed, 0xd0, 0x28, 0x50, 0x0d, 0xa6, 0x60, 0x83, 0x36, 0x7d,
+	0x1c, 0x05, 0xd6, 0x37, 0x06, 0x98, 0x4f, 0x22, 0xc9, 0x9e, 0x93, 0x3e, 0x8b, 0xe4, 0x75, 0x24,
+	0xfb, 0x08, 0x8a, 0x24, 0x92, 0xcc, 0x09, 0x95, 0x07, 0xad, 0xda, 0x3b, 0x57, 0xaa, 0x96, 0x07,
+	0xd3, 0x5c, 0x81, 0x64, 0x16, 0x2b, 0x80, 0xa5, 0x1d, 0x22, 0xf0, 0xb5, 0x5b, 0x93, 0xb9, 0x09,
+	0xb7, 0x9a, 0x5d, 0xe6, 0xbe, 0x50, 0x43, 0x28, 0x12, 0xd9, 0xac, 0x5f, 0x54, 0xd6, 0x7d, 0x22,
+	0x3e, 0x15, 0xe8, 0x59, 0xcf, 0xa0, 0xa4, 0xc3, 0xe5, 0x4f, 0xe4, 0x31, 0xcc, 0x36, 0x23, 0x4e,
+	0x31, 0xae, 0xd6, 0xf1, 0xea, 0x3f, 0x81, 0x5b, 0x5f, 0x42, 0xe9, 0xa4, 0xb7, 0x17, 0x8f, 0xec,
+	0x56, 0x94, 0x3e, 0xff, 0x15, 0xb8, 0x21, 0x7b, 0x79, 0xe5, 0x17, 0x64, 0xef, 0xc0, 0x33, 0x6f,
+	0xc3, 0x42, 0xfc, 0xa3, 0x20, 0x24, 0x7d, 0xe4, 0xfa, 0xc1, 0xcf, 0xb7, 0x10, 0x9f, 0xc7, 0xeb,
+	0x38, 0x3c, 0x57, 0x0e, 0xc6, 0x7e, 0xef, 0x09, 0xdc, 0x72, 0x61, 0xe5, 0x38, 0x64, 0x54, 0x30,
+	0x2e, 0x3a, 0x7e, 0x98, 0xc9, 0x77, 0x08, 0x45, 0x91, 0x9b, 0xb5, 0x8c, 0x9b, 0x57, 0xde, 0xce,
+	0x80, 0x0b, 0xed, 0x7f, 0xf0, 0xb8, 0xf5, 0xb3, 0x01, 0xe5, 0x01, 0xc8, 0x53, 0x0c, 0x99, 0xf0,
+	0x27, 0x2f, 0x99, 0x78, 0xf2, 0x21, 0xf5, 0x90, 0x67, 0xc0, 0x69, 0x3d, 0xf9, 0x94, 0x35, 0x85,
+	0x5d, 0xbb, 0xf7, 0xfd, 0x62, 0x40, 0x65, 0x80, 0xe6, 0xe5, 0xc1, 0x3e, 0x01, 0xcf, 0x07, 0xb0,
+	0x9c, 0x75, 0xe2, 0x21, 0xaa, 0xa5, 0x6c, 0xe3, 0xb5, 0xd9, 0xfe, 0x68, 0xc0, 0x8a, 0xaa, 0x1c,
+	0x4d, 0xf9, 0x1a, 0x93, 0x6b, 0x03, 0x16, 0x23, 0x31, 0x22, 0x67, 0x31, 0xb6, 0xa5, 0x90, 0x47,
+	0x50, 0x68, 0x21, 0x8a, 0x71, 0xc9, 0x29, 0xf0, 0xce, 0xe1, 0xcb, 0xf3, 0xaa, 0xf1, 0xea, 0xbc,
+	0x6a, 0xfc, 0x7e, 0x5e, 0x35, 0xbe, 0xbb, 0xa8, 0x4e, 0xbd, 0xba, 0xa8, 0x4e, 0xfd, 0x7a, 0x51,
+	0x9d, 0xfa, 0x62, 0xbb, 0xed, 0xcb, 0x4e, 0xd4, 0xac, 0xb9, 0x2c, 0xa8, 0xeb, 0x62, 0x7a, 0x8f,
+	0xa2, 0x3c, 0x63, 0xfc, 0x45, 0xba, 0xae, 0xf7, 0xb2, 0x2f, 0x0e, 0xd9, 0x0f, 0x51, 0x34, 0x67,
+	0xd5, 0x87, 0xc6, 0xa3, 0xbf, 0x06, 0x00, 0x1e, 0x76, 0x0a, 0x7c, 0x06, 0x0d, 0x00, 0x00,
+}
+
+func (m *ContractMetadataSetEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractMetadataSetEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractMetadataSetEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractRewardCalculationEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractRewardCalculationEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractRewardCalculationEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WeightedGasConsumed != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.WeightedGasConsumed))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.InflationRewardsCapped.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.Metadata != nil {
+		{
+			size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FeeRebateRewards) > 0 {
+		for iNdEx := len(m.FeeRebateRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeRebateRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size, err := m.InflationRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.GasConsumed != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.GasConsumed))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardsWithdrawEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardsWithdrawEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardsWithdrawEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RewardAddress) > 0 {
+		i -= len(m.RewardAddress)
+		copy(dAtA[i:], m.RewardAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RewardAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardsIBCWithdrawEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardsIBCWithdrawEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardsIBCWithdrawEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RewardAddress) > 0 {
+		i -= len(m.RewardAddress)
+		copy(dAtA[i:], m.RewardAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RewardAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MinConsensusFeeSetEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinConsensusFeeSetEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinConsensusFeeSetEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractFlatFeeSetEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractFlatFeeSetEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractFlatFeeSetEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FlatFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractFlatFeeCollectedEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractFlatFeeCollectedEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractFlatFeeCollectedEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FlatFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TreasurySpendEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreasurySpendEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreasurySpendEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OperationId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OperationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TreasuryBurnEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreasuryBurnEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreasuryBurnEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.OperationId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OperationId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeMetadataSetEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeMetadataSetEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeMetadataSetEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.CodeId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractMetadataGovSetEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractMetadataGovSetEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractMetadataGovSetEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardsRecordsExpiredEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardsRecordsExpiredEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardsRecordsExpiredEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordsNum != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RecordsNum))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RewardsAddress) > 0 {
+		i -= len(m.RewardsAddress)
+		copy(dAtA[i:], m.RewardsAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RewardsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoPayoutSetEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoPayoutSetEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoPayoutSetEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AutoPayout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseFeeSetEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseFeeSetEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseFeeSetEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlockGasUsed != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BlockGasUsed))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseFeeBurnEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseFeeBurnEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseFeeBurnEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxFeeRefundEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxFeeRefundEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxFeeRefundEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Refund) > 0 {
+		for iNdEx := len(m.Refund) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refund[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TxId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.TxId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SponsorshipSetEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SponsorshipSetEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SponsorshipSetEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Sponsorship.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *SponsorshipDepositEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SponsorshipDepositEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SponsorshipDepositEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SponsorshipWithdrawEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SponsorshipWithdrawEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SponsorshipWithdrawEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RecipientAddress) > 0 {
+		i -= len(m.RecipientAddress)
+		copy(dAtA[i:], m.RecipientAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RecipientAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxFeeSponsoredEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxFeeSponsoredEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxFeeSponsoredEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvents(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.UserAddress) > 0 {
+		i -= len(m.UserAddress)
+		copy(dAtA[i:], m.UserAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.UserAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ContractMetadataSetEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *ContractRewardCalculationEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.GasConsumed != 0 {
+		n += 1 + sovEvents(uint64(m.GasConsumed))
+	}
+	l = m.InflationRewards.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if len(m.FeeRebateRewards) > 0 {
+		for _, e := range m.FeeRebateRewards {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.Metadata != nil {
+		l = m.Metadata.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.InflationRewardsCapped.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.WeightedGasConsumed != 0 {
+		n += 1 + sovEvents(uint64(m.WeightedGasConsumed))
+	}
+	return n
+}
+
+func (m *RewardsWithdrawEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RewardAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardsIBCWithdrawEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RewardAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovEvents(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *MinConsensusFeeSetEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *ContractFlatFeeSetEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.FlatFee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *ContractFlatFeeCollectedEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.FlatFee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *TreasurySpendEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperationId != 0 {
+		n += 1 + sovEvents(uint64(m.OperationId))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TreasuryBurnEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperationId != 0 {
+		n += 1 + sovEvents(uint64(m.OperationId))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CodeMetadataSetEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeId != 0 {
+		n += 1 + sovEvents(uint64(m.CodeId))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *ContractMetadataGovSetEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *RewardsRecordsExpiredEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RewardsAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	if m.RecordsNum != 0 {
+		n += 1 + sovEvents(uint64(m.RecordsNum))
+	}
+	return n
+}
+
+func (m *AutoPayoutSetEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.AutoPayout.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *BaseFeeSetEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Fee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.BlockGasUsed != 0 {
+		n += 1 + sovEvents(uint64(m.BlockGasUsed))
+	}
+	return n
+}
+
+func (m *BaseFeeBurnEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxFeeRefundEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		n += 1 + sovEvents(uint64(m.TxId))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Refund) > 0 {
+		for _, e := range m.Refund {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SponsorshipSetEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sponsorship.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *SponsorshipDepositEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SponsorshipWithdrawEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.RecipientAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TxFeeSponsoredEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.UserAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ContractMetadataSetEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractMetadataSetEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractMetadataSetEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractRewardCalculationEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractRewardCalculationEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractRewardCalculationEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasConsumed", wireType)
+			}
+			m.GasConsumed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasConsumed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRebateRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRebateRewards = append(m.FeeRebateRewards, types.Coin{})
+			if err := m.FeeRebateRewards[len(m.FeeRebateRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = &ContractMetadata{}
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRewardsCapped", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRewardsCapped.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WeightedGasConsumed", wireType)
+			}
+			m.WeightedGasConsumed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WeightedGasConsumed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardsWithdrawEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardsWithdrawEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardsWithdrawEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardsIBCWithdrawEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardsIBCWithdrawEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardsIBCWithdrawEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinConsensusFeeSetEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinConsensusFeeSetEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinConsensusFeeSetEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractFlatFeeSetEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractFlatFeeSetEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractFlatFeeSetEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlatFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractFlatFeeCollectedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractFlatFeeCollectedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractFlatFeeCollectedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlatFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TreasurySpendEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreasurySpendEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreasurySpendEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationId", wireType)
+			}
+			m.OperationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TreasuryBurnEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreasuryBurnEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreasuryBurnEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationId", wireType)
+			}
+			m.OperationId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperationId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CodeMetadataSetEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeMetadataSetEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeMetadataSetEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			m.CodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractMetadataGovSetEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractMetadataGovSetEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractMetadataGovSetEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardsRecordsExpiredEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardsRecordsExpiredEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardsRecordsExpiredEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordsNum", wireType)
+			}
+			m.RecordsNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordsNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoPayoutSetEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoPayoutSetEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoPayoutSetEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPayout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutoPayout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseFeeSetEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseFeeSetEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseFeeSetEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockGasUsed", wireType)
+			}
+			m.BlockGasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockGasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseFeeBurnEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseFeeBurnEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseFeeBurnEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ContractMetadataSetEvent) Unmarshal(dAtA []byte) error {
+func (m *TxFeeRefundEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -609,15 +4542,34 @@ func (m *ContractMetadataSetEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractMetadataSetEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxFeeRefundEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractMetadataSetEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxFeeRefundEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -645,11 +4597,11 @@ func (m *ContractMetadataSetEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -676,7 +4628,8 @@ func (m *ContractMetadataSetEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Refund = append(m.Refund, types.Coin{})
+			if err := m.Refund[len(m.Refund)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -701,7 +4654,7 @@ func (m *ContractMetadataSetEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractRewardCalculationEvent) Unmarshal(dAtA []byte) error {
+func (m *SponsorshipSetEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -724,17 +4677,17 @@ func (m *ContractRewardCalculationEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractRewardCalculationEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: SponsorshipSetEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractRewardCalculationEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SponsorshipSetEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorship", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -744,48 +4697,80 @@ func (m *ContractRewardCalculationEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Sponsorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasConsumed", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.GasConsumed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasConsumed |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
 			}
-		case 3:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SponsorshipDepositEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SponsorshipDepositEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SponsorshipDepositEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -795,30 +4780,29 @@ func (m *ContractRewardCalculationEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeRebateRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -828,29 +4812,27 @@ func (m *ContractRewardCalculationEvent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeRebateRewards = append(m.FeeRebateRewards, types.Coin{})
-			if err := m.FeeRebateRewards[len(m.FeeRebateRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -877,10 +4859,8 @@ func (m *ContractRewardCalculationEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Metadata == nil {
-				m.Metadata = &ContractMetadata{}
-			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -905,7 +4885,7 @@ func (m *ContractRewardCalculationEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardsWithdrawEvent) Unmarshal(dAtA []byte) error {
+func (m *SponsorshipWithdrawEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -928,15 +4908,15 @@ func (m *RewardsWithdrawEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardsWithdrawEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: SponsorshipWithdrawEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardsWithdrawEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SponsorshipWithdrawEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -964,11 +4944,43 @@ func (m *RewardsWithdrawEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardAddress = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -995,8 +5007,8 @@ func (m *RewardsWithdrawEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types.Coin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1021,7 +5033,7 @@ func (m *RewardsWithdrawEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MinConsensusFeeSetEvent) Unmarshal(dAtA []byte) error {
+func (m *TxFeeSponsoredEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1044,15 +5056,79 @@ func (m *MinConsensusFeeSetEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MinConsensusFeeSetEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxFeeSponsoredEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MinConsensusFeeSetEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxFeeSponsoredEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1079,7 +5155,8 @@ func (m *MinConsensusFeeSetEvent) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
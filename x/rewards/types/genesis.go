@@ -16,28 +16,58 @@ func NewGenesisState(
 	minConsFee sdk.DecCoin,
 	rewardsRecordLastID uint64,
 	rewardsRecords []RewardsRecord,
+	flatFees []FlatFee,
+	treasuryOperationLastID uint64,
+	treasuryOperations []TreasuryOperation,
+	codesMetadata []CodeMetadata,
+	autoPayouts []AutoPayout,
+	contractsInflationUsage []ContractInflationUsage,
+	minConsFeeHistory []MinConsensusFeeRecord,
+	baseFee sdk.DecCoin,
+	sponsorships []Sponsorship,
+	sponsorshipUsages []SponsorshipUsage,
 ) *GenesisState {
 	return &GenesisState{
-		Params:              params,
-		ContractsMetadata:   contractsMetadata,
-		BlockRewards:        blockRewards,
-		TxRewards:           txRewards,
-		MinConsensusFee:     minConsFee,
-		RewardsRecordLastId: rewardsRecordLastID,
-		RewardsRecords:      rewardsRecords,
+		Params:                  params,
+		ContractsMetadata:       contractsMetadata,
+		BlockRewards:            blockRewards,
+		TxRewards:               txRewards,
+		MinConsensusFee:         minConsFee,
+		RewardsRecordLastId:     rewardsRecordLastID,
+		RewardsRecords:          rewardsRecords,
+		FlatFees:                flatFees,
+		TreasuryOperationLastId: treasuryOperationLastID,
+		TreasuryOperations:      treasuryOperations,
+		CodesMetadata:           codesMetadata,
+		AutoPayouts:             autoPayouts,
+		ContractsInflationUsage: contractsInflationUsage,
+		MinConsensusFeeHistory:  minConsFeeHistory,
+		BaseFee:                 baseFee,
+		Sponsorships:            sponsorships,
+		SponsorshipUsages:       sponsorshipUsages,
 	}
 }
 
 // DefaultGenesisState returns a default genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params:              DefaultParams(),
-		ContractsMetadata:   []ContractMetadata{},
-		BlockRewards:        []BlockRewards{},
-		TxRewards:           []TxRewards{},
-		MinConsensusFee:     sdk.DecCoin{},
-		RewardsRecordLastId: 0,
-		RewardsRecords:      []RewardsRecord{},
+		Params:                  DefaultParams(),
+		ContractsMetadata:       []ContractMetadata{},
+		BlockRewards:            []BlockRewards{},
+		TxRewards:               []TxRewards{},
+		MinConsensusFee:         sdk.DecCoin{},
+		RewardsRecordLastId:     0,
+		RewardsRecords:          []RewardsRecord{},
+		FlatFees:                []FlatFee{},
+		TreasuryOperationLastId: 0,
+		TreasuryOperations:      []TreasuryOperation{},
+		CodesMetadata:           []CodeMetadata{},
+		AutoPayouts:             []AutoPayout{},
+		ContractsInflationUsage: []ContractInflationUsage{},
+		MinConsensusFeeHistory:  []MinConsensusFeeRecord{},
+		BaseFee:                 sdk.DecCoin{},
+		Sponsorships:            []Sponsorship{},
+		SponsorshipUsages:       []SponsorshipUsage{},
 	}
 }
 
@@ -110,5 +140,115 @@ func (m GenesisState) Validate() error {
 		return fmt.Errorf("rewardsRecordLastId: %d < max RewardsRecord ID (%d)", m.RewardsRecordLastId, rewardsRecordIDMax)
 	}
 
+	flatFeeContractAddrSet := make(map[string]struct{})
+	for i, flatFee := range m.FlatFees {
+		if err := flatFee.Validate(); err != nil {
+			return fmt.Errorf("flatFees [%d]: %w", i, err)
+		}
+		if _, ok := contractAddrSet[flatFee.ContractAddress]; !ok {
+			return fmt.Errorf("flatFees [%d]: contract metadata not found: %s", i, flatFee.ContractAddress)
+		}
+		if _, ok := flatFeeContractAddrSet[flatFee.ContractAddress]; ok {
+			return fmt.Errorf("flatFees [%d]: duplicated contract address: %s", i, flatFee.ContractAddress)
+		}
+		flatFeeContractAddrSet[flatFee.ContractAddress] = struct{}{}
+	}
+
+	treasuryOperationIDMax := uint64(0)
+	treasuryOperationIdSet := make(map[uint64]struct{})
+	for i, op := range m.TreasuryOperations {
+		if err := op.Validate(); err != nil {
+			return fmt.Errorf("treasuryOperations [%d]: %w", i, err)
+		}
+		if _, ok := treasuryOperationIdSet[op.Id]; ok {
+			return fmt.Errorf("treasuryOperations [%d]: duplicated id: %d", i, op.Id)
+		}
+
+		if op.Id > treasuryOperationIDMax {
+			treasuryOperationIDMax = op.Id
+		}
+		treasuryOperationIdSet[op.Id] = struct{}{}
+	}
+
+	if m.TreasuryOperationLastId < treasuryOperationIDMax {
+		return fmt.Errorf("treasuryOperationLastId: %d < max TreasuryOperation ID (%d)", m.TreasuryOperationLastId, treasuryOperationIDMax)
+	}
+
+	codeIDSet := make(map[uint64]struct{})
+	for i, meta := range m.CodesMetadata {
+		if err := meta.Validate(); err != nil {
+			return fmt.Errorf("codesMetadata [%d]: %w", i, err)
+		}
+		if _, ok := codeIDSet[meta.CodeId]; ok {
+			return fmt.Errorf("codesMetadata [%d]: duplicated code ID: %d", i, meta.CodeId)
+		}
+		codeIDSet[meta.CodeId] = struct{}{}
+	}
+
+	autoPayoutAddrSet := make(map[string]struct{})
+	for i, autoPayout := range m.AutoPayouts {
+		if err := autoPayout.Validate(); err != nil {
+			return fmt.Errorf("autoPayouts [%d]: %w", i, err)
+		}
+		if _, ok := autoPayoutAddrSet[autoPayout.ContractAddress]; ok {
+			return fmt.Errorf("autoPayouts [%d]: duplicated contract address: %s", i, autoPayout.ContractAddress)
+		}
+		autoPayoutAddrSet[autoPayout.ContractAddress] = struct{}{}
+	}
+
+	inflationUsageAddrSet := make(map[string]struct{})
+	for i, usage := range m.ContractsInflationUsage {
+		if err := usage.Validate(); err != nil {
+			return fmt.Errorf("contractsInflationUsage [%d]: %w", i, err)
+		}
+		if _, ok := inflationUsageAddrSet[usage.ContractAddress]; ok {
+			return fmt.Errorf("contractsInflationUsage [%d]: duplicated contract address: %s", i, usage.ContractAddress)
+		}
+		inflationUsageAddrSet[usage.ContractAddress] = struct{}{}
+	}
+
+	minConsFeeHeightSet := make(map[int64]struct{})
+	for i, record := range m.MinConsensusFeeHistory {
+		if err := record.Validate(); err != nil {
+			return fmt.Errorf("minConsensusFeeHistory [%d]: %w", i, err)
+		}
+		if _, ok := minConsFeeHeightSet[record.Height]; ok {
+			return fmt.Errorf("minConsensusFeeHistory [%d]: duplicated height: %d", i, record.Height)
+		}
+		minConsFeeHeightSet[record.Height] = struct{}{}
+	}
+
+	if !pkg.DecCoinIsZero(m.BaseFee) {
+		if err := pkg.ValidateDecCoin(m.BaseFee); err != nil {
+			return fmt.Errorf("baseFee: %w", err)
+		}
+	}
+
+	sponsorshipAddrSet := make(map[string]struct{})
+	for i, sponsorship := range m.Sponsorships {
+		if err := sponsorship.Validate(); err != nil {
+			return fmt.Errorf("sponsorships [%d]: %w", i, err)
+		}
+		if _, ok := sponsorshipAddrSet[sponsorship.ContractAddress]; ok {
+			return fmt.Errorf("sponsorships [%d]: duplicated contract address: %s", i, sponsorship.ContractAddress)
+		}
+		sponsorshipAddrSet[sponsorship.ContractAddress] = struct{}{}
+	}
+
+	sponsorshipUsageKeySet := make(map[string]struct{})
+	for i, usage := range m.SponsorshipUsages {
+		if err := usage.Validate(); err != nil {
+			return fmt.Errorf("sponsorshipUsages [%d]: %w", i, err)
+		}
+		if _, ok := sponsorshipAddrSet[usage.ContractAddress]; !ok {
+			return fmt.Errorf("sponsorshipUsages [%d]: sponsorship not found: %s", i, usage.ContractAddress)
+		}
+		usageKey := usage.ContractAddress + "/" + usage.UserAddress
+		if _, ok := sponsorshipUsageKeySet[usageKey]; ok {
+			return fmt.Errorf("sponsorshipUsages [%d]: duplicated contract / user address pair: %s", i, usageKey)
+		}
+		sponsorshipUsageKeySet[usageKey] = struct{}{}
+	}
+
 	return nil
 }
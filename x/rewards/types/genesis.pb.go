@@ -41,6 +41,26 @@ type GenesisState struct {
 	RewardsRecordLastId uint64 `protobuf:"varint,6,opt,name=rewards_record_last_id,json=rewardsRecordLastId,proto3" json:"rewards_record_last_id,omitempty"`
 	// rewards_records defines a list of all active (undistributed) rewards records.
 	RewardsRecords []RewardsRecord `protobuf:"bytes,7,rep,name=rewards_records,json=rewardsRecords,proto3" json:"rewards_records"`
+	// flat_fees defines a list of contract flat fees.
+	FlatFees []FlatFee `protobuf:"bytes,8,rep,name=flat_fees,json=flatFees,proto3" json:"flat_fees"`
+	// treasury_operation_last_id defines the last unique ID for a TreasuryOperation objs.
+	TreasuryOperationLastId uint64 `protobuf:"varint,9,opt,name=treasury_operation_last_id,json=treasuryOperationLastId,proto3" json:"treasury_operation_last_id,omitempty"`
+	// treasury_operations defines a list of all governance-approved treasury operations (history).
+	TreasuryOperations []TreasuryOperation `protobuf:"bytes,10,rep,name=treasury_operations,json=treasuryOperations,proto3" json:"treasury_operations"`
+	// codes_metadata defines a list of all code metadata (contract metadata defaults).
+	CodesMetadata []CodeMetadata `protobuf:"bytes,11,rep,name=codes_metadata,json=codesMetadata,proto3" json:"codes_metadata"`
+	// auto_payouts defines a list of all contracts automatic payout options.
+	AutoPayouts []AutoPayout `protobuf:"bytes,12,rep,name=auto_payouts,json=autoPayouts,proto3" json:"auto_payouts"`
+	// contracts_inflation_usage defines a list of contracts inflation rewards usage for the current epoch.
+	ContractsInflationUsage []ContractInflationUsage `protobuf:"bytes,13,rep,name=contracts_inflation_usage,json=contractsInflationUsage,proto3" json:"contracts_inflation_usage"`
+	// min_consensus_fee_history is the minimum consensus fee history.
+	MinConsensusFeeHistory []MinConsensusFeeRecord `protobuf:"bytes,14,rep,name=min_consensus_fee_history,json=minConsensusFeeHistory,proto3" json:"min_consensus_fee_history"`
+	// base_fee is the base gas unit price driven by the block gas utilization.
+	BaseFee types.DecCoin `protobuf:"bytes,15,opt,name=base_fee,json=baseFee,proto3" json:"base_fee"`
+	// sponsorships defines a list of all contract transaction fees sponsorships.
+	Sponsorships []Sponsorship `protobuf:"bytes,16,rep,name=sponsorships,proto3" json:"sponsorships"`
+	// sponsorship_usages defines a list of all sponsored fees per contract and user.
+	SponsorshipUsages []SponsorshipUsage `protobuf:"bytes,17,rep,name=sponsorship_usages,json=sponsorshipUsages,proto3" json:"sponsorship_usages"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -125,6 +145,76 @@ func (m *GenesisState) GetRewardsRecords() []RewardsRecord {
 	return nil
 }
 
+func (m *GenesisState) GetFlatFees() []FlatFee {
+	if m != nil {
+		return m.FlatFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTreasuryOperationLastId() uint64 {
+	if m != nil {
+		return m.TreasuryOperationLastId
+	}
+	return 0
+}
+
+func (m *GenesisState) GetTreasuryOperations() []TreasuryOperation {
+	if m != nil {
+		return m.TreasuryOperations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCodesMetadata() []CodeMetadata {
+	if m != nil {
+		return m.CodesMetadata
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoPayouts() []AutoPayout {
+	if m != nil {
+		return m.AutoPayouts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContractsInflationUsage() []ContractInflationUsage {
+	if m != nil {
+		return m.ContractsInflationUsage
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMinConsensusFeeHistory() []MinConsensusFeeRecord {
+	if m != nil {
+		return m.MinConsensusFeeHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBaseFee() types.DecCoin {
+	if m != nil {
+		return m.BaseFee
+	}
+	return types.DecCoin{}
+}
+
+func (m *GenesisState) GetSponsorships() []Sponsorship {
+	if m != nil {
+		return m.Sponsorships
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSponsorshipUsages() []SponsorshipUsage {
+	if m != nil {
+		return m.SponsorshipUsages
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "archway.rewards.v1beta1.GenesisState")
 }
@@ -134,34 +224,50 @@ func init() {
 }
 
 var fileDescriptor_cdced50517b403fe = []byte{
-	// 427 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x13, 0x16, 0x8a, 0xf0, 0x06, 0xd3, 0x0c, 0x82, 0x68, 0x42, 0x59, 0x35, 0x69, 0xa8,
-	0x1c, 0x70, 0xb4, 0xee, 0xcc, 0xa5, 0x45, 0x4c, 0x48, 0x03, 0x4d, 0x01, 0x2e, 0x1c, 0x88, 0x1c,
-	0xe7, 0x91, 0x45, 0x5b, 0xec, 0xca, 0xcf, 0xa3, 0xdd, 0xb7, 0xe0, 0x43, 0xf0, 0x61, 0x7a, 0xec,
-	0x91, 0x13, 0x42, 0xed, 0x17, 0x99, 0xea, 0x38, 0x51, 0x7b, 0xc8, 0x2d, 0x79, 0xff, 0xff, 0xff,
-	0xf7, 0x9e, 0xed, 0x47, 0x4e, 0xb8, 0x16, 0x57, 0x53, 0x7e, 0x17, 0x6b, 0x98, 0x72, 0x9d, 0x63,
-	0xfc, 0xeb, 0x34, 0x03, 0xc3, 0x4f, 0xe3, 0x02, 0x24, 0x60, 0x89, 0x6c, 0xa2, 0x95, 0x51, 0xf4,
-	0xa5, 0xb3, 0x31, 0x67, 0x63, 0xce, 0x76, 0xf8, 0xbc, 0x50, 0x85, 0xb2, 0x9e, 0x78, 0xfd, 0x55,
-	0xdb, 0x0f, 0x23, 0xa1, 0xb0, 0x52, 0x18, 0x67, 0x1c, 0xa1, 0x25, 0x0a, 0x55, 0x4a, 0xa7, 0x77,
-	0x76, 0x6d, 0xf0, 0xd6, 0x76, 0xfc, 0x27, 0x20, 0x7b, 0xe7, 0xf5, 0x1c, 0x5f, 0x0c, 0x37, 0x40,
-	0xdf, 0x91, 0xde, 0x84, 0x6b, 0x5e, 0x61, 0xe8, 0xf7, 0xfd, 0xc1, 0xee, 0xf0, 0x88, 0x75, 0xcc,
-	0xc5, 0x2e, 0xad, 0x6d, 0x14, 0xcc, 0xff, 0x1d, 0x79, 0x89, 0x0b, 0xd1, 0x1f, 0x84, 0x0a, 0x25,
-	0x8d, 0xe6, 0xc2, 0x60, 0x5a, 0x81, 0xe1, 0x39, 0x37, 0x3c, 0x7c, 0xd0, 0xdf, 0x19, 0xec, 0x0e,
-	0xdf, 0x74, 0xa2, 0xc6, 0x2e, 0xf2, 0xc9, 0x05, 0x1c, 0xf4, 0xa0, 0x45, 0x35, 0x02, 0xbd, 0x24,
-	0x4f, 0xb2, 0x1b, 0x25, 0xae, 0x53, 0x87, 0x08, 0x77, 0x2c, 0xfa, 0xa4, 0x13, 0x3d, 0x5a, 0xbb,
-	0x93, 0xba, 0xe8, 0xb0, 0x7b, 0xd9, 0x46, 0x8d, 0x9e, 0x13, 0x62, 0x66, 0x2d, 0x2e, 0xb0, 0xb8,
-	0xe3, 0x4e, 0xdc, 0xd7, 0xd9, 0x36, 0xeb, 0xb1, 0x69, 0x0a, 0xf4, 0x33, 0x39, 0xa8, 0x4a, 0x99,
-	0x0a, 0x25, 0x11, 0x24, 0xde, 0x62, 0xfa, 0x13, 0x20, 0x7c, 0x68, 0x2f, 0xf1, 0x15, 0xab, 0x5f,
-	0x8b, 0xad, 0x5f, 0xab, 0x65, 0xbd, 0x07, 0x31, 0x56, 0xa5, 0x74, 0xa4, 0xfd, 0xaa, 0x94, 0xe3,
-	0x26, 0xfb, 0x01, 0x80, 0x9e, 0x91, 0x17, 0xae, 0x7b, 0xaa, 0x41, 0x28, 0x9d, 0xa7, 0x37, 0x1c,
-	0x4d, 0x5a, 0xe6, 0x61, 0xaf, 0xef, 0x0f, 0x82, 0xe4, 0x99, 0x53, 0x13, 0x2b, 0x5e, 0x70, 0x34,
-	0x1f, 0x73, 0xfa, 0x8d, 0xec, 0x6f, 0x87, 0x30, 0x7c, 0x64, 0x8f, 0xf4, 0xba, 0xf3, 0x48, 0xc9,
-	0x26, 0xc6, 0x0d, 0xf3, 0x74, 0x8b, 0x8d, 0xa3, 0x8b, 0xf9, 0x32, 0xf2, 0x17, 0xcb, 0xc8, 0xff,
-	0xbf, 0x8c, 0xfc, 0xdf, 0xab, 0xc8, 0x5b, 0xac, 0x22, 0xef, 0xef, 0x2a, 0xf2, 0xbe, 0x0f, 0x8b,
-	0xd2, 0x5c, 0xdd, 0x66, 0x4c, 0xa8, 0x2a, 0x76, 0x1d, 0xde, 0x4a, 0x30, 0x53, 0xa5, 0xaf, 0x9b,
-	0xff, 0x78, 0xd6, 0x2e, 0xa1, 0xb9, 0x9b, 0x00, 0x66, 0x3d, 0xbb, 0x7b, 0x67, 0xf7, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x55, 0xdb, 0xf3, 0x49, 0x1a, 0x03, 0x00, 0x00,
+	// 676 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xcd, 0x4e, 0xdb, 0x40,
+	0x10, 0xc7, 0x93, 0x42, 0xf9, 0xd8, 0x04, 0x68, 0x96, 0x0a, 0x16, 0x54, 0x85, 0x88, 0x96, 0x8a,
+	0x56, 0xaa, 0x2d, 0xe0, 0x58, 0x71, 0x28, 0xa9, 0xa0, 0x48, 0x40, 0x51, 0x28, 0x97, 0x1e, 0x6a,
+	0x6d, 0xec, 0x4d, 0x62, 0x91, 0x78, 0xdc, 0x9d, 0x75, 0x21, 0x6f, 0xd1, 0xc7, 0xe2, 0xc8, 0xb1,
+	0xa7, 0xaa, 0x82, 0xf7, 0xa8, 0x2a, 0xaf, 0xd7, 0xc6, 0x09, 0xb2, 0xe8, 0xcd, 0xde, 0xf9, 0xcf,
+	0x6f, 0x66, 0x76, 0x67, 0x86, 0x6c, 0x70, 0xe9, 0xf6, 0x2e, 0xf9, 0xd0, 0x96, 0xe2, 0x92, 0x4b,
+	0x0f, 0xed, 0x1f, 0x5b, 0x6d, 0xa1, 0xf8, 0x96, 0xdd, 0x15, 0x81, 0x40, 0x1f, 0xad, 0x50, 0x82,
+	0x02, 0xba, 0x6c, 0x64, 0x96, 0x91, 0x59, 0x46, 0xb6, 0xfa, 0xbc, 0x0b, 0x5d, 0xd0, 0x1a, 0x3b,
+	0xfe, 0x4a, 0xe4, 0xab, 0x75, 0x17, 0x70, 0x00, 0x68, 0xb7, 0x39, 0x8a, 0x8c, 0xe8, 0x82, 0x1f,
+	0x18, 0x7b, 0x61, 0xd4, 0x14, 0xaf, 0x65, 0xeb, 0x7f, 0x09, 0xa9, 0x1e, 0x24, 0x79, 0x9c, 0x29,
+	0xae, 0x04, 0xdd, 0x25, 0x53, 0x21, 0x97, 0x7c, 0x80, 0xac, 0xdc, 0x28, 0x6f, 0x56, 0xb6, 0xd7,
+	0xac, 0x82, 0xbc, 0xac, 0x53, 0x2d, 0xdb, 0x9b, 0xbc, 0xfe, 0xbd, 0x56, 0x6a, 0x19, 0x27, 0xfa,
+	0x8d, 0x50, 0x17, 0x02, 0x25, 0xb9, 0xab, 0xd0, 0x19, 0x08, 0xc5, 0x3d, 0xae, 0x38, 0x7b, 0xd2,
+	0x98, 0xd8, 0xac, 0x6c, 0xbf, 0x29, 0x44, 0x35, 0x8d, 0xcb, 0xb1, 0x71, 0x30, 0xd0, 0x5a, 0x86,
+	0x4a, 0x0d, 0xf4, 0x94, 0xcc, 0xb5, 0xfb, 0xe0, 0x5e, 0x38, 0x06, 0xc1, 0x26, 0x34, 0x7a, 0xa3,
+	0x10, 0xbd, 0x17, 0xab, 0x5b, 0xc9, 0xa1, 0xc1, 0x56, 0xdb, 0xb9, 0x33, 0x7a, 0x40, 0x88, 0xba,
+	0xca, 0x70, 0x93, 0x1a, 0xb7, 0x5e, 0x88, 0xfb, 0x72, 0x35, 0xca, 0x9a, 0x55, 0xe9, 0x01, 0x3d,
+	0x21, 0xb5, 0x81, 0x1f, 0x38, 0x2e, 0x04, 0x28, 0x02, 0x8c, 0xd0, 0xe9, 0x08, 0xc1, 0x9e, 0xea,
+	0x4b, 0x7c, 0x61, 0x25, 0xaf, 0x65, 0xc5, 0xaf, 0x95, 0xb1, 0x3e, 0x0a, 0xb7, 0x09, 0x7e, 0x60,
+	0x48, 0x0b, 0x03, 0x3f, 0x68, 0xa6, 0xbe, 0xfb, 0x42, 0xd0, 0x1d, 0xb2, 0x64, 0xa2, 0x3b, 0x52,
+	0xb8, 0x20, 0x3d, 0xa7, 0xcf, 0x51, 0x39, 0xbe, 0xc7, 0xa6, 0x1a, 0xe5, 0xcd, 0xc9, 0xd6, 0xa2,
+	0xb1, 0xb6, 0xb4, 0xf1, 0x88, 0xa3, 0x3a, 0xf4, 0xe8, 0x39, 0x59, 0x18, 0x75, 0x42, 0x36, 0xad,
+	0x4b, 0x7a, 0x5d, 0x58, 0x52, 0x2b, 0x8f, 0x31, 0xc9, 0xcc, 0x8f, 0xb0, 0x91, 0x36, 0xc9, 0x6c,
+	0xa7, 0xcf, 0x55, 0x5c, 0x12, 0xb2, 0x19, 0x0d, 0x6c, 0x14, 0x02, 0xf7, 0xfb, 0x5c, 0xed, 0x0b,
+	0x61, 0x50, 0x33, 0x9d, 0xe4, 0x17, 0xe9, 0x7b, 0xb2, 0xaa, 0xa4, 0xe0, 0x18, 0xc9, 0xa1, 0x03,
+	0xa1, 0x90, 0x5c, 0xf9, 0x10, 0x64, 0x45, 0xcd, 0xea, 0xa2, 0x96, 0x53, 0xc5, 0xe7, 0x54, 0x60,
+	0x0a, 0xe3, 0x64, 0xf1, 0xa1, 0x33, 0x32, 0xa2, 0x73, 0x79, 0x5b, 0xfc, 0x5e, 0xe3, 0x38, 0x93,
+	0x15, 0x7d, 0x10, 0x07, 0x69, 0x8b, 0xcc, 0xbb, 0xe0, 0x89, 0x5c, 0xdf, 0x56, 0x1e, 0x69, 0xae,
+	0x26, 0x78, 0x62, 0xac, 0x67, 0xe7, 0x34, 0x22, 0xeb, 0xd7, 0x23, 0x52, 0xe5, 0x91, 0x02, 0x27,
+	0xe4, 0x43, 0x88, 0x14, 0xb2, 0xaa, 0x26, 0xbe, 0x2c, 0x24, 0x7e, 0x88, 0x14, 0x9c, 0x6a, 0xad,
+	0xe1, 0x55, 0x78, 0x76, 0x82, 0xf4, 0x3b, 0x59, 0xb9, 0x9f, 0x2e, 0x3f, 0xe8, 0xf4, 0x93, 0x2b,
+	0x8c, 0x90, 0x77, 0x05, 0x9b, 0xd3, 0x68, 0xfb, 0xd1, 0x21, 0x3b, 0x4c, 0xfd, 0xce, 0x63, 0x37,
+	0x13, 0x66, 0x39, 0xe3, 0x8e, 0x9a, 0x29, 0x90, 0x95, 0x07, 0x5d, 0xed, 0xf4, 0x7c, 0x54, 0x20,
+	0x87, 0x6c, 0x5e, 0x87, 0xb4, 0x0a, 0x43, 0x1e, 0x8f, 0xb6, 0xf4, 0x48, 0x8b, 0x2d, 0x8d, 0xf5,
+	0xfb, 0xa7, 0x84, 0x49, 0x77, 0xc9, 0x4c, 0x3c, 0x25, 0x7a, 0x7a, 0x16, 0xfe, 0x7b, 0x7a, 0xa6,
+	0x63, 0x5b, 0x3c, 0x35, 0x27, 0xa4, 0x8a, 0x21, 0x04, 0x08, 0x12, 0x7b, 0x7e, 0x88, 0xec, 0x99,
+	0x4e, 0xf1, 0x55, 0x61, 0x8a, 0x67, 0xf7, 0xe2, 0x74, 0x3d, 0xe4, 0xfd, 0xe3, 0x85, 0x96, 0xfb,
+	0x4f, 0xae, 0x1a, 0x59, 0xed, 0x91, 0x85, 0x96, 0xa3, 0xe6, 0x6f, 0xb9, 0x86, 0x63, 0xe7, 0xb8,
+	0x77, 0x74, 0x7d, 0x5b, 0x2f, 0xdf, 0xdc, 0xd6, 0xcb, 0x7f, 0x6e, 0xeb, 0xe5, 0x9f, 0x77, 0xf5,
+	0xd2, 0xcd, 0x5d, 0xbd, 0xf4, 0xeb, 0xae, 0x5e, 0xfa, 0xba, 0xdd, 0xf5, 0x55, 0x2f, 0x6a, 0x5b,
+	0x2e, 0x0c, 0x6c, 0x13, 0xe7, 0x5d, 0x20, 0xd4, 0x25, 0xc8, 0x8b, 0xf4, 0xdf, 0xbe, 0xca, 0xd6,
+	0xbb, 0x1a, 0x86, 0x02, 0xdb, 0x53, 0x7a, 0xab, 0xef, 0xfc, 0x1b, 0x00, 0xdc, 0x3c, 0xe7, 0x3a,
+	0x74, 0x06, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -184,6 +290,137 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SponsorshipUsages) > 0 {
+		for iNdEx := len(m.SponsorshipUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SponsorshipUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.Sponsorships) > 0 {
+		for iNdEx := len(m.Sponsorships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sponsorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	{
+		size, err := m.BaseFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if len(m.MinConsensusFeeHistory) > 0 {
+		for iNdEx := len(m.MinConsensusFeeHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinConsensusFeeHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.ContractsInflationUsage) > 0 {
+		for iNdEx := len(m.ContractsInflationUsage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractsInflationUsage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.AutoPayouts) > 0 {
+		for iNdEx := len(m.AutoPayouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AutoPayouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.CodesMetadata) > 0 {
+		for iNdEx := len(m.CodesMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CodesMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.TreasuryOperations) > 0 {
+		for iNdEx := len(m.TreasuryOperations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TreasuryOperations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.TreasuryOperationLastId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TreasuryOperationLastId))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.FlatFees) > 0 {
+		for iNdEx := len(m.FlatFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FlatFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.RewardsRecords) > 0 {
 		for iNdEx := len(m.RewardsRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -316,6 +553,59 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FlatFees) > 0 {
+		for _, e := range m.FlatFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TreasuryOperationLastId != 0 {
+		n += 1 + sovGenesis(uint64(m.TreasuryOperationLastId))
+	}
+	if len(m.TreasuryOperations) > 0 {
+		for _, e := range m.TreasuryOperations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CodesMetadata) > 0 {
+		for _, e := range m.CodesMetadata {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AutoPayouts) > 0 {
+		for _, e := range m.AutoPayouts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractsInflationUsage) > 0 {
+		for _, e := range m.ContractsInflationUsage {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MinConsensusFeeHistory) > 0 {
+		for _, e := range m.MinConsensusFeeHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Sponsorships) > 0 {
+		for _, e := range m.Sponsorships {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SponsorshipUsages) > 0 {
+		for _, e := range m.SponsorshipUsages {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -575,6 +865,330 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FlatFees = append(m.FlatFees, FlatFee{})
+			if err := m.FlatFees[len(m.FlatFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryOperationLastId", wireType)
+			}
+			m.TreasuryOperationLastId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TreasuryOperationLastId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryOperations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryOperations = append(m.TreasuryOperations, TreasuryOperation{})
+			if err := m.TreasuryOperations[len(m.TreasuryOperations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodesMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodesMetadata = append(m.CodesMetadata, CodeMetadata{})
+			if err := m.CodesMetadata[len(m.CodesMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPayouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoPayouts = append(m.AutoPayouts, AutoPayout{})
+			if err := m.AutoPayouts[len(m.AutoPayouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractsInflationUsage", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractsInflationUsage = append(m.ContractsInflationUsage, ContractInflationUsage{})
+			if err := m.ContractsInflationUsage[len(m.ContractsInflationUsage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinConsensusFeeHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinConsensusFeeHistory = append(m.MinConsensusFeeHistory, MinConsensusFeeRecord{})
+			if err := m.MinConsensusFeeHistory[len(m.MinConsensusFeeHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorships", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsorships = append(m.Sponsorships, Sponsorship{})
+			if err := m.Sponsorships[len(m.Sponsorships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsorshipUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SponsorshipUsages = append(m.SponsorshipUsages, SponsorshipUsage{})
+			if err := m.SponsorshipUsages[len(m.SponsorshipUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
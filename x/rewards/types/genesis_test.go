@@ -56,6 +56,36 @@ func TestRewardsGenesisStateValidate(t *testing.T) {
 						CalculatedTime:   mockTime,
 					},
 				},
+				FlatFees: []rewardsTypes.FlatFee{
+					{ContractAddress: contractAddr.String(), FlatFee: sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())},
+				},
+				TreasuryOperationLastId: 2,
+				TreasuryOperations: []rewardsTypes.TreasuryOperation{
+					{
+						Id:        1,
+						Type:      rewardsTypes.TreasuryOperationType_TREASURY_OPERATION_TYPE_SPEND,
+						Recipient: accAddr.String(),
+						Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+						Height:    1,
+						Time:      mockTime,
+					},
+					{
+						Id:     2,
+						Type:   rewardsTypes.TreasuryOperationType_TREASURY_OPERATION_TYPE_BURN,
+						Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+						Height: 1,
+						Time:   mockTime,
+					},
+				},
+				CodesMetadata: []rewardsTypes.CodeMetadata{
+					{CodeId: 1, RewardsAddress: accAddr.String()},
+				},
+				Sponsorships: []rewardsTypes.Sponsorship{
+					{ContractAddress: contractAddr.String(), Deposit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt()))},
+				},
+				SponsorshipUsages: []rewardsTypes.SponsorshipUsage{
+					{ContractAddress: contractAddr.String(), UserAddress: accAddr.String(), Fees: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt()))},
+				},
 			},
 		},
 		{
@@ -206,6 +236,281 @@ func TestRewardsGenesisStateValidate(t *testing.T) {
 			},
 			errExpected: true,
 		},
+		{
+			name: "Fail: invalid FlatFees: zero fee",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				ContractsMetadata: []rewardsTypes.ContractMetadata{
+					{ContractAddress: contractAddr.String(), OwnerAddress: accAddr.String()},
+				},
+				FlatFees: []rewardsTypes.FlatFee{
+					{ContractAddress: contractAddr.String(), FlatFee: sdk.NewCoin(sdk.DefaultBondDenom, sdk.ZeroInt())},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid FlatFees: metadata not found",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				FlatFees: []rewardsTypes.FlatFee{
+					{ContractAddress: contractAddr.String(), FlatFee: sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid FlatFees: duplicates",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				ContractsMetadata: []rewardsTypes.ContractMetadata{
+					{ContractAddress: contractAddr.String(), OwnerAddress: accAddr.String()},
+				},
+				FlatFees: []rewardsTypes.FlatFee{
+					{ContractAddress: contractAddr.String(), FlatFee: sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())},
+					{ContractAddress: contractAddr.String(), FlatFee: sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid TreasuryOperations: unknown type",
+			genesisState: rewardsTypes.GenesisState{
+				Params:                  rewardsTypes.DefaultParams(),
+				TreasuryOperationLastId: 1,
+				TreasuryOperations: []rewardsTypes.TreasuryOperation{
+					{
+						Id:     1,
+						Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+						Height: 1,
+						Time:   mockTime,
+					},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid TreasuryOperations: burn with recipient",
+			genesisState: rewardsTypes.GenesisState{
+				Params:                  rewardsTypes.DefaultParams(),
+				TreasuryOperationLastId: 1,
+				TreasuryOperations: []rewardsTypes.TreasuryOperation{
+					{
+						Id:        1,
+						Type:      rewardsTypes.TreasuryOperationType_TREASURY_OPERATION_TYPE_BURN,
+						Recipient: accAddr.String(),
+						Amount:    sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+						Height:    1,
+						Time:      mockTime,
+					},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid TreasuryOperations: duplicates",
+			genesisState: rewardsTypes.GenesisState{
+				Params:                  rewardsTypes.DefaultParams(),
+				TreasuryOperationLastId: 1,
+				TreasuryOperations: []rewardsTypes.TreasuryOperation{
+					{
+						Id:     1,
+						Type:   rewardsTypes.TreasuryOperationType_TREASURY_OPERATION_TYPE_BURN,
+						Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+						Height: 1,
+						Time:   mockTime,
+					},
+					{
+						Id:     1,
+						Type:   rewardsTypes.TreasuryOperationType_TREASURY_OPERATION_TYPE_BURN,
+						Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+						Height: 1,
+						Time:   mockTime,
+					},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid TreasuryOperation lastID",
+			genesisState: rewardsTypes.GenesisState{
+				Params:                  rewardsTypes.DefaultParams(),
+				TreasuryOperationLastId: 1,
+				TreasuryOperations: []rewardsTypes.TreasuryOperation{
+					{
+						Id:     2,
+						Type:   rewardsTypes.TreasuryOperationType_TREASURY_OPERATION_TYPE_BURN,
+						Amount: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt())),
+						Height: 1,
+						Time:   mockTime,
+					},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid CodesMetadata: zero code ID",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				CodesMetadata: []rewardsTypes.CodeMetadata{
+					{CodeId: 0, RewardsAddress: accAddr.String()},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid CodesMetadata: duplicates",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				CodesMetadata: []rewardsTypes.CodeMetadata{
+					{CodeId: 1, RewardsAddress: accAddr.String()},
+					{CodeId: 1},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid AutoPayouts: no triggers set",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				AutoPayouts: []rewardsTypes.AutoPayout{
+					{ContractAddress: accAddr.String()},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid AutoPayouts: duplicates",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				AutoPayouts: []rewardsTypes.AutoPayout{
+					{ContractAddress: accAddr.String(), IntervalBlocks: 1},
+					{ContractAddress: accAddr.String(), IntervalBlocks: 2},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid ContractsInflationUsage: negative amount",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				ContractsInflationUsage: []rewardsTypes.ContractInflationUsage{
+					{ContractAddress: contractAddr.String(), Epoch: 1, Amount: sdk.NewInt(-1)},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid ContractsInflationUsage: duplicates",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				ContractsInflationUsage: []rewardsTypes.ContractInflationUsage{
+					{ContractAddress: contractAddr.String(), Epoch: 1, Amount: sdk.NewInt(1)},
+					{ContractAddress: contractAddr.String(), Epoch: 2, Amount: sdk.NewInt(2)},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid MinConsensusFeeHistory: zero height",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				MinConsensusFeeHistory: []rewardsTypes.MinConsensusFeeRecord{
+					{Height: 0, Fee: sdk.NewDecCoinFromDec("uarch", sdk.NewDecWithPrec(1, 2))},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid MinConsensusFeeHistory: duplicates",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				MinConsensusFeeHistory: []rewardsTypes.MinConsensusFeeRecord{
+					{Height: 1, Fee: sdk.NewDecCoinFromDec("uarch", sdk.NewDecWithPrec(1, 2))},
+					{Height: 1, Fee: sdk.NewDecCoinFromDec("uarch", sdk.NewDecWithPrec(2, 2))},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid Sponsorships: negative block height",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				Sponsorships: []rewardsTypes.Sponsorship{
+					{ContractAddress: contractAddr.String(), BlockHeight: -1},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid Sponsorships: user fee limit period is not set",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				Sponsorships: []rewardsTypes.Sponsorship{
+					{ContractAddress: contractAddr.String(), UserFeeLimit: sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.OneInt()))},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid Sponsorships: duplicates",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				Sponsorships: []rewardsTypes.Sponsorship{
+					{ContractAddress: contractAddr.String()},
+					{ContractAddress: contractAddr.String(), FundFromRewards: true},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid SponsorshipUsages: invalid user address",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				Sponsorships: []rewardsTypes.Sponsorship{
+					{ContractAddress: contractAddr.String()},
+				},
+				SponsorshipUsages: []rewardsTypes.SponsorshipUsage{
+					{ContractAddress: contractAddr.String(), UserAddress: "invalid"},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid SponsorshipUsages: sponsorship not found",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				SponsorshipUsages: []rewardsTypes.SponsorshipUsage{
+					{ContractAddress: contractAddr.String(), UserAddress: accAddr.String()},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid SponsorshipUsages: duplicates",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				Sponsorships: []rewardsTypes.Sponsorship{
+					{ContractAddress: contractAddr.String()},
+				},
+				SponsorshipUsages: []rewardsTypes.SponsorshipUsage{
+					{ContractAddress: contractAddr.String(), UserAddress: accAddr.String()},
+					{ContractAddress: contractAddr.String(), UserAddress: accAddr.String()},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid BaseFee",
+			genesisState: rewardsTypes.GenesisState{
+				Params: rewardsTypes.DefaultParams(),
+				BaseFee: sdk.DecCoin{
+					Denom:  sdk.DefaultBondDenom,
+					Amount: sdk.NewDec(-1),
+				},
+			},
+			errExpected: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -5,6 +5,8 @@ const (
 	ModuleName = "rewards"
 	// StoreKey is the module KV storage prefix key.
 	StoreKey = ModuleName
+	// TStoreKey is the module transient storage prefix key.
+	TStoreKey = "transient_" + ModuleName
 	// QuerierRoute is the querier route for the module.
 	QuerierRoute = ModuleName
 	// RouterKey is the msg router key for the module.
@@ -15,6 +17,17 @@ const (
 
 	// TreasuryCollector is the module account name to keep undistributed rewards in.
 	TreasuryCollector = "treasury"
+
+	// SponsorshipCollector is the module account holding contract sponsorship deposits.
+	SponsorshipCollector = "sponsorship"
+)
+
+// Transient store keys.
+var (
+	// CheckTxPriorityKey defines the key for storing the current CheckTx transaction priority.
+	// Key: CheckTxPriorityKey
+	// Value: int64
+	CheckTxPriorityKey = []byte{0x00}
 )
 
 // ContractMetadata prefixed store state keys.
@@ -64,6 +77,16 @@ var (
 	// Key: MinConsFeeStatePrefix | MinConsFeeKey
 	// Value: sdk.Coin
 	MinConsFeeKey = []byte{0x00}
+
+	// MinConsFeeHistoryPrefix defines the prefix for storing MinConsensusFeeRecord objects.
+	// Key: MinConsFeeStatePrefix | MinConsFeeHistoryPrefix | {Height}
+	// Value: MinConsensusFeeRecord
+	MinConsFeeHistoryPrefix = []byte{0x01}
+
+	// BaseFeeKey defines the key for storing the base fee coin.
+	// Key: MinConsFeeStatePrefix | BaseFeeKey
+	// Value: sdk.DecCoin
+	BaseFeeKey = []byte{0x02}
 )
 
 // RewardsRecord prefixed store state keys.
@@ -85,4 +108,90 @@ var (
 	// Key: RewardsRecordStatePrefix | RewardsRecordAddressIndexPrefix | {RewardsAddress} | {ID}
 	// Value: None
 	RewardsRecordAddressIndexPrefix = []byte{0x02}
+
+	// RewardsRecordTimeIndexPrefix defines the prefix for storing RewardsRecord's CalculatedTime index.
+	// Key: RewardsRecordStatePrefix | RewardsRecordTimeIndexPrefix | {CalculatedTime} | {ID}
+	// Value: None
+	RewardsRecordTimeIndexPrefix = []byte{0x03}
+)
+
+// FlatFee prefixed store state keys.
+var (
+	// FlatFeeStatePrefix defines the state global prefix.
+	FlatFeeStatePrefix = []byte{0x05}
+
+	// FlatFeePrefix defines the prefix for storing contract flat fee coins.
+	// Key: FlatFeeStatePrefix | FlatFeePrefix | {ContractAddress}
+	// Value: sdk.Coin
+	FlatFeePrefix = []byte{0x00}
+)
+
+// TreasuryOperation prefixed store state keys.
+var (
+	// TreasuryOperationStatePrefix defines the state global prefix.
+	TreasuryOperationStatePrefix = []byte{0x06}
+
+	// TreasuryOperationIDKey defines the key for storing last unique TreasuryOperation's ID.
+	// Key: TreasuryOperationStatePrefix | TreasuryOperationIDKey
+	// Value: uint64
+	TreasuryOperationIDKey = []byte{0x00}
+
+	// TreasuryOperationPrefix defines the prefix for storing TreasuryOperation objects.
+	// Key: TreasuryOperationStatePrefix | TreasuryOperationPrefix | {ID}
+	// Value: TreasuryOperation
+	TreasuryOperationPrefix = []byte{0x01}
+)
+
+// CodeMetadata prefixed store state keys.
+var (
+	// CodeMetadataStatePrefix defines the state global prefix.
+	CodeMetadataStatePrefix = []byte{0x07}
+
+	// CodeMetadataPrefix defines the prefix for storing CodeMetadata objects.
+	// Key: CodeMetadataStatePrefix | CodeMetadataPrefix | {CodeID}
+	// Value: CodeMetadata
+	CodeMetadataPrefix = []byte{0x00}
+)
+
+// AutoPayout prefixed store state keys.
+var (
+	// AutoPayoutStatePrefix defines the state global prefix.
+	AutoPayoutStatePrefix = []byte{0x08}
+
+	// AutoPayoutCursorKey defines the key for storing the contract address to continue the EndBlocker processing from.
+	// Key: AutoPayoutStatePrefix | AutoPayoutCursorKey
+	// Value: {RewardsAddress}
+	AutoPayoutCursorKey = []byte{0x00}
+
+	// AutoPayoutPrefix defines the prefix for storing AutoPayout objects.
+	// Key: AutoPayoutStatePrefix | AutoPayoutPrefix | {ContractAddress}
+	// Value: AutoPayout
+	AutoPayoutPrefix = []byte{0x01}
+)
+
+// ContractInflationUsage prefixed store state keys.
+var (
+	// ContractInflationUsageStatePrefix defines the state global prefix.
+	ContractInflationUsageStatePrefix = []byte{0x09}
+
+	// ContractInflationUsagePrefix defines the prefix for storing ContractInflationUsage objects.
+	// Key: ContractInflationUsageStatePrefix | ContractInflationUsagePrefix | {ContractAddress}
+	// Value: ContractInflationUsage
+	ContractInflationUsagePrefix = []byte{0x00}
+)
+
+// Sponsorship prefixed store state keys.
+var (
+	// SponsorshipStatePrefix defines the state global prefix.
+	SponsorshipStatePrefix = []byte{0x0A}
+
+	// SponsorshipPrefix defines the prefix for storing Sponsorship objects.
+	// Key: SponsorshipStatePrefix | SponsorshipPrefix | {ContractAddress}
+	// Value: Sponsorship
+	SponsorshipPrefix = []byte{0x00}
+
+	// SponsorshipUsagePrefix defines the prefix for storing SponsorshipUsage objects.
+	// Key: SponsorshipStatePrefix | SponsorshipUsagePrefix | {ContractAddress} | {UserAddress}
+	// Value: SponsorshipUsage
+	SponsorshipUsagePrefix = []byte{0x01}
 )
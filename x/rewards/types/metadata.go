@@ -13,11 +13,59 @@ func (m ContractMetadata) HasOwnerAddress() bool {
 	return m.OwnerAddress != ""
 }
 
+// HasPendingOwnerAddress returns true if the ownership transfer is in progress.
+func (m ContractMetadata) HasPendingOwnerAddress() bool {
+	return m.PendingOwnerAddress != ""
+}
+
 // HasRewardsAddress returns true if the rewards address is set.
 func (m ContractMetadata) HasRewardsAddress() bool {
 	return m.RewardsAddress != ""
 }
 
+// HasRewardsRecipients returns true if the weighted rewards recipients are set.
+func (m ContractMetadata) HasRewardsRecipients() bool {
+	return len(m.RewardsRecipients) > 0
+}
+
+// IsAffiliatedAddress returns true if the address is the contract itself (sponsored transactions), the contract owner,
+// the rewards address or one of rewards recipients.
+func (m ContractMetadata) IsAffiliatedAddress(addr string) bool {
+	if addr == "" {
+		return false
+	}
+
+	if m.ContractAddress == addr || m.OwnerAddress == addr || m.RewardsAddress == addr {
+		return true
+	}
+	for _, recipient := range m.RewardsRecipients {
+		if recipient.Address == addr {
+			return true
+		}
+	}
+
+	return false
+}
+
+// EffectiveRewardsRecipients returns the list of rewards distribution destinations.
+// The rewards address (if set) is returned as a single recipient with the weight of 1.0.
+func (m ContractMetadata) EffectiveRewardsRecipients() []RewardsRecipient {
+	if m.HasRewardsRecipients() {
+		return m.RewardsRecipients
+	}
+
+	if m.HasRewardsAddress() {
+		return []RewardsRecipient{
+			{
+				Address: m.RewardsAddress,
+				Weight:  sdk.OneDec(),
+			},
+		}
+	}
+
+	return nil
+}
+
 // MustGetContractAddress returns the contract address.
 // CONTRACT: panics in case of an error.
 func (m ContractMetadata) MustGetContractAddress() sdk.AccAddress {
@@ -59,6 +107,22 @@ func (m ContractMetadata) Validate(genesisValidation bool) error {
 		}
 	}
 
+	if m.HasPendingOwnerAddress() {
+		if _, err := sdk.AccAddressFromBech32(m.PendingOwnerAddress); err != nil {
+			return sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "invalid pending owner address: %v", err)
+		}
+	}
+
+	if m.HasRewardsRecipients() {
+		if m.HasRewardsAddress() {
+			return sdkErrors.Wrap(sdkErrors.ErrInvalidRequest, "rewards address and rewards recipients can not be set together")
+		}
+
+		if err := ValidateRewardsRecipients(m.RewardsRecipients); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -67,3 +131,141 @@ func (m ContractMetadata) String() string {
 	bz, _ := yaml.Marshal(m)
 	return string(bz)
 }
+
+// HasRewardsAddress returns true if the rewards address is set.
+func (m CodeMetadata) HasRewardsAddress() bool {
+	return m.RewardsAddress != ""
+}
+
+// HasRewardsRecipients returns true if the weighted rewards recipients are set.
+func (m CodeMetadata) HasRewardsRecipients() bool {
+	return len(m.RewardsRecipients) > 0
+}
+
+// ContractMetadata builds the default ContractMetadata for a contract instantiated from the code.
+// Owner address is not set since the result is a read-only fallback used for the rewards distribution.
+func (m CodeMetadata) ContractMetadata(contractAddr sdk.AccAddress) ContractMetadata {
+	return ContractMetadata{
+		ContractAddress:   contractAddr.String(),
+		RewardsAddress:    m.RewardsAddress,
+		RewardsRecipients: m.RewardsRecipients,
+	}
+}
+
+// Validate performs object fields validation.
+func (m CodeMetadata) Validate() error {
+	if m.CodeId == 0 {
+		return sdkErrors.Wrap(sdkErrors.ErrInvalidRequest, "invalid code ID: must be GT 0")
+	}
+
+	if m.RewardsAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(m.RewardsAddress); err != nil {
+			return sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "invalid rewards address: %v", err)
+		}
+	}
+
+	if m.HasRewardsRecipients() {
+		if m.HasRewardsAddress() {
+			return sdkErrors.Wrap(sdkErrors.ErrInvalidRequest, "rewards address and rewards recipients can not be set together")
+		}
+
+		if err := ValidateRewardsRecipients(m.RewardsRecipients); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (m CodeMetadata) String() string {
+	bz, _ := yaml.Marshal(m)
+	return string(bz)
+}
+
+// MustGetAddress returns the recipient address.
+// CONTRACT: panics in case of an error.
+func (r RewardsRecipient) MustGetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(r.Address)
+	if err != nil {
+		panic(fmt.Errorf("parsing rewards recipient address (%s): %s", r.Address, err))
+	}
+
+	return addr
+}
+
+// Validate performs object fields validation.
+func (r RewardsRecipient) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "invalid address: %v", err)
+	}
+
+	if r.Weight.IsNil() || !r.Weight.IsPositive() || r.Weight.GT(sdk.OneDec()) {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, "weight must be in the (0.0, 1.0] range: %s", r.Weight)
+	}
+
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (r RewardsRecipient) String() string {
+	bz, _ := yaml.Marshal(r)
+	return string(bz)
+}
+
+// ValidateRewardsRecipients validates a weighted rewards recipients list.
+// Addresses must be unique, weights must sum up to 1.0 and the list size is limited by MaxRewardsRecipients.
+func ValidateRewardsRecipients(recipients []RewardsRecipient) error {
+	if len(recipients) == 0 {
+		return sdkErrors.Wrap(sdkErrors.ErrInvalidRequest, "rewards recipients: empty")
+	}
+	if len(recipients) > MaxRewardsRecipients {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, "rewards recipients: length (%d) exceeds max (%d)", len(recipients), MaxRewardsRecipients)
+	}
+
+	weightsTotal := sdk.ZeroDec()
+	recipientsSet := make(map[string]struct{}, len(recipients))
+	for i, recipient := range recipients {
+		if err := recipient.Validate(); err != nil {
+			return sdkErrors.Wrapf(err, "rewards recipient [%d]", i)
+		}
+
+		if _, ok := recipientsSet[recipient.Address]; ok {
+			return sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, "rewards recipient [%d]: duplicated address", i)
+		}
+		recipientsSet[recipient.Address] = struct{}{}
+
+		weightsTotal = weightsTotal.Add(recipient.Weight)
+	}
+
+	if !weightsTotal.Equal(sdk.OneDec()) {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, "rewards recipients weights must sum up to 1.0: %s", weightsTotal)
+	}
+
+	return nil
+}
+
+// SplitRewardsByRecipients splits rewards between recipients according to their weights.
+// Shares are truncated and the leftovers (dust) are credited to the first recipient,
+// so the sum of shares is always equal to the input rewards.
+// CONTRACT: recipients must be validated (non-empty and weights sum up to 1.0).
+func SplitRewardsByRecipients(rewards sdk.Coins, recipients []RewardsRecipient) []sdk.Coins {
+	shares := make([]sdk.Coins, len(recipients))
+	if len(recipients) == 0 {
+		return shares
+	}
+
+	distributed := sdk.NewCoins()
+	for i := 1; i < len(recipients); i++ {
+		share := sdk.NewCoins()
+		for _, coin := range rewards {
+			share = share.Add(sdk.NewCoin(coin.Denom, coin.Amount.ToDec().Mul(recipients[i].Weight).TruncateInt()))
+		}
+
+		shares[i] = share
+		distributed = distributed.Add(share...)
+	}
+	shares[0] = rewards.Sub(distributed)
+
+	return shares
+}
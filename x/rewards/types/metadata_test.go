@@ -3,7 +3,9 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
 	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
@@ -17,11 +19,25 @@ func TestContractMetadataValidate(t *testing.T) {
 		errExpected         bool
 	}
 
-	accAddrs, _ := e2eTesting.GenAccounts(1)
+	accAddrs, _ := e2eTesting.GenAccounts(2)
 	accAddr := accAddrs[0]
 
 	contractAddr := e2eTesting.GenContractAddresses(1)[0]
 
+	// newRecipients builds a recipients list of the given size with weights summing up to 1.0
+	newRecipients := func(n int) []rewardsTypes.RewardsRecipient {
+		addrs, _ := e2eTesting.GenAccounts(uint(n))
+		weight := sdk.OneDec().QuoInt64(int64(n))
+
+		recipients := make([]rewardsTypes.RewardsRecipient, 0, n)
+		for _, addr := range addrs {
+			recipients = append(recipients, rewardsTypes.RewardsRecipient{Address: addr.String(), Weight: weight})
+		}
+		recipients[0].Weight = weight.Add(sdk.OneDec().Sub(weight.MulInt64(int64(n))))
+
+		return recipients
+	}
+
 	testCases := []testCase{
 		{
 			name: "OK: empty",
@@ -43,6 +59,16 @@ func TestContractMetadataValidate(t *testing.T) {
 				RewardsAddress:  accAddr.String(),
 			},
 		},
+		{
+			name: "OK: with RewardsRecipients",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				RewardsRecipients: []rewardsTypes.RewardsRecipient{
+					{Address: accAddrs[0].String(), Weight: sdk.NewDecWithPrec(3, 1)},
+					{Address: accAddrs[1].String(), Weight: sdk.NewDecWithPrec(7, 1)},
+				},
+			},
+		},
 		{
 			name: "Fail: invalid ContractAddress",
 			meta: rewardsTypes.ContractMetadata{
@@ -58,6 +84,91 @@ func TestContractMetadataValidate(t *testing.T) {
 			},
 			errExpected: true,
 		},
+		{
+			name: "Fail: RewardsAddress and RewardsRecipients are set",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				RewardsAddress:  accAddr.String(),
+				RewardsRecipients: []rewardsTypes.RewardsRecipient{
+					{Address: accAddr.String(), Weight: sdk.OneDec()},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid RewardsRecipients address",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				RewardsRecipients: []rewardsTypes.RewardsRecipient{
+					{Address: "invalid", Weight: sdk.OneDec()},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: RewardsRecipients zero weight",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				RewardsRecipients: []rewardsTypes.RewardsRecipient{
+					{Address: accAddrs[0].String(), Weight: sdk.OneDec()},
+					{Address: accAddrs[1].String(), Weight: sdk.ZeroDec()},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: RewardsRecipients duplicated address",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				RewardsRecipients: []rewardsTypes.RewardsRecipient{
+					{Address: accAddr.String(), Weight: sdk.NewDecWithPrec(5, 1)},
+					{Address: accAddr.String(), Weight: sdk.NewDecWithPrec(5, 1)},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: RewardsRecipients weights sum is not 1.0",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				RewardsRecipients: []rewardsTypes.RewardsRecipient{
+					{Address: accAddrs[0].String(), Weight: sdk.NewDecWithPrec(5, 1)},
+					{Address: accAddrs[1].String(), Weight: sdk.NewDecWithPrec(4, 1)},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: RewardsRecipients max length",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress:   contractAddr.String(),
+				RewardsRecipients: newRecipients(rewardsTypes.MaxRewardsRecipients),
+			},
+		},
+		{
+			name: "Fail: RewardsRecipients max length exceeded",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress:   contractAddr.String(),
+				RewardsRecipients: newRecipients(rewardsTypes.MaxRewardsRecipients + 1),
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: with PendingOwnerAddress",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress:     contractAddr.String(),
+				OwnerAddress:        accAddr.String(),
+				PendingOwnerAddress: accAddrs[1].String(),
+			},
+		},
+		{
+			name: "Fail: invalid PendingOwnerAddress",
+			meta: rewardsTypes.ContractMetadata{
+				ContractAddress:     contractAddr.String(),
+				PendingOwnerAddress: "invalid",
+			},
+			errExpected: true,
+		},
 		{
 			name: "Fail: empty OwnerAddress with genesis validation",
 			meta: rewardsTypes.ContractMetadata{
@@ -79,3 +190,154 @@ func TestContractMetadataValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestCodeMetadataValidate(t *testing.T) {
+	type testCase struct {
+		name        string
+		meta        rewardsTypes.CodeMetadata
+		errExpected bool
+	}
+
+	accAddrs, _ := e2eTesting.GenAccounts(2)
+	accAddr := accAddrs[0]
+
+	testCases := []testCase{
+		{
+			name: "OK: empty",
+			meta: rewardsTypes.CodeMetadata{
+				CodeId: 1,
+			},
+		},
+		{
+			name: "OK: with RewardsAddress",
+			meta: rewardsTypes.CodeMetadata{
+				CodeId:         1,
+				RewardsAddress: accAddr.String(),
+			},
+		},
+		{
+			name: "OK: with RewardsRecipients",
+			meta: rewardsTypes.CodeMetadata{
+				CodeId: 1,
+				RewardsRecipients: []rewardsTypes.RewardsRecipient{
+					{Address: accAddrs[0].String(), Weight: sdk.NewDecWithPrec(3, 1)},
+					{Address: accAddrs[1].String(), Weight: sdk.NewDecWithPrec(7, 1)},
+				},
+			},
+		},
+		{
+			name: "Fail: zero CodeId",
+			meta: rewardsTypes.CodeMetadata{
+				RewardsAddress: accAddr.String(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid RewardsAddress",
+			meta: rewardsTypes.CodeMetadata{
+				CodeId:         1,
+				RewardsAddress: "invalid",
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: RewardsAddress and RewardsRecipients are set",
+			meta: rewardsTypes.CodeMetadata{
+				CodeId:         1,
+				RewardsAddress: accAddr.String(),
+				RewardsRecipients: []rewardsTypes.RewardsRecipient{
+					{Address: accAddr.String(), Weight: sdk.OneDec()},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: RewardsRecipients weights sum is not 1.0",
+			meta: rewardsTypes.CodeMetadata{
+				CodeId: 1,
+				RewardsRecipients: []rewardsTypes.RewardsRecipient{
+					{Address: accAddrs[0].String(), Weight: sdk.NewDecWithPrec(5, 1)},
+					{Address: accAddrs[1].String(), Weight: sdk.NewDecWithPrec(4, 1)},
+				},
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.meta.Validate()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSplitRewardsByRecipients(t *testing.T) {
+	type testCase struct {
+		name           string
+		rewards        string    // [sdk.Coins]
+		weights        []sdk.Dec // recipients weights
+		sharesExpected []string  // [sdk.Coins]
+	}
+
+	accAddrs, _ := e2eTesting.GenAccounts(3)
+
+	testCases := []testCase{
+		{
+			name:           "OK: single recipient",
+			rewards:        "100stake,10uarch",
+			weights:        []sdk.Dec{sdk.OneDec()},
+			sharesExpected: []string{"100stake,10uarch"},
+		},
+		{
+			name:           "OK: two recipients without dust",
+			rewards:        "100stake",
+			weights:        []sdk.Dec{sdk.NewDecWithPrec(4, 1), sdk.NewDecWithPrec(6, 1)},
+			sharesExpected: []string{"40stake", "60stake"},
+		},
+		{
+			name:           "OK: three recipients with dust credited to the first one",
+			rewards:        "100stake,1uarch",
+			weights:        []sdk.Dec{sdk.MustNewDecFromStr("0.333333333333333334"), sdk.MustNewDecFromStr("0.333333333333333333"), sdk.MustNewDecFromStr("0.333333333333333333")},
+			sharesExpected: []string{"34stake,1uarch", "33stake", "33stake"},
+		},
+		{
+			name:           "OK: empty rewards",
+			rewards:        "",
+			weights:        []sdk.Dec{sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1)},
+			sharesExpected: []string{"", ""},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rewards, err := sdk.ParseCoinsNormalized(tc.rewards)
+			require.NoError(t, err)
+
+			recipients := make([]rewardsTypes.RewardsRecipient, 0, len(tc.weights))
+			for i, weight := range tc.weights {
+				recipients = append(recipients, rewardsTypes.RewardsRecipient{
+					Address: accAddrs[i].String(),
+					Weight:  weight,
+				})
+			}
+
+			shares := rewardsTypes.SplitRewardsByRecipients(rewards, recipients)
+			require.Len(t, shares, len(tc.sharesExpected))
+
+			sharesTotal := sdk.NewCoins()
+			for i, shareExpectedStr := range tc.sharesExpected {
+				shareExpected, err := sdk.ParseCoinsNormalized(shareExpectedStr)
+				require.NoError(t, err)
+
+				assert.Equal(t, shareExpected.String(), shares[i].String(), "share [%d]", i)
+				sharesTotal = sharesTotal.Add(shares[i]...)
+			}
+			assert.Equal(t, rewards.String(), sharesTotal.String())
+		})
+	}
+}
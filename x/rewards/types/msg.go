@@ -2,23 +2,46 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+
+	"github.com/archway-network/archway/pkg"
 )
 
 const (
 	TypeMsgSetContractMetadata = "set-contract-metadata"
 	TypeMsgWithdrawRewards     = "withdraw-rewards"
+	TypeMsgSetFlatFee          = "set-flat-fee"
+
+	TypeMsgWithdrawRewardsAndDelegate      = "withdraw-rewards-and-delegate"
+	TypeMsgAcceptContractMetadataOwnership = "accept-contract-metadata-ownership"
+	TypeMsgCancelContractMetadataOwnership = "cancel-contract-metadata-ownership"
+	TypeMsgSetCodeMetadata                 = "set-code-metadata"
+	TypeMsgSetAutoPayout                   = "set-auto-payout"
+	TypeMsgSetSponsorship                  = "set-sponsorship"
+	TypeMsgDepositSponsorship              = "deposit-sponsorship"
+	TypeMsgWithdrawSponsorship             = "withdraw-sponsorship"
 )
 
 var (
 	_ sdk.Msg = &MsgSetContractMetadata{}
 	_ sdk.Msg = &MsgWithdrawRewards{}
+	_ sdk.Msg = &MsgSetFlatFee{}
+	_ sdk.Msg = &MsgWithdrawRewardsAndDelegate{}
+	_ sdk.Msg = &MsgAcceptContractMetadataOwnership{}
+	_ sdk.Msg = &MsgCancelContractMetadataOwnership{}
+	_ sdk.Msg = &MsgSetCodeMetadata{}
+	_ sdk.Msg = &MsgSetAutoPayout{}
+	_ sdk.Msg = &MsgSetSponsorship{}
+	_ sdk.Msg = &MsgDepositSponsorship{}
+	_ sdk.Msg = &MsgWithdrawSponsorship{}
 )
 
 // NewMsgSetContractMetadata creates a new MsgSetContractMetadata instance.
-func NewMsgSetContractMetadata(senderAddr, contractAddr sdk.AccAddress, ownerAddr, rewardsAddr *sdk.AccAddress) *MsgSetContractMetadata {
+func NewMsgSetContractMetadata(senderAddr, contractAddr sdk.AccAddress, ownerAddr, rewardsAddr *sdk.AccAddress, rewardsRecipients []RewardsRecipient) *MsgSetContractMetadata {
 	msg := &MsgSetContractMetadata{
 		SenderAddress: senderAddr.String(),
 		Metadata: ContractMetadata{
@@ -32,6 +55,9 @@ func NewMsgSetContractMetadata(senderAddr, contractAddr sdk.AccAddress, ownerAdd
 	if rewardsAddr != nil {
 		msg.Metadata.RewardsAddress = rewardsAddr.String()
 	}
+	if len(rewardsRecipients) > 0 {
+		msg.Metadata.RewardsRecipients = rewardsRecipients
+	}
 
 	return msg
 }
@@ -68,6 +94,10 @@ func (m MsgSetContractMetadata) ValidateBasic() error {
 		return err
 	}
 
+	if m.Metadata.HasPendingOwnerAddress() {
+		return sdkErrors.Wrap(sdkErrors.ErrInvalidRequest, "pending owner address can not be set directly (use the owner address field to nominate a new owner)")
+	}
+
 	return nil
 }
 
@@ -95,6 +125,53 @@ func NewMsgWithdrawRewardsByIDs(senderAddr sdk.AccAddress, recordIDs []uint64) *
 	}
 }
 
+// NewMsgWithdrawRewardsIBCTransfer creates a new MsgWithdrawRewards_IBCTransfer instance.
+func NewMsgWithdrawRewardsIBCTransfer(sourceChannel, receiver string, timeoutTimestamp uint64) *MsgWithdrawRewards_IBCTransfer {
+	return &MsgWithdrawRewards_IBCTransfer{
+		SourceChannel:    sourceChannel,
+		Receiver:         receiver,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+// Validate performs object fields validation.
+func (m MsgWithdrawRewards_IBCTransfer) Validate() error {
+	if err := host.ChannelIdentifierValidator(m.SourceChannel); err != nil {
+		return fmt.Errorf("sourceChannel: %w", err)
+	}
+
+	if strings.TrimSpace(m.Receiver) == "" {
+		return fmt.Errorf("receiver: empty")
+	}
+
+	if m.TimeoutTimestamp == 0 {
+		return fmt.Errorf("timeoutTimestamp: must be GT 0")
+	}
+
+	return nil
+}
+
+// Validate performs object fields validation.
+func (m MsgWithdrawRewards_RecordIDs) Validate() error {
+	if len(m.Ids) == 0 {
+		return sdkErrors.Wrap(sdkErrors.ErrInvalidRequest, "invalid record IDs: empty")
+	}
+
+	idsSet := make(map[uint64]struct{})
+	for _, id := range m.Ids {
+		if id == 0 {
+			return sdkErrors.Wrap(sdkErrors.ErrInvalidRequest, "invalid record IDs: must be GT 0")
+		}
+
+		if _, ok := idsSet[id]; ok {
+			return sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, "invalid record IDs: duplicate ID (%d)", id)
+		}
+		idsSet[id] = struct{}{}
+	}
+
+	return nil
+}
+
 // Route implements the sdk.Msg interface.
 func (m MsgWithdrawRewards) Route() string { return RouterKey }
 
@@ -143,20 +220,150 @@ func (m MsgWithdrawRewards) ValidateBasic() error {
 			return sdkErrors.Wrap(sdkErrors.ErrInvalidRequest, "invalid record IDs: nil request")
 		}
 
-		if len(modeReq.RecordIds.Ids) == 0 {
-			return sdkErrors.Wrap(sdkErrors.ErrInvalidRequest, "invalid record IDs: empty")
+		if err := modeReq.RecordIds.Validate(); err != nil {
+			return err
+		}
+	default:
+		return sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "unknown withdraw rewards mode: %T", m.Mode)
+	}
+
+	if m.IbcTransfer != nil {
+		if err := m.IbcTransfer.Validate(); err != nil {
+			return sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, "invalid IBC transfer: %v", err)
 		}
+	}
+
+	return nil
+}
+
+// NewMsgSetFlatFee creates a new MsgSetFlatFee instance.
+func NewMsgSetFlatFee(senderAddr, contractAddr sdk.AccAddress, flatFee sdk.Coin) *MsgSetFlatFee {
+	return &MsgSetFlatFee{
+		SenderAddress:   senderAddr.String(),
+		ContractAddress: contractAddr.String(),
+		FlatFeeAmount:   flatFee,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (m MsgSetFlatFee) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgSetFlatFee) Type() string { return TypeMsgSetFlatFee }
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgSetFlatFee) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(m.SenderAddress)
+	if err != nil {
+		panic(fmt.Errorf("parsing sender address (%s): %w", m.SenderAddress, err))
+	}
+
+	return []sdk.AccAddress{senderAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (m MsgSetFlatFee) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgSetFlatFee) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.SenderAddress); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "invalid contract address: %v", err)
+	}
+
+	if err := pkg.ValidateCoin(m.FlatFeeAmount); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidCoins, "invalid flat fee: %v", err)
+	}
+
+	return nil
+}
 
-		idsSet := make(map[uint64]struct{})
-		for _, id := range m.GetRecordIds().Ids {
-			if id == 0 {
-				return sdkErrors.Wrap(sdkErrors.ErrInvalidRequest, "invalid record IDs: must be GT 0")
-			}
+// NewMsgWithdrawRewardsAndDelegateByLimit creates a new MsgWithdrawRewardsAndDelegate instance using the records limit oneof option.
+func NewMsgWithdrawRewardsAndDelegateByLimit(senderAddr sdk.AccAddress, valAddr sdk.ValAddress, recordsLimit uint64) *MsgWithdrawRewardsAndDelegate {
+	return &MsgWithdrawRewardsAndDelegate{
+		RewardsAddress:   senderAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Mode: &MsgWithdrawRewardsAndDelegate_RecordsLimit{
+			RecordsLimit: &MsgWithdrawRewards_RecordsLimit{
+				Limit: recordsLimit,
+			},
+		},
+	}
+}
+
+// NewMsgWithdrawRewardsAndDelegateByIDs creates a new MsgWithdrawRewardsAndDelegate instance using the record IDs oneof option.
+func NewMsgWithdrawRewardsAndDelegateByIDs(senderAddr sdk.AccAddress, valAddr sdk.ValAddress, recordIDs []uint64) *MsgWithdrawRewardsAndDelegate {
+	return &MsgWithdrawRewardsAndDelegate{
+		RewardsAddress:   senderAddr.String(),
+		ValidatorAddress: valAddr.String(),
+		Mode: &MsgWithdrawRewardsAndDelegate_RecordIds{
+			RecordIds: &MsgWithdrawRewards_RecordIDs{
+				Ids: recordIDs,
+			},
+		},
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (m MsgWithdrawRewardsAndDelegate) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgWithdrawRewardsAndDelegate) Type() string { return TypeMsgWithdrawRewardsAndDelegate }
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgWithdrawRewardsAndDelegate) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(m.RewardsAddress)
+	if err != nil {
+		panic(fmt.Errorf("parsing rewards address (%s): %w", m.RewardsAddress, err))
+	}
 
-			if _, ok := idsSet[id]; ok {
-				return sdkErrors.Wrapf(sdkErrors.ErrInvalidRequest, "invalid record IDs: duplicate ID (%d)", id)
-			}
-			idsSet[id] = struct{}{}
+	return []sdk.AccAddress{senderAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (m MsgWithdrawRewardsAndDelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgWithdrawRewardsAndDelegate) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.RewardsAddress); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "invalid rewards address: %v", err)
+	}
+
+	if _, err := sdk.ValAddressFromBech32(m.ValidatorAddress); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "invalid validator address: %v", err)
+	}
+
+	if m.Mode == nil {
+		return sdkErrors.Wrap(sdkErrors.ErrInvalidRequest, "invalid mode: nil")
+	}
+
+	switch modeReq := m.Mode.(type) {
+	case *MsgWithdrawRewardsAndDelegate_RecordsLimit:
+		if modeReq == nil {
+			return sdkErrors.Wrap(sdkErrors.ErrInvalidRequest, "invalid records limit: nil mode object")
+		}
+		if modeReq.RecordsLimit == nil {
+			return sdkErrors.Wrap(sdkErrors.ErrInvalidRequest, "invalid records limit: nil request")
+		}
+	case *MsgWithdrawRewardsAndDelegate_RecordIds:
+		if modeReq == nil {
+			return sdkErrors.Wrap(sdkErrors.ErrInvalidRequest, "invalid record IDs: nil mode object")
+		}
+		if modeReq.RecordIds == nil {
+			return sdkErrors.Wrap(sdkErrors.ErrInvalidRequest, "invalid record IDs: nil request")
+		}
+
+		if err := modeReq.RecordIds.Validate(); err != nil {
+			return err
 		}
 	default:
 		return sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "unknown withdraw rewards mode: %T", m.Mode)
@@ -164,3 +371,355 @@ func (m MsgWithdrawRewards) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgAcceptContractMetadataOwnership creates a new MsgAcceptContractMetadataOwnership instance.
+func NewMsgAcceptContractMetadataOwnership(senderAddr, contractAddr sdk.AccAddress) *MsgAcceptContractMetadataOwnership {
+	return &MsgAcceptContractMetadataOwnership{
+		SenderAddress:   senderAddr.String(),
+		ContractAddress: contractAddr.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (m MsgAcceptContractMetadataOwnership) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgAcceptContractMetadataOwnership) Type() string {
+	return TypeMsgAcceptContractMetadataOwnership
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgAcceptContractMetadataOwnership) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(m.SenderAddress)
+	if err != nil {
+		panic(fmt.Errorf("parsing sender address (%s): %w", m.SenderAddress, err))
+	}
+
+	return []sdk.AccAddress{senderAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (m MsgAcceptContractMetadataOwnership) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgAcceptContractMetadataOwnership) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.SenderAddress); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "invalid contract address: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgCancelContractMetadataOwnership creates a new MsgCancelContractMetadataOwnership instance.
+func NewMsgCancelContractMetadataOwnership(senderAddr, contractAddr sdk.AccAddress) *MsgCancelContractMetadataOwnership {
+	return &MsgCancelContractMetadataOwnership{
+		SenderAddress:   senderAddr.String(),
+		ContractAddress: contractAddr.String(),
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (m MsgCancelContractMetadataOwnership) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgCancelContractMetadataOwnership) Type() string {
+	return TypeMsgCancelContractMetadataOwnership
+}
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgCancelContractMetadataOwnership) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(m.SenderAddress)
+	if err != nil {
+		panic(fmt.Errorf("parsing sender address (%s): %w", m.SenderAddress, err))
+	}
+
+	return []sdk.AccAddress{senderAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (m MsgCancelContractMetadataOwnership) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgCancelContractMetadataOwnership) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.SenderAddress); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "invalid contract address: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgSetCodeMetadata creates a new MsgSetCodeMetadata instance.
+func NewMsgSetCodeMetadata(senderAddr sdk.AccAddress, codeID uint64, rewardsAddr *sdk.AccAddress, rewardsRecipients []RewardsRecipient) *MsgSetCodeMetadata {
+	msg := &MsgSetCodeMetadata{
+		SenderAddress: senderAddr.String(),
+		Metadata: CodeMetadata{
+			CodeId: codeID,
+		},
+	}
+
+	if rewardsAddr != nil {
+		msg.Metadata.RewardsAddress = rewardsAddr.String()
+	}
+	if len(rewardsRecipients) > 0 {
+		msg.Metadata.RewardsRecipients = rewardsRecipients
+	}
+
+	return msg
+}
+
+// Route implements the sdk.Msg interface.
+func (m MsgSetCodeMetadata) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgSetCodeMetadata) Type() string { return TypeMsgSetCodeMetadata }
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgSetCodeMetadata) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(m.SenderAddress)
+	if err != nil {
+		panic(fmt.Errorf("parsing sender address (%s): %w", m.SenderAddress, err))
+	}
+
+	return []sdk.AccAddress{senderAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (m MsgSetCodeMetadata) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgSetCodeMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.SenderAddress); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+
+	return m.Metadata.Validate()
+}
+
+// NewMsgSetAutoPayout creates a new MsgSetAutoPayout instance.
+func NewMsgSetAutoPayout(senderAddr, contractAddr sdk.AccAddress, intervalBlocks uint64, threshold sdk.Coins) *MsgSetAutoPayout {
+	return &MsgSetAutoPayout{
+		SenderAddress:   senderAddr.String(),
+		ContractAddress: contractAddr.String(),
+		IntervalBlocks:  intervalBlocks,
+		Threshold:       threshold,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (m MsgSetAutoPayout) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgSetAutoPayout) Type() string { return TypeMsgSetAutoPayout }
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgSetAutoPayout) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(m.SenderAddress)
+	if err != nil {
+		panic(fmt.Errorf("parsing sender address (%s): %w", m.SenderAddress, err))
+	}
+
+	return []sdk.AccAddress{senderAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (m MsgSetAutoPayout) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgSetAutoPayout) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.SenderAddress); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "invalid contract address: %v", err)
+	}
+
+	if err := m.Threshold.Validate(); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidCoins, "invalid threshold: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgSetSponsorship creates a new MsgSetSponsorship instance.
+func NewMsgSetSponsorship(senderAddr, contractAddr sdk.AccAddress, userFeeLimit sdk.Coins, userFeeLimitPeriod uint64, blockFeeLimit sdk.Coins, fundFromRewards bool) *MsgSetSponsorship {
+	return &MsgSetSponsorship{
+		SenderAddress:      senderAddr.String(),
+		ContractAddress:    contractAddr.String(),
+		UserFeeLimit:       userFeeLimit,
+		UserFeeLimitPeriod: userFeeLimitPeriod,
+		BlockFeeLimit:      blockFeeLimit,
+		FundFromRewards:    fundFromRewards,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (m MsgSetSponsorship) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgSetSponsorship) Type() string { return TypeMsgSetSponsorship }
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgSetSponsorship) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(m.SenderAddress)
+	if err != nil {
+		panic(fmt.Errorf("parsing sender address (%s): %w", m.SenderAddress, err))
+	}
+
+	return []sdk.AccAddress{senderAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (m MsgSetSponsorship) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgSetSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.SenderAddress); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "invalid contract address: %v", err)
+	}
+
+	if err := m.UserFeeLimit.Validate(); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidCoins, "invalid user fee limit: %v", err)
+	}
+
+	if !m.UserFeeLimit.Empty() && m.UserFeeLimitPeriod == 0 {
+		return sdkErrors.Wrap(sdkErrors.ErrInvalidRequest, "user fee limit period must be GT 0 if the user fee limit is set")
+	}
+
+	if err := m.BlockFeeLimit.Validate(); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidCoins, "invalid block fee limit: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgDepositSponsorship creates a new MsgDepositSponsorship instance.
+func NewMsgDepositSponsorship(senderAddr, contractAddr sdk.AccAddress, amount sdk.Coins) *MsgDepositSponsorship {
+	return &MsgDepositSponsorship{
+		SenderAddress:   senderAddr.String(),
+		ContractAddress: contractAddr.String(),
+		Amount:          amount,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (m MsgDepositSponsorship) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgDepositSponsorship) Type() string { return TypeMsgDepositSponsorship }
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgDepositSponsorship) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(m.SenderAddress)
+	if err != nil {
+		panic(fmt.Errorf("parsing sender address (%s): %w", m.SenderAddress, err))
+	}
+
+	return []sdk.AccAddress{senderAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (m MsgDepositSponsorship) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgDepositSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.SenderAddress); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "invalid contract address: %v", err)
+	}
+
+	if err := m.Amount.Validate(); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidCoins, "invalid amount: %v", err)
+	}
+
+	if m.Amount.IsZero() {
+		return sdkErrors.Wrap(sdkErrors.ErrInvalidCoins, "invalid amount: must be non-zero")
+	}
+
+	return nil
+}
+
+// NewMsgWithdrawSponsorship creates a new MsgWithdrawSponsorship instance.
+func NewMsgWithdrawSponsorship(senderAddr, contractAddr sdk.AccAddress, amount sdk.Coins) *MsgWithdrawSponsorship {
+	return &MsgWithdrawSponsorship{
+		SenderAddress:   senderAddr.String(),
+		ContractAddress: contractAddr.String(),
+		Amount:          amount,
+	}
+}
+
+// Route implements the sdk.Msg interface.
+func (m MsgWithdrawSponsorship) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface.
+func (m MsgWithdrawSponsorship) Type() string { return TypeMsgWithdrawSponsorship }
+
+// GetSigners implements the sdk.Msg interface.
+func (m MsgWithdrawSponsorship) GetSigners() []sdk.AccAddress {
+	senderAddr, err := sdk.AccAddressFromBech32(m.SenderAddress)
+	if err != nil {
+		panic(fmt.Errorf("parsing sender address (%s): %w", m.SenderAddress, err))
+	}
+
+	return []sdk.AccAddress{senderAddr}
+}
+
+// GetSignBytes implements the sdk.Msg interface.
+func (m MsgWithdrawSponsorship) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic implements the sdk.Msg interface.
+func (m MsgWithdrawSponsorship) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.SenderAddress); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "invalid sender address: %v", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidAddress, "invalid contract address: %v", err)
+	}
+
+	if err := m.Amount.Validate(); err != nil {
+		return sdkErrors.Wrapf(sdkErrors.ErrInvalidCoins, "invalid amount: %v", err)
+	}
+
+	if m.Amount.IsZero() {
+		return sdkErrors.Wrap(sdkErrors.ErrInvalidCoins, "invalid amount: must be non-zero")
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
@@ -51,6 +52,17 @@ func TestMsgSetContractMetadataValidateBasic(t *testing.T) {
 			},
 			errExpected: true,
 		},
+		{
+			name: "Fail: PendingOwnerAddress set directly",
+			msg: rewardsTypes.MsgSetContractMetadata{
+				SenderAddress: accAddr.String(),
+				Metadata: rewardsTypes.ContractMetadata{
+					ContractAddress:     contractAddr.String(),
+					PendingOwnerAddress: accAddr.String(),
+				},
+			},
+			errExpected: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -178,6 +190,547 @@ func TestMsgWithdrawRewardsValidateBasic(t *testing.T) {
 			},
 			errExpected: true,
 		},
+		{
+			name: "OK: IBC transfer",
+			msg: rewardsTypes.MsgWithdrawRewards{
+				RewardsAddress: accAddr.String(),
+				Mode: &rewardsTypes.MsgWithdrawRewards_RecordsLimit_{
+					RecordsLimit: &rewardsTypes.MsgWithdrawRewards_RecordsLimit{
+						Limit: 1,
+					},
+				},
+				IbcTransfer: rewardsTypes.NewMsgWithdrawRewardsIBCTransfer("channel-0", "cosmos1receiver", 1),
+			},
+		},
+		{
+			name: "Fail: IBC transfer: invalid channel",
+			msg: rewardsTypes.MsgWithdrawRewards{
+				RewardsAddress: accAddr.String(),
+				Mode: &rewardsTypes.MsgWithdrawRewards_RecordsLimit_{
+					RecordsLimit: &rewardsTypes.MsgWithdrawRewards_RecordsLimit{
+						Limit: 1,
+					},
+				},
+				IbcTransfer: rewardsTypes.NewMsgWithdrawRewardsIBCTransfer("", "cosmos1receiver", 1),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: IBC transfer: empty receiver",
+			msg: rewardsTypes.MsgWithdrawRewards{
+				RewardsAddress: accAddr.String(),
+				Mode: &rewardsTypes.MsgWithdrawRewards_RecordIds{
+					RecordIds: &rewardsTypes.MsgWithdrawRewards_RecordIDs{
+						Ids: []uint64{1},
+					},
+				},
+				IbcTransfer: rewardsTypes.NewMsgWithdrawRewardsIBCTransfer("channel-0", " ", 1),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: IBC transfer: zero timeout",
+			msg: rewardsTypes.MsgWithdrawRewards{
+				RewardsAddress: accAddr.String(),
+				Mode: &rewardsTypes.MsgWithdrawRewards_RecordIds{
+					RecordIds: &rewardsTypes.MsgWithdrawRewards_RecordIDs{
+						Ids: []uint64{1},
+					},
+				},
+				IbcTransfer: rewardsTypes.NewMsgWithdrawRewardsIBCTransfer("channel-0", "cosmos1receiver", 0),
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetFlatFeeValidateBasic(t *testing.T) {
+	type testCase struct {
+		name        string
+		msg         rewardsTypes.MsgSetFlatFee
+		errExpected bool
+	}
+
+	accAddrs, _ := e2eTesting.GenAccounts(1)
+	accAddr := accAddrs[0]
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+
+	testCases := []testCase{
+		{
+			name: "OK",
+			msg: rewardsTypes.MsgSetFlatFee{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: contractAddr.String(),
+				FlatFeeAmount:   sdk.NewInt64Coin("uarch", 10),
+			},
+		},
+		{
+			name: "OK: zero fee (removal)",
+			msg: rewardsTypes.MsgSetFlatFee{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: contractAddr.String(),
+				FlatFeeAmount:   sdk.NewInt64Coin("uarch", 0),
+			},
+		},
+		{
+			name: "Fail: invalid SenderAddress",
+			msg: rewardsTypes.MsgSetFlatFee{
+				SenderAddress:   "invalid",
+				ContractAddress: contractAddr.String(),
+				FlatFeeAmount:   sdk.NewInt64Coin("uarch", 10),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid ContractAddress",
+			msg: rewardsTypes.MsgSetFlatFee{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: "invalid",
+				FlatFeeAmount:   sdk.NewInt64Coin("uarch", 10),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid FlatFeeAmount",
+			msg: rewardsTypes.MsgSetFlatFee{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: contractAddr.String(),
+				FlatFeeAmount:   sdk.Coin{Denom: "", Amount: sdk.OneInt()},
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgWithdrawRewardsAndDelegateValidateBasic(t *testing.T) {
+	type testCase struct {
+		name        string
+		msg         rewardsTypes.MsgWithdrawRewardsAndDelegate
+		errExpected bool
+	}
+
+	accAddrs, _ := e2eTesting.GenAccounts(1)
+	accAddr := accAddrs[0]
+	valAddr := sdk.ValAddress(accAddr)
+
+	testCases := []testCase{
+		{
+			name: "OK: RecordsLimit",
+			msg:  *rewardsTypes.NewMsgWithdrawRewardsAndDelegateByLimit(accAddr, valAddr, 1),
+		},
+		{
+			name: "OK: RecordIDs",
+			msg:  *rewardsTypes.NewMsgWithdrawRewardsAndDelegateByIDs(accAddr, valAddr, []uint64{1, 2}),
+		},
+		{
+			name: "Fail: invalid RewardsAddress",
+			msg: rewardsTypes.MsgWithdrawRewardsAndDelegate{
+				RewardsAddress:   "invalid",
+				ValidatorAddress: valAddr.String(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid ValidatorAddress",
+			msg: rewardsTypes.MsgWithdrawRewardsAndDelegate{
+				RewardsAddress:   accAddr.String(),
+				ValidatorAddress: accAddr.String(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: no mode set",
+			msg: rewardsTypes.MsgWithdrawRewardsAndDelegate{
+				RewardsAddress:   accAddr.String(),
+				ValidatorAddress: valAddr.String(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: RecordsLimit: empty request",
+			msg: rewardsTypes.MsgWithdrawRewardsAndDelegate{
+				RewardsAddress:   accAddr.String(),
+				ValidatorAddress: valAddr.String(),
+				Mode:             &rewardsTypes.MsgWithdrawRewardsAndDelegate_RecordsLimit{},
+			},
+			errExpected: true,
+		},
+		{
+			name:        "Fail: RecordIDs: empty IDs",
+			msg:         *rewardsTypes.NewMsgWithdrawRewardsAndDelegateByIDs(accAddr, valAddr, []uint64{}),
+			errExpected: true,
+		},
+		{
+			name:        "Fail: RecordIDs: duplicated ID",
+			msg:         *rewardsTypes.NewMsgWithdrawRewardsAndDelegateByIDs(accAddr, valAddr, []uint64{1, 1}),
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgContractMetadataOwnershipValidateBasic(t *testing.T) {
+	type testCase struct {
+		name        string
+		msg         sdk.Msg
+		errExpected bool
+	}
+
+	accAddrs, _ := e2eTesting.GenAccounts(1)
+	accAddr := accAddrs[0]
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+
+	testCases := []testCase{
+		{
+			name: "OK: accept",
+			msg:  rewardsTypes.NewMsgAcceptContractMetadataOwnership(accAddr, contractAddr),
+		},
+		{
+			name: "OK: cancel",
+			msg:  rewardsTypes.NewMsgCancelContractMetadataOwnership(accAddr, contractAddr),
+		},
+		{
+			name: "Fail: accept: invalid SenderAddress",
+			msg: &rewardsTypes.MsgAcceptContractMetadataOwnership{
+				SenderAddress:   "invalid",
+				ContractAddress: contractAddr.String(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: accept: invalid ContractAddress",
+			msg: &rewardsTypes.MsgAcceptContractMetadataOwnership{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: "invalid",
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: cancel: invalid SenderAddress",
+			msg: &rewardsTypes.MsgCancelContractMetadataOwnership{
+				SenderAddress:   "invalid",
+				ContractAddress: contractAddr.String(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: cancel: invalid ContractAddress",
+			msg: &rewardsTypes.MsgCancelContractMetadataOwnership{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: "invalid",
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetCodeMetadataValidateBasic(t *testing.T) {
+	type testCase struct {
+		name        string
+		msg         rewardsTypes.MsgSetCodeMetadata
+		errExpected bool
+	}
+
+	accAddrs, _ := e2eTesting.GenAccounts(1)
+	accAddr := accAddrs[0]
+
+	testCases := []testCase{
+		{
+			name: "OK",
+			msg:  *rewardsTypes.NewMsgSetCodeMetadata(accAddr, 1, &accAddr, nil),
+		},
+		{
+			name: "Fail: invalid SenderAddress",
+			msg: rewardsTypes.MsgSetCodeMetadata{
+				SenderAddress: "invalid",
+				Metadata: rewardsTypes.CodeMetadata{
+					CodeId: 1,
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid Metadata",
+			msg: rewardsTypes.MsgSetCodeMetadata{
+				SenderAddress: accAddr.String(),
+				Metadata: rewardsTypes.CodeMetadata{
+					CodeId: 0,
+				},
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetAutoPayoutValidateBasic(t *testing.T) {
+	type testCase struct {
+		name        string
+		msg         rewardsTypes.MsgSetAutoPayout
+		errExpected bool
+	}
+
+	accAddrs, _ := e2eTesting.GenAccounts(1)
+	accAddr := accAddrs[0]
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+
+	testCases := []testCase{
+		{
+			name: "OK",
+			msg: rewardsTypes.MsgSetAutoPayout{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: contractAddr.String(),
+				IntervalBlocks:  100,
+				Threshold:       sdk.NewCoins(sdk.NewInt64Coin("uarch", 10)),
+			},
+		},
+		{
+			name: "OK: no triggers (removal)",
+			msg: rewardsTypes.MsgSetAutoPayout{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: contractAddr.String(),
+			},
+		},
+		{
+			name: "Fail: invalid SenderAddress",
+			msg: rewardsTypes.MsgSetAutoPayout{
+				SenderAddress:   "invalid",
+				ContractAddress: contractAddr.String(),
+				IntervalBlocks:  100,
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid ContractAddress",
+			msg: rewardsTypes.MsgSetAutoPayout{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: "invalid",
+				IntervalBlocks:  100,
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid Threshold",
+			msg: rewardsTypes.MsgSetAutoPayout{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: contractAddr.String(),
+				Threshold:       sdk.Coins{sdk.Coin{Denom: "", Amount: sdk.OneInt()}},
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSetSponsorshipValidateBasic(t *testing.T) {
+	type testCase struct {
+		name        string
+		msg         rewardsTypes.MsgSetSponsorship
+		errExpected bool
+	}
+
+	accAddrs, _ := e2eTesting.GenAccounts(1)
+	accAddr := accAddrs[0]
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+
+	testCases := []testCase{
+		{
+			name: "OK",
+			msg: rewardsTypes.MsgSetSponsorship{
+				SenderAddress:      accAddr.String(),
+				ContractAddress:    contractAddr.String(),
+				UserFeeLimit:       sdk.NewCoins(sdk.NewInt64Coin("uarch", 10)),
+				UserFeeLimitPeriod: 100,
+				BlockFeeLimit:      sdk.NewCoins(sdk.NewInt64Coin("uarch", 100)),
+				FundFromRewards:    true,
+			},
+		},
+		{
+			name: "OK: no limits",
+			msg: rewardsTypes.MsgSetSponsorship{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: contractAddr.String(),
+			},
+		},
+		{
+			name: "Fail: invalid SenderAddress",
+			msg: rewardsTypes.MsgSetSponsorship{
+				SenderAddress:   "invalid",
+				ContractAddress: contractAddr.String(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid ContractAddress",
+			msg: rewardsTypes.MsgSetSponsorship{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: "invalid",
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid UserFeeLimit",
+			msg: rewardsTypes.MsgSetSponsorship{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: contractAddr.String(),
+				UserFeeLimit:    sdk.Coins{sdk.Coin{Denom: "", Amount: sdk.OneInt()}},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: UserFeeLimitPeriod is not set",
+			msg: rewardsTypes.MsgSetSponsorship{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: contractAddr.String(),
+				UserFeeLimit:    sdk.NewCoins(sdk.NewInt64Coin("uarch", 10)),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid BlockFeeLimit",
+			msg: rewardsTypes.MsgSetSponsorship{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: contractAddr.String(),
+				BlockFeeLimit:   sdk.Coins{sdk.Coin{Denom: "uarch", Amount: sdk.ZeroInt()}},
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgSponsorshipFundsValidateBasic(t *testing.T) {
+	type testCase struct {
+		name        string
+		msg         sdk.Msg
+		errExpected bool
+	}
+
+	accAddrs, _ := e2eTesting.GenAccounts(1)
+	accAddr := accAddrs[0]
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uarch", 10))
+
+	testCases := []testCase{
+		{
+			name: "OK: deposit",
+			msg:  rewardsTypes.NewMsgDepositSponsorship(accAddr, contractAddr, amount),
+		},
+		{
+			name: "OK: withdraw",
+			msg:  rewardsTypes.NewMsgWithdrawSponsorship(accAddr, contractAddr, amount),
+		},
+		{
+			name: "Fail: deposit: invalid SenderAddress",
+			msg: &rewardsTypes.MsgDepositSponsorship{
+				SenderAddress:   "invalid",
+				ContractAddress: contractAddr.String(),
+				Amount:          amount,
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: withdraw: invalid ContractAddress",
+			msg: &rewardsTypes.MsgWithdrawSponsorship{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: "invalid",
+				Amount:          amount,
+			},
+			errExpected: true,
+		},
+		{
+			name:        "Fail: deposit: empty Amount",
+			msg:         rewardsTypes.NewMsgDepositSponsorship(accAddr, contractAddr, sdk.NewCoins()),
+			errExpected: true,
+		},
+		{
+			name: "Fail: withdraw: invalid Amount",
+			msg: &rewardsTypes.MsgWithdrawSponsorship{
+				SenderAddress:   accAddr.String(),
+				ContractAddress: contractAddr.String(),
+				Amount:          sdk.Coins{sdk.Coin{Denom: "", Amount: sdk.OneInt()}},
+			},
+			errExpected: true,
+		},
 	}
 
 	for _, tc := range testCases {
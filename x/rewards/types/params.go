@@ -2,16 +2,36 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramTypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"sigs.k8s.io/yaml"
+
+	trackingTypes "github.com/archway-network/archway/x/tracking/types"
 )
 
 var (
 	InflationRewardsRatioParamKey = []byte("InflationRewardsRatio")
 	TxFeeRebateRatioParamKey      = []byte("TxFeeRebateRatio")
 	MaxWithdrawRecordsParamKey    = []byte("MaxWithdrawRecords")
+	RecordExpiryParamKey          = []byte("RewardsRecordExpiry")
+	MaxAutoPayoutsParamKey        = []byte("MaxAutoPayoutsPerBlock")
+	InflationShareCapParamKey     = []byte("ContractInflationShareCap")
+	InflationEpochCapParamKey     = []byte("ContractInflationEpochCap")
+	InflationCapEpochParamKey     = []byte("InflationCapEpochBlocks")
+	SelfDealingPolicyParamKey     = []byte("SelfDealingPolicy")
+	OperationWeightsParamKey      = []byte("ContractOperationWeights")
+	AcceptedFeeDenomsParamKey     = []byte("AcceptedFeeDenoms")
+	MinConsFeeModeParamKey        = []byte("MinConsensusFeeMode")
+	MinConsFeeWindowParamKey      = []byte("MinConsensusFeeWindow")
+	MinConsFeeHistoryParamKey     = []byte("MinConsensusFeeHistoryBlocks")
+	BaseFeeChangeRateParamKey     = []byte("BaseFeeMaxChangeRate")
+	BaseFeeTargetParamKey         = []byte("BaseFeeTargetUtilization")
+	BaseFeeBurnRatioParamKey      = []byte("BaseFeeBurnRatio")
+	TxPriorityTiersParamKey       = []byte("TxPriorityTiers")
+	GasRefundRatioParamKey        = []byte("UnusedGasRefundRatio")
+	MaxExpiredRecordsParamKey     = []byte("MaxExpiredRecordsPerBlock")
 )
 
 // Limit below are var (not const) for E2E tests to change them.
@@ -22,12 +42,42 @@ var (
 	// MaxRecordsQueryLimit defines the page limit for querying RewardsRecords.
 	// Limit is defined by the TestRewardsRecordsQueryLimit E2E test.
 	MaxRecordsQueryLimit = uint64(7500)
+	// MaxContractOperationWeight defines the ContractOperationWeight max value.
+	MaxContractOperationWeight = sdk.NewDec(10)
+	// MaxRewardsRecipients defines the max number of weighted rewards recipients per contract.
+	// Each recipient adds a RewardsRecord per distribution, so the list size is bounded.
+	MaxRewardsRecipients = 10
 )
 
 var (
 	DefaultInflationRatio     = sdk.MustNewDecFromStr("0.20") // 20%
 	DefaultTxFeeRebateRatio   = sdk.MustNewDecFromStr("0.50") // 50%
 	DefaultMaxWithdrawRecords = MaxWithdrawRecordsParamLimit
+	DefaultRecordExpiry       = time.Duration(0) // disabled
+	DefaultMaxExpiredRecords  = uint64(1000)
+	DefaultMaxAutoPayouts     = uint64(100)
+	DefaultInflationShareCap  = sdk.OneDec()  // not capped
+	DefaultInflationEpochCap  = sdk.ZeroInt() // not capped
+	DefaultInflationCapEpoch  = uint64(17280) // ~1 day with 5s blocks
+	DefaultSelfDealingPolicy  = SelfDealingPolicy_SELF_DEALING_POLICY_NONE
+	DefaultOperationWeights   = []ContractOperationWeight{ // all operations are weighted equally
+		{OperationType: trackingTypes.ContractOperation_CONTRACT_OPERATION_INSTANTIATION, Weight: sdk.OneDec()},
+		{OperationType: trackingTypes.ContractOperation_CONTRACT_OPERATION_EXECUTION, Weight: sdk.OneDec()},
+		{OperationType: trackingTypes.ContractOperation_CONTRACT_OPERATION_QUERY, Weight: sdk.OneDec()},
+		{OperationType: trackingTypes.ContractOperation_CONTRACT_OPERATION_MIGRATE, Weight: sdk.OneDec()},
+		{OperationType: trackingTypes.ContractOperation_CONTRACT_OPERATION_IBC, Weight: sdk.OneDec()},
+		{OperationType: trackingTypes.ContractOperation_CONTRACT_OPERATION_SUDO, Weight: sdk.OneDec()},
+		{OperationType: trackingTypes.ContractOperation_CONTRACT_OPERATION_REPLY, Weight: sdk.OneDec()},
+	}
+	DefaultAcceptedFeeDenoms = []FeeDenom(nil) // only the base denom is accepted
+	DefaultMinConsFeeMode    = MinConsensusFeeMode_MIN_CONSENSUS_FEE_MODE_INSTANT
+	DefaultMinConsFeeWindow  = uint64(100)
+	DefaultMinConsFeeHistory = uint64(720)   // ~1 hour with 5s blocks
+	DefaultBaseFeeChangeRate = sdk.ZeroDec() // disabled
+	DefaultBaseFeeTarget     = sdk.NewDecWithPrec(5, 1)
+	DefaultBaseFeeBurnRatio  = sdk.ZeroDec()
+	DefaultTxPriorityTiers   = []TxPriorityTier(nil) // all transactions have the same priority
+	DefaultGasRefundRatio    = sdk.ZeroDec()         // disabled
 )
 
 var _ paramTypes.ParamSet = (*Params)(nil)
@@ -38,11 +88,38 @@ func ParamKeyTable() paramTypes.KeyTable {
 }
 
 // NewParams creates a new Params instance.
-func NewParams(inflationRewardsRatio, txFeeRebateRatio sdk.Dec, maxwithdrawRecords uint64) Params {
+func NewParams(
+	inflationRewardsRatio, txFeeRebateRatio sdk.Dec,
+	maxwithdrawRecords uint64, recordExpiry time.Duration, maxExpiredRecords, maxAutoPayouts uint64,
+	inflationShareCap sdk.Dec, inflationEpochCap sdk.Int, inflationCapEpochBlocks uint64,
+	selfDealingPolicy SelfDealingPolicy, operationWeights []ContractOperationWeight,
+	acceptedFeeDenoms []FeeDenom,
+	minConsFeeMode MinConsensusFeeMode, minConsFeeWindow, minConsFeeHistoryBlocks uint64,
+	baseFeeChangeRate, baseFeeTarget, baseFeeBurnRatio sdk.Dec,
+	txPriorityTiers []TxPriorityTier,
+	gasRefundRatio sdk.Dec,
+) Params {
 	return Params{
-		InflationRewardsRatio: inflationRewardsRatio,
-		TxFeeRebateRatio:      txFeeRebateRatio,
-		MaxWithdrawRecords:    maxwithdrawRecords,
+		InflationRewardsRatio:        inflationRewardsRatio,
+		TxFeeRebateRatio:             txFeeRebateRatio,
+		MaxWithdrawRecords:           maxwithdrawRecords,
+		RewardsRecordExpiry:          recordExpiry,
+		MaxExpiredRecordsPerBlock:    maxExpiredRecords,
+		MaxAutoPayoutsPerBlock:       maxAutoPayouts,
+		ContractInflationShareCap:    inflationShareCap,
+		ContractInflationEpochCap:    inflationEpochCap,
+		InflationCapEpochBlocks:      inflationCapEpochBlocks,
+		SelfDealingPolicy:            selfDealingPolicy,
+		ContractOperationWeights:     operationWeights,
+		AcceptedFeeDenoms:            acceptedFeeDenoms,
+		MinConsensusFeeMode:          minConsFeeMode,
+		MinConsensusFeeWindow:        minConsFeeWindow,
+		MinConsensusFeeHistoryBlocks: minConsFeeHistoryBlocks,
+		BaseFeeMaxChangeRate:         baseFeeChangeRate,
+		BaseFeeTargetUtilization:     baseFeeTarget,
+		BaseFeeBurnRatio:             baseFeeBurnRatio,
+		TxPriorityTiers:              txPriorityTiers,
+		UnusedGasRefundRatio:         gasRefundRatio,
 	}
 }
 
@@ -52,6 +129,23 @@ func DefaultParams() Params {
 		DefaultInflationRatio,
 		DefaultTxFeeRebateRatio,
 		DefaultMaxWithdrawRecords,
+		DefaultRecordExpiry,
+		DefaultMaxExpiredRecords,
+		DefaultMaxAutoPayouts,
+		DefaultInflationShareCap,
+		DefaultInflationEpochCap,
+		DefaultInflationCapEpoch,
+		DefaultSelfDealingPolicy,
+		DefaultOperationWeights,
+		DefaultAcceptedFeeDenoms,
+		DefaultMinConsFeeMode,
+		DefaultMinConsFeeWindow,
+		DefaultMinConsFeeHistory,
+		DefaultBaseFeeChangeRate,
+		DefaultBaseFeeTarget,
+		DefaultBaseFeeBurnRatio,
+		DefaultTxPriorityTiers,
+		DefaultGasRefundRatio,
 	)
 }
 
@@ -61,6 +155,23 @@ func (m *Params) ParamSetPairs() paramTypes.ParamSetPairs {
 		paramTypes.NewParamSetPair(InflationRewardsRatioParamKey, &m.InflationRewardsRatio, validateInflationRewardsRatio),
 		paramTypes.NewParamSetPair(TxFeeRebateRatioParamKey, &m.TxFeeRebateRatio, validateTxFeeRebateRatio),
 		paramTypes.NewParamSetPair(MaxWithdrawRecordsParamKey, &m.MaxWithdrawRecords, validateMaxWithdrawRecords),
+		paramTypes.NewParamSetPair(RecordExpiryParamKey, &m.RewardsRecordExpiry, validateRecordExpiry),
+		paramTypes.NewParamSetPair(MaxExpiredRecordsParamKey, &m.MaxExpiredRecordsPerBlock, validateMaxExpiredRecords),
+		paramTypes.NewParamSetPair(MaxAutoPayoutsParamKey, &m.MaxAutoPayoutsPerBlock, validateMaxAutoPayouts),
+		paramTypes.NewParamSetPair(InflationShareCapParamKey, &m.ContractInflationShareCap, validateInflationShareCap),
+		paramTypes.NewParamSetPair(InflationEpochCapParamKey, &m.ContractInflationEpochCap, validateInflationEpochCap),
+		paramTypes.NewParamSetPair(InflationCapEpochParamKey, &m.InflationCapEpochBlocks, validateInflationCapEpoch),
+		paramTypes.NewParamSetPair(SelfDealingPolicyParamKey, &m.SelfDealingPolicy, validateSelfDealingPolicy),
+		paramTypes.NewParamSetPair(OperationWeightsParamKey, &m.ContractOperationWeights, validateOperationWeights),
+		paramTypes.NewParamSetPair(AcceptedFeeDenomsParamKey, &m.AcceptedFeeDenoms, validateAcceptedFeeDenoms),
+		paramTypes.NewParamSetPair(MinConsFeeModeParamKey, &m.MinConsensusFeeMode, validateMinConsFeeMode),
+		paramTypes.NewParamSetPair(MinConsFeeWindowParamKey, &m.MinConsensusFeeWindow, validateMinConsFeeWindow),
+		paramTypes.NewParamSetPair(MinConsFeeHistoryParamKey, &m.MinConsensusFeeHistoryBlocks, validateMinConsFeeHistory),
+		paramTypes.NewParamSetPair(BaseFeeChangeRateParamKey, &m.BaseFeeMaxChangeRate, validateBaseFeeChangeRate),
+		paramTypes.NewParamSetPair(BaseFeeTargetParamKey, &m.BaseFeeTargetUtilization, validateBaseFeeTarget),
+		paramTypes.NewParamSetPair(BaseFeeBurnRatioParamKey, &m.BaseFeeBurnRatio, validateBaseFeeBurnRatio),
+		paramTypes.NewParamSetPair(TxPriorityTiersParamKey, &m.TxPriorityTiers, validateTxPriorityTiers),
+		paramTypes.NewParamSetPair(GasRefundRatioParamKey, &m.UnusedGasRefundRatio, validateGasRefundRatio),
 	}
 }
 
@@ -75,6 +186,57 @@ func (m Params) Validate() error {
 	if err := validateMaxWithdrawRecords(m.MaxWithdrawRecords); err != nil {
 		return err
 	}
+	if err := validateRecordExpiry(m.RewardsRecordExpiry); err != nil {
+		return err
+	}
+	if err := validateMaxExpiredRecords(m.MaxExpiredRecordsPerBlock); err != nil {
+		return err
+	}
+	if err := validateMaxAutoPayouts(m.MaxAutoPayoutsPerBlock); err != nil {
+		return err
+	}
+	if err := validateInflationShareCap(m.ContractInflationShareCap); err != nil {
+		return err
+	}
+	if err := validateInflationEpochCap(m.ContractInflationEpochCap); err != nil {
+		return err
+	}
+	if err := validateInflationCapEpoch(m.InflationCapEpochBlocks); err != nil {
+		return err
+	}
+	if err := validateSelfDealingPolicy(m.SelfDealingPolicy); err != nil {
+		return err
+	}
+	if err := validateOperationWeights(m.ContractOperationWeights); err != nil {
+		return err
+	}
+	if err := validateAcceptedFeeDenoms(m.AcceptedFeeDenoms); err != nil {
+		return err
+	}
+	if err := validateMinConsFeeMode(m.MinConsensusFeeMode); err != nil {
+		return err
+	}
+	if err := validateMinConsFeeWindow(m.MinConsensusFeeWindow); err != nil {
+		return err
+	}
+	if err := validateMinConsFeeHistory(m.MinConsensusFeeHistoryBlocks); err != nil {
+		return err
+	}
+	if err := validateBaseFeeChangeRate(m.BaseFeeMaxChangeRate); err != nil {
+		return err
+	}
+	if err := validateBaseFeeTarget(m.BaseFeeTargetUtilization); err != nil {
+		return err
+	}
+	if err := validateBaseFeeBurnRatio(m.BaseFeeBurnRatio); err != nil {
+		return err
+	}
+	if err := validateTxPriorityTiers(m.TxPriorityTiers); err != nil {
+		return err
+	}
+	if err := validateGasRefundRatio(m.UnusedGasRefundRatio); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -148,3 +310,359 @@ func validateMaxWithdrawRecords(v interface{}) (retErr error) {
 
 	return nil
 }
+
+func validateRecordExpiry(v interface{}) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("rewardsRecordExpiry param: %w", retErr)
+		}
+	}()
+
+	p, ok := v.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if p < 0 {
+		return fmt.Errorf("must be GTE 0")
+	}
+
+	return nil
+}
+
+func validateMaxExpiredRecords(v interface{}) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("maxExpiredRecordsPerBlock param: %w", retErr)
+		}
+	}()
+
+	p, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if p == 0 {
+		return fmt.Errorf("must be GTE 1")
+	}
+
+	return nil
+}
+
+func validateMaxAutoPayouts(v interface{}) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("maxAutoPayoutsPerBlock param: %w", retErr)
+		}
+	}()
+
+	if _, ok := v.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateInflationShareCap(v interface{}) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("contractInflationShareCap param: %w", retErr)
+		}
+	}()
+
+	p, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if p.IsNil() || p.IsNegative() {
+		return fmt.Errorf("must be GTE 0.0")
+	}
+	if p.GT(sdk.OneDec()) {
+		return fmt.Errorf("must be LTE 1.0")
+	}
+
+	return nil
+}
+
+func validateInflationEpochCap(v interface{}) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("contractInflationEpochCap param: %w", retErr)
+		}
+	}()
+
+	p, ok := v.(sdk.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if p.IsNil() || p.IsNegative() {
+		return fmt.Errorf("must be GTE 0")
+	}
+
+	return nil
+}
+
+func validateInflationCapEpoch(v interface{}) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("inflationCapEpochBlocks param: %w", retErr)
+		}
+	}()
+
+	p, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if p == 0 {
+		return fmt.Errorf("must be GTE 1")
+	}
+
+	return nil
+}
+
+func validateSelfDealingPolicy(v interface{}) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("selfDealingPolicy param: %w", retErr)
+		}
+	}()
+
+	p, ok := v.(SelfDealingPolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if _, found := SelfDealingPolicy_name[int32(p)]; !found {
+		return fmt.Errorf("unknown policy")
+	}
+
+	return nil
+}
+
+func validateOperationWeights(v interface{}) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("contractOperationWeights param: %w", retErr)
+		}
+	}()
+
+	p, ok := v.([]ContractOperationWeight)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	opTypeSet := make(map[trackingTypes.ContractOperation]struct{})
+	for i, weight := range p {
+		if err := weight.Validate(); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if _, ok := opTypeSet[weight.OperationType]; ok {
+			return fmt.Errorf("[%d]: duplicated operationType", i)
+		}
+		opTypeSet[weight.OperationType] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateAcceptedFeeDenoms(v interface{}) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("acceptedFeeDenoms param: %w", retErr)
+		}
+	}()
+
+	p, ok := v.([]FeeDenom)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	denomSet := make(map[string]struct{})
+	for i, feeDenom := range p {
+		if err := feeDenom.Validate(); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if _, ok := denomSet[feeDenom.Denom]; ok {
+			return fmt.Errorf("[%d]: duplicated denom", i)
+		}
+		denomSet[feeDenom.Denom] = struct{}{}
+	}
+
+	return nil
+}
+
+func validateMinConsFeeMode(v interface{}) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("minConsensusFeeMode param: %w", retErr)
+		}
+	}()
+
+	p, ok := v.(MinConsensusFeeMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if _, found := MinConsensusFeeMode_name[int32(p)]; !found {
+		return fmt.Errorf("unknown mode")
+	}
+
+	return nil
+}
+
+func validateMinConsFeeWindow(v interface{}) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("minConsensusFeeWindow param: %w", retErr)
+		}
+	}()
+
+	p, ok := v.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if p == 0 {
+		return fmt.Errorf("must be GTE 1")
+	}
+
+	return nil
+}
+
+func validateMinConsFeeHistory(v interface{}) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("minConsensusFeeHistoryBlocks param: %w", retErr)
+		}
+	}()
+
+	if _, ok := v.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	return nil
+}
+
+func validateBaseFeeChangeRate(v interface{}) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("baseFeeMaxChangeRate param: %w", retErr)
+		}
+	}()
+
+	p, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if p.IsNil() || p.IsNegative() {
+		return fmt.Errorf("must be GTE 0.0")
+	}
+	if p.GT(sdk.OneDec()) {
+		return fmt.Errorf("must be LTE 1.0")
+	}
+
+	return nil
+}
+
+func validateBaseFeeTarget(v interface{}) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("baseFeeTargetUtilization param: %w", retErr)
+		}
+	}()
+
+	p, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if p.IsNil() || !p.IsPositive() {
+		return fmt.Errorf("must be GT 0.0")
+	}
+	if p.GT(sdk.OneDec()) {
+		return fmt.Errorf("must be LTE 1.0")
+	}
+
+	return nil
+}
+
+func validateBaseFeeBurnRatio(v interface{}) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("baseFeeBurnRatio param: %w", retErr)
+		}
+	}()
+
+	p, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if p.IsNil() || p.IsNegative() {
+		return fmt.Errorf("must be GTE 0.0")
+	}
+	if p.GT(sdk.OneDec()) {
+		return fmt.Errorf("must be LTE 1.0")
+	}
+
+	return nil
+}
+
+func validateTxPriorityTiers(v interface{}) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("txPriorityTiers param: %w", retErr)
+		}
+	}()
+
+	p, ok := v.([]TxPriorityTier)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	for i, tier := range p {
+		if err := tier.Validate(); err != nil {
+			return fmt.Errorf("[%d]: %w", i, err)
+		}
+
+		if i == 0 {
+			continue
+		}
+		if !tier.MinFeeRatio.GT(p[i-1].MinFeeRatio) {
+			return fmt.Errorf("[%d]: minFeeRatio: must be GT the previous tier one", i)
+		}
+		if tier.Priority <= p[i-1].Priority {
+			return fmt.Errorf("[%d]: priority: must be GT the previous tier one", i)
+		}
+	}
+
+	return nil
+}
+
+func validateGasRefundRatio(v interface{}) (retErr error) {
+	defer func() {
+		if retErr != nil {
+			retErr = fmt.Errorf("unusedGasRefundRatio param: %w", retErr)
+		}
+	}()
+
+	p, ok := v.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", v)
+	}
+
+	if p.IsNil() || p.IsNegative() {
+		return fmt.Errorf("must be GTE 0.0")
+	}
+	if p.GT(sdk.OneDec()) {
+		return fmt.Errorf("must be LTE 1.0")
+	}
+
+	return nil
+}
@@ -2,11 +2,13 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 
 	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+	trackingTypes "github.com/archway-network/archway/x/tracking/types"
 )
 
 func TestRewardsParamsValidate(t *testing.T) {
@@ -20,53 +22,793 @@ func TestRewardsParamsValidate(t *testing.T) {
 		{
 			name: "OK",
 			params: rewardsTypes.Params{
-				InflationRewardsRatio: sdk.NewDecWithPrec(2, 2),
-				TxFeeRebateRatio:      sdk.NewDecWithPrec(5, 2),
-				MaxWithdrawRecords:    1,
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+			},
+		},
+		{
+			name: "OK: RewardsRecordExpiry set",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				RewardsRecordExpiry:       100 * time.Second,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+			},
+		},
+		{
+			name: "OK: MaxAutoPayoutsPerBlock set",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				MaxAutoPayoutsPerBlock:    10,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+			},
+		},
+		{
+			name: "OK: inflation caps set",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.NewDecWithPrec(1, 1),
+				ContractInflationEpochCap: sdk.NewInt(1000),
+				InflationCapEpochBlocks:   100,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+			},
+		},
+		{
+			name: "OK: ContractOperationWeights set",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+				ContractOperationWeights: []rewardsTypes.ContractOperationWeight{
+					{OperationType: trackingTypes.ContractOperation_CONTRACT_OPERATION_QUERY, Weight: sdk.ZeroDec()},
+					{OperationType: trackingTypes.ContractOperation_CONTRACT_OPERATION_IBC, Weight: sdk.NewDec(2)},
+				},
+			},
+		},
+		{
+			name: "OK: AcceptedFeeDenoms set",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+				AcceptedFeeDenoms: []rewardsTypes.FeeDenom{
+					{Denom: "uusdc", Rate: sdk.NewDecWithPrec(15, 1)},
+					{Denom: "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", Rate: sdk.NewDecWithPrec(1, 6)},
+				},
+			},
+		},
+		{
+			name: "OK: MinConsensusFee EMA mode set",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:        sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:             sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:           1,
+				MaxExpiredRecordsPerBlock:    1,
+				ContractInflationShareCap:    sdk.OneDec(),
+				ContractInflationEpochCap:    sdk.ZeroInt(),
+				InflationCapEpochBlocks:      1,
+				MinConsensusFeeMode:          rewardsTypes.MinConsensusFeeMode_MIN_CONSENSUS_FEE_MODE_EMA,
+				MinConsensusFeeWindow:        10,
+				BaseFeeMaxChangeRate:         sdk.ZeroDec(),
+				BaseFeeTargetUtilization:     sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:             sdk.ZeroDec(),
+				UnusedGasRefundRatio:         sdk.ZeroDec(),
+				MinConsensusFeeHistoryBlocks: 100,
 			},
 		},
 		{
 			name: "Fail: InflationRewardsRatio: negative",
 			params: rewardsTypes.Params{
-				InflationRewardsRatio: sdk.NewDecWithPrec(-2, 2),
-				TxFeeRebateRatio:      sdk.NewDecWithPrec(5, 2),
-				MaxWithdrawRecords:    1,
+				InflationRewardsRatio:     sdk.NewDecWithPrec(-2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
 			},
 			errExpected: true,
 		},
 		{
 			name: "Fail: InflationRewardsRatio: equal to 1.0",
 			params: rewardsTypes.Params{
-				InflationRewardsRatio: sdk.NewDecWithPrec(1, 0),
-				TxFeeRebateRatio:      sdk.NewDecWithPrec(5, 2),
-				MaxWithdrawRecords:    1,
+				InflationRewardsRatio:     sdk.NewDecWithPrec(1, 0),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
 			},
 			errExpected: true,
 		},
 		{
 			name: "Fail: TxFeeRebateRatio: negative",
 			params: rewardsTypes.Params{
-				InflationRewardsRatio: sdk.NewDecWithPrec(2, 2),
-				TxFeeRebateRatio:      sdk.NewDecWithPrec(-1, 2),
-				MaxWithdrawRecords:    1,
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(-1, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
 			},
 			errExpected: true,
 		},
 		{
 			name: "Fail: TxFeeRebateRatio: equal to 1.0",
 			params: rewardsTypes.Params{
-				InflationRewardsRatio: sdk.NewDecWithPrec(2, 2),
-				TxFeeRebateRatio:      sdk.NewDecWithPrec(1, 0),
-				MaxWithdrawRecords:    1,
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(1, 0),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
 			},
 			errExpected: true,
 		},
 		{
 			name: "Fail: MaxWithdrawRecords: empty",
 			params: rewardsTypes.Params{
-				InflationRewardsRatio: sdk.NewDecWithPrec(2, 2),
-				TxFeeRebateRatio:      sdk.NewDecWithPrec(1, 0),
-				MaxWithdrawRecords:    0,
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(1, 0),
+				MaxWithdrawRecords:        0,
+				MaxExpiredRecordsPerBlock: 1,
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: RewardsRecordExpiry: negative",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				RewardsRecordExpiry:       -time.Second,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: MaxExpiredRecordsPerBlock: empty",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 0,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: ContractInflationShareCap: GT 1.0",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.NewDecWithPrec(11, 1),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: ContractInflationEpochCap: negative",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.NewInt(-1),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: InflationCapEpochBlocks: empty",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   0,
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: SelfDealingPolicy: unknown",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+				SelfDealingPolicy:         3,
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: ContractOperationWeights: unspecified operation",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+				ContractOperationWeights: []rewardsTypes.ContractOperationWeight{
+					{OperationType: trackingTypes.ContractOperation_CONTRACT_OPERATION_UNSPECIFIED, Weight: sdk.OneDec()},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: ContractOperationWeights: negative weight",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+				ContractOperationWeights: []rewardsTypes.ContractOperationWeight{
+					{OperationType: trackingTypes.ContractOperation_CONTRACT_OPERATION_QUERY, Weight: sdk.NewDec(-1)},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: ContractOperationWeights: weight GT max",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+				ContractOperationWeights: []rewardsTypes.ContractOperationWeight{
+					{OperationType: trackingTypes.ContractOperation_CONTRACT_OPERATION_QUERY, Weight: sdk.NewDec(11)},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: ContractOperationWeights: duplicates",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+				ContractOperationWeights: []rewardsTypes.ContractOperationWeight{
+					{OperationType: trackingTypes.ContractOperation_CONTRACT_OPERATION_QUERY, Weight: sdk.OneDec()},
+					{OperationType: trackingTypes.ContractOperation_CONTRACT_OPERATION_QUERY, Weight: sdk.NewDec(2)},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: AcceptedFeeDenoms: invalid denom",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+				AcceptedFeeDenoms: []rewardsTypes.FeeDenom{
+					{Denom: "1uusdc", Rate: sdk.OneDec()},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: AcceptedFeeDenoms: zero rate",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+				AcceptedFeeDenoms: []rewardsTypes.FeeDenom{
+					{Denom: "uusdc", Rate: sdk.ZeroDec()},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: AcceptedFeeDenoms: negative rate",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+				AcceptedFeeDenoms: []rewardsTypes.FeeDenom{
+					{Denom: "uusdc", Rate: sdk.NewDec(-1)},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: AcceptedFeeDenoms: duplicates",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+				AcceptedFeeDenoms: []rewardsTypes.FeeDenom{
+					{Denom: "uusdc", Rate: sdk.OneDec()},
+					{Denom: "uusdc", Rate: sdk.NewDec(2)},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: MinConsensusFeeMode: unknown",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeMode:       rewardsTypes.MinConsensusFeeMode(-1),
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: MinConsensusFeeWindow: empty",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeMode:       rewardsTypes.MinConsensusFeeMode_MIN_CONSENSUS_FEE_MODE_EMA,
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: BaseFee set",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.NewDecWithPrec(125, 3),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.NewDecWithPrec(5, 1),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+			},
+		},
+		{
+			name: "Fail: BaseFeeMaxChangeRate: negative",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.NewDecWithPrec(-1, 2),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: BaseFeeMaxChangeRate: GT 1.0",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.NewDecWithPrec(11, 1),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: BaseFeeTargetUtilization: zero",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.ZeroDec(),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: BaseFeeTargetUtilization: GT 1.0",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(11, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: BaseFeeBurnRatio: negative",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.NewDecWithPrec(-1, 2),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: BaseFeeBurnRatio: GT 1.0",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.NewDecWithPrec(11, 1),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: TxPriorityTiers set",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+				TxPriorityTiers: []rewardsTypes.TxPriorityTier{
+					{MinFeeRatio: sdk.OneDec(), Priority: 1},
+					{MinFeeRatio: sdk.NewDec(2), Priority: 2},
+					{MinFeeRatio: sdk.NewDec(10), Priority: 100},
+				},
+			},
+		},
+		{
+			name: "Fail: TxPriorityTiers: MinFeeRatio LT 1.0",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+				TxPriorityTiers: []rewardsTypes.TxPriorityTier{
+					{MinFeeRatio: sdk.NewDecWithPrec(5, 1), Priority: 1},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: TxPriorityTiers: zero priority",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+				TxPriorityTiers: []rewardsTypes.TxPriorityTier{
+					{MinFeeRatio: sdk.NewDec(2), Priority: 0},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: TxPriorityTiers: MinFeeRatio not sorted",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+				TxPriorityTiers: []rewardsTypes.TxPriorityTier{
+					{MinFeeRatio: sdk.NewDec(2), Priority: 1},
+					{MinFeeRatio: sdk.NewDec(2), Priority: 2},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: TxPriorityTiers: priority not sorted",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.ZeroDec(),
+				TxPriorityTiers: []rewardsTypes.TxPriorityTier{
+					{MinFeeRatio: sdk.NewDec(2), Priority: 2},
+					{MinFeeRatio: sdk.NewDec(3), Priority: 1},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "OK: UnusedGasRefundRatio set",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.NewDecWithPrec(5, 1),
+			},
+		},
+		{
+			name: "Fail: UnusedGasRefundRatio: nil",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.Dec{},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: UnusedGasRefundRatio: negative",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.NewDecWithPrec(-1, 2),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: UnusedGasRefundRatio: GT 1.0",
+			params: rewardsTypes.Params{
+				InflationRewardsRatio:     sdk.NewDecWithPrec(2, 2),
+				TxFeeRebateRatio:          sdk.NewDecWithPrec(5, 2),
+				MaxWithdrawRecords:        1,
+				MaxExpiredRecordsPerBlock: 1,
+				ContractInflationShareCap: sdk.OneDec(),
+				ContractInflationEpochCap: sdk.ZeroInt(),
+				InflationCapEpochBlocks:   1,
+				MinConsensusFeeWindow:     1,
+				BaseFeeMaxChangeRate:      sdk.ZeroDec(),
+				BaseFeeTargetUtilization:  sdk.NewDecWithPrec(5, 1),
+				BaseFeeBurnRatio:          sdk.ZeroDec(),
+				UnusedGasRefundRatio:      sdk.NewDecWithPrec(11, 1),
 			},
 			errExpected: true,
 		},
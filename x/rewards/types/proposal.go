@@ -0,0 +1,185 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ProposalTypeTreasurySpend defines the type for a TreasurySpendProposal.
+	ProposalTypeTreasurySpend = "TreasurySpend"
+	// ProposalTypeTreasuryBurn defines the type for a TreasuryBurnProposal.
+	ProposalTypeTreasuryBurn = "TreasuryBurn"
+	// ProposalTypeSetContractMetadata defines the type for a SetContractMetadataProposal.
+	ProposalTypeSetContractMetadata = "SetContractMetadata"
+)
+
+var (
+	_ govTypes.Content = &TreasurySpendProposal{}
+	_ govTypes.Content = &TreasuryBurnProposal{}
+	_ govTypes.Content = &SetContractMetadataProposal{}
+)
+
+func init() {
+	govTypes.RegisterProposalType(ProposalTypeTreasurySpend)
+	govTypes.RegisterProposalTypeCodec(&TreasurySpendProposal{}, "rewards/TreasurySpendProposal")
+	govTypes.RegisterProposalType(ProposalTypeTreasuryBurn)
+	govTypes.RegisterProposalTypeCodec(&TreasuryBurnProposal{}, "rewards/TreasuryBurnProposal")
+	govTypes.RegisterProposalType(ProposalTypeSetContractMetadata)
+	govTypes.RegisterProposalTypeCodec(&SetContractMetadataProposal{}, "rewards/SetContractMetadataProposal")
+}
+
+// NewTreasurySpendProposal creates a new TreasurySpendProposal instance.
+func NewTreasurySpendProposal(title, description string, recipient sdk.AccAddress, amount sdk.Coins) *TreasurySpendProposal {
+	return &TreasurySpendProposal{
+		Title:       title,
+		Description: description,
+		Recipient:   recipient.String(),
+		Amount:      amount,
+	}
+}
+
+// GetTitle implements the govTypes.Content interface.
+func (p *TreasurySpendProposal) GetTitle() string { return p.Title }
+
+// GetDescription implements the govTypes.Content interface.
+func (p *TreasurySpendProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute implements the govTypes.Content interface.
+func (p *TreasurySpendProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements the govTypes.Content interface.
+func (p *TreasurySpendProposal) ProposalType() string { return ProposalTypeTreasurySpend }
+
+// ValidateBasic implements the govTypes.Content interface.
+func (p *TreasurySpendProposal) ValidateBasic() error {
+	if err := govTypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(p.Recipient); err != nil {
+		return fmt.Errorf("recipient: %w", err)
+	}
+
+	if err := validateProposalAmount(p.Amount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MustGetRecipient returns the recipient address.
+// CONTRACT: panics in case of an error (should not happen since we validate the proposal).
+func (p TreasurySpendProposal) MustGetRecipient() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(p.Recipient)
+	if err != nil {
+		panic(fmt.Errorf("parsing treasurySpendProposal recipient: %w", err))
+	}
+	return addr
+}
+
+// String implements the fmt.Stringer interface.
+func (p TreasurySpendProposal) String() string {
+	bz, _ := yaml.Marshal(p)
+	return string(bz)
+}
+
+// NewTreasuryBurnProposal creates a new TreasuryBurnProposal instance.
+func NewTreasuryBurnProposal(title, description string, amount sdk.Coins) *TreasuryBurnProposal {
+	return &TreasuryBurnProposal{
+		Title:       title,
+		Description: description,
+		Amount:      amount,
+	}
+}
+
+// GetTitle implements the govTypes.Content interface.
+func (p *TreasuryBurnProposal) GetTitle() string { return p.Title }
+
+// GetDescription implements the govTypes.Content interface.
+func (p *TreasuryBurnProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute implements the govTypes.Content interface.
+func (p *TreasuryBurnProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements the govTypes.Content interface.
+func (p *TreasuryBurnProposal) ProposalType() string { return ProposalTypeTreasuryBurn }
+
+// ValidateBasic implements the govTypes.Content interface.
+func (p *TreasuryBurnProposal) ValidateBasic() error {
+	if err := govTypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if err := validateProposalAmount(p.Amount); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (p TreasuryBurnProposal) String() string {
+	bz, _ := yaml.Marshal(p)
+	return string(bz)
+}
+
+// NewSetContractMetadataProposal creates a new SetContractMetadataProposal instance.
+func NewSetContractMetadataProposal(title, description string, metadata ContractMetadata) *SetContractMetadataProposal {
+	return &SetContractMetadataProposal{
+		Title:       title,
+		Description: description,
+		Metadata:    metadata,
+	}
+}
+
+// GetTitle implements the govTypes.Content interface.
+func (p *SetContractMetadataProposal) GetTitle() string { return p.Title }
+
+// GetDescription implements the govTypes.Content interface.
+func (p *SetContractMetadataProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute implements the govTypes.Content interface.
+func (p *SetContractMetadataProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements the govTypes.Content interface.
+func (p *SetContractMetadataProposal) ProposalType() string { return ProposalTypeSetContractMetadata }
+
+// ValidateBasic implements the govTypes.Content interface.
+// Metadata is validated in the strict mode since it replaces the existing one (owner address must be set).
+func (p *SetContractMetadataProposal) ValidateBasic() error {
+	if err := govTypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if err := p.Metadata.Validate(true); err != nil {
+		return fmt.Errorf("metadata: %w", err)
+	}
+
+	if p.Metadata.HasPendingOwnerAddress() {
+		return fmt.Errorf("metadata: pending owner address can not be set")
+	}
+
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (p SetContractMetadataProposal) String() string {
+	bz, _ := yaml.Marshal(p)
+	return string(bz)
+}
+
+// validateProposalAmount checks that the treasury proposal amount is valid and non-zero.
+func validateProposalAmount(amount sdk.Coins) error {
+	if err := amount.Validate(); err != nil {
+		return fmt.Errorf("amount: %w", err)
+	}
+	if amount.IsZero() {
+		return fmt.Errorf("amount: must be non-zero")
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	rewardsTypes "github.com/archway-network/archway/x/rewards/types"
+)
+
+func TestTreasuryProposalsValidateBasic(t *testing.T) {
+	type testCase struct {
+		name        string
+		proposal    interface{ ValidateBasic() error }
+		errExpected bool
+	}
+
+	accAddrs, _ := e2eTesting.GenAccounts(1)
+	accAddr := accAddrs[0]
+	amount := sdk.NewCoins(sdk.NewInt64Coin("uarch", 100))
+
+	testCases := []testCase{
+		{
+			name:     "OK: spend",
+			proposal: rewardsTypes.NewTreasurySpendProposal("Title", "Description", accAddr, amount),
+		},
+		{
+			name:        "Fail: spend: empty title",
+			proposal:    rewardsTypes.NewTreasurySpendProposal("", "Description", accAddr, amount),
+			errExpected: true,
+		},
+		{
+			name: "Fail: spend: invalid recipient",
+			proposal: &rewardsTypes.TreasurySpendProposal{
+				Title:       "Title",
+				Description: "Description",
+				Recipient:   "invalid",
+				Amount:      amount,
+			},
+			errExpected: true,
+		},
+		{
+			name:        "Fail: spend: empty amount",
+			proposal:    rewardsTypes.NewTreasurySpendProposal("Title", "Description", accAddr, sdk.NewCoins()),
+			errExpected: true,
+		},
+		{
+			name: "Fail: spend: invalid amount",
+			proposal: rewardsTypes.NewTreasurySpendProposal("Title", "Description", accAddr, sdk.Coins{
+				sdk.Coin{Denom: "uarch", Amount: sdk.NewInt(-1)},
+			}),
+			errExpected: true,
+		},
+		{
+			name:     "OK: burn",
+			proposal: rewardsTypes.NewTreasuryBurnProposal("Title", "Description", amount),
+		},
+		{
+			name:        "Fail: burn: empty description",
+			proposal:    rewardsTypes.NewTreasuryBurnProposal("Title", "", amount),
+			errExpected: true,
+		},
+		{
+			name:        "Fail: burn: empty amount",
+			proposal:    rewardsTypes.NewTreasuryBurnProposal("Title", "Description", nil),
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestSetContractMetadataProposalValidateBasic(t *testing.T) {
+	type testCase struct {
+		name        string
+		proposal    *rewardsTypes.SetContractMetadataProposal
+		errExpected bool
+	}
+
+	accAddrs, _ := e2eTesting.GenAccounts(2)
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+
+	testCases := []testCase{
+		{
+			name: "OK",
+			proposal: rewardsTypes.NewSetContractMetadataProposal("Title", "Description", rewardsTypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				OwnerAddress:    accAddrs[0].String(),
+				RewardsAddress:  accAddrs[1].String(),
+			}),
+		},
+		{
+			name: "Fail: empty title",
+			proposal: rewardsTypes.NewSetContractMetadataProposal("", "Description", rewardsTypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				OwnerAddress:    accAddrs[0].String(),
+			}),
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid contract address",
+			proposal: rewardsTypes.NewSetContractMetadataProposal("Title", "Description", rewardsTypes.ContractMetadata{
+				ContractAddress: "invalid",
+				OwnerAddress:    accAddrs[0].String(),
+			}),
+			errExpected: true,
+		},
+		{
+			name: "Fail: empty owner address",
+			proposal: rewardsTypes.NewSetContractMetadataProposal("Title", "Description", rewardsTypes.ContractMetadata{
+				ContractAddress: contractAddr.String(),
+				RewardsAddress:  accAddrs[1].String(),
+			}),
+			errExpected: true,
+		},
+		{
+			name: "Fail: pending owner address is set",
+			proposal: rewardsTypes.NewSetContractMetadataProposal("Title", "Description", rewardsTypes.ContractMetadata{
+				ContractAddress:     contractAddr.String(),
+				OwnerAddress:        accAddrs[0].String(),
+				PendingOwnerAddress: accAddrs[1].String(),
+			}),
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
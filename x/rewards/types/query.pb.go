@@ -7,24 +7,29 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -328,7 +333,7 @@ var xxx_messageInfo_QueryRewardsPoolRequest proto.InternalMessageInfo
 type QueryRewardsPoolResponse struct {
 	// undistributed_funds are undistributed yet tokens (ready for withdrawal).
 	UndistributedFunds []types.Coin `protobuf:"bytes,1,rep,name=undistributed_funds,json=undistributedFunds,proto3" json:"undistributed_funds"`
-	// treasury_funds are treasury tokens available (no mechanism is available to withdraw ATM).
+	// treasury_funds are treasury tokens available (could be spent / burned via governance proposals).
 	// Treasury tokens are collected on a block basis. Those tokens are unused block rewards.
 	TreasuryFunds []types.Coin `protobuf:"bytes,2,rep,name=treasury_funds,json=treasuryFunds,proto3" json:"treasury_funds"`
 }
@@ -432,6 +437,11 @@ type QueryEstimateTxFeesResponse struct {
 	GasUnitPrice types.DecCoin `protobuf:"bytes,1,opt,name=gas_unit_price,json=gasUnitPrice,proto3" json:"gas_unit_price"`
 	// estimated_fee is the estimated transaction fee for a given gas limit.
 	EstimatedFee types.Coin `protobuf:"bytes,2,opt,name=estimated_fee,json=estimatedFee,proto3" json:"estimated_fee"`
+	// gas_unit_prices defines the minimum transaction fee per gas unit for every accepted fee denom (including the base one).
+	GasUnitPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=gas_unit_prices,json=gasUnitPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"gas_unit_prices"`
+	// estimated_fees are the estimated transaction fees for a given gas limit for every accepted fee denom (including the base one).
+	// Any of these fees satisfies the minimum consensus fee.
+	EstimatedFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=estimated_fees,json=estimatedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"estimated_fees"`
 }
 
 func (m *QueryEstimateTxFeesResponse) Reset()         { *m = QueryEstimateTxFeesResponse{} }
@@ -481,6 +491,20 @@ func (m *QueryEstimateTxFeesResponse) GetEstimatedFee() types.Coin {
 	return types.Coin{}
 }
 
+func (m *QueryEstimateTxFeesResponse) GetGasUnitPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.GasUnitPrices
+	}
+	return nil
+}
+
+func (m *QueryEstimateTxFeesResponse) GetEstimatedFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.EstimatedFees
+	}
+	return nil
+}
+
 // BlockTracking is the tracking information for a block.
 type BlockTracking struct {
 	// inflation_rewards defines the inflation rewards for the block.
@@ -746,1108 +770,3253 @@ func (m *QueryOutstandingRewardsResponse) GetRecordsNum() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "archway.rewards.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "archway.rewards.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryContractMetadataRequest)(nil), "archway.rewards.v1beta1.QueryContractMetadataRequest")
-	proto.RegisterType((*QueryContractMetadataResponse)(nil), "archway.rewards.v1beta1.QueryContractMetadataResponse")
-	proto.RegisterType((*QueryBlockRewardsTrackingRequest)(nil), "archway.rewards.v1beta1.QueryBlockRewardsTrackingRequest")
-	proto.RegisterType((*QueryBlockRewardsTrackingResponse)(nil), "archway.rewards.v1beta1.QueryBlockRewardsTrackingResponse")
-	proto.RegisterType((*QueryRewardsPoolRequest)(nil), "archway.rewards.v1beta1.QueryRewardsPoolRequest")
-	proto.RegisterType((*QueryRewardsPoolResponse)(nil), "archway.rewards.v1beta1.QueryRewardsPoolResponse")
-	proto.RegisterType((*QueryEstimateTxFeesRequest)(nil), "archway.rewards.v1beta1.QueryEstimateTxFeesRequest")
-	proto.RegisterType((*QueryEstimateTxFeesResponse)(nil), "archway.rewards.v1beta1.QueryEstimateTxFeesResponse")
-	proto.RegisterType((*BlockTracking)(nil), "archway.rewards.v1beta1.BlockTracking")
-	proto.RegisterType((*QueryRewardsRecordsRequest)(nil), "archway.rewards.v1beta1.QueryRewardsRecordsRequest")
-	proto.RegisterType((*QueryRewardsRecordsResponse)(nil), "archway.rewards.v1beta1.QueryRewardsRecordsResponse")
-	proto.RegisterType((*QueryOutstandingRewardsRequest)(nil), "archway.rewards.v1beta1.QueryOutstandingRewardsRequest")
-	proto.RegisterType((*QueryOutstandingRewardsResponse)(nil), "archway.rewards.v1beta1.QueryOutstandingRewardsResponse")
+// QueryFlatFeeRequest is the request for Query.FlatFee.
+type QueryFlatFeeRequest struct {
+	// contract_address is the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("archway/rewards/v1beta1/query.proto", fileDescriptor_078e0e66cc6cb70d)
+func (m *QueryFlatFeeRequest) Reset()         { *m = QueryFlatFeeRequest{} }
+func (m *QueryFlatFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFlatFeeRequest) ProtoMessage()    {}
+func (*QueryFlatFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_078e0e66cc6cb70d, []int{15}
 }
-
-var fileDescriptor_078e0e66cc6cb70d = []byte{
-	// 1030 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x96, 0xcd, 0x6f, 0x1b, 0xc5,
-	0x1b, 0xc7, 0xbd, 0xf9, 0xa5, 0xf9, 0x35, 0x4f, 0x12, 0x37, 0x4c, 0x23, 0x35, 0xdd, 0x06, 0x3b,
-	0x4c, 0xc9, 0x5b, 0x9b, 0x7a, 0x89, 0xcb, 0x5b, 0x2b, 0x71, 0x20, 0x04, 0x43, 0x45, 0x81, 0x60,
-	0x05, 0x09, 0x71, 0xb1, 0xc6, 0xeb, 0xc9, 0x76, 0x15, 0x7b, 0xc7, 0xdd, 0x99, 0x25, 0xce, 0x95,
-	0x0b, 0x5c, 0x90, 0x90, 0xb8, 0x70, 0xe0, 0xc0, 0x11, 0x0e, 0x20, 0x0e, 0x1c, 0xca, 0x91, 0x5b,
-	0x8f, 0x95, 0xb8, 0x70, 0x42, 0x28, 0xe1, 0x0f, 0x41, 0x3b, 0xfb, 0x8c, 0x63, 0xc7, 0xbb, 0x9b,
-	0x98, 0x53, 0x92, 0x67, 0xe6, 0xfb, 0x7d, 0x3e, 0xf3, 0xcc, 0xec, 0xf3, 0x04, 0x6e, 0xb2, 0xd0,
-	0x7d, 0x74, 0xc8, 0x8e, 0x9c, 0x90, 0x1f, 0xb2, 0xb0, 0x25, 0x9d, 0xcf, 0xb6, 0x9a, 0x5c, 0xb1,
-	0x2d, 0xe7, 0x71, 0xc4, 0xc3, 0xa3, 0x4a, 0x37, 0x14, 0x4a, 0x90, 0x6b, 0xb8, 0xa9, 0x82, 0x9b,
-	0x2a, 0xb8, 0xc9, 0x5e, 0xf0, 0x84, 0x27, 0xf4, 0x1e, 0x27, 0xfe, 0x2d, 0xd9, 0x6e, 0x2f, 0x79,
-	0x42, 0x78, 0x6d, 0xee, 0xb0, 0xae, 0xef, 0xb0, 0x20, 0x10, 0x8a, 0x29, 0x5f, 0x04, 0x12, 0x57,
-	0x4b, 0xae, 0x90, 0x1d, 0x21, 0x9d, 0x26, 0x93, 0xbc, 0x9f, 0xcd, 0x15, 0x7e, 0x80, 0xeb, 0xb7,
-	0x06, 0xd7, 0x35, 0x45, 0x7f, 0x57, 0x97, 0x79, 0x7e, 0xa0, 0xcd, 0x70, 0xef, 0x4a, 0x16, 0xbd,
-	0x01, 0xd5, 0xdb, 0xe8, 0x02, 0x90, 0x8f, 0x62, 0xa3, 0x5d, 0x16, 0xb2, 0x8e, 0xac, 0xf3, 0xc7,
-	0x11, 0x97, 0x8a, 0xee, 0xc1, 0xd5, 0xa1, 0xa8, 0xec, 0x8a, 0x40, 0x72, 0xf2, 0x06, 0x4c, 0x75,
-	0x75, 0x64, 0xd1, 0x5a, 0xb6, 0xd6, 0x67, 0xaa, 0xe5, 0x4a, 0xc6, 0xe9, 0x2b, 0x89, 0x70, 0x7b,
-	0xf2, 0xe9, 0x5f, 0xe5, 0x42, 0x1d, 0x45, 0xf4, 0x01, 0x2c, 0x69, 0xd7, 0xb7, 0x44, 0xa0, 0x42,
-	0xe6, 0xaa, 0xf7, 0xb9, 0x62, 0x2d, 0xa6, 0x18, 0x66, 0x25, 0x1b, 0x30, 0xef, 0xe2, 0x52, 0x83,
-	0xb5, 0x5a, 0x21, 0x97, 0x49, 0xa2, 0xe9, 0xfa, 0x15, 0x13, 0x7f, 0x33, 0x09, 0xd3, 0x36, 0x3c,
-	0x9f, 0x61, 0x85, 0xa8, 0xef, 0xc1, 0xe5, 0x0e, 0xc6, 0x10, 0x76, 0x23, 0x13, 0xf6, 0xac, 0x09,
-	0x62, 0xf7, 0x0d, 0x28, 0x85, 0x65, 0x9d, 0x6d, 0xbb, 0x2d, 0xdc, 0x83, 0x7a, 0xa2, 0xde, 0x0b,
-	0x99, 0x7b, 0xe0, 0x07, 0x9e, 0x29, 0x99, 0x07, 0x2f, 0xe4, 0xec, 0x41, 0xaa, 0x6d, 0xb8, 0xd4,
-	0x8c, 0xd7, 0x11, 0x69, 0x35, 0x13, 0x49, 0xbb, 0x18, 0x39, 0xf2, 0x24, 0x52, 0x7a, 0x1d, 0xae,
-	0xe9, 0x44, 0x98, 0x63, 0x57, 0x88, 0xb6, 0x61, 0xf8, 0xd5, 0x82, 0xc5, 0xd1, 0x35, 0xcc, 0xbd,
-	0x0b, 0x57, 0xa3, 0xa0, 0xe5, 0x4b, 0x15, 0xfa, 0xcd, 0x48, 0xf1, 0x56, 0x63, 0x3f, 0x0a, 0x5a,
-	0x71, 0x81, 0xff, 0xb7, 0x3e, 0x53, 0xbd, 0x5e, 0x49, 0x9e, 0x56, 0x25, 0x7e, 0x5a, 0x03, 0x85,
-	0xf1, 0x03, 0x4c, 0x4e, 0x86, 0xb4, 0xb5, 0x58, 0x4a, 0x6a, 0x50, 0x54, 0x21, 0x67, 0x32, 0x0a,
-	0x8f, 0xd0, 0x6c, 0xe2, 0x62, 0x66, 0x73, 0x46, 0xa6, 0x7d, 0xe8, 0x3d, 0xb0, 0x35, 0xf5, 0xdb,
-	0x52, 0xf9, 0x1d, 0xa6, 0xf8, 0x5e, 0xaf, 0xc6, 0xb9, 0x79, 0x8b, 0xe4, 0x06, 0x4c, 0x7b, 0x4c,
-	0x36, 0xda, 0x7e, 0xc7, 0x57, 0xba, 0x6e, 0x93, 0xf5, 0xcb, 0x1e, 0x93, 0x0f, 0xe3, 0xbf, 0xe9,
-	0x4f, 0x16, 0xdc, 0x48, 0xd5, 0xe2, 0xa1, 0xdf, 0x85, 0x62, 0x2c, 0x8e, 0x02, 0x5f, 0x35, 0xba,
-	0xa1, 0xef, 0x72, 0xac, 0xfc, 0x52, 0x2a, 0xe2, 0x0e, 0x77, 0x07, 0x28, 0x67, 0x3d, 0x26, 0x3f,
-	0x0e, 0x7c, 0xb5, 0x1b, 0xeb, 0xc8, 0x0e, 0xcc, 0x71, 0xcc, 0xd1, 0x6a, 0xec, 0x73, 0xbe, 0x38,
-	0xa1, 0x8d, 0xce, 0x3d, 0xeb, 0x6c, 0x5f, 0x55, 0xe3, 0x9c, 0x3e, 0xb1, 0x60, 0x6e, 0xe8, 0x6e,
-	0xc9, 0x27, 0xf0, 0x9c, 0x1f, 0xec, 0xb7, 0xf5, 0xa7, 0xdb, 0xc0, 0x67, 0x80, 0x90, 0x2b, 0xf9,
-	0xcf, 0x03, 0x2f, 0x19, 0xf3, 0xcc, 0xf7, 0x5d, 0x30, 0x4e, 0xde, 0x01, 0x50, 0xbd, 0xbe, 0x65,
-	0x72, 0x35, 0x34, 0xd3, 0x72, 0xaf, 0x37, 0xec, 0x37, 0xad, 0x4c, 0xe0, 0xfe, 0xe4, 0xb7, 0xdf,
-	0x97, 0x0b, 0xf4, 0x2b, 0x0b, 0xaf, 0x09, 0xc3, 0x75, 0xee, 0x0a, 0xfd, 0x23, 0xb9, 0xa6, 0x35,
-	0xb8, 0x82, 0x96, 0x67, 0xbe, 0xdd, 0x22, 0x86, 0xf1, 0xd3, 0x25, 0x35, 0x80, 0xd3, 0x66, 0x85,
-	0x55, 0x5c, 0x1d, 0xaa, 0x62, 0xd2, 0x5f, 0x4f, 0x5b, 0x89, 0xc7, 0x31, 0x49, 0x7d, 0x40, 0x49,
-	0x7f, 0x36, 0x57, 0x7f, 0x96, 0x07, 0xaf, 0xbe, 0x06, 0xff, 0x0f, 0x93, 0x10, 0xbe, 0xf1, 0xec,
-	0xaf, 0x6d, 0xc8, 0x01, 0xcf, 0x6f, 0xc4, 0x71, 0x19, 0x47, 0x78, 0xd7, 0xce, 0xe5, 0x4d, 0x20,
-	0x86, 0x80, 0x1f, 0x40, 0x49, 0xf3, 0x7e, 0x18, 0x29, 0xa9, 0x58, 0xd0, 0xd2, 0x8d, 0x01, 0x13,
-	0x8f, 0x57, 0x43, 0xfa, 0xa5, 0x05, 0xe5, 0x4c, 0x2f, 0x3c, 0xff, 0x0e, 0xcc, 0x29, 0xa1, 0x58,
-	0x7b, 0xe0, 0x51, 0x5d, 0xe8, 0xe3, 0x9c, 0xd5, 0x2a, 0xf3, 0x88, 0xca, 0x30, 0x83, 0x85, 0x68,
-	0x04, 0x51, 0x47, 0x1f, 0x7f, 0xb2, 0x0e, 0x18, 0xfa, 0x20, 0xea, 0x54, 0x7f, 0x9b, 0x86, 0x4b,
-	0x1a, 0x85, 0x7c, 0x61, 0xc1, 0x54, 0xd2, 0xf7, 0xc9, 0xed, 0xcc, 0x52, 0x8f, 0x0e, 0x1b, 0x7b,
-	0xf3, 0x62, 0x9b, 0x93, 0x63, 0x51, 0xfa, 0xf9, 0x1f, 0xff, 0x7c, 0x33, 0xb1, 0x44, 0x6c, 0x67,
-	0x74, 0xc0, 0x39, 0xc9, 0xa0, 0x21, 0xbf, 0x58, 0x30, 0x7f, 0xb6, 0xa9, 0x93, 0x57, 0xf2, 0xd3,
-	0x64, 0x0c, 0x25, 0xfb, 0xd5, 0x71, 0x65, 0xc8, 0x79, 0x47, 0x73, 0xae, 0x91, 0x95, 0x34, 0xce,
-	0xfe, 0x98, 0x33, 0x23, 0x86, 0xfc, 0x6e, 0xc1, 0x42, 0xda, 0xe8, 0x20, 0xf7, 0xf2, 0xf3, 0xe7,
-	0x8c, 0x24, 0xfb, 0xfe, 0x7f, 0x91, 0x22, 0x7e, 0x55, 0xe3, 0x6f, 0x92, 0x5b, 0x69, 0xf8, 0x7a,
-	0x10, 0x99, 0x77, 0xd5, 0x50, 0x06, 0xf5, 0x3b, 0x0b, 0x66, 0x06, 0x26, 0x0f, 0x79, 0x29, 0x3f,
-	0xff, 0xe8, 0x00, 0xb3, 0xb7, 0xc6, 0x50, 0x20, 0xe8, 0xba, 0x06, 0xa5, 0x64, 0x39, 0x0d, 0xd4,
-	0x20, 0x76, 0x63, 0x9c, 0x1f, 0x2d, 0x28, 0x0e, 0x8f, 0x09, 0x72, 0x37, 0x3f, 0x5f, 0xea, 0x40,
-	0xb2, 0x5f, 0x1e, 0x4f, 0x84, 0x9c, 0x9b, 0x9a, 0x73, 0x95, 0xbc, 0x98, 0xc6, 0x69, 0x66, 0x44,
-	0x43, 0xf5, 0xe2, 0xd9, 0x22, 0xc9, 0x0f, 0x16, 0x14, 0x87, 0xfb, 0xda, 0x79, 0xac, 0xa9, 0x5d,
-	0xf9, 0x3c, 0xd6, 0xf4, 0xd6, 0x49, 0x6f, 0x6b, 0xd6, 0x15, 0x72, 0x33, 0xaf, 0xa6, 0xa6, 0x3f,
-	0x3e, 0xb1, 0x80, 0x8c, 0xb6, 0x21, 0xf2, 0x5a, 0x7e, 0xe6, 0xcc, 0x26, 0x68, 0xbf, 0x3e, 0xbe,
-	0x10, 0xb1, 0x1d, 0x8d, 0xbd, 0x41, 0xd6, 0xd2, 0xb0, 0xc5, 0xa9, 0xce, 0xbc, 0xdc, 0xed, 0x87,
-	0x4f, 0x8f, 0x4b, 0xd6, 0xb3, 0xe3, 0x92, 0xf5, 0xf7, 0x71, 0xc9, 0xfa, 0xfa, 0xa4, 0x54, 0x78,
-	0x76, 0x52, 0x2a, 0xfc, 0x79, 0x52, 0x2a, 0x7c, 0x5a, 0xf5, 0x7c, 0xf5, 0x28, 0x6a, 0x56, 0x5c,
-	0xd1, 0x31, 0x66, 0x77, 0x02, 0xae, 0x0e, 0x45, 0x78, 0xd0, 0x37, 0xef, 0xf5, 0xed, 0xd5, 0x51,
-	0x97, 0xcb, 0xe6, 0x94, 0xfe, 0x8f, 0xfa, 0xee, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x71, 0x59,
-	0x67, 0x4f, 0x38, 0x0c, 0x00, 0x00,
+func (m *QueryFlatFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFlatFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFlatFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFlatFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFlatFeeRequest.Merge(m, src)
+}
+func (m *QueryFlatFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFlatFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFlatFeeRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryFlatFeeRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns module parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ContractMetadata returns the contract rewards parameters (metadata).
-	ContractMetadata(ctx context.Context, in *QueryContractMetadataRequest, opts ...grpc.CallOption) (*QueryContractMetadataResponse, error)
-	// BlockRewardsTracking returns block rewards tracking for the current block.
-	BlockRewardsTracking(ctx context.Context, in *QueryBlockRewardsTrackingRequest, opts ...grpc.CallOption) (*QueryBlockRewardsTrackingResponse, error)
-	// RewardsPool returns the current undistributed rewards pool funds.
-	RewardsPool(ctx context.Context, in *QueryRewardsPoolRequest, opts ...grpc.CallOption) (*QueryRewardsPoolResponse, error)
-	// EstimateTxFees returns the estimated transaction fees for the given transaction
-	// gas limit using the minimum consensus fee value for the current block.
-	EstimateTxFees(ctx context.Context, in *QueryEstimateTxFeesRequest, opts ...grpc.CallOption) (*QueryEstimateTxFeesResponse, error)
-	// RewardsRecords returns the paginated list of RewardsRecord objects stored for the provided rewards_address.
-	RewardsRecords(ctx context.Context, in *QueryRewardsRecordsRequest, opts ...grpc.CallOption) (*QueryRewardsRecordsResponse, error)
-	// OutstandingRewards returns total rewards credited from different contracts for the provided rewards_address.
-	OutstandingRewards(ctx context.Context, in *QueryOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryOutstandingRewardsResponse, error)
+func (m *QueryFlatFeeRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryFlatFeeResponse is the response for Query.FlatFee.
+type QueryFlatFeeResponse struct {
+	// flat_fee_amount defines the flat fee charged for every contract execution.
+	FlatFeeAmount types.Coin `protobuf:"bytes,1,opt,name=flat_fee_amount,json=flatFeeAmount,proto3" json:"flat_fee_amount"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryFlatFeeResponse) Reset()         { *m = QueryFlatFeeResponse{} }
+func (m *QueryFlatFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFlatFeeResponse) ProtoMessage()    {}
+func (*QueryFlatFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_078e0e66cc6cb70d, []int{16}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryFlatFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFlatFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFlatFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryFlatFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFlatFeeResponse.Merge(m, src)
+}
+func (m *QueryFlatFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFlatFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFlatFeeResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ContractMetadata(ctx context.Context, in *QueryContractMetadataRequest, opts ...grpc.CallOption) (*QueryContractMetadataResponse, error) {
-	out := new(QueryContractMetadataResponse)
-	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Query/ContractMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryFlatFeeResponse proto.InternalMessageInfo
+
+func (m *QueryFlatFeeResponse) GetFlatFeeAmount() types.Coin {
+	if m != nil {
+		return m.FlatFeeAmount
 	}
-	return out, nil
+	return types.Coin{}
 }
 
-func (c *queryClient) BlockRewardsTracking(ctx context.Context, in *QueryBlockRewardsTrackingRequest, opts ...grpc.CallOption) (*QueryBlockRewardsTrackingResponse, error) {
-	out := new(QueryBlockRewardsTrackingResponse)
-	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Query/BlockRewardsTracking", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryTreasuryBalanceRequest is the request for Query.TreasuryBalance.
+type QueryTreasuryBalanceRequest struct {
 }
 
-func (c *queryClient) RewardsPool(ctx context.Context, in *QueryRewardsPoolRequest, opts ...grpc.CallOption) (*QueryRewardsPoolResponse, error) {
-	out := new(QueryRewardsPoolResponse)
-	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Query/RewardsPool", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTreasuryBalanceRequest) Reset()         { *m = QueryTreasuryBalanceRequest{} }
+func (m *QueryTreasuryBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryBalanceRequest) ProtoMessage()    {}
+func (*QueryTreasuryBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_078e0e66cc6cb70d, []int{17}
+}
+func (m *QueryTreasuryBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryBalanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTreasuryBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryBalanceRequest.Merge(m, src)
+}
+func (m *QueryTreasuryBalanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryBalanceRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) EstimateTxFees(ctx context.Context, in *QueryEstimateTxFeesRequest, opts ...grpc.CallOption) (*QueryEstimateTxFeesResponse, error) {
-	out := new(QueryEstimateTxFeesResponse)
-	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Query/EstimateTxFees", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_QueryTreasuryBalanceRequest proto.InternalMessageInfo
+
+// QueryTreasuryBalanceResponse is the response for Query.TreasuryBalance.
+type QueryTreasuryBalanceResponse struct {
+	// funds are treasury tokens available.
+	Funds []types.Coin `protobuf:"bytes,1,rep,name=funds,proto3" json:"funds"`
 }
 
-func (c *queryClient) RewardsRecords(ctx context.Context, in *QueryRewardsRecordsRequest, opts ...grpc.CallOption) (*QueryRewardsRecordsResponse, error) {
-	out := new(QueryRewardsRecordsResponse)
-	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Query/RewardsRecords", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTreasuryBalanceResponse) Reset()         { *m = QueryTreasuryBalanceResponse{} }
+func (m *QueryTreasuryBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryBalanceResponse) ProtoMessage()    {}
+func (*QueryTreasuryBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_078e0e66cc6cb70d, []int{18}
+}
+func (m *QueryTreasuryBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTreasuryBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryBalanceResponse.Merge(m, src)
+}
+func (m *QueryTreasuryBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryBalanceResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) OutstandingRewards(ctx context.Context, in *QueryOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryOutstandingRewardsResponse, error) {
-	out := new(QueryOutstandingRewardsResponse)
-	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Query/OutstandingRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTreasuryBalanceResponse proto.InternalMessageInfo
+
+func (m *QueryTreasuryBalanceResponse) GetFunds() []types.Coin {
+	if m != nil {
+		return m.Funds
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns module parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ContractMetadata returns the contract rewards parameters (metadata).
-	ContractMetadata(context.Context, *QueryContractMetadataRequest) (*QueryContractMetadataResponse, error)
-	// BlockRewardsTracking returns block rewards tracking for the current block.
-	BlockRewardsTracking(context.Context, *QueryBlockRewardsTrackingRequest) (*QueryBlockRewardsTrackingResponse, error)
-	// RewardsPool returns the current undistributed rewards pool funds.
-	RewardsPool(context.Context, *QueryRewardsPoolRequest) (*QueryRewardsPoolResponse, error)
-	// EstimateTxFees returns the estimated transaction fees for the given transaction
-	// gas limit using the minimum consensus fee value for the current block.
-	EstimateTxFees(context.Context, *QueryEstimateTxFeesRequest) (*QueryEstimateTxFeesResponse, error)
-	// RewardsRecords returns the paginated list of RewardsRecord objects stored for the provided rewards_address.
-	RewardsRecords(context.Context, *QueryRewardsRecordsRequest) (*QueryRewardsRecordsResponse, error)
-	// OutstandingRewards returns total rewards credited from different contracts for the provided rewards_address.
-	OutstandingRewards(context.Context, *QueryOutstandingRewardsRequest) (*QueryOutstandingRewardsResponse, error)
+// QueryTreasuryHistoryRequest is the request for Query.TreasuryHistory.
+type QueryTreasuryHistoryRequest struct {
+	// pagination is an optional pagination options for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryTreasuryHistoryRequest) Reset()         { *m = QueryTreasuryHistoryRequest{} }
+func (m *QueryTreasuryHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryHistoryRequest) ProtoMessage()    {}
+func (*QueryTreasuryHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_078e0e66cc6cb70d, []int{19}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryTreasuryHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ContractMetadata(ctx context.Context, req *QueryContractMetadataRequest) (*QueryContractMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ContractMetadata not implemented")
+func (m *QueryTreasuryHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) BlockRewardsTracking(ctx context.Context, req *QueryBlockRewardsTrackingRequest) (*QueryBlockRewardsTrackingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlockRewardsTracking not implemented")
+func (m *QueryTreasuryHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryHistoryRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) RewardsPool(ctx context.Context, req *QueryRewardsPoolRequest) (*QueryRewardsPoolResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RewardsPool not implemented")
+func (m *QueryTreasuryHistoryRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) EstimateTxFees(ctx context.Context, req *QueryEstimateTxFeesRequest) (*QueryEstimateTxFeesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EstimateTxFees not implemented")
+func (m *QueryTreasuryHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryHistoryRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) RewardsRecords(ctx context.Context, req *QueryRewardsRecordsRequest) (*QueryRewardsRecordsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RewardsRecords not implemented")
+
+var xxx_messageInfo_QueryTreasuryHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryTreasuryHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) OutstandingRewards(ctx context.Context, req *QueryOutstandingRewardsRequest) (*QueryOutstandingRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OutstandingRewards not implemented")
+
+// QueryTreasuryHistoryResponse is the response for Query.TreasuryHistory.
+type QueryTreasuryHistoryResponse struct {
+	// operations is the list of treasury operations.
+	Operations []TreasuryOperation `protobuf:"bytes,1,rep,name=operations,proto3" json:"operations"`
+	// pagination is the pagination details in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryTreasuryHistoryResponse) Reset()         { *m = QueryTreasuryHistoryResponse{} }
+func (m *QueryTreasuryHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTreasuryHistoryResponse) ProtoMessage()    {}
+func (*QueryTreasuryHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_078e0e66cc6cb70d, []int{20}
+}
+func (m *QueryTreasuryHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTreasuryHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTreasuryHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTreasuryHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTreasuryHistoryResponse.Merge(m, src)
+}
+func (m *QueryTreasuryHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTreasuryHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTreasuryHistoryResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTreasuryHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryTreasuryHistoryResponse) GetOperations() []TreasuryOperation {
+	if m != nil {
+		return m.Operations
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.rewards.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ContractMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryContractMetadataRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ContractMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.rewards.v1beta1.Query/ContractMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ContractMetadata(ctx, req.(*QueryContractMetadataRequest))
+func (m *QueryTreasuryHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_BlockRewardsTracking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlockRewardsTrackingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BlockRewardsTracking(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.rewards.v1beta1.Query/BlockRewardsTracking",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlockRewardsTracking(ctx, req.(*QueryBlockRewardsTrackingRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryCodeMetadataRequest is the request for Query.CodeMetadata.
+type QueryCodeMetadataRequest struct {
+	// code_id is the contract code ID.
+	CodeId uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
 }
 
-func _Query_RewardsPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRewardsPoolRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RewardsPool(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.rewards.v1beta1.Query/RewardsPool",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RewardsPool(ctx, req.(*QueryRewardsPoolRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryCodeMetadataRequest) Reset()         { *m = QueryCodeMetadataRequest{} }
+func (m *QueryCodeMetadataRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCodeMetadataRequest) ProtoMessage()    {}
+func (*QueryCodeMetadataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_078e0e66cc6cb70d, []int{21}
 }
-
-func _Query_EstimateTxFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEstimateTxFeesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EstimateTxFees(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.rewards.v1beta1.Query/EstimateTxFees",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EstimateTxFees(ctx, req.(*QueryEstimateTxFeesRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *QueryCodeMetadataRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Query_RewardsRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRewardsRecordsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RewardsRecords(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.rewards.v1beta1.Query/RewardsRecords",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RewardsRecords(ctx, req.(*QueryRewardsRecordsRequest))
+func (m *QueryCodeMetadataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCodeMetadataRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryCodeMetadataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCodeMetadataRequest.Merge(m, src)
+}
+func (m *QueryCodeMetadataRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCodeMetadataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCodeMetadataRequest.DiscardUnknown(m)
 }
 
-func _Query_OutstandingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOutstandingRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OutstandingRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.rewards.v1beta1.Query/OutstandingRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OutstandingRewards(ctx, req.(*QueryOutstandingRewardsRequest))
+var xxx_messageInfo_QueryCodeMetadataRequest proto.InternalMessageInfo
+
+func (m *QueryCodeMetadataRequest) GetCodeId() uint64 {
+	if m != nil {
+		return m.CodeId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "archway.rewards.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ContractMetadata",
-			Handler:    _Query_ContractMetadata_Handler,
-		},
-		{
-			MethodName: "BlockRewardsTracking",
-			Handler:    _Query_BlockRewardsTracking_Handler,
-		},
-		{
-			MethodName: "RewardsPool",
-			Handler:    _Query_RewardsPool_Handler,
-		},
-		{
-			MethodName: "EstimateTxFees",
-			Handler:    _Query_EstimateTxFees_Handler,
-		},
-		{
-			MethodName: "RewardsRecords",
-			Handler:    _Query_RewardsRecords_Handler,
-		},
-		{
-			MethodName: "OutstandingRewards",
-			Handler:    _Query_OutstandingRewards_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "archway/rewards/v1beta1/query.proto",
+// QueryCodeMetadataResponse is the response for Query.CodeMetadata.
+type QueryCodeMetadataResponse struct {
+	Metadata CodeMetadata `protobuf:"bytes,1,opt,name=metadata,proto3" json:"metadata"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCodeMetadataResponse) Reset()         { *m = QueryCodeMetadataResponse{} }
+func (m *QueryCodeMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCodeMetadataResponse) ProtoMessage()    {}
+func (*QueryCodeMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_078e0e66cc6cb70d, []int{22}
+}
+func (m *QueryCodeMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCodeMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCodeMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryCodeMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCodeMetadataResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryCodeMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCodeMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCodeMetadataResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCodeMetadataResponse proto.InternalMessageInfo
+
+func (m *QueryCodeMetadataResponse) GetMetadata() CodeMetadata {
+	if m != nil {
+		return m.Metadata
 	}
-	return dAtA[:n], nil
+	return CodeMetadata{}
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRewardsRecordExpiryRequest is the request for Query.RewardsRecordExpiry.
+type QueryRewardsRecordExpiryRequest struct {
+	// rewards_address is the target address to query records for (bech32 encoded).
+	RewardsAddress string `protobuf:"bytes,1,opt,name=rewards_address,json=rewardsAddress,proto3" json:"rewards_address,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRewardsRecordExpiryRequest) Reset()         { *m = QueryRewardsRecordExpiryRequest{} }
+func (m *QueryRewardsRecordExpiryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsRecordExpiryRequest) ProtoMessage()    {}
+func (*QueryRewardsRecordExpiryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_078e0e66cc6cb70d, []int{23}
+}
+func (m *QueryRewardsRecordExpiryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsRecordExpiryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsRecordExpiryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardsRecordExpiryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsRecordExpiryRequest.Merge(m, src)
+}
+func (m *QueryRewardsRecordExpiryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsRecordExpiryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsRecordExpiryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryContractMetadataRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardsRecordExpiryRequest proto.InternalMessageInfo
+
+func (m *QueryRewardsRecordExpiryRequest) GetRewardsAddress() string {
+	if m != nil {
+		return m.RewardsAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryContractMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRewardsRecordExpiryResponse is the response for Query.RewardsRecordExpiry.
+type QueryRewardsRecordExpiryResponse struct {
+	// record is the soonest expiring RewardsRecord of the address.
+	// Not set if there are no records or records expiration is disabled.
+	Record *RewardsRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record,omitempty"`
+	// expiry_time is the time the record expires at (zero if the record is not set).
+	ExpiryTime time.Time `protobuf:"bytes,2,opt,name=expiry_time,json=expiryTime,proto3,stdtime" json:"expiry_time"`
 }
 
-func (m *QueryContractMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryRewardsRecordExpiryResponse) Reset()         { *m = QueryRewardsRecordExpiryResponse{} }
+func (m *QueryRewardsRecordExpiryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsRecordExpiryResponse) ProtoMessage()    {}
+func (*QueryRewardsRecordExpiryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_078e0e66cc6cb70d, []int{24}
+}
+func (m *QueryRewardsRecordExpiryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsRecordExpiryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsRecordExpiryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardsRecordExpiryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsRecordExpiryResponse.Merge(m, src)
+}
+func (m *QueryRewardsRecordExpiryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsRecordExpiryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsRecordExpiryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryContractMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardsRecordExpiryResponse proto.InternalMessageInfo
+
+func (m *QueryRewardsRecordExpiryResponse) GetRecord() *RewardsRecord {
+	if m != nil {
+		return m.Record
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryContractMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRewardsRecordExpiryResponse) GetExpiryTime() time.Time {
+	if m != nil {
+		return m.ExpiryTime
+	}
+	return time.Time{}
 }
 
-func (m *QueryContractMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+// QueryAutoPayoutRequest is the request for Query.AutoPayout.
+type QueryAutoPayoutRequest struct {
+	// contract_address is the contract address to query options for (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *QueryAutoPayoutRequest) Reset()         { *m = QueryAutoPayoutRequest{} }
+func (m *QueryAutoPayoutRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoPayoutRequest) ProtoMessage()    {}
+func (*QueryAutoPayoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_078e0e66cc6cb70d, []int{25}
+}
+func (m *QueryAutoPayoutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoPayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoPayoutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryBlockRewardsTrackingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAutoPayoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoPayoutRequest.Merge(m, src)
 }
-
-func (m *QueryBlockRewardsTrackingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAutoPayoutRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryBlockRewardsTrackingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryAutoPayoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoPayoutRequest.DiscardUnknown(m)
 }
 
-func (m *QueryBlockRewardsTrackingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAutoPayoutRequest proto.InternalMessageInfo
+
+func (m *QueryAutoPayoutRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryBlockRewardsTrackingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAutoPayoutResponse is the response for Query.AutoPayout.
+type QueryAutoPayoutResponse struct {
+	AutoPayout AutoPayout `protobuf:"bytes,1,opt,name=auto_payout,json=autoPayout,proto3" json:"auto_payout"`
 }
 
-func (m *QueryBlockRewardsTrackingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryAutoPayoutResponse) Reset()         { *m = QueryAutoPayoutResponse{} }
+func (m *QueryAutoPayoutResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAutoPayoutResponse) ProtoMessage()    {}
+func (*QueryAutoPayoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_078e0e66cc6cb70d, []int{26}
+}
+func (m *QueryAutoPayoutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAutoPayoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAutoPayoutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryAutoPayoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAutoPayoutResponse.Merge(m, src)
+}
+func (m *QueryAutoPayoutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAutoPayoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAutoPayoutResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRewardsPoolRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAutoPayoutResponse proto.InternalMessageInfo
+
+func (m *QueryAutoPayoutResponse) GetAutoPayout() AutoPayout {
+	if m != nil {
+		return m.AutoPayout
 	}
-	return dAtA[:n], nil
+	return AutoPayout{}
 }
 
-func (m *QueryRewardsPoolRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMinConsensusFeeHistoryRequest is the request for Query.MinConsensusFeeHistory.
+type QueryMinConsensusFeeHistoryRequest struct {
+	// pagination is an optional pagination options for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryRewardsPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryMinConsensusFeeHistoryRequest) Reset()         { *m = QueryMinConsensusFeeHistoryRequest{} }
+func (m *QueryMinConsensusFeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMinConsensusFeeHistoryRequest) ProtoMessage()    {}
+func (*QueryMinConsensusFeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_078e0e66cc6cb70d, []int{27}
+}
+func (m *QueryMinConsensusFeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinConsensusFeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinConsensusFeeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinConsensusFeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinConsensusFeeHistoryRequest.Merge(m, src)
+}
+func (m *QueryMinConsensusFeeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinConsensusFeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinConsensusFeeHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRewardsPoolResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMinConsensusFeeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryMinConsensusFeeHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRewardsPoolResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMinConsensusFeeHistoryResponse is the response for Query.MinConsensusFeeHistory.
+type QueryMinConsensusFeeHistoryResponse struct {
+	// records is the list of minimum consensus fee values (ordered by height).
+	Records []MinConsensusFeeRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// pagination is the pagination details in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryRewardsPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TreasuryFunds) > 0 {
-		for iNdEx := len(m.TreasuryFunds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TreasuryFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.UndistributedFunds) > 0 {
-		for iNdEx := len(m.UndistributedFunds) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.UndistributedFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryMinConsensusFeeHistoryResponse) Reset()         { *m = QueryMinConsensusFeeHistoryResponse{} }
+func (m *QueryMinConsensusFeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMinConsensusFeeHistoryResponse) ProtoMessage()    {}
+func (*QueryMinConsensusFeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_078e0e66cc6cb70d, []int{28}
+}
+func (m *QueryMinConsensusFeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinConsensusFeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinConsensusFeeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryEstimateTxFeesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryMinConsensusFeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinConsensusFeeHistoryResponse.Merge(m, src)
 }
-
-func (m *QueryEstimateTxFeesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMinConsensusFeeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinConsensusFeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinConsensusFeeHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryEstimateTxFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.GasLimit != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_QueryMinConsensusFeeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryMinConsensusFeeHistoryResponse) GetRecords() []MinConsensusFeeRecord {
+	if m != nil {
+		return m.Records
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryEstimateTxFeesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMinConsensusFeeHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryEstimateTxFeesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryBaseFeeRequest is the request for Query.BaseFee.
+type QueryBaseFeeRequest struct {
 }
 
-func (m *QueryEstimateTxFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.EstimatedFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.GasUnitPrice.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryBaseFeeRequest) Reset()         { *m = QueryBaseFeeRequest{} }
+func (m *QueryBaseFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeRequest) ProtoMessage()    {}
+func (*QueryBaseFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_078e0e66cc6cb70d, []int{29}
+}
+func (m *QueryBaseFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *BlockTracking) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryBaseFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeRequest.Merge(m, src)
+}
+func (m *QueryBaseFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeRequest.DiscardUnknown(m)
 }
 
-func (m *BlockTracking) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryBaseFeeRequest proto.InternalMessageInfo
+
+// QueryBaseFeeResponse is the response for Query.BaseFee.
+type QueryBaseFeeResponse struct {
+	// base_fee defines the base gas unit price.
+	BaseFee types.DecCoin `protobuf:"bytes,1,opt,name=base_fee,json=baseFee,proto3" json:"base_fee"`
 }
 
-func (m *BlockTracking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TxRewards) > 0 {
-		for iNdEx := len(m.TxRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TxRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.InflationRewards.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryBaseFeeResponse) Reset()         { *m = QueryBaseFeeResponse{} }
+func (m *QueryBaseFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeResponse) ProtoMessage()    {}
+func (*QueryBaseFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_078e0e66cc6cb70d, []int{30}
+}
+func (m *QueryBaseFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryBaseFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeResponse.Merge(m, src)
+}
+func (m *QueryBaseFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRewardsRecordsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryBaseFeeResponse proto.InternalMessageInfo
+
+func (m *QueryBaseFeeResponse) GetBaseFee() types.DecCoin {
+	if m != nil {
+		return m.BaseFee
 	}
-	return dAtA[:n], nil
+	return types.DecCoin{}
 }
 
-func (m *QueryRewardsRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySponsorshipRequest is the request for Query.Sponsorship.
+type QuerySponsorshipRequest struct {
+	// contract_address is the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// user_address is an optional user address to query sponsored fees for (bech32 encoded).
+	UserAddress string `protobuf:"bytes,2,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
 }
 
-func (m *QueryRewardsRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QuerySponsorshipRequest) Reset()         { *m = QuerySponsorshipRequest{} }
+func (m *QuerySponsorshipRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipRequest) ProtoMessage()    {}
+func (*QuerySponsorshipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_078e0e66cc6cb70d, []int{31}
+}
+func (m *QuerySponsorshipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorshipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorshipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.RewardsAddress) > 0 {
-		i -= len(m.RewardsAddress)
-		copy(dAtA[i:], m.RewardsAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RewardsAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QuerySponsorshipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipRequest.Merge(m, src)
+}
+func (m *QuerySponsorshipRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorshipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipRequest proto.InternalMessageInfo
+
+func (m *QuerySponsorshipRequest) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryRewardsRecordsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySponsorshipRequest) GetUserAddress() string {
+	if m != nil {
+		return m.UserAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryRewardsRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QuerySponsorshipResponse is the response for Query.Sponsorship.
+type QuerySponsorshipResponse struct {
+	Sponsorship Sponsorship `protobuf:"bytes,1,opt,name=sponsorship,proto3" json:"sponsorship"`
+	// user_fees defines the amount of fees sponsored for the user (empty if the user_address is not set).
+	UserFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=user_fees,json=userFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"user_fees"`
 }
 
-func (m *QueryRewardsRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QuerySponsorshipResponse) Reset()         { *m = QuerySponsorshipResponse{} }
+func (m *QuerySponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipResponse) ProtoMessage()    {}
+func (*QuerySponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_078e0e66cc6cb70d, []int{32}
+}
+func (m *QuerySponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryOutstandingRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QuerySponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipResponse.Merge(m, src)
 }
-
-func (m *QueryOutstandingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QuerySponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipResponse.DiscardUnknown(m)
 }
 
-func (m *QueryOutstandingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RewardsAddress) > 0 {
-		i -= len(m.RewardsAddress)
-		copy(dAtA[i:], m.RewardsAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.RewardsAddress)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QuerySponsorshipResponse proto.InternalMessageInfo
+
+func (m *QuerySponsorshipResponse) GetSponsorship() Sponsorship {
+	if m != nil {
+		return m.Sponsorship
 	}
-	return len(dAtA) - i, nil
+	return Sponsorship{}
 }
 
-func (m *QueryOutstandingRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QuerySponsorshipResponse) GetUserFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UserFees
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryOutstandingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "archway.rewards.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "archway.rewards.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryContractMetadataRequest)(nil), "archway.rewards.v1beta1.QueryContractMetadataRequest")
+	proto.RegisterType((*QueryContractMetadataResponse)(nil), "archway.rewards.v1beta1.QueryContractMetadataResponse")
+	proto.RegisterType((*QueryBlockRewardsTrackingRequest)(nil), "archway.rewards.v1beta1.QueryBlockRewardsTrackingRequest")
+	proto.RegisterType((*QueryBlockRewardsTrackingResponse)(nil), "archway.rewards.v1beta1.QueryBlockRewardsTrackingResponse")
+	proto.RegisterType((*QueryRewardsPoolRequest)(nil), "archway.rewards.v1beta1.QueryRewardsPoolRequest")
+	proto.RegisterType((*QueryRewardsPoolResponse)(nil), "archway.rewards.v1beta1.QueryRewardsPoolResponse")
+	proto.RegisterType((*QueryEstimateTxFeesRequest)(nil), "archway.rewards.v1beta1.QueryEstimateTxFeesRequest")
+	proto.RegisterType((*QueryEstimateTxFeesResponse)(nil), "archway.rewards.v1beta1.QueryEstimateTxFeesResponse")
+	proto.RegisterType((*BlockTracking)(nil), "archway.rewards.v1beta1.BlockTracking")
+	proto.RegisterType((*QueryRewardsRecordsRequest)(nil), "archway.rewards.v1beta1.QueryRewardsRecordsRequest")
+	proto.RegisterType((*QueryRewardsRecordsResponse)(nil), "archway.rewards.v1beta1.QueryRewardsRecordsResponse")
+	proto.RegisterType((*QueryOutstandingRewardsRequest)(nil), "archway.rewards.v1beta1.QueryOutstandingRewardsRequest")
+	proto.RegisterType((*QueryOutstandingRewardsResponse)(nil), "archway.rewards.v1beta1.QueryOutstandingRewardsResponse")
+	proto.RegisterType((*QueryFlatFeeRequest)(nil), "archway.rewards.v1beta1.QueryFlatFeeRequest")
+	proto.RegisterType((*QueryFlatFeeResponse)(nil), "archway.rewards.v1beta1.QueryFlatFeeResponse")
+	proto.RegisterType((*QueryTreasuryBalanceRequest)(nil), "archway.rewards.v1beta1.QueryTreasuryBalanceRequest")
+	proto.RegisterType((*QueryTreasuryBalanceResponse)(nil), "archway.rewards.v1beta1.QueryTreasuryBalanceResponse")
+	proto.RegisterType((*QueryTreasuryHistoryRequest)(nil), "archway.rewards.v1beta1.QueryTreasuryHistoryRequest")
+	proto.RegisterType((*QueryTreasuryHistoryResponse)(nil), "archway.rewards.v1beta1.QueryTreasuryHistoryResponse")
+	proto.RegisterType((*QueryCodeMetadataRequest)(nil), "archway.rewards.v1beta1.QueryCodeMetadataRequest")
+	proto.RegisterType((*QueryCodeMetadataResponse)(nil), "archway.rewards.v1beta1.QueryCodeMetadataResponse")
+	proto.RegisterType((*QueryRewardsRecordExpiryRequest)(nil), "archway.rewards.v1beta1.QueryRewardsRecordExpiryRequest")
+	proto.RegisterType((*QueryRewardsRecordExpiryResponse)(nil), "archway.rewards.v1beta1.QueryRewardsRecordExpiryResponse")
+	proto.RegisterType((*QueryAutoPayoutRequest)(nil), "archway.rewards.v1beta1.QueryAutoPayoutRequest")
+	proto.RegisterType((*QueryAutoPayoutResponse)(nil), "archway.rewards.v1beta1.QueryAutoPayoutResponse")
+	proto.RegisterType((*QueryMinConsensusFeeHistoryRequest)(nil), "archway.rewards.v1beta1.QueryMinConsensusFeeHistoryRequest")
+	proto.RegisterType((*QueryMinConsensusFeeHistoryResponse)(nil), "archway.rewards.v1beta1.QueryMinConsensusFeeHistoryResponse")
+	proto.RegisterType((*QueryBaseFeeRequest)(nil), "archway.rewards.v1beta1.QueryBaseFeeRequest")
+	proto.RegisterType((*QueryBaseFeeResponse)(nil), "archway.rewards.v1beta1.QueryBaseFeeResponse")
+	proto.RegisterType((*QuerySponsorshipRequest)(nil), "archway.rewards.v1beta1.QuerySponsorshipRequest")
+	proto.RegisterType((*QuerySponsorshipResponse)(nil), "archway.rewards.v1beta1.QuerySponsorshipResponse")
 }
 
-func (m *QueryOutstandingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RecordsNum != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.RecordsNum))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.TotalRewards) > 0 {
-		for iNdEx := len(m.TotalRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+func init() {
+	proto.RegisterFile("archway/rewards/v1beta1/query.proto", fileDescriptor_078e0e66cc6cb70d)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+var fileDescriptor_078e0e66cc6cb70d = []byte{
+	// 1776 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0x6d, 0xc7, 0x1f, 0x4f, 0xfe, 0x48, 0x27, 0xde, 0xb5, 0xc3, 0x78, 0x25, 0x9b, 0x8e,
+	0x63, 0x3b, 0x8e, 0xa5, 0xd8, 0x5e, 0xb7, 0x9b, 0xed, 0x07, 0x1a, 0x3b, 0xd1, 0x6e, 0xb6, 0xd9,
+	0x5d, 0x57, 0x75, 0x80, 0xa2, 0x17, 0x62, 0x24, 0x8e, 0x65, 0xc2, 0x12, 0xa9, 0x70, 0x86, 0x8d,
+	0x75, 0xed, 0xa5, 0x3d, 0xb4, 0x40, 0x80, 0x5e, 0x02, 0x34, 0x28, 0x72, 0x6c, 0x8a, 0xa2, 0xe8,
+	0xa1, 0x87, 0xf4, 0xd0, 0x02, 0xbd, 0x05, 0x3d, 0x05, 0xed, 0xa5, 0xa7, 0xa6, 0x48, 0xfa, 0x87,
+	0x14, 0x1c, 0x3e, 0x52, 0xa4, 0x44, 0xea, 0xc3, 0x48, 0x4e, 0x89, 0x66, 0xde, 0xfb, 0xbd, 0xdf,
+	0x1b, 0xbe, 0x79, 0xf3, 0x7b, 0x86, 0x15, 0xea, 0x54, 0x4e, 0x1e, 0xd1, 0x66, 0xc1, 0x61, 0x8f,
+	0xa8, 0x63, 0xf0, 0xc2, 0x4f, 0xb7, 0xcb, 0x4c, 0xd0, 0xed, 0xc2, 0x43, 0x97, 0x39, 0xcd, 0x7c,
+	0xc3, 0xb1, 0x85, 0x4d, 0xe6, 0xd1, 0x28, 0x8f, 0x46, 0x79, 0x34, 0x52, 0xe7, 0xaa, 0x76, 0xd5,
+	0x96, 0x36, 0x05, 0xef, 0x7f, 0xbe, 0xb9, 0xba, 0x58, 0xb5, 0xed, 0x6a, 0x8d, 0x15, 0x68, 0xc3,
+	0x2c, 0x50, 0xcb, 0xb2, 0x05, 0x15, 0xa6, 0x6d, 0x71, 0xdc, 0xcd, 0xe1, 0xae, 0xfc, 0x55, 0x76,
+	0x8f, 0x0b, 0xc2, 0xac, 0x33, 0x2e, 0x68, 0xbd, 0x81, 0x06, 0xd9, 0x8a, 0xcd, 0xeb, 0x36, 0x2f,
+	0x94, 0x29, 0x67, 0x21, 0x9d, 0x8a, 0x6d, 0x5a, 0xb8, 0x7f, 0x3d, 0xba, 0x2f, 0x69, 0x86, 0x56,
+	0x0d, 0x5a, 0x35, 0x2d, 0x19, 0x0d, 0x6d, 0x57, 0xd3, 0xd2, 0x0b, 0x32, 0x91, 0x66, 0xda, 0x1c,
+	0x90, 0x1f, 0x7a, 0x40, 0x87, 0xd4, 0xa1, 0x75, 0x5e, 0x62, 0x0f, 0x5d, 0xc6, 0x85, 0x76, 0x04,
+	0x97, 0x62, 0xab, 0xbc, 0x61, 0x5b, 0x9c, 0x91, 0xef, 0xc2, 0x58, 0x43, 0xae, 0x2c, 0x28, 0x4b,
+	0xca, 0x7a, 0x66, 0x27, 0x97, 0x4f, 0x39, 0x9e, 0xbc, 0xef, 0xb8, 0x3f, 0xfa, 0xf2, 0x3f, 0xb9,
+	0xa1, 0x12, 0x3a, 0x69, 0xf7, 0x60, 0x51, 0xa2, 0x1e, 0xd8, 0x96, 0x70, 0x68, 0x45, 0x7c, 0xc9,
+	0x04, 0x35, 0xa8, 0xa0, 0x18, 0x95, 0x6c, 0xc0, 0xc5, 0x0a, 0x6e, 0xe9, 0xd4, 0x30, 0x1c, 0xc6,
+	0xfd, 0x40, 0x93, 0xa5, 0xd9, 0x60, 0xfd, 0xb6, 0xbf, 0xac, 0xd5, 0xe0, 0xa3, 0x14, 0x28, 0xa4,
+	0xfa, 0x03, 0x98, 0xa8, 0xe3, 0x1a, 0x92, 0xdd, 0x48, 0x25, 0xdb, 0x0e, 0x82, 0xb4, 0x43, 0x00,
+	0x4d, 0x83, 0x25, 0x19, 0x6d, 0xbf, 0x66, 0x57, 0x4e, 0x4b, 0xbe, 0xf7, 0x91, 0x43, 0x2b, 0xa7,
+	0xa6, 0x55, 0x0d, 0x8e, 0xac, 0x0a, 0xcb, 0x5d, 0x6c, 0x90, 0xd5, 0x3e, 0x5c, 0x28, 0x7b, 0xfb,
+	0x48, 0xe9, 0x5a, 0x2a, 0x25, 0x89, 0x12, 0xb8, 0x23, 0x1f, 0xdf, 0x55, 0xbb, 0x0c, 0xf3, 0x32,
+	0x10, 0xc6, 0x38, 0xb4, 0xed, 0x5a, 0xc0, 0xe1, 0xcf, 0x0a, 0x2c, 0x74, 0xee, 0x61, 0xec, 0x43,
+	0xb8, 0xe4, 0x5a, 0x86, 0xc9, 0x85, 0x63, 0x96, 0x5d, 0xc1, 0x0c, 0xfd, 0xd8, 0xb5, 0x0c, 0xef,
+	0x80, 0x47, 0xd6, 0x33, 0x3b, 0x97, 0xf3, 0x7e, 0x69, 0xe5, 0xbd, 0xd2, 0x8a, 0x1c, 0x8c, 0x69,
+	0x61, 0x70, 0x12, 0xf3, 0x2d, 0x7a, 0xae, 0xa4, 0x08, 0x33, 0xc2, 0x61, 0x94, 0xbb, 0x4e, 0x13,
+	0xc1, 0x86, 0xfb, 0x03, 0x9b, 0x0e, 0xdc, 0x24, 0x8e, 0x76, 0x0b, 0x54, 0xc9, 0xfa, 0x2e, 0x17,
+	0x66, 0x9d, 0x0a, 0x76, 0x74, 0x56, 0x64, 0x2c, 0xa8, 0x45, 0x72, 0x05, 0x26, 0xab, 0x94, 0xeb,
+	0x35, 0xb3, 0x6e, 0x0a, 0x79, 0x6e, 0xa3, 0xa5, 0x89, 0x2a, 0xe5, 0xf7, 0xbd, 0xdf, 0xda, 0xd3,
+	0x11, 0xb8, 0x92, 0xe8, 0x8b, 0x49, 0x7f, 0x0e, 0x33, 0x9e, 0xb3, 0x6b, 0x99, 0x42, 0x6f, 0x38,
+	0x66, 0x85, 0xe1, 0xc9, 0x2f, 0x26, 0x52, 0xbc, 0xc3, 0x2a, 0x11, 0x96, 0x53, 0x55, 0xca, 0x1f,
+	0x58, 0xa6, 0x38, 0xf4, 0xfc, 0xc8, 0x1d, 0x98, 0x66, 0x18, 0xc3, 0xd0, 0x8f, 0x19, 0x5b, 0x18,
+	0x5e, 0x52, 0xfa, 0xc9, 0x75, 0x2a, 0xf4, 0x2a, 0x32, 0x46, 0x9a, 0x30, 0x1b, 0xe7, 0xc3, 0x17,
+	0x46, 0x96, 0x46, 0x7a, 0x12, 0xda, 0xf5, 0xa0, 0x7e, 0xff, 0x3a, 0xb7, 0x59, 0x35, 0xc5, 0x89,
+	0x5b, 0xce, 0x57, 0xec, 0x7a, 0x01, 0x7b, 0x81, 0xff, 0xcf, 0x16, 0x37, 0x4e, 0x0b, 0xa2, 0xd9,
+	0x60, 0x3c, 0xf0, 0xe1, 0xa5, 0xe9, 0x28, 0x7f, 0x4e, 0x1c, 0x98, 0x89, 0x25, 0xc0, 0x17, 0x46,
+	0x7b, 0x7d, 0xad, 0x9b, 0x18, 0x76, 0xbd, 0x8f, 0xb0, 0x18, 0x33, 0x9a, 0x2d, 0xd7, 0x5e, 0x28,
+	0x30, 0x1d, 0x2b, 0x65, 0xf2, 0x63, 0xf8, 0x86, 0x69, 0x1d, 0xd7, 0x64, 0xa7, 0xd2, 0xb1, 0xea,
+	0xf1, 0x9b, 0xac, 0x76, 0xbf, 0x0d, 0x58, 0xd3, 0x78, 0xac, 0x17, 0x43, 0x14, 0x5c, 0x27, 0x9f,
+	0x01, 0x88, 0xb3, 0x10, 0xd2, 0xaf, 0x44, 0x2d, 0x15, 0xf2, 0xe8, 0x2c, 0x8e, 0x37, 0x29, 0x82,
+	0x85, 0x4f, 0x47, 0x9f, 0x3c, 0xcb, 0x0d, 0x69, 0xbf, 0x52, 0xb0, 0x2a, 0x71, 0xb9, 0xc4, 0x2a,
+	0xb6, 0x63, 0x84, 0x55, 0xb9, 0x06, 0xb3, 0x08, 0xd9, 0xd6, 0xaa, 0x66, 0x70, 0x19, 0x3b, 0x15,
+	0x29, 0x02, 0xb4, 0x7a, 0x33, 0x16, 0xcd, 0xb5, 0xd8, 0x91, 0xfb, 0xef, 0x4d, 0xab, 0x73, 0x56,
+	0x19, 0x06, 0x29, 0x45, 0x3c, 0xb5, 0x3f, 0x2a, 0x70, 0x25, 0x91, 0x0f, 0x56, 0x7a, 0x11, 0xc6,
+	0x1d, 0x7f, 0x09, 0xaf, 0x74, 0x7a, 0x73, 0x89, 0x21, 0x60, 0xfe, 0x81, 0xb3, 0x77, 0x8c, 0x1d,
+	0x7c, 0xd7, 0x7a, 0xf2, 0xf5, 0x49, 0xc4, 0x08, 0xdf, 0x83, 0xac, 0xe4, 0xfb, 0xb5, 0x2b, 0xb8,
+	0xa0, 0x96, 0x21, 0xfb, 0x20, 0x06, 0x1e, 0xec, 0x0c, 0xb5, 0x5f, 0x28, 0x90, 0x4b, 0xc5, 0xc2,
+	0xfc, 0xef, 0xc0, 0xb4, 0xb0, 0x05, 0xad, 0x45, 0x8a, 0xaa, 0xaf, 0x5e, 0x34, 0x25, 0xbd, 0x82,
+	0x22, 0xca, 0x41, 0x06, 0x0f, 0x42, 0xb7, 0xdc, 0xba, 0x4c, 0x7f, 0xb4, 0x04, 0xb8, 0xf4, 0x95,
+	0x5b, 0xd7, 0xbe, 0x8f, 0x2f, 0x63, 0xb1, 0x46, 0x45, 0x91, 0xb1, 0x73, 0x3c, 0x5d, 0x3a, 0xcc,
+	0xc5, 0x11, 0x30, 0x81, 0xcf, 0x60, 0xd6, 0xab, 0x68, 0xef, 0x6a, 0xea, 0xb4, 0x6e, 0xbb, 0x96,
+	0xc0, 0x7b, 0xd1, 0xbb, 0x9d, 0x1e, 0xfb, 0x50, 0xb7, 0xa5, 0x97, 0xf6, 0x11, 0x16, 0xca, 0x11,
+	0x36, 0xd9, 0x7d, 0x5a, 0xa3, 0x56, 0x25, 0xa0, 0xaa, 0x3d, 0x80, 0xc5, 0xe4, 0x6d, 0xe4, 0xb1,
+	0x07, 0x17, 0x06, 0x7a, 0x19, 0x7c, 0x6b, 0x8d, 0xb5, 0x45, 0xfd, 0xdc, 0xe4, 0xc2, 0x76, 0x9a,
+	0x18, 0xb5, 0xed, 0x1a, 0x28, 0xe7, 0xbe, 0x06, 0x7f, 0x51, 0x60, 0x31, 0x39, 0x4e, 0xf8, 0xcc,
+	0x81, 0xdd, 0x60, 0x8e, 0xb4, 0x0e, 0x72, 0xb8, 0x9e, 0xde, 0x06, 0x10, 0xe5, 0xeb, 0xc0, 0x05,
+	0x93, 0x8a, 0x60, 0xbc, 0xbb, 0x1b, 0xb1, 0x8b, 0xaf, 0xf3, 0x81, 0x6d, 0xb0, 0x76, 0xed, 0x33,
+	0x0f, 0xe3, 0x15, 0xdb, 0x60, 0xba, 0x69, 0xe0, 0x1b, 0x37, 0xe6, 0xfd, 0xbc, 0x67, 0x68, 0x06,
+	0x5c, 0x4e, 0x70, 0x0a, 0x6b, 0xa6, 0x5d, 0xe5, 0xac, 0x76, 0x51, 0x39, 0x2d, 0x80, 0x0e, 0x85,
+	0xf3, 0x05, 0x5e, 0xb0, 0x58, 0x6b, 0xb8, 0x7b, 0xd6, 0x30, 0x9d, 0xe6, 0xc0, 0xb7, 0xf5, 0xb9,
+	0x02, 0x4b, 0xe9, 0x60, 0xc8, 0xfc, 0x7b, 0x30, 0xe6, 0xdf, 0xaa, 0x9e, 0x52, 0x28, 0x86, 0x52,
+	0x42, 0x2f, 0x72, 0x17, 0x32, 0x4c, 0x22, 0xea, 0x9e, 0x88, 0xc6, 0xaf, 0xa2, 0xe6, 0x7d, 0x85,
+	0x9d, 0x0f, 0x14, 0x76, 0xfe, 0x28, 0x50, 0xd8, 0xfb, 0x13, 0x5e, 0xc6, 0x8f, 0x5f, 0xe7, 0x94,
+	0x12, 0xf8, 0x8e, 0xde, 0x96, 0x76, 0x00, 0x1f, 0x4a, 0xaa, 0xb7, 0x5d, 0x61, 0x1f, 0xd2, 0xa6,
+	0xed, 0x8a, 0x73, 0xdc, 0x68, 0x06, 0xf3, 0x1d, 0x20, 0x98, 0xe6, 0x17, 0x90, 0xa1, 0xae, 0xb0,
+	0xf5, 0x86, 0x5c, 0xc6, 0x5c, 0x57, 0x52, 0x73, 0x6d, 0x21, 0x04, 0x75, 0x48, 0xc3, 0x15, 0xad,
+	0x06, 0x9a, 0x0c, 0xf3, 0xa5, 0x69, 0x1d, 0x78, 0xe0, 0x16, 0x77, 0x79, 0x91, 0xb1, 0xf7, 0x74,
+	0xd1, 0xfe, 0xa6, 0xc0, 0x4a, 0xd7, 0x70, 0x98, 0xe1, 0x57, 0xed, 0xef, 0x4e, 0x3e, 0x35, 0xbb,
+	0x36, 0xa4, 0xf7, 0xfc, 0xfe, 0x7c, 0x80, 0x9d, 0x7a, 0x9f, 0x72, 0xd6, 0xea, 0xd4, 0xda, 0x03,
+	0x98, 0x8b, 0x2f, 0x87, 0xb3, 0xcd, 0x84, 0x87, 0x2e, 0xa5, 0x5d, 0xff, 0x1a, 0x71, 0xbc, 0xec,
+	0xc3, 0x68, 0x55, 0xac, 0x81, 0x1f, 0x79, 0x68, 0xb6, 0xc3, 0x4f, 0xcc, 0xc6, 0xe0, 0x95, 0x44,
+	0x96, 0x61, 0xca, 0xe5, 0xcc, 0x09, 0xcd, 0x86, 0xa5, 0x59, 0xc6, 0x5b, 0x0b, 0x8a, 0xed, 0x9f,
+	0x81, 0xc6, 0x8f, 0x45, 0xc2, 0x24, 0xee, 0x43, 0x86, 0xb7, 0x96, 0x31, 0x8f, 0xab, 0xa9, 0x1f,
+	0x24, 0x02, 0x81, 0xf9, 0x44, 0xdd, 0xc9, 0x09, 0x4c, 0x4a, 0x36, 0x52, 0x2c, 0x0e, 0xbf, 0x7b,
+	0xb1, 0x38, 0xe1, 0xa1, 0x17, 0x19, 0xe3, 0x3b, 0xbf, 0xfd, 0x00, 0x2e, 0xc8, 0xa4, 0xc8, 0xcf,
+	0x15, 0x18, 0xf3, 0x87, 0x47, 0xb2, 0x99, 0xca, 0xbb, 0x73, 0x62, 0x55, 0x6f, 0xf4, 0x67, 0xec,
+	0x9f, 0x93, 0xa6, 0xfd, 0xec, 0x5f, 0xff, 0xfb, 0xf5, 0xf0, 0x22, 0x51, 0x0b, 0x9d, 0x53, 0x72,
+	0xc1, 0x9f, 0x56, 0xc9, 0x9f, 0x14, 0xb8, 0xd8, 0x3e, 0x19, 0x92, 0xbd, 0xee, 0x61, 0x52, 0x26,
+	0x5b, 0xf5, 0x9b, 0x83, 0xba, 0x21, 0xcf, 0x2d, 0xc9, 0x73, 0x8d, 0xac, 0x26, 0xf1, 0x0c, 0x8b,
+	0x2a, 0xe8, 0xe2, 0xe4, 0xef, 0x0a, 0xcc, 0x25, 0xcd, 0x9f, 0xe4, 0x56, 0xf7, 0xf8, 0x5d, 0xe6,
+	0x5a, 0xf5, 0xd3, 0xf3, 0xb8, 0x22, 0xfd, 0x1d, 0x49, 0xff, 0x06, 0xb9, 0x9e, 0x44, 0x5f, 0x4e,
+	0xb3, 0x81, 0x5a, 0xd3, 0x45, 0x40, 0xf5, 0xa9, 0x02, 0x99, 0xc8, 0xf8, 0x4a, 0x6e, 0x76, 0x8f,
+	0xdf, 0x39, 0x05, 0xab, 0xdb, 0x03, 0x78, 0x20, 0xd1, 0x75, 0x49, 0x54, 0x23, 0x4b, 0x49, 0x44,
+	0x03, 0x8a, 0x0d, 0x8f, 0xce, 0x73, 0x05, 0x66, 0xe2, 0xb3, 0x26, 0xd9, 0xed, 0x1e, 0x2f, 0x71,
+	0xaa, 0x55, 0x3f, 0x1e, 0xcc, 0x09, 0x79, 0xde, 0x90, 0x3c, 0xaf, 0x91, 0xab, 0x49, 0x3c, 0x83,
+	0xd1, 0x4b, 0x17, 0x67, 0xf2, 0xca, 0x92, 0xdf, 0x29, 0x30, 0x13, 0x9f, 0x16, 0x7a, 0x71, 0x4d,
+	0x9c, 0x75, 0xd4, 0x8f, 0x07, 0x73, 0x42, 0xae, 0x9b, 0x92, 0xeb, 0x2a, 0x59, 0xe9, 0x76, 0xa6,
+	0x41, 0xd7, 0x7f, 0xa1, 0x00, 0xe9, 0x14, 0xf7, 0xe4, 0x5b, 0xdd, 0x23, 0xa7, 0x8e, 0x16, 0xea,
+	0x27, 0x83, 0x3b, 0x22, 0xed, 0x82, 0xa4, 0xbd, 0x41, 0xd6, 0x92, 0x68, 0xdb, 0x2d, 0xbf, 0xa0,
+	0x72, 0xc9, 0x2f, 0x15, 0x18, 0x47, 0x2d, 0x4f, 0x7a, 0x74, 0xa1, 0xf8, 0xd0, 0xa0, 0x6e, 0xf5,
+	0x69, 0x8d, 0xcc, 0xae, 0x4a, 0x66, 0x59, 0xb2, 0x98, 0xc4, 0x2c, 0x18, 0x1d, 0xc8, 0x1f, 0x14,
+	0x98, 0x6d, 0x93, 0xf6, 0xa4, 0xc7, 0x07, 0x4c, 0x1e, 0x14, 0xd4, 0xbd, 0x01, 0xbd, 0xfa, 0xa9,
+	0xd1, 0xf0, 0xef, 0x45, 0x65, 0xa4, 0x16, 0xa5, 0x8b, 0xd2, 0xa2, 0x5f, 0xba, 0x71, 0xe1, 0xa3,
+	0xee, 0x0d, 0xe8, 0x35, 0x10, 0xdd, 0x13, 0xa4, 0xf6, 0x4c, 0x81, 0xa9, 0xa8, 0x90, 0x26, 0xdb,
+	0xbd, 0x3a, 0x7b, 0x87, 0xd4, 0x57, 0x77, 0x06, 0x71, 0x41, 0x96, 0x1b, 0x92, 0xe5, 0x0a, 0x59,
+	0x4e, 0x7e, 0x08, 0x0c, 0xd6, 0x7a, 0x04, 0xfe, 0xaa, 0xc0, 0xa5, 0x04, 0xe5, 0x4d, 0x3e, 0x19,
+	0xe0, 0x16, 0xc7, 0x94, 0xbf, 0x7a, 0xeb, 0x1c, 0x9e, 0xc8, 0x7b, 0x5b, 0xf2, 0xde, 0x24, 0x1b,
+	0xbd, 0x9b, 0x80, 0xee, 0xcb, 0x72, 0xf2, 0x44, 0x01, 0x68, 0xe9, 0x60, 0x52, 0xe8, 0x1e, 0xbc,
+	0x43, 0xb8, 0xab, 0x37, 0xfb, 0x77, 0x40, 0x92, 0x6b, 0x92, 0xe4, 0x32, 0xc9, 0x25, 0x91, 0x8c,
+	0xc8, 0x77, 0xf2, 0x0f, 0x05, 0x3e, 0x4c, 0x96, 0xc3, 0xe4, 0xdb, 0xdd, 0xa3, 0x76, 0xd5, 0xec,
+	0xea, 0x77, 0xce, 0xe7, 0x8c, 0xf4, 0xf7, 0x24, 0xfd, 0x02, 0xd9, 0x4a, 0xa2, 0x5f, 0x37, 0x2d,
+	0xbd, 0x12, 0x38, 0xcb, 0xbf, 0x2d, 0x04, 0xa5, 0xec, 0xf5, 0x2d, 0x14, 0xc1, 0xbd, 0xfa, 0x56,
+	0x5c, 0x42, 0xab, 0x5b, 0x7d, 0x5a, 0xf7, 0xd3, 0xb7, 0x02, 0xcd, 0x4d, 0x7e, 0xa3, 0x40, 0x26,
+	0xa2, 0x47, 0x7b, 0xbd, 0xfb, 0x9d, 0x3a, 0x5b, 0xdd, 0x1e, 0xc0, 0xa3, 0x9f, 0x2f, 0x1f, 0x91,
+	0xc2, 0xfb, 0xf7, 0x5f, 0xbe, 0xc9, 0x2a, 0xaf, 0xde, 0x64, 0x95, 0xff, 0xbe, 0xc9, 0x2a, 0x8f,
+	0xdf, 0x66, 0x87, 0x5e, 0xbd, 0xcd, 0x0e, 0xfd, 0xfb, 0x6d, 0x76, 0xe8, 0x27, 0x3b, 0x11, 0xb9,
+	0x8b, 0x20, 0x5b, 0x16, 0x13, 0x8f, 0x6c, 0xe7, 0x34, 0x04, 0x3d, 0x0b, 0x61, 0xa5, 0xfc, 0x2d,
+	0x8f, 0xc9, 0xf9, 0x74, 0xf7, 0xff, 0x03, 0x00, 0x1f, 0x5a, 0x3b, 0x78, 0x83, 0x1a, 0x00, 0x00,
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ContractMetadata returns the contract rewards parameters (metadata).
+	ContractMetadata(ctx context.Context, in *QueryContractMetadataRequest, opts ...grpc.CallOption) (*QueryContractMetadataResponse, error)
+	// BlockRewardsTracking returns block rewards tracking for the current block.
+	BlockRewardsTracking(ctx context.Context, in *QueryBlockRewardsTrackingRequest, opts ...grpc.CallOption) (*QueryBlockRewardsTrackingResponse, error)
+	// RewardsPool returns the current undistributed rewards pool funds.
+	RewardsPool(ctx context.Context, in *QueryRewardsPoolRequest, opts ...grpc.CallOption) (*QueryRewardsPoolResponse, error)
+	// EstimateTxFees returns the estimated transaction fees for the given transaction
+	// gas limit using the minimum consensus fee value for the current block.
+	EstimateTxFees(ctx context.Context, in *QueryEstimateTxFeesRequest, opts ...grpc.CallOption) (*QueryEstimateTxFeesResponse, error)
+	// RewardsRecords returns the paginated list of RewardsRecord objects stored for the provided rewards_address.
+	RewardsRecords(ctx context.Context, in *QueryRewardsRecordsRequest, opts ...grpc.CallOption) (*QueryRewardsRecordsResponse, error)
+	// OutstandingRewards returns total rewards credited from different contracts for the provided rewards_address.
+	OutstandingRewards(ctx context.Context, in *QueryOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryOutstandingRewardsResponse, error)
+	// FlatFee returns the flat fee charged for every execution of the provided contract.
+	FlatFee(ctx context.Context, in *QueryFlatFeeRequest, opts ...grpc.CallOption) (*QueryFlatFeeResponse, error)
+	// TreasuryBalance returns the current treasury funds.
+	TreasuryBalance(ctx context.Context, in *QueryTreasuryBalanceRequest, opts ...grpc.CallOption) (*QueryTreasuryBalanceResponse, error)
+	// TreasuryHistory returns the paginated list of governance-approved treasury operations (spend / burn).
+	TreasuryHistory(ctx context.Context, in *QueryTreasuryHistoryRequest, opts ...grpc.CallOption) (*QueryTreasuryHistoryResponse, error)
+	// CodeMetadata returns the code rewards parameters (default metadata for contracts instantiated from the code).
+	CodeMetadata(ctx context.Context, in *QueryCodeMetadataRequest, opts ...grpc.CallOption) (*QueryCodeMetadataResponse, error)
+	// RewardsRecordExpiry returns the soonest expiring RewardsRecord for a rewards address.
+	RewardsRecordExpiry(ctx context.Context, in *QueryRewardsRecordExpiryRequest, opts ...grpc.CallOption) (*QueryRewardsRecordExpiryResponse, error)
+	// AutoPayout returns the automatic rewards payout options for a contract.
+	AutoPayout(ctx context.Context, in *QueryAutoPayoutRequest, opts ...grpc.CallOption) (*QueryAutoPayoutResponse, error)
+	// MinConsensusFeeHistory returns the paginated list of minimum consensus fee values set within the history window.
+	MinConsensusFeeHistory(ctx context.Context, in *QueryMinConsensusFeeHistoryRequest, opts ...grpc.CallOption) (*QueryMinConsensusFeeHistoryResponse, error)
+	// BaseFee returns the current base fee (gas unit price driven by the block gas utilization).
+	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
+	// Sponsorship returns the contract transaction fees sponsorship and the amount of fees sponsored for a user.
+	Sponsorship(ctx context.Context, in *QuerySponsorshipRequest, opts ...grpc.CallOption) (*QuerySponsorshipResponse, error)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryContractMetadataRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryContractMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Metadata.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryBlockRewardsTrackingRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) ContractMetadata(ctx context.Context, in *QueryContractMetadataRequest, opts ...grpc.CallOption) (*QueryContractMetadataResponse, error) {
+	out := new(QueryContractMetadataResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Query/ContractMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryBlockRewardsTrackingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) BlockRewardsTracking(ctx context.Context, in *QueryBlockRewardsTrackingRequest, opts ...grpc.CallOption) (*QueryBlockRewardsTrackingResponse, error) {
+	out := new(QueryBlockRewardsTrackingResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Query/BlockRewardsTracking", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Block.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *QueryRewardsPoolRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) RewardsPool(ctx context.Context, in *QueryRewardsPoolRequest, opts ...grpc.CallOption) (*QueryRewardsPoolResponse, error) {
+	out := new(QueryRewardsPoolResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Query/RewardsPool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryRewardsPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.UndistributedFunds) > 0 {
-		for _, e := range m.UndistributedFunds {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if len(m.TreasuryFunds) > 0 {
-		for _, e := range m.TreasuryFunds {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (c *queryClient) EstimateTxFees(ctx context.Context, in *QueryEstimateTxFeesRequest, opts ...grpc.CallOption) (*QueryEstimateTxFeesResponse, error) {
+	out := new(QueryEstimateTxFeesResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Query/EstimateTxFees", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryEstimateTxFeesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) RewardsRecords(ctx context.Context, in *QueryRewardsRecordsRequest, opts ...grpc.CallOption) (*QueryRewardsRecordsResponse, error) {
+	out := new(QueryRewardsRecordsResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Query/RewardsRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.GasLimit != 0 {
-		n += 1 + sovQuery(uint64(m.GasLimit))
+	return out, nil
+}
+
+func (c *queryClient) OutstandingRewards(ctx context.Context, in *QueryOutstandingRewardsRequest, opts ...grpc.CallOption) (*QueryOutstandingRewardsResponse, error) {
+	out := new(QueryOutstandingRewardsResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Query/OutstandingRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryEstimateTxFeesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) FlatFee(ctx context.Context, in *QueryFlatFeeRequest, opts ...grpc.CallOption) (*QueryFlatFeeResponse, error) {
+	out := new(QueryFlatFeeResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Query/FlatFee", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.GasUnitPrice.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.EstimatedFee.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return out, nil
 }
 
-func (m *BlockTracking) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) TreasuryBalance(ctx context.Context, in *QueryTreasuryBalanceRequest, opts ...grpc.CallOption) (*QueryTreasuryBalanceResponse, error) {
+	out := new(QueryTreasuryBalanceResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Query/TreasuryBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.InflationRewards.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	if len(m.TxRewards) > 0 {
-		for _, e := range m.TxRewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *queryClient) TreasuryHistory(ctx context.Context, in *QueryTreasuryHistoryRequest, opts ...grpc.CallOption) (*QueryTreasuryHistoryResponse, error) {
+	out := new(QueryTreasuryHistoryResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Query/TreasuryHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryRewardsRecordsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) CodeMetadata(ctx context.Context, in *QueryCodeMetadataRequest, opts ...grpc.CallOption) (*QueryCodeMetadataResponse, error) {
+	out := new(QueryCodeMetadataResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Query/CodeMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.RewardsAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) RewardsRecordExpiry(ctx context.Context, in *QueryRewardsRecordExpiryRequest, opts ...grpc.CallOption) (*QueryRewardsRecordExpiryResponse, error) {
+	out := new(QueryRewardsRecordExpiryResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Query/RewardsRecordExpiry", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return out, nil
+}
+
+func (c *queryClient) AutoPayout(ctx context.Context, in *QueryAutoPayoutRequest, opts ...grpc.CallOption) (*QueryAutoPayoutResponse, error) {
+	out := new(QueryAutoPayoutResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Query/AutoPayout", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryRewardsRecordsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) MinConsensusFeeHistory(ctx context.Context, in *QueryMinConsensusFeeHistoryRequest, opts ...grpc.CallOption) (*QueryMinConsensusFeeHistoryResponse, error) {
+	out := new(QueryMinConsensusFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Query/MinConsensusFeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
+	return out, nil
+}
+
+func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error) {
+	out := new(QueryBaseFeeResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Query/BaseFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Sponsorship(ctx context.Context, in *QuerySponsorshipRequest, opts ...grpc.CallOption) (*QuerySponsorshipResponse, error) {
+	out := new(QuerySponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Query/Sponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ContractMetadata returns the contract rewards parameters (metadata).
+	ContractMetadata(context.Context, *QueryContractMetadataRequest) (*QueryContractMetadataResponse, error)
+	// BlockRewardsTracking returns block rewards tracking for the current block.
+	BlockRewardsTracking(context.Context, *QueryBlockRewardsTrackingRequest) (*QueryBlockRewardsTrackingResponse, error)
+	// RewardsPool returns the current undistributed rewards pool funds.
+	RewardsPool(context.Context, *QueryRewardsPoolRequest) (*QueryRewardsPoolResponse, error)
+	// EstimateTxFees returns the estimated transaction fees for the given transaction
+	// gas limit using the minimum consensus fee value for the current block.
+	EstimateTxFees(context.Context, *QueryEstimateTxFeesRequest) (*QueryEstimateTxFeesResponse, error)
+	// RewardsRecords returns the paginated list of RewardsRecord objects stored for the provided rewards_address.
+	RewardsRecords(context.Context, *QueryRewardsRecordsRequest) (*QueryRewardsRecordsResponse, error)
+	// OutstandingRewards returns total rewards credited from different contracts for the provided rewards_address.
+	OutstandingRewards(context.Context, *QueryOutstandingRewardsRequest) (*QueryOutstandingRewardsResponse, error)
+	// FlatFee returns the flat fee charged for every execution of the provided contract.
+	FlatFee(context.Context, *QueryFlatFeeRequest) (*QueryFlatFeeResponse, error)
+	// TreasuryBalance returns the current treasury funds.
+	TreasuryBalance(context.Context, *QueryTreasuryBalanceRequest) (*QueryTreasuryBalanceResponse, error)
+	// TreasuryHistory returns the paginated list of governance-approved treasury operations (spend / burn).
+	TreasuryHistory(context.Context, *QueryTreasuryHistoryRequest) (*QueryTreasuryHistoryResponse, error)
+	// CodeMetadata returns the code rewards parameters (default metadata for contracts instantiated from the code).
+	CodeMetadata(context.Context, *QueryCodeMetadataRequest) (*QueryCodeMetadataResponse, error)
+	// RewardsRecordExpiry returns the soonest expiring RewardsRecord for a rewards address.
+	RewardsRecordExpiry(context.Context, *QueryRewardsRecordExpiryRequest) (*QueryRewardsRecordExpiryResponse, error)
+	// AutoPayout returns the automatic rewards payout options for a contract.
+	AutoPayout(context.Context, *QueryAutoPayoutRequest) (*QueryAutoPayoutResponse, error)
+	// MinConsensusFeeHistory returns the paginated list of minimum consensus fee values set within the history window.
+	MinConsensusFeeHistory(context.Context, *QueryMinConsensusFeeHistoryRequest) (*QueryMinConsensusFeeHistoryResponse, error)
+	// BaseFee returns the current base fee (gas unit price driven by the block gas utilization).
+	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
+	// Sponsorship returns the contract transaction fees sponsorship and the amount of fees sponsored for a user.
+	Sponsorship(context.Context, *QuerySponsorshipRequest) (*QuerySponsorshipResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ContractMetadata(ctx context.Context, req *QueryContractMetadataRequest) (*QueryContractMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractMetadata not implemented")
+}
+func (*UnimplementedQueryServer) BlockRewardsTracking(ctx context.Context, req *QueryBlockRewardsTrackingRequest) (*QueryBlockRewardsTrackingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlockRewardsTracking not implemented")
+}
+func (*UnimplementedQueryServer) RewardsPool(ctx context.Context, req *QueryRewardsPoolRequest) (*QueryRewardsPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsPool not implemented")
+}
+func (*UnimplementedQueryServer) EstimateTxFees(ctx context.Context, req *QueryEstimateTxFeesRequest) (*QueryEstimateTxFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EstimateTxFees not implemented")
+}
+func (*UnimplementedQueryServer) RewardsRecords(ctx context.Context, req *QueryRewardsRecordsRequest) (*QueryRewardsRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsRecords not implemented")
+}
+func (*UnimplementedQueryServer) OutstandingRewards(ctx context.Context, req *QueryOutstandingRewardsRequest) (*QueryOutstandingRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OutstandingRewards not implemented")
+}
+func (*UnimplementedQueryServer) FlatFee(ctx context.Context, req *QueryFlatFeeRequest) (*QueryFlatFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlatFee not implemented")
+}
+func (*UnimplementedQueryServer) TreasuryBalance(ctx context.Context, req *QueryTreasuryBalanceRequest) (*QueryTreasuryBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TreasuryBalance not implemented")
+}
+func (*UnimplementedQueryServer) TreasuryHistory(ctx context.Context, req *QueryTreasuryHistoryRequest) (*QueryTreasuryHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TreasuryHistory not implemented")
+}
+func (*UnimplementedQueryServer) CodeMetadata(ctx context.Context, req *QueryCodeMetadataRequest) (*QueryCodeMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CodeMetadata not implemented")
+}
+func (*UnimplementedQueryServer) RewardsRecordExpiry(ctx context.Context, req *QueryRewardsRecordExpiryRequest) (*QueryRewardsRecordExpiryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardsRecordExpiry not implemented")
+}
+func (*UnimplementedQueryServer) AutoPayout(ctx context.Context, req *QueryAutoPayoutRequest) (*QueryAutoPayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AutoPayout not implemented")
+}
+func (*UnimplementedQueryServer) MinConsensusFeeHistory(ctx context.Context, req *QueryMinConsensusFeeHistoryRequest) (*QueryMinConsensusFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinConsensusFeeHistory not implemented")
+}
+func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
+}
+func (*UnimplementedQueryServer) Sponsorship(ctx context.Context, req *QuerySponsorshipRequest) (*QuerySponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sponsorship not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContractMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContractMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Query/ContractMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractMetadata(ctx, req.(*QueryContractMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlockRewardsTracking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlockRewardsTrackingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlockRewardsTracking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Query/BlockRewardsTracking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlockRewardsTracking(ctx, req.(*QueryBlockRewardsTrackingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardsPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsPoolRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Query/RewardsPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsPool(ctx, req.(*QueryRewardsPoolRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EstimateTxFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEstimateTxFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EstimateTxFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Query/EstimateTxFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EstimateTxFees(ctx, req.(*QueryEstimateTxFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardsRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Query/RewardsRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsRecords(ctx, req.(*QueryRewardsRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OutstandingRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOutstandingRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OutstandingRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Query/OutstandingRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OutstandingRewards(ctx, req.(*QueryOutstandingRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FlatFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFlatFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FlatFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Query/FlatFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FlatFee(ctx, req.(*QueryFlatFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TreasuryBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTreasuryBalanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TreasuryBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Query/TreasuryBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TreasuryBalance(ctx, req.(*QueryTreasuryBalanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TreasuryHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTreasuryHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TreasuryHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Query/TreasuryHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TreasuryHistory(ctx, req.(*QueryTreasuryHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CodeMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCodeMetadataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CodeMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Query/CodeMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CodeMetadata(ctx, req.(*QueryCodeMetadataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardsRecordExpiry_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsRecordExpiryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardsRecordExpiry(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Query/RewardsRecordExpiry",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardsRecordExpiry(ctx, req.(*QueryRewardsRecordExpiryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AutoPayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAutoPayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AutoPayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Query/AutoPayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AutoPayout(ctx, req.(*QueryAutoPayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinConsensusFeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinConsensusFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinConsensusFeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Query/MinConsensusFeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinConsensusFeeHistory(ctx, req.(*QueryMinConsensusFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Query/BaseFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFee(ctx, req.(*QueryBaseFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Sponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Sponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Query/Sponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sponsorship(ctx, req.(*QuerySponsorshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "archway.rewards.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ContractMetadata",
+			Handler:    _Query_ContractMetadata_Handler,
+		},
+		{
+			MethodName: "BlockRewardsTracking",
+			Handler:    _Query_BlockRewardsTracking_Handler,
+		},
+		{
+			MethodName: "RewardsPool",
+			Handler:    _Query_RewardsPool_Handler,
+		},
+		{
+			MethodName: "EstimateTxFees",
+			Handler:    _Query_EstimateTxFees_Handler,
+		},
+		{
+			MethodName: "RewardsRecords",
+			Handler:    _Query_RewardsRecords_Handler,
+		},
+		{
+			MethodName: "OutstandingRewards",
+			Handler:    _Query_OutstandingRewards_Handler,
+		},
+		{
+			MethodName: "FlatFee",
+			Handler:    _Query_FlatFee_Handler,
+		},
+		{
+			MethodName: "TreasuryBalance",
+			Handler:    _Query_TreasuryBalance_Handler,
+		},
+		{
+			MethodName: "TreasuryHistory",
+			Handler:    _Query_TreasuryHistory_Handler,
+		},
+		{
+			MethodName: "CodeMetadata",
+			Handler:    _Query_CodeMetadata_Handler,
+		},
+		{
+			MethodName: "RewardsRecordExpiry",
+			Handler:    _Query_RewardsRecordExpiry_Handler,
+		},
+		{
+			MethodName: "AutoPayout",
+			Handler:    _Query_AutoPayout_Handler,
+		},
+		{
+			MethodName: "MinConsensusFeeHistory",
+			Handler:    _Query_MinConsensusFeeHistory_Handler,
+		},
+		{
+			MethodName: "BaseFee",
+			Handler:    _Query_BaseFee_Handler,
+		},
+		{
+			MethodName: "Sponsorship",
+			Handler:    _Query_Sponsorship_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "archway/rewards/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContractMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContractMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContractMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockRewardsTrackingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockRewardsTrackingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockRewardsTrackingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlockRewardsTrackingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlockRewardsTrackingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlockRewardsTrackingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Block.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsPoolRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsPoolRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsPoolRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TreasuryFunds) > 0 {
+		for iNdEx := len(m.TreasuryFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TreasuryFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.UndistributedFunds) > 0 {
+		for iNdEx := len(m.UndistributedFunds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UndistributedFunds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateTxFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateTxFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateTxFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEstimateTxFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEstimateTxFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEstimateTxFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EstimatedFees) > 0 {
+		for iNdEx := len(m.EstimatedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EstimatedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.GasUnitPrices) > 0 {
+		for iNdEx := len(m.GasUnitPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasUnitPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.EstimatedFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.GasUnitPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockTracking) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockTracking) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockTracking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TxRewards) > 0 {
+		for iNdEx := len(m.TxRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.InflationRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RewardsAddress) > 0 {
+		i -= len(m.RewardsAddress)
+		copy(dAtA[i:], m.RewardsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RewardsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutstandingRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutstandingRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutstandingRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardsAddress) > 0 {
+		i -= len(m.RewardsAddress)
+		copy(dAtA[i:], m.RewardsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RewardsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOutstandingRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOutstandingRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOutstandingRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordsNum != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RecordsNum))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TotalRewards) > 0 {
+		for iNdEx := len(m.TotalRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFlatFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFlatFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFlatFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFlatFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFlatFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFlatFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FlatFeeAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryBalanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryBalanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryBalanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Funds) > 0 {
+		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTreasuryHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTreasuryHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTreasuryHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCodeMetadataRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeMetadataRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeMetadataRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CodeId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCodeMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCodeMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCodeMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsRecordExpiryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsRecordExpiryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsRecordExpiryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardsAddress) > 0 {
+		i -= len(m.RewardsAddress)
+		copy(dAtA[i:], m.RewardsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RewardsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsRecordExpiryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsRecordExpiryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsRecordExpiryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n13, err13 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpiryTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiryTime):])
+	if err13 != nil {
+		return 0, err13
+	}
+	i -= n13
+	i = encodeVarintQuery(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x12
+	if m.Record != nil {
+		{
+			size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoPayoutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoPayoutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoPayoutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAutoPayoutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAutoPayoutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAutoPayoutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.AutoPayout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinConsensusFeeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinConsensusFeeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinConsensusFeeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinConsensusFeeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinConsensusFeeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinConsensusFeeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBaseFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.BaseFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorshipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorshipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorshipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UserAddress) > 0 {
+		i -= len(m.UserAddress)
+		copy(dAtA[i:], m.UserAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.UserAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UserFees) > 0 {
+		for iNdEx := len(m.UserFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Sponsorship.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryContractMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContractMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlockRewardsTrackingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlockRewardsTrackingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Block.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRewardsPoolRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRewardsPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UndistributedFunds) > 0 {
+		for _, e := range m.UndistributedFunds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TreasuryFunds) > 0 {
+		for _, e := range m.TreasuryFunds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEstimateTxFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GasLimit != 0 {
+		n += 1 + sovQuery(uint64(m.GasLimit))
+	}
+	return n
+}
+
+func (m *QueryEstimateTxFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.GasUnitPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EstimatedFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.GasUnitPrices) > 0 {
+		for _, e := range m.GasUnitPrices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.EstimatedFees) > 0 {
+		for _, e := range m.EstimatedFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BlockTracking) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InflationRewards.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.TxRewards) > 0 {
+		for _, e := range m.TxRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRewardsRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RewardsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutstandingRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RewardsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOutstandingRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TotalRewards) > 0 {
+		for _, e := range m.TotalRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.RecordsNum != 0 {
+		n += 1 + sovQuery(uint64(m.RecordsNum))
+	}
+	return n
+}
+
+func (m *QueryFlatFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFlatFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FlatFeeAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTreasuryBalanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTreasuryBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryTreasuryHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTreasuryHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCodeMetadataRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeId != 0 {
+		n += 1 + sovQuery(uint64(m.CodeId))
+	}
+	return n
+}
+
+func (m *QueryCodeMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Metadata.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRewardsRecordExpiryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RewardsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsRecordExpiryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Record != nil {
+		l = m.Record.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpiryTime)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAutoPayoutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAutoPayoutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AutoPayout.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMinConsensusFeeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMinConsensusFeeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
 	_ = l
 	if len(m.Records) > 0 {
 		for _, e := range m.Records {
@@ -1855,51 +4024,1631 @@ func (m *QueryRewardsRecordsResponse) Size() (n int) {
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySponsorshipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.UserAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Sponsorship.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.UserFees) > 0 {
+		for _, e := range m.UserFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractMetadataRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractMetadataRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContractMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockRewardsTrackingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockRewardsTrackingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockRewardsTrackingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlockRewardsTrackingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlockRewardsTrackingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlockRewardsTrackingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsPoolRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsPoolRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UndistributedFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UndistributedFunds = append(m.UndistributedFunds, types.Coin{})
+			if err := m.UndistributedFunds[len(m.UndistributedFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryFunds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryFunds = append(m.TreasuryFunds, types.Coin{})
+			if err := m.TreasuryFunds[len(m.TreasuryFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateTxFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateTxFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateTxFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEstimateTxFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEstimateTxFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEstimateTxFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUnitPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasUnitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EstimatedFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUnitPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasUnitPrices = append(m.GasUnitPrices, types.DecCoin{})
+			if err := m.GasUnitPrices[len(m.GasUnitPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EstimatedFees = append(m.EstimatedFees, types.Coin{})
+			if err := m.EstimatedFees[len(m.EstimatedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockTracking) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockTracking: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockTracking: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxRewards = append(m.TxRewards, TxRewards{})
+			if err := m.TxRewards[len(m.TxRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardsRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardsRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardsRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, RewardsRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutstandingRewardsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *QueryOutstandingRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOutstandingRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalRewards = append(m.TotalRewards, types.Coin{})
+			if err := m.TotalRewards[len(m.TotalRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordsNum", wireType)
+			}
+			m.RecordsNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordsNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.RewardsAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryFlatFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFlatFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFlatFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryOutstandingRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	if len(m.TotalRewards) > 0 {
-		for _, e := range m.TotalRewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryFlatFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFlatFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFlatFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlatFeeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.RecordsNum != 0 {
-		n += 1 + sovQuery(uint64(m.RecordsNum))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTreasuryBalanceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1922,10 +5671,10 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTreasuryBalanceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTreasuryBalanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1949,7 +5698,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTreasuryBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1972,15 +5721,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTreasuryBalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTreasuryBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2007,7 +5756,8 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Funds = append(m.Funds, types.Coin{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2032,7 +5782,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContractMetadataRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTreasuryHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2055,17 +5805,17 @@ func (m *QueryContractMetadataRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractMetadataRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTreasuryHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTreasuryHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2075,23 +5825,27 @@ func (m *QueryContractMetadataRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2114,7 +5868,7 @@ func (m *QueryContractMetadataRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryContractMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTreasuryHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2137,15 +5891,15 @@ func (m *QueryContractMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryContractMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTreasuryHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryContractMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTreasuryHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2172,7 +5926,44 @@ func (m *QueryContractMetadataResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Operations = append(m.Operations, TreasuryOperation{})
+			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2197,7 +5988,7 @@ func (m *QueryContractMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockRewardsTrackingRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryCodeMetadataRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2220,12 +6011,31 @@ func (m *QueryBlockRewardsTrackingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockRewardsTrackingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCodeMetadataRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockRewardsTrackingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCodeMetadataRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			m.CodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2247,7 +6057,7 @@ func (m *QueryBlockRewardsTrackingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlockRewardsTrackingResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCodeMetadataResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2270,15 +6080,15 @@ func (m *QueryBlockRewardsTrackingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlockRewardsTrackingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCodeMetadataResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlockRewardsTrackingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCodeMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2305,7 +6115,7 @@ func (m *QueryBlockRewardsTrackingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Block.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2330,7 +6140,7 @@ func (m *QueryBlockRewardsTrackingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsPoolRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardsRecordExpiryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2353,12 +6163,44 @@ func (m *QueryRewardsPoolRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsPoolRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardsRecordExpiryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsPoolRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardsRecordExpiryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2380,7 +6222,7 @@ func (m *QueryRewardsPoolRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRewardsRecordExpiryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2403,15 +6245,15 @@ func (m *QueryRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRewardsRecordExpiryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRewardsRecordExpiryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UndistributedFunds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2438,14 +6280,16 @@ func (m *QueryRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UndistributedFunds = append(m.UndistributedFunds, types.Coin{})
-			if err := m.UndistributedFunds[len(m.UndistributedFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Record == nil {
+				m.Record = &RewardsRecord{}
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryFunds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTime", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2472,10 +6316,91 @@ func (m *QueryRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TreasuryFunds = append(m.TreasuryFunds, types.Coin{})
-			if err := m.TreasuryFunds[len(m.TreasuryFunds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExpiryTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAutoPayoutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAutoPayoutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAutoPayoutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2498,7 +6423,7 @@ func (m *QueryRewardsPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEstimateTxFeesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAutoPayoutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2521,17 +6446,17 @@ func (m *QueryEstimateTxFeesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEstimateTxFeesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAutoPayoutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEstimateTxFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAutoPayoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPayout", wireType)
 			}
-			m.GasLimit = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2541,11 +6466,25 @@ func (m *QueryEstimateTxFeesRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasLimit |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutoPayout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2567,7 +6506,7 @@ func (m *QueryEstimateTxFeesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEstimateTxFeesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMinConsensusFeeHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2590,15 +6529,15 @@ func (m *QueryEstimateTxFeesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEstimateTxFeesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMinConsensusFeeHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEstimateTxFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMinConsensusFeeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasUnitPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2625,40 +6564,10 @@ func (m *QueryEstimateTxFeesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.GasUnitPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EstimatedFee", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if err := m.EstimatedFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2683,7 +6592,7 @@ func (m *QueryEstimateTxFeesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockTracking) Unmarshal(dAtA []byte) error {
+func (m *QueryMinConsensusFeeHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2706,15 +6615,15 @@ func (m *BlockTracking) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockTracking: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMinConsensusFeeHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockTracking: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMinConsensusFeeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2741,13 +6650,14 @@ func (m *BlockTracking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Records = append(m.Records, MinConsensusFeeRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2774,8 +6684,10 @@ func (m *BlockTracking) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TxRewards = append(m.TxRewards, TxRewards{})
-			if err := m.TxRewards[len(m.TxRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2800,7 +6712,7 @@ func (m *BlockTracking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsRecordsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBaseFeeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2823,80 +6735,12 @@ func (m *QueryRewardsRecordsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsRecordsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBaseFeeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2918,7 +6762,7 @@ func (m *QueryRewardsRecordsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRewardsRecordsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2941,49 +6785,15 @@ func (m *QueryRewardsRecordsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRewardsRecordsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBaseFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRewardsRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Records = append(m.Records, RewardsRecord{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3010,10 +6820,7 @@ func (m *QueryRewardsRecordsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3038,7 +6845,7 @@ func (m *QueryRewardsRecordsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySponsorshipRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3061,15 +6868,15 @@ func (m *QueryOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutstandingRewardsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySponsorshipRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutstandingRewardsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySponsorshipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3097,7 +6904,39 @@ func (m *QueryOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3120,7 +6959,7 @@ func (m *QueryOutstandingRewardsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySponsorshipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3143,15 +6982,15 @@ func (m *QueryOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOutstandingRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySponsorshipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOutstandingRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorship", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3178,16 +7017,15 @@ func (m *QueryOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalRewards = append(m.TotalRewards, types.Coin{})
-			if err := m.TotalRewards[len(m.TotalRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Sponsorship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordsNum", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserFees", wireType)
 			}
-			m.RecordsNum = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3197,11 +7035,26 @@ func (m *QueryOutstandingRewardsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RecordsNum |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UserFees = append(m.UserFees, types.Coin{})
+			if err := m.UserFees[len(m.UserFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2,13 +2,19 @@ package types
 
 import (
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"sigs.k8s.io/yaml"
 
 	"github.com/archway-network/archway/pkg"
+	trackingTypes "github.com/archway-network/archway/x/tracking/types"
 )
 
+// RewardsRecordMergeWindow defines the RewardsRecord time slot new rewards are merged into the latest record within.
+// A new record is created for the rewards address once the slot is over.
+const RewardsRecordMergeWindow = 24 * time.Hour
+
 // HasRewards returns true if the block rewards have been set.
 func (m BlockRewards) HasRewards() bool {
 	return !m.InflationRewards.IsZero()
@@ -65,6 +71,16 @@ func (m TxRewards) Validate() error {
 		}
 	}
 
+	for i, coin := range m.FeeCollectorFees {
+		if pkg.CoinIsZero(coin) {
+			return fmt.Errorf("feeCollectorFees [%d]: must be non-zero", i)
+		}
+
+		if err := pkg.ValidateCoin(coin); err != nil {
+			return fmt.Errorf("feeCollectorFees [%d]: %w", i, err)
+		}
+	}
+
 	return nil
 }
 
@@ -122,3 +138,349 @@ func (m RewardsRecord) Validate() error {
 
 	return nil
 }
+
+// MustGetContractAddress returns the contract address.
+// CONTRACT: panics in case of an error.
+func (m FlatFee) MustGetContractAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.ContractAddress)
+	if err != nil {
+		panic(fmt.Errorf("parsing flatFee contractAddress: %w", err))
+	}
+	return addr
+}
+
+// Validate performs object fields validation.
+func (m FlatFee) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return fmt.Errorf("contractAddress: %w", err)
+	}
+
+	if pkg.CoinIsZero(m.FlatFee) {
+		return fmt.Errorf("flatFee: must be non-zero")
+	}
+
+	if err := pkg.ValidateCoin(m.FlatFee); err != nil {
+		return fmt.Errorf("flatFee: %w", err)
+	}
+
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (m FlatFee) String() string {
+	bz, _ := yaml.Marshal(m)
+	return string(bz)
+}
+
+// Validate performs object fields validation.
+func (m TreasuryOperation) Validate() error {
+	if m.Id <= 0 {
+		return fmt.Errorf("id: must be GT 0")
+	}
+
+	switch m.Type {
+	case TreasuryOperationType_TREASURY_OPERATION_TYPE_SPEND:
+		if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+			return fmt.Errorf("recipient: %w", err)
+		}
+	case TreasuryOperationType_TREASURY_OPERATION_TYPE_BURN:
+		if m.Recipient != "" {
+			return fmt.Errorf("recipient: must be empty for the burn operation")
+		}
+	default:
+		return fmt.Errorf("type: unknown operation type: %s", m.Type)
+	}
+
+	if err := m.Amount.Validate(); err != nil {
+		return fmt.Errorf("amount: %w", err)
+	}
+	if m.Amount.IsZero() {
+		return fmt.Errorf("amount: must be non-zero")
+	}
+
+	if m.Height < 0 {
+		return fmt.Errorf("height: must be GTE 0")
+	}
+
+	if m.Time.IsZero() {
+		return fmt.Errorf("time: must be non-zero")
+	}
+
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (m TreasuryOperation) String() string {
+	bz, _ := yaml.Marshal(m)
+	return string(bz)
+}
+
+// MustGetContractAddress returns the contract address.
+// CONTRACT: panics in case of an error.
+func (m AutoPayout) MustGetContractAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.ContractAddress)
+	if err != nil {
+		panic(fmt.Errorf("parsing autoPayout contractAddress: %w", err))
+	}
+	return addr
+}
+
+// IsEnabled returns true if at least one of the payout triggers is set.
+func (m AutoPayout) IsEnabled() bool {
+	return m.IntervalBlocks > 0 || !m.Threshold.Empty()
+}
+
+// IsDue returns true if the payout should be performed at the given height for the accrued rewards.
+func (m AutoPayout) IsDue(height int64, accruedRewards sdk.Coins) bool {
+	if accruedRewards.IsZero() {
+		return false
+	}
+
+	if m.IntervalBlocks > 0 && height-m.LastPayoutHeight >= int64(m.IntervalBlocks) {
+		return true
+	}
+
+	for _, coin := range m.Threshold {
+		if accruedRewards.AmountOf(coin.Denom).GTE(coin.Amount) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Validate performs object fields validation.
+func (m AutoPayout) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return fmt.Errorf("contractAddress: %w", err)
+	}
+
+	if err := m.Threshold.Validate(); err != nil {
+		return fmt.Errorf("threshold: %w", err)
+	}
+
+	if !m.IsEnabled() {
+		return fmt.Errorf("intervalBlocks / threshold: one of them must be set")
+	}
+
+	if m.LastPayoutHeight < 0 {
+		return fmt.Errorf("lastPayoutHeight: must be GTE 0")
+	}
+
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (m AutoPayout) String() string {
+	bz, _ := yaml.Marshal(m)
+	return string(bz)
+}
+
+// MustGetContractAddress returns the contract address.
+// CONTRACT: panics in case of an error.
+func (m ContractInflationUsage) MustGetContractAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.ContractAddress)
+	if err != nil {
+		panic(fmt.Errorf("parsing contractInflationUsage contractAddress: %w", err))
+	}
+	return addr
+}
+
+// Validate performs object fields validation.
+func (m ContractInflationUsage) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return fmt.Errorf("contractAddress: %w", err)
+	}
+
+	if m.Amount.IsNil() || m.Amount.IsNegative() {
+		return fmt.Errorf("amount: must be GTE 0")
+	}
+
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (m ContractInflationUsage) String() string {
+	bz, _ := yaml.Marshal(m)
+	return string(bz)
+}
+
+// MustGetContractAddress returns the contract address.
+// CONTRACT: panics in case of an error.
+func (m Sponsorship) MustGetContractAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.ContractAddress)
+	if err != nil {
+		panic(fmt.Errorf("parsing sponsorship contractAddress: %w", err))
+	}
+	return addr
+}
+
+// BlockFeesAt returns the amount of fees sponsored within the given block.
+func (m Sponsorship) BlockFeesAt(height int64) sdk.Coins {
+	if m.BlockHeight != height {
+		return sdk.NewCoins()
+	}
+	return m.BlockFees
+}
+
+// IsEnabled returns true if the sponsorship limits are set (empty limits disable the sponsorship).
+func (m Sponsorship) IsEnabled() bool {
+	return !m.UserFeeLimit.Empty() && !m.BlockFeeLimit.Empty()
+}
+
+// UserFeeLimitPeriodAt returns the user fee limit period index for the given block.
+// CONTRACT: UserFeeLimitPeriod must be GT 0.
+func (m Sponsorship) UserFeeLimitPeriodAt(height int64) uint64 {
+	return uint64(height) / m.UserFeeLimitPeriod
+}
+
+// Validate performs object fields validation.
+func (m Sponsorship) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return fmt.Errorf("contractAddress: %w", err)
+	}
+
+	if err := m.Deposit.Validate(); err != nil {
+		return fmt.Errorf("deposit: %w", err)
+	}
+
+	if err := m.UserFeeLimit.Validate(); err != nil {
+		return fmt.Errorf("userFeeLimit: %w", err)
+	}
+
+	if err := m.BlockFeeLimit.Validate(); err != nil {
+		return fmt.Errorf("blockFeeLimit: %w", err)
+	}
+
+	if m.BlockHeight < 0 {
+		return fmt.Errorf("blockHeight: must be GTE 0")
+	}
+
+	if err := m.BlockFees.Validate(); err != nil {
+		return fmt.Errorf("blockFees: %w", err)
+	}
+
+	if !m.UserFeeLimit.Empty() && m.UserFeeLimitPeriod == 0 {
+		return fmt.Errorf("userFeeLimitPeriod: must be GT 0 if userFeeLimit is set")
+	}
+
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (m Sponsorship) String() string {
+	bz, _ := yaml.Marshal(m)
+	return string(bz)
+}
+
+// MustGetContractAddress returns the contract address.
+// CONTRACT: panics in case of an error.
+func (m SponsorshipUsage) MustGetContractAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.ContractAddress)
+	if err != nil {
+		panic(fmt.Errorf("parsing sponsorshipUsage contractAddress: %w", err))
+	}
+	return addr
+}
+
+// MustGetUserAddress returns the user address.
+// CONTRACT: panics in case of an error.
+func (m SponsorshipUsage) MustGetUserAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.UserAddress)
+	if err != nil {
+		panic(fmt.Errorf("parsing sponsorshipUsage userAddress: %w", err))
+	}
+	return addr
+}
+
+// Validate performs object fields validation.
+func (m SponsorshipUsage) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return fmt.Errorf("contractAddress: %w", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.UserAddress); err != nil {
+		return fmt.Errorf("userAddress: %w", err)
+	}
+
+	if err := m.Fees.Validate(); err != nil {
+		return fmt.Errorf("fees: %w", err)
+	}
+
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (m SponsorshipUsage) String() string {
+	bz, _ := yaml.Marshal(m)
+	return string(bz)
+}
+
+// Validate performs object fields validation.
+func (m ContractOperationWeight) Validate() error {
+	if m.OperationType == trackingTypes.ContractOperation_CONTRACT_OPERATION_UNSPECIFIED {
+		return fmt.Errorf("operationType: must be specified")
+	}
+	if _, found := trackingTypes.ContractOperation_name[int32(m.OperationType)]; !found {
+		return fmt.Errorf("operationType: unknown type")
+	}
+
+	if m.Weight.IsNil() || m.Weight.IsNegative() {
+		return fmt.Errorf("weight: must be GTE 0.0")
+	}
+	if m.Weight.GT(MaxContractOperationWeight) {
+		return fmt.Errorf("weight: must be LTE %s", MaxContractOperationWeight)
+	}
+
+	return nil
+}
+
+// Validate performs object fields validation.
+func (m FeeDenom) Validate() error {
+	if err := sdk.ValidateDenom(m.Denom); err != nil {
+		return fmt.Errorf("denom: %w", err)
+	}
+
+	if m.Rate.IsNil() || !m.Rate.IsPositive() {
+		return fmt.Errorf("rate: must be GT 0.0")
+	}
+
+	return nil
+}
+
+// ConvertGasUnitPrice converts the base denom gas unit price to the fee denom one.
+func (m FeeDenom) ConvertGasUnitPrice(basePrice sdk.DecCoin) sdk.DecCoin {
+	return sdk.NewDecCoinFromDec(m.Denom, basePrice.Amount.Quo(m.Rate))
+}
+
+// Validate performs object fields validation.
+func (m TxPriorityTier) Validate() error {
+	if m.MinFeeRatio.IsNil() || m.MinFeeRatio.LT(sdk.OneDec()) {
+		return fmt.Errorf("minFeeRatio: must be GTE 1.0")
+	}
+
+	if m.Priority <= 0 {
+		return fmt.Errorf("priority: must be GT 0")
+	}
+
+	return nil
+}
+
+// Validate performs object fields validation.
+func (m MinConsensusFeeRecord) Validate() error {
+	if m.Height <= 0 {
+		return fmt.Errorf("height: must be GT 0")
+	}
+
+	if err := pkg.ValidateDecCoin(m.Fee); err != nil {
+		return fmt.Errorf("fee: %w", err)
+	}
+
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (m MinConsensusFeeRecord) String() string {
+	bz, _ := yaml.Marshal(m)
+	return string(bz)
+}
@@ -6,11 +6,13 @@ package types
 
 import (
 	fmt "fmt"
+	types1 "github.com/archway-network/archway/x/tracking/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
@@ -30,6 +32,91 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TreasuryOperationType defines the governance-approved treasury funds operation type.
+type TreasuryOperationType int32
+
+const (
+	TreasuryOperationType_TREASURY_OPERATION_TYPE_UNSPECIFIED TreasuryOperationType = 0
+	TreasuryOperationType_TREASURY_OPERATION_TYPE_SPEND       TreasuryOperationType = 1
+	TreasuryOperationType_TREASURY_OPERATION_TYPE_BURN        TreasuryOperationType = 2
+)
+
+var TreasuryOperationType_name = map[int32]string{
+	0: "TREASURY_OPERATION_TYPE_UNSPECIFIED",
+	1: "TREASURY_OPERATION_TYPE_SPEND",
+	2: "TREASURY_OPERATION_TYPE_BURN",
+}
+
+var TreasuryOperationType_value = map[string]int32{
+	"TREASURY_OPERATION_TYPE_UNSPECIFIED": 0,
+	"TREASURY_OPERATION_TYPE_SPEND":       1,
+	"TREASURY_OPERATION_TYPE_BURN":        2,
+}
+
+func (x TreasuryOperationType) String() string {
+	return proto.EnumName(TreasuryOperationType_name, int32(x))
+}
+
+func (TreasuryOperationType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_50f478faffe74434, []int{0}
+}
+
+// SelfDealingPolicy defines the contract inflation rewards policy for self-dealing transactions.
+// A transaction is self-dealing for a contract if its fees are paid by the contract owner, rewards address or one of rewards recipients.
+type SelfDealingPolicy int32
+
+const (
+	SelfDealingPolicy_SELF_DEALING_POLICY_NONE       SelfDealingPolicy = 0
+	SelfDealingPolicy_SELF_DEALING_POLICY_EXCLUDE    SelfDealingPolicy = 1
+	SelfDealingPolicy_SELF_DEALING_POLICY_CAP_TO_FEE SelfDealingPolicy = 2
+)
+
+var SelfDealingPolicy_name = map[int32]string{
+	0: "SELF_DEALING_POLICY_NONE",
+	1: "SELF_DEALING_POLICY_EXCLUDE",
+	2: "SELF_DEALING_POLICY_CAP_TO_FEE",
+}
+
+var SelfDealingPolicy_value = map[string]int32{
+	"SELF_DEALING_POLICY_NONE":       0,
+	"SELF_DEALING_POLICY_EXCLUDE":    1,
+	"SELF_DEALING_POLICY_CAP_TO_FEE": 2,
+}
+
+func (x SelfDealingPolicy) String() string {
+	return proto.EnumName(SelfDealingPolicy_name, int32(x))
+}
+
+func (SelfDealingPolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_50f478faffe74434, []int{1}
+}
+
+// MinConsensusFeeMode defines how the minimum consensus fee is updated every block.
+type MinConsensusFeeMode int32
+
+const (
+	MinConsensusFeeMode_MIN_CONSENSUS_FEE_MODE_INSTANT MinConsensusFeeMode = 0
+	MinConsensusFeeMode_MIN_CONSENSUS_FEE_MODE_EMA     MinConsensusFeeMode = 1
+)
+
+var MinConsensusFeeMode_name = map[int32]string{
+	0: "MIN_CONSENSUS_FEE_MODE_INSTANT",
+	1: "MIN_CONSENSUS_FEE_MODE_EMA",
+}
+
+var MinConsensusFeeMode_value = map[string]int32{
+	"MIN_CONSENSUS_FEE_MODE_INSTANT": 0,
+	"MIN_CONSENSUS_FEE_MODE_EMA":     1,
+}
+
+func (x MinConsensusFeeMode) String() string {
+	return proto.EnumName(MinConsensusFeeMode_name, int32(x))
+}
+
+func (MinConsensusFeeMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_50f478faffe74434, []int{2}
+}
+
 // Params defines the module parameters.
 type Params struct {
 	// inflation_rewards_ratio defines the percentage of minted inflation tokens that are used for dApp rewards [0.0, 1.0].
@@ -40,6 +127,53 @@ type Params struct {
 	TxFeeRebateRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=tx_fee_rebate_ratio,json=txFeeRebateRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"tx_fee_rebate_ratio"`
 	// max_withdraw_records defines the maximum number of RewardsRecord objects used for the withdrawal operation.
 	MaxWithdrawRecords uint64 `protobuf:"varint,3,opt,name=max_withdraw_records,json=maxWithdrawRecords,proto3" json:"max_withdraw_records,omitempty"`
+	// rewards_record_expiry defines the period after which a not withdrawn RewardsRecord expires (since its calculated_time).
+	// Expired records rewards are transferred to the Treasury. If set to 0, records never expire.
+	RewardsRecordExpiry time.Duration `protobuf:"bytes,4,opt,name=rewards_record_expiry,json=rewardsRecordExpiry,proto3,stdduration" json:"rewards_record_expiry"`
+	// max_auto_payouts_per_block defines the maximum number of AutoPayout entries checked by the EndBlocker per block.
+	// Entries are processed in a round-robin manner. If set to 0, automatic payouts are disabled.
+	MaxAutoPayoutsPerBlock uint64 `protobuf:"varint,5,opt,name=max_auto_payouts_per_block,json=maxAutoPayoutsPerBlock,proto3" json:"max_auto_payouts_per_block,omitempty"`
+	// contract_inflation_share_cap defines the maximum share of block inflation rewards a single contract can receive [0.0, 1.0].
+	// Rewards above the cap are transferred to the Treasury. If set to 1.0, the share is not capped.
+	ContractInflationShareCap github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,6,opt,name=contract_inflation_share_cap,json=contractInflationShareCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"contract_inflation_share_cap"`
+	// contract_inflation_epoch_cap defines the maximum amount of inflation rewards a single contract can receive per epoch.
+	// Rewards above the cap are transferred to the Treasury. If set to 0, the amount is not capped.
+	ContractInflationEpochCap github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=contract_inflation_epoch_cap,json=contractInflationEpochCap,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"contract_inflation_epoch_cap"`
+	// inflation_cap_epoch_blocks defines the epoch length (in blocks) for the contract_inflation_epoch_cap.
+	InflationCapEpochBlocks uint64 `protobuf:"varint,8,opt,name=inflation_cap_epoch_blocks,json=inflationCapEpochBlocks,proto3" json:"inflation_cap_epoch_blocks,omitempty"`
+	// self_dealing_policy defines how the gas of transactions, which fees are paid by the contract owner or its rewards address,
+	// is accounted for the contract inflation rewards.
+	SelfDealingPolicy SelfDealingPolicy `protobuf:"varint,9,opt,name=self_dealing_policy,json=selfDealingPolicy,proto3,enum=archway.rewards.v1beta1.SelfDealingPolicy" json:"self_dealing_policy,omitempty"`
+	// contract_operation_weights defines the gas weights per contract operation type applied on the rewards estimation.
+	// Operations without a weight set are weighted 1.0.
+	ContractOperationWeights []ContractOperationWeight `protobuf:"bytes,10,rep,name=contract_operation_weights,json=contractOperationWeights,proto3" json:"contract_operation_weights"`
+	// accepted_fee_denoms defines the list of alternative denoms the minimum consensus fee can be paid with.
+	// The minimum consensus fee is always accepted in the base (inflation) denom.
+	AcceptedFeeDenoms []FeeDenom `protobuf:"bytes,11,rep,name=accepted_fee_denoms,json=acceptedFeeDenoms,proto3" json:"accepted_fee_denoms"`
+	// min_consensus_fee_mode defines how the minimum consensus fee is updated every block.
+	MinConsensusFeeMode MinConsensusFeeMode `protobuf:"varint,12,opt,name=min_consensus_fee_mode,json=minConsensusFeeMode,proto3,enum=archway.rewards.v1beta1.MinConsensusFeeMode" json:"min_consensus_fee_mode,omitempty"`
+	// min_consensus_fee_window defines the smoothing window (in blocks) for the MIN_CONSENSUS_FEE_MODE_EMA mode.
+	MinConsensusFeeWindow uint64 `protobuf:"varint,13,opt,name=min_consensus_fee_window,json=minConsensusFeeWindow,proto3" json:"min_consensus_fee_window,omitempty"`
+	// min_consensus_fee_history_blocks defines the number of blocks the minimum consensus fee history is kept for.
+	// If set to 0, the history is not kept.
+	MinConsensusFeeHistoryBlocks uint64 `protobuf:"varint,14,opt,name=min_consensus_fee_history_blocks,json=minConsensusFeeHistoryBlocks,proto3" json:"min_consensus_fee_history_blocks,omitempty"`
+	// base_fee_max_change_rate defines the maximum base fee change per block [0.0, 1.0].
+	// The base fee is increased (or decreased) by this rate if the block is full (or empty).
+	// If set to 0.0, the base fee is disabled.
+	BaseFeeMaxChangeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,15,opt,name=base_fee_max_change_rate,json=baseFeeMaxChangeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee_max_change_rate"`
+	// base_fee_target_utilization defines the target block gas limit utilization for the base fee (0.0, 1.0].
+	BaseFeeTargetUtilization github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,16,opt,name=base_fee_target_utilization,json=baseFeeTargetUtilization,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee_target_utilization"`
+	// base_fee_burn_ratio defines the share of the base fee paid by a transaction that is burned [0.0, 1.0].
+	BaseFeeBurnRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,17,opt,name=base_fee_burn_ratio,json=baseFeeBurnRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"base_fee_burn_ratio"`
+	// tx_priority_tiers defines the mempool priority rules for transactions paying fees above the minimum gas unit price.
+	// Tiers must be sorted by the min_fee_ratio in ascending order (an empty list sets the same priority for all transactions).
+	TxPriorityTiers []TxPriorityTier `protobuf:"bytes,18,rep,name=tx_priority_tiers,json=txPriorityTiers,proto3" json:"tx_priority_tiers"`
+	// unused_gas_refund_ratio defines the share of fees paid for the unused transaction gas that is refunded [0.0, 1.0].
+	// Refunds are disabled if set to 0.
+	UnusedGasRefundRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,19,opt,name=unused_gas_refund_ratio,json=unusedGasRefundRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"unused_gas_refund_ratio"`
+	// max_expired_records_per_block defines the maximum number of expired RewardsRecord objects swept by the EndBlocker per block.
+	// Records are swept oldest first, the rest are swept by the following blocks.
+	MaxExpiredRecordsPerBlock uint64 `protobuf:"varint,20,opt,name=max_expired_records_per_block,json=maxExpiredRecordsPerBlock,proto3" json:"max_expired_records_per_block,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -81,6 +215,83 @@ func (m *Params) GetMaxWithdrawRecords() uint64 {
 	return 0
 }
 
+func (m *Params) GetRewardsRecordExpiry() time.Duration {
+	if m != nil {
+		return m.RewardsRecordExpiry
+	}
+	return 0
+}
+
+func (m *Params) GetMaxAutoPayoutsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxAutoPayoutsPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetInflationCapEpochBlocks() uint64 {
+	if m != nil {
+		return m.InflationCapEpochBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetSelfDealingPolicy() SelfDealingPolicy {
+	if m != nil {
+		return m.SelfDealingPolicy
+	}
+	return SelfDealingPolicy_SELF_DEALING_POLICY_NONE
+}
+
+func (m *Params) GetContractOperationWeights() []ContractOperationWeight {
+	if m != nil {
+		return m.ContractOperationWeights
+	}
+	return nil
+}
+
+func (m *Params) GetAcceptedFeeDenoms() []FeeDenom {
+	if m != nil {
+		return m.AcceptedFeeDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetMinConsensusFeeMode() MinConsensusFeeMode {
+	if m != nil {
+		return m.MinConsensusFeeMode
+	}
+	return MinConsensusFeeMode_MIN_CONSENSUS_FEE_MODE_INSTANT
+}
+
+func (m *Params) GetMinConsensusFeeWindow() uint64 {
+	if m != nil {
+		return m.MinConsensusFeeWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMinConsensusFeeHistoryBlocks() uint64 {
+	if m != nil {
+		return m.MinConsensusFeeHistoryBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetTxPriorityTiers() []TxPriorityTier {
+	if m != nil {
+		return m.TxPriorityTiers
+	}
+	return nil
+}
+
+func (m *Params) GetMaxExpiredRecordsPerBlock() uint64 {
+	if m != nil {
+		return m.MaxExpiredRecordsPerBlock
+	}
+	return 0
+}
+
 // ContractMetadata defines the contract rewards distribution options for a particular contract.
 type ContractMetadata struct {
 	// contract_address defines the contract address (bech32 encoded).
@@ -90,8 +301,14 @@ type ContractMetadata struct {
 	// If owner_address is set to contract address, contract can modify the metadata on its own using WASM bindings.
 	OwnerAddress string `protobuf:"bytes,2,opt,name=owner_address,json=ownerAddress,proto3" json:"owner_address,omitempty"`
 	// rewards_address is an address to distribute rewards to (bech32 encoded).
-	// If not set (empty), rewards are not distributed for this contract.
+	// If not set (empty) and rewards_recipients are not set, rewards are not distributed for this contract.
 	RewardsAddress string `protobuf:"bytes,3,opt,name=rewards_address,json=rewardsAddress,proto3" json:"rewards_address,omitempty"`
+	// rewards_recipients defines a weighted list of addresses to distribute rewards to.
+	// If set, contract rewards are split between recipients according to their weights and rewards_address must be empty.
+	RewardsRecipients []RewardsRecipient `protobuf:"bytes,4,rep,name=rewards_recipients,json=rewardsRecipients,proto3" json:"rewards_recipients"`
+	// pending_owner_address is the nominated owner address that has to accept the ownership transfer (bech32 encoded).
+	// If not set (empty), there is no ownership transfer in progress.
+	PendingOwnerAddress string `protobuf:"bytes,5,opt,name=pending_owner_address,json=pendingOwnerAddress,proto3" json:"pending_owner_address,omitempty"`
 }
 
 func (m *ContractMetadata) Reset()      { *m = ContractMetadata{} }
@@ -147,6 +364,122 @@ func (m *ContractMetadata) GetRewardsAddress() string {
 	return ""
 }
 
+func (m *ContractMetadata) GetRewardsRecipients() []RewardsRecipient {
+	if m != nil {
+		return m.RewardsRecipients
+	}
+	return nil
+}
+
+func (m *ContractMetadata) GetPendingOwnerAddress() string {
+	if m != nil {
+		return m.PendingOwnerAddress
+	}
+	return ""
+}
+
+// RewardsRecipient defines a weighted rewards distribution destination.
+type RewardsRecipient struct {
+	// address is the address to distribute rewards to (bech32 encoded).
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// weight defines the share of contract rewards for this recipient (0.0, 1.0].
+	// Weights of all recipients must sum up to 1.0.
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *RewardsRecipient) Reset()      { *m = RewardsRecipient{} }
+func (*RewardsRecipient) ProtoMessage() {}
+func (*RewardsRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f478faffe74434, []int{2}
+}
+func (m *RewardsRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardsRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardsRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardsRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardsRecipient.Merge(m, src)
+}
+func (m *RewardsRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardsRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardsRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardsRecipient proto.InternalMessageInfo
+
+func (m *RewardsRecipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// FlatFee defines the flat fee charged for every contract execution (on top of gas fees).
+type FlatFee struct {
+	// contract_address defines the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// flat_fee defines the fee coin charged for every MsgExecuteContract targeting the contract.
+	FlatFee types.Coin `protobuf:"bytes,2,opt,name=flat_fee,json=flatFee,proto3" json:"flat_fee"`
+}
+
+func (m *FlatFee) Reset()      { *m = FlatFee{} }
+func (*FlatFee) ProtoMessage() {}
+func (*FlatFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f478faffe74434, []int{3}
+}
+func (m *FlatFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlatFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlatFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlatFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlatFee.Merge(m, src)
+}
+func (m *FlatFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlatFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlatFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlatFee proto.InternalMessageInfo
+
+func (m *FlatFee) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *FlatFee) GetFlatFee() types.Coin {
+	if m != nil {
+		return m.FlatFee
+	}
+	return types.Coin{}
+}
+
 // BlockRewards defines block related rewards distribution data.
 type BlockRewards struct {
 	// height defines the block height.
@@ -160,7 +493,7 @@ type BlockRewards struct {
 func (m *BlockRewards) Reset()      { *m = BlockRewards{} }
 func (*BlockRewards) ProtoMessage() {}
 func (*BlockRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50f478faffe74434, []int{2}
+	return fileDescriptor_50f478faffe74434, []int{4}
 }
 func (m *BlockRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,12 +551,18 @@ type TxRewards struct {
 	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 	// fee_rewards is the rewards to be distributed.
 	FeeRewards []types.Coin `protobuf:"bytes,3,rep,name=fee_rewards,json=feeRewards,proto3" json:"fee_rewards"`
+	// fee_collector_fees is the transaction fees share sent to the FeeCollector.
+	// Value is only tracked if unused gas refunds are enabled (used to estimate the FeeCollector share of a refund).
+	FeeCollectorFees []types.Coin `protobuf:"bytes,4,rep,name=fee_collector_fees,json=feeCollectorFees,proto3" json:"fee_collector_fees"`
+	// sponsored flag defines whether the transaction fees were paid from the fee payer contract Sponsorship deposit.
+	// Unused gas refunds of a sponsored transaction are returned to the deposit.
+	Sponsored bool `protobuf:"varint,5,opt,name=sponsored,proto3" json:"sponsored,omitempty"`
 }
 
 func (m *TxRewards) Reset()      { *m = TxRewards{} }
 func (*TxRewards) ProtoMessage() {}
 func (*TxRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50f478faffe74434, []int{3}
+	return fileDescriptor_50f478faffe74434, []int{5}
 }
 func (m *TxRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -273,6 +612,20 @@ func (m *TxRewards) GetFeeRewards() []types.Coin {
 	return nil
 }
 
+func (m *TxRewards) GetFeeCollectorFees() []types.Coin {
+	if m != nil {
+		return m.FeeCollectorFees
+	}
+	return nil
+}
+
+func (m *TxRewards) GetSponsored() bool {
+	if m != nil {
+		return m.Sponsored
+	}
+	return false
+}
+
 // RewardsRecord defines a record that is used to distribute rewards later (lazy distribution).
 // This record is being created by the x/rewards EndBlocker and pruned after the rewards are distributed.
 // An actual rewards x/bank transfer might be triggered by a Tx (via CLI for example) or by a contract via WASM bindings.
@@ -286,15 +639,16 @@ type RewardsRecord struct {
 	// rewards are the rewards to be transferred later.
 	Rewards []types.Coin `protobuf:"bytes,3,rep,name=rewards,proto3" json:"rewards"`
 	// calculated_height defines the block height of rewards calculation event.
+	// Rewards of later events might be merged into the record, so that is the height of the oldest rewards.
 	CalculatedHeight int64 `protobuf:"varint,4,opt,name=calculated_height,json=calculatedHeight,proto3" json:"calculated_height,omitempty"`
-	// calculated_time defines the block time of rewards calculation event.
+	// calculated_time defines the block time of rewards calculation event (the oldest rewards block time).
 	CalculatedTime time.Time `protobuf:"bytes,5,opt,name=calculated_time,json=calculatedTime,proto3,stdtime" json:"calculated_time"`
 }
 
 func (m *RewardsRecord) Reset()      { *m = RewardsRecord{} }
 func (*RewardsRecord) ProtoMessage() {}
 func (*RewardsRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_50f478faffe74434, []int{4}
+	return fileDescriptor_50f478faffe74434, []int{6}
 }
 func (m *RewardsRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -358,425 +712,4604 @@ func (m *RewardsRecord) GetCalculatedTime() time.Time {
 	return time.Time{}
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "archway.rewards.v1beta1.Params")
-	proto.RegisterType((*ContractMetadata)(nil), "archway.rewards.v1beta1.ContractMetadata")
-	proto.RegisterType((*BlockRewards)(nil), "archway.rewards.v1beta1.BlockRewards")
-	proto.RegisterType((*TxRewards)(nil), "archway.rewards.v1beta1.TxRewards")
-	proto.RegisterType((*RewardsRecord)(nil), "archway.rewards.v1beta1.RewardsRecord")
+// TreasuryOperation defines a governance-approved operation over the treasury funds (spend / burn).
+// Objects are created by the x/gov proposal handler and kept as the treasury outflow history.
+type TreasuryOperation struct {
+	// id is the unique ID of the operation.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// type defines the operation type.
+	Type TreasuryOperationType `protobuf:"varint,2,opt,name=type,proto3,enum=archway.rewards.v1beta1.TreasuryOperationType" json:"type,omitempty"`
+	// recipient is the address funds are transferred to (bech32 encoded, empty for the burn operation).
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount defines the operation coins.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// height defines the block height of the operation.
+	Height int64 `protobuf:"varint,5,opt,name=height,proto3" json:"height,omitempty"`
+	// time defines the block time of the operation.
+	Time time.Time `protobuf:"bytes,6,opt,name=time,proto3,stdtime" json:"time"`
 }
 
-func init() {
-	proto.RegisterFile("archway/rewards/v1beta1/rewards.proto", fileDescriptor_50f478faffe74434)
+func (m *TreasuryOperation) Reset()      { *m = TreasuryOperation{} }
+func (*TreasuryOperation) ProtoMessage() {}
+func (*TreasuryOperation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f478faffe74434, []int{7}
 }
-
-var fileDescriptor_50f478faffe74434 = []byte{
-	// 621 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4b, 0x6f, 0xd3, 0x40,
-	0x10, 0x8e, 0x1d, 0x37, 0xa5, 0xdb, 0x57, 0xba, 0x2d, 0x34, 0xe4, 0xe0, 0x44, 0x45, 0x40, 0x11,
-	0xaa, 0x4d, 0xcb, 0x09, 0x4e, 0x90, 0x22, 0x1e, 0x52, 0x2b, 0xa1, 0x55, 0x25, 0x24, 0x24, 0x64,
-	0xad, 0xed, 0x8d, 0x63, 0x25, 0xf6, 0x46, 0xeb, 0x0d, 0x76, 0x6f, 0x5c, 0x10, 0xd7, 0x22, 0x2e,
-	0x1c, 0xf9, 0x39, 0x3d, 0xf6, 0x88, 0x38, 0x14, 0x94, 0xfc, 0x11, 0xe4, 0x7d, 0xa4, 0x01, 0x7a,
-	0x00, 0x4e, 0xf6, 0xcc, 0x7c, 0x3b, 0xf3, 0xcd, 0x37, 0x3b, 0x0b, 0x6e, 0x62, 0x16, 0xf4, 0x72,
-	0x7c, 0xec, 0x32, 0x92, 0x63, 0x16, 0x66, 0xee, 0xdb, 0x5d, 0x9f, 0x70, 0xbc, 0xab, 0x6d, 0x67,
-	0xc8, 0x28, 0xa7, 0x70, 0x53, 0xc1, 0x1c, 0xed, 0x56, 0xb0, 0xe6, 0x46, 0x44, 0x23, 0x2a, 0x30,
-	0x6e, 0xf9, 0x27, 0xe1, 0xcd, 0x56, 0x44, 0x69, 0x34, 0x20, 0xae, 0xb0, 0xfc, 0x51, 0xd7, 0xe5,
-	0x71, 0x42, 0x32, 0x8e, 0x93, 0xa1, 0x02, 0xd8, 0x01, 0xcd, 0x12, 0x9a, 0xb9, 0x3e, 0xce, 0xc8,
-	0xb4, 0x64, 0x40, 0xe3, 0x54, 0xc6, 0xb7, 0x3e, 0x98, 0xa0, 0xf6, 0x12, 0x33, 0x9c, 0x64, 0xb0,
-	0x0b, 0x36, 0xe3, 0xb4, 0x3b, 0xc0, 0x3c, 0xa6, 0xa9, 0xa7, 0xca, 0x7b, 0xac, 0x34, 0x1b, 0x46,
-	0xdb, 0xd8, 0x5e, 0xe8, 0x38, 0xa7, 0xe7, 0xad, 0xca, 0xb7, 0xf3, 0xd6, 0xad, 0x28, 0xe6, 0xbd,
-	0x91, 0xef, 0x04, 0x34, 0x71, 0x55, 0x7a, 0xf9, 0xd9, 0xc9, 0xc2, 0xbe, 0xcb, 0x8f, 0x87, 0x24,
-	0x73, 0x9e, 0x90, 0x00, 0x5d, 0x9d, 0xa6, 0x43, 0x32, 0x1b, 0x2a, 0x0d, 0xf8, 0x06, 0xac, 0xf3,
-	0xc2, 0xeb, 0x12, 0xe2, 0x31, 0xe2, 0x63, 0x4e, 0x54, 0x0d, 0xf3, 0xbf, 0x6a, 0xd4, 0x79, 0xf1,
-	0x94, 0x10, 0x24, 0x12, 0xc9, 0xf4, 0xf7, 0xc0, 0x46, 0x82, 0x0b, 0x2f, 0x8f, 0x79, 0x2f, 0x64,
-	0x38, 0xf7, 0x18, 0x09, 0x28, 0x0b, 0xb3, 0x46, 0xb5, 0x6d, 0x6c, 0x5b, 0x08, 0x26, 0xb8, 0x78,
-	0xa5, 0x42, 0x48, 0x46, 0x1e, 0x5a, 0x9f, 0xbf, 0xb4, 0x2a, 0x5b, 0x1f, 0x0d, 0x50, 0xdf, 0xa7,
-	0x29, 0x67, 0x38, 0xe0, 0x87, 0x84, 0xe3, 0x10, 0x73, 0x0c, 0xef, 0x80, 0x7a, 0xa0, 0x7c, 0x1e,
-	0x0e, 0x43, 0x46, 0xb2, 0x4c, 0x8a, 0x81, 0x56, 0xb5, 0xff, 0xb1, 0x74, 0xc3, 0x1b, 0x60, 0x99,
-	0xe6, 0x29, 0x61, 0x53, 0x9c, 0x68, 0x08, 0x2d, 0x09, 0xa7, 0x06, 0xdd, 0x06, 0xab, 0x5a, 0x59,
-	0x0d, 0xab, 0x0a, 0xd8, 0x8a, 0x72, 0x2b, 0xa0, 0xe2, 0xf4, 0xc9, 0x00, 0x4b, 0x9d, 0x01, 0x0d,
-	0xfa, 0x4a, 0x40, 0x78, 0x0d, 0xd4, 0x7a, 0x24, 0x8e, 0x7a, 0x5c, 0xb0, 0xa8, 0x22, 0x65, 0xc1,
-	0x03, 0xb0, 0xf6, 0xc7, 0xec, 0x04, 0x81, 0xc5, 0xbd, 0xeb, 0x8e, 0x14, 0xce, 0x29, 0xaf, 0x80,
-	0xbe, 0x4e, 0xce, 0x3e, 0x8d, 0xd3, 0x8e, 0x55, 0x8a, 0x8d, 0xea, 0xbf, 0x8f, 0x09, 0x6e, 0x82,
-	0xf9, 0x52, 0xc2, 0x08, 0x6b, 0xd5, 0x6a, 0x09, 0x2e, 0x9e, 0x61, 0xcd, 0xea, 0x9d, 0x01, 0x16,
-	0x8e, 0x0a, 0x0d, 0x5e, 0x07, 0x73, 0xbc, 0xf0, 0xe2, 0x50, 0x30, 0xb2, 0x90, 0xc5, 0x8b, 0x17,
-	0xe1, 0x0c, 0x4f, 0xf3, 0x17, 0x9e, 0x8f, 0xc0, 0xa2, 0x1c, 0xbc, 0x64, 0x58, 0x6d, 0x57, 0xff,
-	0x86, 0x21, 0xe8, 0x96, 0x23, 0x16, 0x47, 0x14, 0x85, 0xf7, 0x26, 0x58, 0xd6, 0x97, 0x4a, 0x4c,
-	0x11, 0xae, 0x00, 0x73, 0xca, 0xc1, 0x8c, 0xc3, 0xcb, 0x94, 0x36, 0x2f, 0x53, 0x1a, 0x3e, 0x00,
-	0xf3, 0xff, 0x48, 0x47, 0xe3, 0xe1, 0x5d, 0xb0, 0x16, 0xe0, 0x41, 0x30, 0x1a, 0x60, 0x4e, 0x42,
-	0x4f, 0x35, 0x6c, 0x89, 0x86, 0xeb, 0x17, 0x81, 0xe7, 0xb2, 0xf5, 0x43, 0xb0, 0x3a, 0x03, 0x2e,
-	0xf7, 0xb4, 0x31, 0x27, 0x06, 0xd4, 0x74, 0xe4, 0x12, 0x3b, 0x7a, 0x89, 0x9d, 0x23, 0xbd, 0xc4,
-	0x9d, 0x2b, 0x65, 0xc1, 0x93, 0xef, 0x2d, 0x03, 0xad, 0x5c, 0x1c, 0x2e, 0xc3, 0x52, 0x87, 0xce,
-	0xc1, 0xe9, 0xd8, 0x36, 0xce, 0xc6, 0xb6, 0xf1, 0x63, 0x6c, 0x1b, 0x27, 0x13, 0xbb, 0x72, 0x36,
-	0xb1, 0x2b, 0x5f, 0x27, 0x76, 0xe5, 0xf5, 0xde, 0xcc, 0x02, 0xa9, 0x37, 0x65, 0x27, 0x25, 0x3c,
-	0xa7, 0xac, 0xaf, 0x6d, 0xb7, 0x98, 0x3e, 0x46, 0x62, 0xa1, 0xfc, 0x9a, 0x60, 0x70, 0xff, 0x67,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0xeb, 0x36, 0x1a, 0xaf, 0xac, 0x04, 0x00, 0x00,
+func (m *TreasuryOperation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TreasuryOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TreasuryOperation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TreasuryOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TreasuryOperation.Merge(m, src)
+}
+func (m *TreasuryOperation) XXX_Size() int {
+	return m.Size()
+}
+func (m *TreasuryOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_TreasuryOperation.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxWithdrawRecords != 0 {
-		i = encodeVarintRewards(dAtA, i, uint64(m.MaxWithdrawRecords))
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size := m.TxFeeRebateRatio.Size()
-		i -= size
-		if _, err := m.TxFeeRebateRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintRewards(dAtA, i, uint64(size))
+var xxx_messageInfo_TreasuryOperation proto.InternalMessageInfo
+
+func (m *TreasuryOperation) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size := m.InflationRewardsRatio.Size()
-		i -= size
-		if _, err := m.InflationRewardsRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintRewards(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *TreasuryOperation) GetType() TreasuryOperationType {
+	if m != nil {
+		return m.Type
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return TreasuryOperationType_TREASURY_OPERATION_TYPE_UNSPECIFIED
 }
 
-func (m *ContractMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TreasuryOperation) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ContractMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *TreasuryOperation) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
 }
 
-func (m *ContractMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RewardsAddress) > 0 {
-		i -= len(m.RewardsAddress)
-		copy(dAtA[i:], m.RewardsAddress)
-		i = encodeVarintRewards(dAtA, i, uint64(len(m.RewardsAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.OwnerAddress) > 0 {
-		i -= len(m.OwnerAddress)
-		copy(dAtA[i:], m.OwnerAddress)
-		i = encodeVarintRewards(dAtA, i, uint64(len(m.OwnerAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ContractAddress) > 0 {
-		i -= len(m.ContractAddress)
-		copy(dAtA[i:], m.ContractAddress)
-		i = encodeVarintRewards(dAtA, i, uint64(len(m.ContractAddress)))
-		i--
-		dAtA[i] = 0xa
+func (m *TreasuryOperation) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *BlockRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TreasuryOperation) GetTime() time.Time {
+	if m != nil {
+		return m.Time
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *BlockRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CodeMetadata defines the default rewards distribution options for all contracts instantiated from a particular code ID.
+// It is used as a fallback for contracts without ContractMetadata set.
+type CodeMetadata struct {
+	// code_id defines the contract code ID.
+	CodeId uint64 `protobuf:"varint,1,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// rewards_address is an address to distribute rewards to (bech32 encoded).
+	RewardsAddress string `protobuf:"bytes,2,opt,name=rewards_address,json=rewardsAddress,proto3" json:"rewards_address,omitempty"`
+	// rewards_recipients defines a weighted list of addresses to distribute rewards to.
+	// If set, contract rewards are split between recipients according to their weights and rewards_address must be empty.
+	RewardsRecipients []RewardsRecipient `protobuf:"bytes,3,rep,name=rewards_recipients,json=rewardsRecipients,proto3" json:"rewards_recipients"`
 }
 
-func (m *BlockRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MaxGas != 0 {
-		i = encodeVarintRewards(dAtA, i, uint64(m.MaxGas))
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size, err := m.InflationRewards.MarshalToSizedBuffer(dAtA[:i])
+func (m *CodeMetadata) Reset()      { *m = CodeMetadata{} }
+func (*CodeMetadata) ProtoMessage() {}
+func (*CodeMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f478faffe74434, []int{8}
+}
+func (m *CodeMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CodeMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CodeMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintRewards(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.Height != 0 {
-		i = encodeVarintRewards(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *CodeMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CodeMetadata.Merge(m, src)
+}
+func (m *CodeMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *CodeMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_CodeMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CodeMetadata proto.InternalMessageInfo
+
+func (m *CodeMetadata) GetCodeId() uint64 {
+	if m != nil {
+		return m.CodeId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *TxRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CodeMetadata) GetRewardsAddress() string {
+	if m != nil {
+		return m.RewardsAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *TxRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *CodeMetadata) GetRewardsRecipients() []RewardsRecipient {
+	if m != nil {
+		return m.RewardsRecipients
+	}
+	return nil
 }
 
-func (m *TxRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FeeRewards) > 0 {
-		for iNdEx := len(m.FeeRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeeRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRewards(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+// AutoPayout defines the automatic rewards payout options for a particular contract.
+// Rewards of the contract metadata rewards_address (or every rewards_recipients address) are withdrawn by the EndBlocker
+// every interval_blocks or when accrued rewards of an address reach the threshold (whatever comes first).
+type AutoPayout struct {
+	// contract_address is the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// interval_blocks defines the number of blocks between payouts (0 if disabled).
+	IntervalBlocks uint64 `protobuf:"varint,2,opt,name=interval_blocks,json=intervalBlocks,proto3" json:"interval_blocks,omitempty"`
+	// threshold defines the accrued rewards amount that triggers a payout (empty if disabled).
+	// Payout is triggered if any of the threshold coins is reached.
+	Threshold github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=threshold,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"threshold"`
+	// last_payout_height defines the block height of the last payout (or the opt-in height).
+	LastPayoutHeight int64 `protobuf:"varint,4,opt,name=last_payout_height,json=lastPayoutHeight,proto3" json:"last_payout_height,omitempty"`
+}
+
+func (m *AutoPayout) Reset()      { *m = AutoPayout{} }
+func (*AutoPayout) ProtoMessage() {}
+func (*AutoPayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f478faffe74434, []int{9}
+}
+func (m *AutoPayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AutoPayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AutoPayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Height != 0 {
-		i = encodeVarintRewards(dAtA, i, uint64(m.Height))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.TxId != 0 {
-		i = encodeVarintRewards(dAtA, i, uint64(m.TxId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *AutoPayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AutoPayout.Merge(m, src)
+}
+func (m *AutoPayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *AutoPayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_AutoPayout.DiscardUnknown(m)
 }
 
-func (m *RewardsRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AutoPayout proto.InternalMessageInfo
+
+func (m *AutoPayout) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *RewardsRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AutoPayout) GetIntervalBlocks() uint64 {
+	if m != nil {
+		return m.IntervalBlocks
+	}
+	return 0
 }
 
-func (m *RewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CalculatedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CalculatedTime):])
-	if err2 != nil {
-		return 0, err2
+func (m *AutoPayout) GetThreshold() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Threshold
 	}
-	i -= n2
-	i = encodeVarintRewards(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x2a
-	if m.CalculatedHeight != 0 {
-		i = encodeVarintRewards(dAtA, i, uint64(m.CalculatedHeight))
-		i--
-		dAtA[i] = 0x20
+	return nil
+}
+
+func (m *AutoPayout) GetLastPayoutHeight() int64 {
+	if m != nil {
+		return m.LastPayoutHeight
 	}
-	if len(m.Rewards) > 0 {
-		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintRewards(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	return 0
+}
+
+// ContractInflationUsage defines the inflation rewards amount received by a contract within the current epoch.
+// Object is used to apply the contract_inflation_epoch_cap param.
+type ContractInflationUsage struct {
+	// contract_address is the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// epoch is the epoch number (block height / inflation_cap_epoch_blocks) the amount is tracked for.
+	Epoch uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// amount is the inflation rewards amount received within the epoch.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount"`
+}
+
+func (m *ContractInflationUsage) Reset()      { *m = ContractInflationUsage{} }
+func (*ContractInflationUsage) ProtoMessage() {}
+func (*ContractInflationUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f478faffe74434, []int{10}
+}
+func (m *ContractInflationUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractInflationUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractInflationUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.RewardsAddress) > 0 {
-		i -= len(m.RewardsAddress)
-		copy(dAtA[i:], m.RewardsAddress)
-		i = encodeVarintRewards(dAtA, i, uint64(len(m.RewardsAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Id != 0 {
-		i = encodeVarintRewards(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *ContractInflationUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractInflationUsage.Merge(m, src)
+}
+func (m *ContractInflationUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractInflationUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractInflationUsage.DiscardUnknown(m)
 }
 
-func encodeVarintRewards(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRewards(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_ContractInflationUsage proto.InternalMessageInfo
+
+func (m *ContractInflationUsage) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.InflationRewardsRatio.Size()
-	n += 1 + l + sovRewards(uint64(l))
-	l = m.TxFeeRebateRatio.Size()
-	n += 1 + l + sovRewards(uint64(l))
-	if m.MaxWithdrawRecords != 0 {
-		n += 1 + sovRewards(uint64(m.MaxWithdrawRecords))
+
+func (m *ContractInflationUsage) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
 	}
-	return n
+	return 0
 }
 
-func (m *ContractMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+// ContractOperationWeight defines the gas weight for a contract operation type.
+type ContractOperationWeight struct {
+	// operation_type defines the contract operation type.
+	OperationType types1.ContractOperation `protobuf:"varint,1,opt,name=operation_type,json=operationType,proto3,enum=archway.tracking.v1beta1.ContractOperation" json:"operation_type,omitempty"`
+	// weight defines the operation gas multiplier.
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *ContractOperationWeight) Reset()         { *m = ContractOperationWeight{} }
+func (m *ContractOperationWeight) String() string { return proto.CompactTextString(m) }
+func (*ContractOperationWeight) ProtoMessage()    {}
+func (*ContractOperationWeight) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f478faffe74434, []int{11}
+}
+func (m *ContractOperationWeight) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractOperationWeight) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractOperationWeight.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.ContractAddress)
-	if l > 0 {
-		n += 1 + l + sovRewards(uint64(l))
+}
+func (m *ContractOperationWeight) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractOperationWeight.Merge(m, src)
+}
+func (m *ContractOperationWeight) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractOperationWeight) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractOperationWeight.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractOperationWeight proto.InternalMessageInfo
+
+func (m *ContractOperationWeight) GetOperationType() types1.ContractOperation {
+	if m != nil {
+		return m.OperationType
 	}
-	l = len(m.OwnerAddress)
-	if l > 0 {
-		n += 1 + l + sovRewards(uint64(l))
+	return types1.ContractOperation_CONTRACT_OPERATION_UNSPECIFIED
+}
+
+// FeeDenom defines an alternative denom accepted for the minimum consensus fee.
+type FeeDenom struct {
+	// denom defines the accepted fee coin denom.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate defines the conversion rate to the base (inflation) denom: the base denom amount equal to 1 unit of the denom.
+	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+}
+
+func (m *FeeDenom) Reset()         { *m = FeeDenom{} }
+func (m *FeeDenom) String() string { return proto.CompactTextString(m) }
+func (*FeeDenom) ProtoMessage()    {}
+func (*FeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f478faffe74434, []int{12}
+}
+func (m *FeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.RewardsAddress)
-	if l > 0 {
-		n += 1 + l + sovRewards(uint64(l))
+}
+func (m *FeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenom.Merge(m, src)
+}
+func (m *FeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenom proto.InternalMessageInfo
+
+func (m *FeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return n
+	return ""
 }
 
-func (m *BlockRewards) Size() (n int) {
-	if m == nil {
-		return 0
+// MinConsensusFeeRecord defines the minimum consensus fee value set at a particular block height.
+// Records are kept for the min_consensus_fee_history_blocks number of blocks.
+type MinConsensusFeeRecord struct {
+	// height defines the block height the fee was set at.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// fee defines the minimum gas unit price set.
+	Fee types.DecCoin `protobuf:"bytes,2,opt,name=fee,proto3" json:"fee"`
+}
+
+func (m *MinConsensusFeeRecord) Reset()      { *m = MinConsensusFeeRecord{} }
+func (*MinConsensusFeeRecord) ProtoMessage() {}
+func (*MinConsensusFeeRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f478faffe74434, []int{13}
+}
+func (m *MinConsensusFeeRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinConsensusFeeRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinConsensusFeeRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.Height != 0 {
-		n += 1 + sovRewards(uint64(m.Height))
+}
+func (m *MinConsensusFeeRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinConsensusFeeRecord.Merge(m, src)
+}
+func (m *MinConsensusFeeRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinConsensusFeeRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinConsensusFeeRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinConsensusFeeRecord proto.InternalMessageInfo
+
+func (m *MinConsensusFeeRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
 	}
-	l = m.InflationRewards.Size()
-	n += 1 + l + sovRewards(uint64(l))
-	if m.MaxGas != 0 {
-		n += 1 + sovRewards(uint64(m.MaxGas))
+	return 0
+}
+
+func (m *MinConsensusFeeRecord) GetFee() types.DecCoin {
+	if m != nil {
+		return m.Fee
 	}
-	return n
+	return types.DecCoin{}
 }
 
-func (m *TxRewards) Size() (n int) {
-	if m == nil {
-		return 0
+// TxPriorityTier defines the mempool priority of a transaction paying fees of at least the min_fee_ratio share
+// of the minimum gas unit price (the higher of the minimum consensus fee and the base fee).
+type TxPriorityTier struct {
+	// min_fee_ratio defines the lower bound of the transaction gas unit price to the minimum gas unit price ratio.
+	MinFeeRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=min_fee_ratio,json=minFeeRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_fee_ratio"`
+	// priority defines the CheckTx priority assigned to a transaction.
+	Priority int64 `protobuf:"varint,2,opt,name=priority,proto3" json:"priority,omitempty"`
+}
+
+func (m *TxPriorityTier) Reset()         { *m = TxPriorityTier{} }
+func (m *TxPriorityTier) String() string { return proto.CompactTextString(m) }
+func (*TxPriorityTier) ProtoMessage()    {}
+func (*TxPriorityTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f478faffe74434, []int{14}
+}
+func (m *TxPriorityTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TxPriorityTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TxPriorityTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if m.TxId != 0 {
-		n += 1 + sovRewards(uint64(m.TxId))
+}
+func (m *TxPriorityTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TxPriorityTier.Merge(m, src)
+}
+func (m *TxPriorityTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *TxPriorityTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_TxPriorityTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TxPriorityTier proto.InternalMessageInfo
+
+func (m *TxPriorityTier) GetPriority() int64 {
+	if m != nil {
+		return m.Priority
 	}
-	if m.Height != 0 {
-		n += 1 + sovRewards(uint64(m.Height))
+	return 0
+}
+
+// Sponsorship defines the contract transaction fees sponsorship.
+// Fees of transactions executing only the sponsored contract are paid from the deposit (within limits).
+// Sponsorship is disabled until both limits are set.
+type Sponsorship struct {
+	// contract_address is the sponsoring contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// deposit defines the funds left to pay transaction fees with.
+	Deposit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=deposit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"deposit"`
+	// user_fee_limit defines the maximum amount of fees sponsored for a single user within a user_fee_limit_period (empty if disabled).
+	UserFeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=user_fee_limit,json=userFeeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"user_fee_limit"`
+	// block_fee_limit defines the maximum amount of fees sponsored within a single block (empty if disabled).
+	BlockFeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=block_fee_limit,json=blockFeeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"block_fee_limit"`
+	// fund_from_rewards flag defines whether the contract rewards are pledged to the deposit instead of creating RewardsRecords.
+	FundFromRewards bool `protobuf:"varint,5,opt,name=fund_from_rewards,json=fundFromRewards,proto3" json:"fund_from_rewards,omitempty"`
+	// block_height defines the block height of the last sponsored transaction.
+	BlockHeight int64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// block_fees defines the amount of fees sponsored within the block_height block.
+	BlockFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=block_fees,json=blockFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"block_fees"`
+	// user_fee_limit_period defines the number of blocks the user_fee_limit is applied within (user usage is reset every period).
+	UserFeeLimitPeriod uint64 `protobuf:"varint,8,opt,name=user_fee_limit_period,json=userFeeLimitPeriod,proto3" json:"user_fee_limit_period,omitempty"`
+}
+
+func (m *Sponsorship) Reset()      { *m = Sponsorship{} }
+func (*Sponsorship) ProtoMessage() {}
+func (*Sponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f478faffe74434, []int{15}
+}
+func (m *Sponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Sponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Sponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.FeeRewards) > 0 {
-		for _, e := range m.FeeRewards {
-			l = e.Size()
-			n += 1 + l + sovRewards(uint64(l))
+}
+func (m *Sponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sponsorship.Merge(m, src)
+}
+func (m *Sponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *Sponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_Sponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Sponsorship proto.InternalMessageInfo
+
+func (m *Sponsorship) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *Sponsorship) GetDeposit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Deposit
+	}
+	return nil
+}
+
+func (m *Sponsorship) GetUserFeeLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UserFeeLimit
+	}
+	return nil
+}
+
+func (m *Sponsorship) GetBlockFeeLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BlockFeeLimit
+	}
+	return nil
+}
+
+func (m *Sponsorship) GetFundFromRewards() bool {
+	if m != nil {
+		return m.FundFromRewards
+	}
+	return false
+}
+
+func (m *Sponsorship) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *Sponsorship) GetBlockFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BlockFees
+	}
+	return nil
+}
+
+func (m *Sponsorship) GetUserFeeLimitPeriod() uint64 {
+	if m != nil {
+		return m.UserFeeLimitPeriod
+	}
+	return 0
+}
+
+// SponsorshipUsage defines the amount of fees sponsored by a contract for a particular user.
+// Object is used to apply the Sponsorship user_fee_limit.
+type SponsorshipUsage struct {
+	// contract_address is the sponsoring contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// user_address is the sponsored fee payer address (bech32 encoded).
+	UserAddress string `protobuf:"bytes,2,opt,name=user_address,json=userAddress,proto3" json:"user_address,omitempty"`
+	// fees defines the total amount of fees sponsored within the period.
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	// period defines the user_fee_limit_period index (block height / user_fee_limit_period) the fees are tracked for.
+	Period uint64 `protobuf:"varint,4,opt,name=period,proto3" json:"period,omitempty"`
+}
+
+func (m *SponsorshipUsage) Reset()      { *m = SponsorshipUsage{} }
+func (*SponsorshipUsage) ProtoMessage() {}
+func (*SponsorshipUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_50f478faffe74434, []int{16}
+}
+func (m *SponsorshipUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SponsorshipUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SponsorshipUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SponsorshipUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SponsorshipUsage.Merge(m, src)
+}
+func (m *SponsorshipUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *SponsorshipUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_SponsorshipUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SponsorshipUsage proto.InternalMessageInfo
+
+func (m *SponsorshipUsage) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *SponsorshipUsage) GetUserAddress() string {
+	if m != nil {
+		return m.UserAddress
+	}
+	return ""
+}
+
+func (m *SponsorshipUsage) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func (m *SponsorshipUsage) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("archway.rewards.v1beta1.TreasuryOperationType", TreasuryOperationType_name, TreasuryOperationType_value)
+	proto.RegisterEnum("archway.rewards.v1beta1.SelfDealingPolicy", SelfDealingPolicy_name, SelfDealingPolicy_value)
+	proto.RegisterEnum("archway.rewards.v1beta1.MinConsensusFeeMode", MinConsensusFeeMode_name, MinConsensusFeeMode_value)
+	proto.RegisterType((*Params)(nil), "archway.rewards.v1beta1.Params")
+	proto.RegisterType((*ContractMetadata)(nil), "archway.rewards.v1beta1.ContractMetadata")
+	proto.RegisterType((*RewardsRecipient)(nil), "archway.rewards.v1beta1.RewardsRecipient")
+	proto.RegisterType((*FlatFee)(nil), "archway.rewards.v1beta1.FlatFee")
+	proto.RegisterType((*BlockRewards)(nil), "archway.rewards.v1beta1.BlockRewards")
+	proto.RegisterType((*TxRewards)(nil), "archway.rewards.v1beta1.TxRewards")
+	proto.RegisterType((*RewardsRecord)(nil), "archway.rewards.v1beta1.RewardsRecord")
+	proto.RegisterType((*TreasuryOperation)(nil), "archway.rewards.v1beta1.TreasuryOperation")
+	proto.RegisterType((*CodeMetadata)(nil), "archway.rewards.v1beta1.CodeMetadata")
+	proto.RegisterType((*AutoPayout)(nil), "archway.rewards.v1beta1.AutoPayout")
+	proto.RegisterType((*ContractInflationUsage)(nil), "archway.rewards.v1beta1.ContractInflationUsage")
+	proto.RegisterType((*ContractOperationWeight)(nil), "archway.rewards.v1beta1.ContractOperationWeight")
+	proto.RegisterType((*FeeDenom)(nil), "archway.rewards.v1beta1.FeeDenom")
+	proto.RegisterType((*MinConsensusFeeRecord)(nil), "archway.rewards.v1beta1.MinConsensusFeeRecord")
+	proto.RegisterType((*TxPriorityTier)(nil), "archway.rewards.v1beta1.TxPriorityTier")
+	proto.RegisterType((*Sponsorship)(nil), "archway.rewards.v1beta1.Sponsorship")
+	proto.RegisterType((*SponsorshipUsage)(nil), "archway.rewards.v1beta1.SponsorshipUsage")
+}
+
+func init() {
+	proto.RegisterFile("archway/rewards/v1beta1/rewards.proto", fileDescriptor_50f478faffe74434)
+}
+
+var fileDescriptor_50f478faffe74434 = []byte{
+	// 2014 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0xcf, 0x53, 0x1b, 0xc9,
+	0xf5, 0x67, 0x24, 0x59, 0xe0, 0x07, 0x08, 0xd1, 0x18, 0x33, 0x66, 0x59, 0xc0, 0x6c, 0x7d, 0xbf,
+	0x66, 0xd9, 0x5d, 0x61, 0x93, 0x54, 0x25, 0x71, 0x2e, 0x0b, 0xfa, 0x61, 0xab, 0x0a, 0x84, 0x6a,
+	0x24, 0xca, 0x61, 0xab, 0x92, 0xa9, 0x66, 0xa6, 0x25, 0x4d, 0xd0, 0x4c, 0x4f, 0xa6, 0x5b, 0x46,
+	0xec, 0x29, 0x87, 0xec, 0x7d, 0xab, 0x72, 0xd9, 0x63, 0x2e, 0xa9, 0x54, 0xe5, 0x9e, 0xfc, 0x01,
+	0x39, 0xed, 0x71, 0x8f, 0xa9, 0x1c, 0xd6, 0x29, 0xfb, 0x9c, 0xbf, 0x20, 0x87, 0xa4, 0xba, 0xa7,
+	0x7b, 0x24, 0x84, 0x54, 0x6b, 0x88, 0x7d, 0x82, 0xe9, 0x7e, 0xef, 0x7d, 0xde, 0xef, 0xf7, 0x5a,
+	0xf0, 0x7f, 0x38, 0x72, 0x3a, 0x17, 0xf8, 0x72, 0x37, 0x22, 0x17, 0x38, 0x72, 0xd9, 0xee, 0xcb,
+	0x27, 0x67, 0x84, 0xe3, 0x27, 0xfa, 0xbb, 0x10, 0x46, 0x94, 0x53, 0xb4, 0xa2, 0xc8, 0x0a, 0xfa,
+	0x58, 0x91, 0xad, 0xde, 0x6b, 0xd3, 0x36, 0x95, 0x34, 0xbb, 0xe2, 0xbf, 0x98, 0x7c, 0x75, 0xa3,
+	0x4d, 0x69, 0xbb, 0x4b, 0x76, 0xe5, 0xd7, 0x59, 0xaf, 0xb5, 0xcb, 0x3d, 0x9f, 0x30, 0x8e, 0xfd,
+	0x50, 0x11, 0xac, 0x8f, 0x12, 0xb8, 0xbd, 0x08, 0x73, 0x8f, 0x06, 0xfa, 0xde, 0xa1, 0xcc, 0xa7,
+	0x6c, 0xf7, 0x0c, 0x33, 0x92, 0xa8, 0xe4, 0x50, 0x4f, 0xdf, 0x3f, 0xd2, 0x6a, 0xf3, 0x08, 0x3b,
+	0xe7, 0x5e, 0xd0, 0x4e, 0x88, 0xf4, 0x41, 0x4c, 0xb8, 0xf5, 0xbb, 0x1c, 0x64, 0xeb, 0x38, 0xc2,
+	0x3e, 0x43, 0x2d, 0x58, 0xf1, 0x82, 0x56, 0x57, 0xc2, 0xd8, 0xca, 0x0e, 0x5b, 0xa2, 0x9a, 0xc6,
+	0xa6, 0xb1, 0x7d, 0xf7, 0xa0, 0xf0, 0xed, 0xf7, 0x1b, 0x53, 0xff, 0xf8, 0x7e, 0xe3, 0xff, 0xdb,
+	0x1e, 0xef, 0xf4, 0xce, 0x0a, 0x0e, 0xf5, 0x77, 0x95, 0x1e, 0xf1, 0x9f, 0xcf, 0x98, 0x7b, 0xbe,
+	0xcb, 0x2f, 0x43, 0xc2, 0x0a, 0x25, 0xe2, 0x58, 0xcb, 0x89, 0x38, 0x2b, 0x96, 0x66, 0x89, 0x0f,
+	0xf4, 0x4b, 0x58, 0xe2, 0x7d, 0xbb, 0x45, 0x88, 0x1d, 0x91, 0x33, 0xcc, 0x89, 0xc2, 0x48, 0xdd,
+	0x0a, 0x23, 0xcf, 0xfb, 0x15, 0x42, 0x2c, 0x29, 0x28, 0x16, 0xff, 0x18, 0xee, 0xf9, 0xb8, 0x6f,
+	0x5f, 0x78, 0xbc, 0xe3, 0x46, 0xf8, 0xc2, 0x8e, 0x88, 0x43, 0x23, 0x97, 0x99, 0xe9, 0x4d, 0x63,
+	0x3b, 0x63, 0x21, 0x1f, 0xf7, 0x5f, 0xa8, 0x2b, 0x2b, 0xbe, 0x41, 0x2f, 0x60, 0x39, 0x31, 0x57,
+	0x1e, 0xd9, 0xa4, 0x1f, 0x7a, 0xd1, 0xa5, 0x99, 0xd9, 0x34, 0xb6, 0x67, 0xf7, 0x1e, 0x14, 0xe2,
+	0x60, 0x14, 0x74, 0x30, 0x0a, 0x25, 0x15, 0x8c, 0x83, 0x19, 0xa1, 0xed, 0x37, 0xaf, 0x36, 0x0c,
+	0x6b, 0x49, 0x49, 0x88, 0x65, 0x96, 0x25, 0x3f, 0x7a, 0x0a, 0xab, 0x42, 0x15, 0xdc, 0xe3, 0xd4,
+	0x0e, 0xf1, 0x25, 0xed, 0x71, 0x66, 0x87, 0x24, 0xb2, 0xcf, 0xba, 0xd4, 0x39, 0x37, 0xef, 0x48,
+	0x85, 0xee, 0xfb, 0xb8, 0xbf, 0xdf, 0xe3, 0xb4, 0x1e, 0xdf, 0xd7, 0x49, 0x74, 0x20, 0x6e, 0x11,
+	0x85, 0x35, 0x87, 0x06, 0x22, 0x5a, 0xdc, 0x1e, 0x84, 0x85, 0x75, 0x70, 0x44, 0x6c, 0x07, 0x87,
+	0x66, 0xf6, 0x56, 0xee, 0x7a, 0xa0, 0x65, 0x56, 0xb5, 0xc8, 0x86, 0x90, 0x58, 0xc4, 0xe1, 0x04,
+	0x40, 0x12, 0x52, 0xa7, 0x23, 0x01, 0xa7, 0x6f, 0x0c, 0x58, 0x0d, 0xf8, 0x18, 0xc0, 0xb2, 0x90,
+	0x28, 0x00, 0x7f, 0x0e, 0xab, 0x03, 0x1c, 0x07, 0x87, 0x0a, 0x4b, 0x3a, 0x87, 0x99, 0x33, 0xd2,
+	0x3b, 0x83, 0x8c, 0x2c, 0xe2, 0x50, 0x72, 0x4a, 0xef, 0x30, 0xf4, 0x05, 0x2c, 0x31, 0xd2, 0x6d,
+	0xd9, 0x2e, 0xc1, 0x5d, 0x2f, 0x68, 0xdb, 0x21, 0xed, 0x7a, 0xce, 0xa5, 0x79, 0x77, 0xd3, 0xd8,
+	0xce, 0xed, 0xed, 0x14, 0x26, 0x94, 0x63, 0xa1, 0x41, 0xba, 0xad, 0x52, 0xcc, 0x52, 0x97, 0x1c,
+	0xd6, 0x22, 0x1b, 0x3d, 0x42, 0x1c, 0x56, 0x13, 0x4f, 0xd0, 0x90, 0xc4, 0xb1, 0xb6, 0x2f, 0x88,
+	0xd7, 0xee, 0x70, 0x66, 0xc2, 0x66, 0x7a, 0x7b, 0x76, 0xef, 0xf1, 0x44, 0x88, 0xa2, 0x62, 0x3d,
+	0xd6, 0x9c, 0x2f, 0x24, 0xe3, 0x41, 0x46, 0x78, 0xce, 0x32, 0x9d, 0xf1, 0xd7, 0x22, 0x0b, 0x97,
+	0xb0, 0xe3, 0x90, 0x90, 0x13, 0x57, 0x16, 0x87, 0x4b, 0x02, 0xea, 0x33, 0x73, 0x56, 0xc2, 0x3d,
+	0x9c, 0x08, 0x57, 0x21, 0xa4, 0x24, 0x28, 0x95, 0xfc, 0x45, 0x2d, 0x43, 0x9f, 0x33, 0x84, 0xe1,
+	0xbe, 0xef, 0x05, 0xb6, 0x43, 0x03, 0x46, 0x02, 0xd6, 0x63, 0x52, 0xba, 0x4f, 0x5d, 0x62, 0xce,
+	0x49, 0x6f, 0x7d, 0x3a, 0x51, 0xf6, 0x91, 0x17, 0x14, 0x35, 0x57, 0x85, 0x90, 0x23, 0xea, 0x12,
+	0x6b, 0xc9, 0xbf, 0x7e, 0x88, 0x7e, 0x02, 0xe6, 0x75, 0x88, 0x0b, 0x2f, 0x70, 0xe9, 0x85, 0x39,
+	0x2f, 0x03, 0xb9, 0x3c, 0xc2, 0xf6, 0x42, 0x5e, 0xa2, 0x0a, 0x6c, 0x5e, 0x67, 0xec, 0x78, 0x8c,
+	0xd3, 0xe8, 0x52, 0x67, 0x42, 0x4e, 0x0a, 0x58, 0x1b, 0x11, 0xf0, 0x3c, 0x26, 0x52, 0xe9, 0xd0,
+	0x02, 0x53, 0xb4, 0xc2, 0xd8, 0x34, 0xdc, 0xb7, 0x9d, 0x0e, 0x0e, 0xda, 0xb2, 0xb3, 0x10, 0x73,
+	0xe1, 0x56, 0x95, 0x72, 0x4f, 0xc8, 0x13, 0xf6, 0xe1, 0x7e, 0x51, 0x0a, 0xb3, 0x30, 0x27, 0xc8,
+	0x87, 0x0f, 0x12, 0x1c, 0x8e, 0xa3, 0x36, 0xe1, 0x76, 0x8f, 0x7b, 0x5d, 0xef, 0x4b, 0x19, 0x4a,
+	0x33, 0x7f, 0x2b, 0x28, 0x53, 0x41, 0x35, 0xa5, 0xc0, 0x93, 0x81, 0x3c, 0xd1, 0x2a, 0x13, 0xb8,
+	0xb3, 0x5e, 0x14, 0xa8, 0x56, 0xb9, 0x78, 0xbb, 0x56, 0xa9, 0x60, 0x0e, 0x7a, 0x51, 0x10, 0xb7,
+	0xca, 0x53, 0x58, 0xe4, 0x7d, 0x3b, 0x8c, 0x3c, 0x1a, 0x79, 0xfc, 0xd2, 0xe6, 0x1e, 0x89, 0x98,
+	0x89, 0x64, 0xc2, 0x3d, 0x9a, 0x98, 0x14, 0xcd, 0x7e, 0x5d, 0x31, 0x34, 0x3d, 0x12, 0xa9, 0xb4,
+	0x5b, 0xe0, 0x57, 0x4e, 0x19, 0x22, 0xb0, 0xd2, 0x0b, 0x7a, 0x8c, 0xb8, 0x76, 0x1b, 0x8b, 0xb6,
+	0xda, 0xea, 0x05, 0xae, 0xd2, 0x7e, 0xe9, 0x76, 0xf1, 0x88, 0xc5, 0x3d, 0xc3, 0xcc, 0x92, 0xc2,
+	0x62, 0x0b, 0x3e, 0x87, 0x0f, 0x45, 0xb8, 0x65, 0xbf, 0x26, 0xae, 0xee, 0xf5, 0x43, 0x4d, 0xf6,
+	0x9e, 0x4c, 0x9e, 0x07, 0x3e, 0xee, 0x97, 0x63, 0x1a, 0xd5, 0xf4, 0x75, 0x9f, 0x7d, 0x9a, 0xf9,
+	0xe6, 0x0f, 0x1b, 0x53, 0x5b, 0x7f, 0x4c, 0x41, 0x5e, 0x17, 0xee, 0x11, 0xe1, 0xd8, 0xc5, 0x1c,
+	0xa3, 0x8f, 0x21, 0x9f, 0xf4, 0x01, 0xec, 0xba, 0x11, 0x61, 0x2c, 0x9e, 0x84, 0xd6, 0x82, 0x3e,
+	0xdf, 0x8f, 0x8f, 0xd1, 0x47, 0x30, 0x4f, 0x2f, 0x02, 0x12, 0x25, 0x74, 0x72, 0x9a, 0x59, 0x73,
+	0xf2, 0x50, 0x13, 0x3d, 0x82, 0x05, 0x3d, 0x67, 0x34, 0x59, 0x5a, 0x92, 0xe5, 0xd4, 0xb1, 0x26,
+	0xfc, 0x15, 0xa0, 0xa1, 0x81, 0xe4, 0x85, 0x1e, 0x09, 0x38, 0x33, 0x33, 0x32, 0x30, 0x1f, 0x4f,
+	0x0c, 0x8c, 0x95, 0x4c, 0xa0, 0x98, 0x43, 0x77, 0x84, 0x68, 0xe4, 0x9c, 0xa1, 0x3d, 0x58, 0x0e,
+	0x49, 0xe0, 0x8a, 0xbe, 0x79, 0x55, 0xeb, 0x3b, 0x52, 0x9d, 0x25, 0x75, 0x79, 0x3c, 0xa4, 0xbc,
+	0xf2, 0xd3, 0x97, 0x90, 0x1f, 0x85, 0x41, 0x26, 0x4c, 0x5f, 0xf5, 0x8e, 0xfe, 0x44, 0x15, 0xc8,
+	0xc6, 0x5d, 0xf3, 0x96, 0xc3, 0x5d, 0x71, 0x2b, 0xec, 0x97, 0x30, 0x5d, 0xe9, 0x62, 0x5e, 0x21,
+	0xe4, 0x26, 0x91, 0x79, 0x0a, 0x33, 0x62, 0x82, 0x88, 0x12, 0x32, 0x53, 0x6a, 0x9e, 0xc7, 0x60,
+	0x05, 0x51, 0x0f, 0x43, 0x6d, 0xdb, 0x0b, 0x94, 0xc7, 0xa6, 0x5b, 0x31, 0x8c, 0xc2, 0xfd, 0xbd,
+	0x01, 0x73, 0x32, 0x57, 0x94, 0xe5, 0xe8, 0x3e, 0x64, 0x3b, 0xb1, 0x59, 0x02, 0x33, 0x6d, 0xa9,
+	0x2f, 0x74, 0x08, 0x8b, 0xd7, 0x16, 0xa8, 0xb7, 0xc5, 0xcc, 0x8f, 0xee, 0x4a, 0x68, 0x05, 0xa6,
+	0x45, 0x6a, 0xb7, 0xb1, 0x5e, 0x5d, 0xb2, 0x3e, 0xee, 0x3f, 0xc3, 0x3a, 0x12, 0xff, 0x32, 0xe0,
+	0x6e, 0xb3, 0xaf, 0x89, 0x97, 0xe0, 0x0e, 0xef, 0xdb, 0x9e, 0x2b, 0x35, 0xca, 0x58, 0x19, 0xde,
+	0xaf, 0xba, 0x43, 0x7a, 0xa6, 0xae, 0xe8, 0xf9, 0x39, 0xcc, 0xc6, 0xdb, 0x57, 0xac, 0x61, 0x7a,
+	0x33, 0xfd, 0x36, 0x1a, 0x42, 0x4b, 0xec, 0x59, 0x31, 0xdc, 0x11, 0x20, 0x21, 0xc1, 0xa1, 0xdd,
+	0x2e, 0x71, 0x38, 0x8d, 0x84, 0x77, 0x75, 0x82, 0xfe, 0xb0, 0xa9, 0x2d, 0x42, 0x8a, 0x9a, 0xb3,
+	0x42, 0x08, 0x43, 0x6b, 0x70, 0x97, 0x85, 0x34, 0x60, 0x34, 0x22, 0xae, 0xcc, 0xc1, 0x19, 0x6b,
+	0x70, 0xa0, 0xec, 0xfd, 0x2a, 0x05, 0xf3, 0xd6, 0xf0, 0x8e, 0x85, 0x72, 0x90, 0x4a, 0x0c, 0x4e,
+	0x79, 0xee, 0xb8, 0xf2, 0x4a, 0x8d, 0x2d, 0xaf, 0x9f, 0xc1, 0xf4, 0x0d, 0x6d, 0xd7, 0xf4, 0xe8,
+	0x13, 0x58, 0x74, 0x70, 0xd7, 0xe9, 0x75, 0xb1, 0x18, 0xd3, 0xca, 0xbb, 0x19, 0xe9, 0xdd, 0xfc,
+	0xe0, 0xe2, 0x79, 0xec, 0xe7, 0x23, 0x58, 0x18, 0x22, 0x16, 0x2b, 0xbe, 0x34, 0x6e, 0x76, 0x6f,
+	0xf5, 0xda, 0x46, 0xd9, 0xd4, 0xfb, 0x7f, 0xbc, 0x52, 0x7e, 0x2d, 0x56, 0xca, 0xdc, 0x80, 0x59,
+	0x5c, 0x2b, 0x3f, 0xfc, 0x2d, 0x05, 0x8b, 0xcd, 0x88, 0x60, 0xd6, 0x8b, 0x2e, 0x93, 0x1d, 0xe2,
+	0x9a, 0x2f, 0x0e, 0x20, 0x23, 0xca, 0x48, 0x3a, 0x20, 0xb7, 0x57, 0x98, 0xdc, 0xcc, 0x47, 0x25,
+	0x35, 0x2f, 0x43, 0x62, 0x49, 0x5e, 0x11, 0x95, 0xa4, 0xfb, 0xa8, 0x46, 0x35, 0x38, 0x40, 0x0e,
+	0x64, 0xb1, 0x4f, 0x7b, 0x01, 0xff, 0xe1, 0xb0, 0x3f, 0x16, 0x26, 0xfd, 0xf9, 0xd5, 0xc6, 0xf6,
+	0x5b, 0x94, 0xbd, 0x60, 0x60, 0x96, 0x12, 0x3d, 0x94, 0xc1, 0x77, 0xae, 0x64, 0xf0, 0x4f, 0x21,
+	0x23, 0xdd, 0x99, 0xbd, 0x81, 0x3b, 0x33, 0x7c, 0xe0, 0xc4, 0xbf, 0x1a, 0x30, 0x57, 0xa4, 0x2e,
+	0x49, 0x5a, 0xfd, 0x0a, 0x4c, 0x3b, 0xd4, 0x25, 0x83, 0x0a, 0xca, 0x8a, 0xcf, 0xea, 0x0d, 0x92,
+	0x6a, 0x7c, 0xcf, 0x4e, 0xbf, 0xab, 0x9e, 0xad, 0x14, 0xff, 0x8f, 0x01, 0x30, 0x78, 0x2d, 0xdc,
+	0xa4, 0x0f, 0x3e, 0x82, 0x05, 0x2f, 0xe0, 0x24, 0x7a, 0x89, 0xbb, 0x7a, 0xb1, 0x4a, 0x49, 0x4b,
+	0x73, 0xfa, 0x58, 0xad, 0x52, 0x1e, 0xdc, 0xe5, 0x9d, 0x88, 0xb0, 0x0e, 0xed, 0xba, 0x66, 0xfa,
+	0xdd, 0xc7, 0x76, 0x20, 0x1d, 0x7d, 0x0a, 0xa8, 0x8b, 0x19, 0x57, 0x6f, 0xa3, 0x91, 0x72, 0x12,
+	0x37, 0xb1, 0x99, 0xcf, 0x87, 0xa7, 0xc0, 0x9f, 0x0c, 0xb8, 0x5f, 0x1c, 0x7d, 0x53, 0x9c, 0x30,
+	0xdc, 0xbe, 0xd1, 0x54, 0xb8, 0x07, 0x77, 0xe4, 0x6b, 0x43, 0xf9, 0x20, 0xfe, 0x10, 0xf3, 0x4a,
+	0xe5, 0x74, 0xfa, 0x56, 0x8f, 0x1d, 0xc5, 0xad, 0x34, 0xfd, 0x8b, 0x01, 0x2b, 0x13, 0x1e, 0x03,
+	0xc8, 0x82, 0xdc, 0xe0, 0x65, 0x21, 0x2b, 0xd5, 0x90, 0x95, 0xfa, 0x49, 0x92, 0x29, 0xc9, 0x3b,
+	0x7d, 0xe2, 0xbb, 0xc2, 0x9a, 0xa7, 0xc3, 0x55, 0xfb, 0xae, 0xa6, 0xed, 0x96, 0x0b, 0x33, 0xfa,
+	0xf1, 0x20, 0xfc, 0x24, 0xdf, 0x21, 0xca, 0x8f, 0xf1, 0x87, 0xe8, 0x2e, 0x72, 0xb3, 0xbe, 0x1d,
+	0x8e, 0xe4, 0xdd, 0x3a, 0x87, 0xe5, 0x91, 0xe7, 0x85, 0x6a, 0xeb, 0x93, 0xa6, 0xeb, 0x8f, 0x21,
+	0x3d, 0x98, 0xe1, 0x6b, 0x63, 0x33, 0xb2, 0x44, 0x9c, 0xa1, 0xa6, 0x2d, 0xc8, 0x55, 0x28, 0x7e,
+	0x6b, 0x40, 0xee, 0xea, 0xde, 0x8a, 0x2c, 0x98, 0x17, 0x2f, 0x0f, 0x39, 0x08, 0xff, 0x87, 0xdf,
+	0x38, 0x66, 0x7d, 0x2f, 0x10, 0xba, 0xcb, 0x6d, 0x74, 0x15, 0x66, 0xf4, 0x32, 0xad, 0x46, 0x6e,
+	0xf2, 0xbd, 0xf5, 0xef, 0x0c, 0xcc, 0x36, 0xe2, 0x99, 0xc6, 0x3a, 0x5e, 0x78, 0x93, 0x64, 0x25,
+	0x30, 0xed, 0x92, 0x90, 0x32, 0x4f, 0x44, 0xf6, 0x9d, 0xd7, 0xa3, 0x96, 0x8d, 0x7e, 0x03, 0xb9,
+	0x1e, 0x23, 0x72, 0x96, 0xdb, 0x5d, 0xcf, 0xf7, 0xf8, 0xfb, 0xa8, 0xfe, 0x39, 0x01, 0x51, 0x21,
+	0xe4, 0x50, 0x00, 0x20, 0x06, 0x0b, 0xb2, 0x17, 0x0d, 0x61, 0xbe, 0x87, 0x69, 0x32, 0x2f, 0x31,
+	0x12, 0xd0, 0x1d, 0x58, 0x94, 0xaf, 0x91, 0x56, 0x44, 0xfd, 0x64, 0x09, 0x8a, 0xb7, 0x8e, 0x05,
+	0x71, 0x51, 0x89, 0xa8, 0xaf, 0x17, 0x9d, 0x87, 0x30, 0x17, 0x2b, 0xa8, 0x52, 0x32, 0x2b, 0xa3,
+	0x3a, 0x2b, 0xcf, 0xd4, 0x94, 0xff, 0x35, 0x40, 0x62, 0x03, 0x33, 0xa7, 0xdf, 0x43, 0xc3, 0xd4,
+	0xea, 0x33, 0xf4, 0x04, 0x96, 0xaf, 0x86, 0x48, 0xbc, 0x74, 0x3c, 0xea, 0xaa, 0x5f, 0x4b, 0xd0,
+	0xb0, 0x73, 0xeb, 0xf2, 0x46, 0x15, 0xc0, 0x2b, 0x03, 0xf2, 0x43, 0xd9, 0x77, 0xe3, 0x7e, 0xf9,
+	0x10, 0x64, 0xe0, 0x46, 0x66, 0xe0, 0xac, 0x38, 0xd3, 0x24, 0x36, 0x64, 0xa4, 0x07, 0xde, 0x43,
+	0xd2, 0x48, 0xc1, 0xa2, 0x31, 0x28, 0x6b, 0x33, 0xf1, 0x88, 0x0e, 0x87, 0x2c, 0xdc, 0xf9, 0xca,
+	0x80, 0xe5, 0xb1, 0xdb, 0x0c, 0x7a, 0x04, 0x1f, 0x35, 0xad, 0xf2, 0x7e, 0xe3, 0xc4, 0x3a, 0xb5,
+	0x8f, 0xeb, 0x65, 0x6b, 0xbf, 0x59, 0x3d, 0xae, 0xd9, 0xcd, 0xd3, 0x7a, 0xd9, 0x3e, 0xa9, 0x35,
+	0xea, 0xe5, 0x62, 0xb5, 0x52, 0x2d, 0x97, 0xf2, 0x53, 0xe8, 0x21, 0x7c, 0x38, 0x89, 0xb0, 0x51,
+	0x2f, 0xd7, 0x4a, 0x79, 0x03, 0x6d, 0xc2, 0xda, 0x24, 0x92, 0x83, 0x13, 0xab, 0x96, 0x4f, 0xed,
+	0xbc, 0x84, 0xc5, 0x6b, 0x3f, 0x32, 0xa1, 0x35, 0x30, 0x1b, 0xe5, 0xc3, 0x8a, 0x5d, 0x2a, 0xef,
+	0x1f, 0x56, 0x6b, 0xcf, 0xec, 0xfa, 0xf1, 0x61, 0xb5, 0x78, 0x6a, 0xd7, 0x8e, 0x6b, 0xe5, 0xfc,
+	0x14, 0xda, 0x80, 0x0f, 0xc6, 0xdd, 0x96, 0x7f, 0x51, 0x3c, 0x3c, 0x29, 0x95, 0xf3, 0x06, 0xda,
+	0x82, 0xf5, 0x71, 0x04, 0xc5, 0xfd, 0xba, 0xdd, 0x3c, 0xb6, 0x2b, 0xe5, 0x72, 0x3e, 0xb5, 0x73,
+	0x0a, 0x4b, 0x63, 0x7e, 0xae, 0x11, 0xac, 0x47, 0xd5, 0x9a, 0x5d, 0x3c, 0xae, 0x35, 0xca, 0xb5,
+	0xc6, 0x49, 0x43, 0x50, 0xdb, 0x47, 0xc7, 0xa5, 0xb2, 0x5d, 0xad, 0x35, 0x9a, 0xfb, 0xb5, 0x66,
+	0x7e, 0x0a, 0xad, 0xc3, 0xea, 0x04, 0x9a, 0xf2, 0xd1, 0x7e, 0xde, 0x38, 0x38, 0xfc, 0xf6, 0xf5,
+	0xba, 0xf1, 0xdd, 0xeb, 0x75, 0xe3, 0x9f, 0xaf, 0xd7, 0x8d, 0xaf, 0xdf, 0xac, 0x4f, 0x7d, 0xf7,
+	0x66, 0x7d, 0xea, 0xef, 0x6f, 0xd6, 0xa7, 0xbe, 0xd8, 0x1b, 0x0a, 0xa1, 0x1a, 0x5c, 0x9f, 0x05,
+	0x84, 0x5f, 0xd0, 0xe8, 0x5c, 0x7f, 0xef, 0xf6, 0x93, 0x9f, 0xce, 0x65, 0x48, 0xcf, 0xb2, 0x72,
+	0x4b, 0xfb, 0xd1, 0x7f, 0x07, 0x00, 0x78, 0x16, 0xa1, 0x5d, 0x5a, 0x17, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxExpiredRecordsPerBlock != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.MaxExpiredRecordsPerBlock))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	{
+		size := m.UnusedGasRefundRatio.Size()
+		i -= size
+		if _, err := m.UnusedGasRefundRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	if len(m.TxPriorityTiers) > 0 {
+		for iNdEx := len(m.TxPriorityTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TxPriorityTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	{
+		size := m.BaseFeeBurnRatio.Size()
+		i -= size
+		if _, err := m.BaseFeeBurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.BaseFeeTargetUtilization.Size()
+		i -= size
+		if _, err := m.BaseFeeTargetUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	{
+		size := m.BaseFeeMaxChangeRate.Size()
+		i -= size
+		if _, err := m.BaseFeeMaxChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	if m.MinConsensusFeeHistoryBlocks != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.MinConsensusFeeHistoryBlocks))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MinConsensusFeeWindow != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.MinConsensusFeeWindow))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MinConsensusFeeMode != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.MinConsensusFeeMode))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.AcceptedFeeDenoms) > 0 {
+		for iNdEx := len(m.AcceptedFeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AcceptedFeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ContractOperationWeights) > 0 {
+		for iNdEx := len(m.ContractOperationWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContractOperationWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.SelfDealingPolicy != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.SelfDealingPolicy))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.InflationCapEpochBlocks != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.InflationCapEpochBlocks))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.ContractInflationEpochCap.Size()
+		i -= size
+		if _, err := m.ContractInflationEpochCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.ContractInflationShareCap.Size()
+		i -= size
+		if _, err := m.ContractInflationShareCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.MaxAutoPayoutsPerBlock != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.MaxAutoPayoutsPerBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RewardsRecordExpiry, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardsRecordExpiry):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintRewards(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.MaxWithdrawRecords != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.MaxWithdrawRecords))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.TxFeeRebateRatio.Size()
+		i -= size
+		if _, err := m.TxFeeRebateRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.InflationRewardsRatio.Size()
+		i -= size
+		if _, err := m.InflationRewardsRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingOwnerAddress) > 0 {
+		i -= len(m.PendingOwnerAddress)
+		copy(dAtA[i:], m.PendingOwnerAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.PendingOwnerAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.RewardsRecipients) > 0 {
+		for iNdEx := len(m.RewardsRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RewardsAddress) > 0 {
+		i -= len(m.RewardsAddress)
+		copy(dAtA[i:], m.RewardsAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.RewardsAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.OwnerAddress) > 0 {
+		i -= len(m.OwnerAddress)
+		copy(dAtA[i:], m.OwnerAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.OwnerAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardsRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardsRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardsRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlatFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlatFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlatFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FlatFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGas != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.MaxGas))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.InflationRewards.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sponsored {
+		i--
+		if m.Sponsored {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FeeCollectorFees) > 0 {
+		for iNdEx := len(m.FeeCollectorFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeCollectorFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FeeRewards) > 0 {
+		for iNdEx := len(m.FeeRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Height != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TxId != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.TxId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardsRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardsRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardsRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CalculatedTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CalculatedTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintRewards(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
+	if m.CalculatedHeight != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.CalculatedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Rewards) > 0 {
+		for iNdEx := len(m.Rewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Rewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RewardsAddress) > 0 {
+		i -= len(m.RewardsAddress)
+		copy(dAtA[i:], m.RewardsAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.RewardsAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TreasuryOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TreasuryOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TreasuryOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintRewards(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x32
+	if m.Height != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Type != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CodeMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CodeMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CodeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardsRecipients) > 0 {
+		for iNdEx := len(m.RewardsRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardsRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RewardsAddress) > 0 {
+		i -= len(m.RewardsAddress)
+		copy(dAtA[i:], m.RewardsAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.RewardsAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.CodeId != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AutoPayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AutoPayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AutoPayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastPayoutHeight != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.LastPayoutHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Threshold) > 0 {
+		for iNdEx := len(m.Threshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Threshold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.IntervalBlocks != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.IntervalBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractInflationUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractInflationUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractInflationUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Epoch != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractOperationWeight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractOperationWeight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractOperationWeight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.OperationType != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.OperationType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MinConsensusFeeRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinConsensusFeeRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinConsensusFeeRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TxPriorityTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TxPriorityTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TxPriorityTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.MinFeeRatio.Size()
+		i -= size
+		if _, err := m.MinFeeRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRewards(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Sponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Sponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UserFeeLimitPeriod != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.UserFeeLimitPeriod))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.BlockFees) > 0 {
+		for iNdEx := len(m.BlockFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FundFromRewards {
+		i--
+		if m.FundFromRewards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BlockFeeLimit) > 0 {
+		for iNdEx := len(m.BlockFeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockFeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.UserFeeLimit) > 0 {
+		for iNdEx := len(m.UserFeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserFeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SponsorshipUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SponsorshipUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SponsorshipUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Period != 0 {
+		i = encodeVarintRewards(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRewards(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.UserAddress) > 0 {
+		i -= len(m.UserAddress)
+		copy(dAtA[i:], m.UserAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.UserAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintRewards(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRewards(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRewards(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.InflationRewardsRatio.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	l = m.TxFeeRebateRatio.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	if m.MaxWithdrawRecords != 0 {
+		n += 1 + sovRewards(uint64(m.MaxWithdrawRecords))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RewardsRecordExpiry)
+	n += 1 + l + sovRewards(uint64(l))
+	if m.MaxAutoPayoutsPerBlock != 0 {
+		n += 1 + sovRewards(uint64(m.MaxAutoPayoutsPerBlock))
+	}
+	l = m.ContractInflationShareCap.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	l = m.ContractInflationEpochCap.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	if m.InflationCapEpochBlocks != 0 {
+		n += 1 + sovRewards(uint64(m.InflationCapEpochBlocks))
+	}
+	if m.SelfDealingPolicy != 0 {
+		n += 1 + sovRewards(uint64(m.SelfDealingPolicy))
+	}
+	if len(m.ContractOperationWeights) > 0 {
+		for _, e := range m.ContractOperationWeights {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if len(m.AcceptedFeeDenoms) > 0 {
+		for _, e := range m.AcceptedFeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if m.MinConsensusFeeMode != 0 {
+		n += 1 + sovRewards(uint64(m.MinConsensusFeeMode))
+	}
+	if m.MinConsensusFeeWindow != 0 {
+		n += 1 + sovRewards(uint64(m.MinConsensusFeeWindow))
+	}
+	if m.MinConsensusFeeHistoryBlocks != 0 {
+		n += 1 + sovRewards(uint64(m.MinConsensusFeeHistoryBlocks))
+	}
+	l = m.BaseFeeMaxChangeRate.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	l = m.BaseFeeTargetUtilization.Size()
+	n += 2 + l + sovRewards(uint64(l))
+	l = m.BaseFeeBurnRatio.Size()
+	n += 2 + l + sovRewards(uint64(l))
+	if len(m.TxPriorityTiers) > 0 {
+		for _, e := range m.TxPriorityTiers {
+			l = e.Size()
+			n += 2 + l + sovRewards(uint64(l))
+		}
+	}
+	l = m.UnusedGasRefundRatio.Size()
+	n += 2 + l + sovRewards(uint64(l))
+	if m.MaxExpiredRecordsPerBlock != 0 {
+		n += 2 + sovRewards(uint64(m.MaxExpiredRecordsPerBlock))
+	}
+	return n
+}
+
+func (m *ContractMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	l = len(m.OwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	l = len(m.RewardsAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if len(m.RewardsRecipients) > 0 {
+		for _, e := range m.RewardsRecipients {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	l = len(m.PendingOwnerAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	return n
+}
+
+func (m *RewardsRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	return n
+}
+
+func (m *FlatFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	l = m.FlatFee.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	return n
+}
+
+func (m *BlockRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRewards(uint64(m.Height))
+	}
+	l = m.InflationRewards.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	if m.MaxGas != 0 {
+		n += 1 + sovRewards(uint64(m.MaxGas))
+	}
+	return n
+}
+
+func (m *TxRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TxId != 0 {
+		n += 1 + sovRewards(uint64(m.TxId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRewards(uint64(m.Height))
+	}
+	if len(m.FeeRewards) > 0 {
+		for _, e := range m.FeeRewards {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if len(m.FeeCollectorFees) > 0 {
+		for _, e := range m.FeeCollectorFees {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if m.Sponsored {
+		n += 2
+	}
+	return n
+}
+
+func (m *RewardsRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRewards(uint64(m.Id))
+	}
+	l = len(m.RewardsAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if len(m.Rewards) > 0 {
+		for _, e := range m.Rewards {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if m.CalculatedHeight != 0 {
+		n += 1 + sovRewards(uint64(m.CalculatedHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CalculatedTime)
+	n += 1 + l + sovRewards(uint64(l))
+	return n
+}
+
+func (m *TreasuryOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRewards(uint64(m.Id))
+	}
+	if m.Type != 0 {
+		n += 1 + sovRewards(uint64(m.Type))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if m.Height != 0 {
+		n += 1 + sovRewards(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovRewards(uint64(l))
+	return n
+}
+
+func (m *CodeMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CodeId != 0 {
+		n += 1 + sovRewards(uint64(m.CodeId))
+	}
+	l = len(m.RewardsAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if len(m.RewardsRecipients) > 0 {
+		for _, e := range m.RewardsRecipients {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AutoPayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if m.IntervalBlocks != 0 {
+		n += 1 + sovRewards(uint64(m.IntervalBlocks))
+	}
+	if len(m.Threshold) > 0 {
+		for _, e := range m.Threshold {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if m.LastPayoutHeight != 0 {
+		n += 1 + sovRewards(uint64(m.LastPayoutHeight))
+	}
+	return n
+}
+
+func (m *ContractInflationUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovRewards(uint64(m.Epoch))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	return n
+}
+
+func (m *ContractOperationWeight) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OperationType != 0 {
+		n += 1 + sovRewards(uint64(m.OperationType))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	return n
+}
+
+func (m *FeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	return n
+}
+
+func (m *MinConsensusFeeRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovRewards(uint64(m.Height))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	return n
+}
+
+func (m *TxPriorityTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinFeeRatio.Size()
+	n += 1 + l + sovRewards(uint64(l))
+	if m.Priority != 0 {
+		n += 1 + sovRewards(uint64(m.Priority))
+	}
+	return n
+}
+
+func (m *Sponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if len(m.UserFeeLimit) > 0 {
+		for _, e := range m.UserFeeLimit {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if len(m.BlockFeeLimit) > 0 {
+		for _, e := range m.BlockFeeLimit {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if m.FundFromRewards {
+		n += 2
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovRewards(uint64(m.BlockHeight))
+	}
+	if len(m.BlockFees) > 0 {
+		for _, e := range m.BlockFees {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if m.UserFeeLimitPeriod != 0 {
+		n += 1 + sovRewards(uint64(m.UserFeeLimitPeriod))
+	}
+	return n
+}
+
+func (m *SponsorshipUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	l = len(m.UserAddress)
+	if l > 0 {
+		n += 1 + l + sovRewards(uint64(l))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovRewards(uint64(l))
+		}
+	}
+	if m.Period != 0 {
+		n += 1 + sovRewards(uint64(m.Period))
+	}
+	return n
+}
+
+func sovRewards(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRewards(x uint64) (n int) {
+	return sovRewards(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRewardsRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRewardsRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxFeeRebateRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TxFeeRebateRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWithdrawRecords", wireType)
+			}
+			m.MaxWithdrawRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxWithdrawRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsRecordExpiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RewardsRecordExpiry, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAutoPayoutsPerBlock", wireType)
+			}
+			m.MaxAutoPayoutsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAutoPayoutsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractInflationShareCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ContractInflationShareCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractInflationEpochCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ContractInflationEpochCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationCapEpochBlocks", wireType)
+			}
+			m.InflationCapEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflationCapEpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SelfDealingPolicy", wireType)
+			}
+			m.SelfDealingPolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SelfDealingPolicy |= SelfDealingPolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractOperationWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractOperationWeights = append(m.ContractOperationWeights, ContractOperationWeight{})
+			if err := m.ContractOperationWeights[len(m.ContractOperationWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedFeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedFeeDenoms = append(m.AcceptedFeeDenoms, FeeDenom{})
+			if err := m.AcceptedFeeDenoms[len(m.AcceptedFeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinConsensusFeeMode", wireType)
+			}
+			m.MinConsensusFeeMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinConsensusFeeMode |= MinConsensusFeeMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinConsensusFeeWindow", wireType)
+			}
+			m.MinConsensusFeeWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinConsensusFeeWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinConsensusFeeHistoryBlocks", wireType)
+			}
+			m.MinConsensusFeeHistoryBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinConsensusFeeHistoryBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeMaxChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeMaxChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeTargetUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeTargetUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeBurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeBurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxPriorityTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxPriorityTiers = append(m.TxPriorityTiers, TxPriorityTier{})
+			if err := m.TxPriorityTiers[len(m.TxPriorityTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnusedGasRefundRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnusedGasRefundRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxExpiredRecordsPerBlock", wireType)
+			}
+			m.MaxExpiredRecordsPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxExpiredRecordsPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsRecipients = append(m.RewardsRecipients, RewardsRecipient{})
+			if err := m.RewardsRecipients[len(m.RewardsRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwnerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOwnerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardsRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardsRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardsRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FlatFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlatFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlatFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlatFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			}
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TxRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TxRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TxRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+			}
+			m.TxId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TxId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeRewards = append(m.FeeRewards, types.Coin{})
+			if err := m.FeeRewards[len(m.FeeRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollectorFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeCollectorFees = append(m.FeeCollectorFees, types.Coin{})
+			if err := m.FeeCollectorFees[len(m.FeeCollectorFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsored", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Sponsored = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardsRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardsRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rewards = append(m.Rewards, types.Coin{})
+			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CalculatedHeight", wireType)
+			}
+			m.CalculatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CalculatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CalculatedTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CalculatedTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TreasuryOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TreasuryOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TreasuryOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= TreasuryOperationType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CodeMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CodeMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CodeMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			m.CodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsRecipients = append(m.RewardsRecipients, RewardsRecipient{})
+			if err := m.RewardsRecipients[len(m.RewardsRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AutoPayout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AutoPayout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AutoPayout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalBlocks", wireType)
+			}
+			m.IntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = append(m.Threshold, types.Coin{})
+			if err := m.Threshold[len(m.Threshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPayoutHeight", wireType)
+			}
+			m.LastPayoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPayoutHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractInflationUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractInflationUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractInflationUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *RewardsRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovRewards(uint64(m.Id))
-	}
-	l = len(m.RewardsAddress)
-	if l > 0 {
-		n += 1 + l + sovRewards(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.Rewards) > 0 {
-		for _, e := range m.Rewards {
-			l = e.Size()
-			n += 1 + l + sovRewards(uint64(l))
+	return nil
+}
+func (m *ContractOperationWeight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractOperationWeight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractOperationWeight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationType", wireType)
+			}
+			m.OperationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OperationType |= types1.ContractOperation(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.CalculatedHeight != 0 {
-		n += 1 + sovRewards(uint64(m.CalculatedHeight))
-	}
-	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CalculatedTime)
-	n += 1 + l + sovRewards(uint64(l))
-	return n
-}
 
-func sovRewards(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRewards(x uint64) (n int) {
-	return sovRewards(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *FeeDenom) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -799,15 +5332,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeeDenom: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationRewardsRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -835,13 +5368,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationRewardsRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxFeeRebateRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -869,15 +5400,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TxFeeRebateRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRewards(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinConsensusFeeRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRewards
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinConsensusFeeRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinConsensusFeeRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxWithdrawRecords", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			m.MaxWithdrawRecords = 0
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRewards
@@ -887,11 +5468,44 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxWithdrawRecords |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRewards(dAtA[iNdEx:])
@@ -913,7 +5527,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractMetadata) Unmarshal(dAtA []byte) error {
+func (m *TxPriorityTier) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -936,15 +5550,15 @@ func (m *ContractMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: TxPriorityTier: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TxPriorityTier: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinFeeRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -972,45 +5586,15 @@ func (m *ContractMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OwnerAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRewards
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRewards
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRewards
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.MinFeeRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.OwnerAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			var stringLen uint64
+			m.Priority = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRewards
@@ -1020,24 +5604,11 @@ func (m *ContractMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Priority |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthRewards
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthRewards
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRewards(dAtA[iNdEx:])
@@ -1059,7 +5630,7 @@ func (m *ContractMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *BlockRewards) Unmarshal(dAtA []byte) error {
+func (m *Sponsorship) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1082,17 +5653,17 @@ func (m *BlockRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: BlockRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: Sponsorship: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BlockRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Sponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			m.Height = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRewards
@@ -1102,14 +5673,27 @@ func (m *BlockRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1136,15 +5720,16 @@ func (m *BlockRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationRewards.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserFeeLimit", wireType)
 			}
-			m.MaxGas = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRewards
@@ -1154,66 +5739,65 @@ func (m *BlockRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxGas |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRewards(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
 				return ErrInvalidLengthRewards
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TxRewards) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRewards
+			m.UserFeeLimit = append(m.UserFeeLimit, types.Coin{})
+			if err := m.UserFeeLimit[len(m.UserFeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockFeeLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.BlockFeeLimit = append(m.BlockFeeLimit, types.Coin{})
+			if err := m.BlockFeeLimit[len(m.BlockFeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TxRewards: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TxRewards: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FundFromRewards", wireType)
 			}
-			m.TxId = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRewards
@@ -1223,16 +5807,17 @@ func (m *TxRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TxId |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			m.FundFromRewards = bool(v != 0)
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.Height = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRewards
@@ -1242,14 +5827,14 @@ func (m *TxRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Height |= int64(b&0x7F) << shift
+				m.BlockHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeeRewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1276,11 +5861,30 @@ func (m *TxRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeeRewards = append(m.FeeRewards, types.Coin{})
-			if err := m.FeeRewards[len(m.FeeRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.BlockFees = append(m.BlockFees, types.Coin{})
+			if err := m.BlockFees[len(m.BlockFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserFeeLimitPeriod", wireType)
+			}
+			m.UserFeeLimitPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRewards
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserFeeLimitPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRewards(dAtA[iNdEx:])
@@ -1302,7 +5906,7 @@ func (m *TxRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RewardsRecord) Unmarshal(dAtA []byte) error {
+func (m *SponsorshipUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1325,17 +5929,17 @@ func (m *RewardsRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RewardsRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: SponsorshipUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RewardsRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SponsorshipUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			m.Id = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRewards
@@ -1345,14 +5949,27 @@ func (m *RewardsRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRewards
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRewards
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UserAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1380,11 +5997,11 @@ func (m *RewardsRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
+			m.UserAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rewards", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1411,35 +6028,16 @@ func (m *RewardsRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rewards = append(m.Rewards, types.Coin{})
-			if err := m.Rewards[len(m.Rewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CalculatedHeight", wireType)
-			}
-			m.CalculatedHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowRewards
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CalculatedHeight |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CalculatedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
 			}
-			var msglen int
+			m.Period = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRewards
@@ -1449,25 +6047,11 @@ func (m *RewardsRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Period |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthRewards
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthRewards
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CalculatedTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRewards(dAtA[iNdEx:])
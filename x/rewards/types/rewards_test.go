@@ -86,6 +86,15 @@ func TestTxRewardsValidate(t *testing.T) {
 				FeeRewards: sdk.NewCoins(sdk.NewCoin("uatom", sdk.OneInt())),
 			},
 		},
+		{
+			name: "OK: with fee collector fees",
+			record: rewardsTypes.TxRewards{
+				TxId:             1,
+				Height:           1,
+				FeeRewards:       sdk.NewCoins(sdk.NewCoin("uatom", sdk.OneInt())),
+				FeeCollectorFees: sdk.NewCoins(sdk.NewCoin("uatom", sdk.NewInt(2))),
+			},
+		},
 		{
 			name: "OK: no rewards",
 			record: rewardsTypes.TxRewards{
@@ -132,6 +141,15 @@ func TestTxRewardsValidate(t *testing.T) {
 			},
 			errExpected: true,
 		},
+		{
+			name: "Fail: invalid FeeCollectorFees (zero coin)",
+			record: rewardsTypes.TxRewards{
+				TxId:             1,
+				Height:           1,
+				FeeCollectorFees: []sdk.Coin{{Denom: "uarch", Amount: sdk.ZeroInt()}},
+			},
+			errExpected: true,
+		},
 	}
 
 	for _, tc := range testCases {
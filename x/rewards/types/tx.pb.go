@@ -7,6 +7,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -133,6 +134,8 @@ type MsgWithdrawRewards struct {
 	//	*MsgWithdrawRewards_RecordsLimit_
 	//	*MsgWithdrawRewards_RecordIds
 	Mode isMsgWithdrawRewards_Mode `protobuf_oneof:"mode"`
+	// ibc_transfer if set, rewards are transferred to the receiver on another chain via IBC (the rewards_address is the sender).
+	IbcTransfer *MsgWithdrawRewards_IBCTransfer `protobuf:"bytes,4,opt,name=ibc_transfer,json=ibcTransfer,proto3" json:"ibc_transfer,omitempty"`
 }
 
 func (m *MsgWithdrawRewards) Reset()         { *m = MsgWithdrawRewards{} }
@@ -212,6 +215,13 @@ func (m *MsgWithdrawRewards) GetRecordIds() *MsgWithdrawRewards_RecordIDs {
 	return nil
 }
 
+func (m *MsgWithdrawRewards) GetIbcTransfer() *MsgWithdrawRewards_IBCTransfer {
+	if m != nil {
+		return m.IbcTransfer
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*MsgWithdrawRewards) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -308,6 +318,70 @@ func (m *MsgWithdrawRewards_RecordIDs) GetIds() []uint64 {
 	return nil
 }
 
+// IBCTransfer defines the ICS-20 transfer options to withdraw rewards to an address on another chain.
+type MsgWithdrawRewards_IBCTransfer struct {
+	// source_channel is the ICS-20 transfer channel ID (the "transfer" port is used).
+	SourceChannel string `protobuf:"bytes,1,opt,name=source_channel,json=sourceChannel,proto3" json:"source_channel,omitempty"`
+	// receiver is the rewards receiver address on the counterparty chain.
+	Receiver string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	// timeout_timestamp is the transfer timeout timestamp (UNIX time in nanoseconds) on the counterparty chain.
+	TimeoutTimestamp uint64 `protobuf:"varint,3,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+}
+
+func (m *MsgWithdrawRewards_IBCTransfer) Reset()         { *m = MsgWithdrawRewards_IBCTransfer{} }
+func (m *MsgWithdrawRewards_IBCTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawRewards_IBCTransfer) ProtoMessage()    {}
+func (*MsgWithdrawRewards_IBCTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cda0f3b1281e62e0, []int{2, 2}
+}
+func (m *MsgWithdrawRewards_IBCTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawRewards_IBCTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawRewards_IBCTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawRewards_IBCTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawRewards_IBCTransfer.Merge(m, src)
+}
+func (m *MsgWithdrawRewards_IBCTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawRewards_IBCTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawRewards_IBCTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawRewards_IBCTransfer proto.InternalMessageInfo
+
+func (m *MsgWithdrawRewards_IBCTransfer) GetSourceChannel() string {
+	if m != nil {
+		return m.SourceChannel
+	}
+	return ""
+}
+
+func (m *MsgWithdrawRewards_IBCTransfer) GetReceiver() string {
+	if m != nil {
+		return m.Receiver
+	}
+	return ""
+}
+
+func (m *MsgWithdrawRewards_IBCTransfer) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
+}
+
 // MsgWithdrawRewardsResponse is the response for Msg.WithdrawRewards.
 type MsgWithdrawRewardsResponse struct {
 	// records_num is the number of RewardsRecord objects processed.
@@ -363,560 +437,5107 @@ func (m *MsgWithdrawRewardsResponse) GetTotalRewards() []types.Coin {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*MsgSetContractMetadata)(nil), "archway.rewards.v1beta1.MsgSetContractMetadata")
-	proto.RegisterType((*MsgSetContractMetadataResponse)(nil), "archway.rewards.v1beta1.MsgSetContractMetadataResponse")
-	proto.RegisterType((*MsgWithdrawRewards)(nil), "archway.rewards.v1beta1.MsgWithdrawRewards")
-	proto.RegisterType((*MsgWithdrawRewards_RecordsLimit)(nil), "archway.rewards.v1beta1.MsgWithdrawRewards.RecordsLimit")
-	proto.RegisterType((*MsgWithdrawRewards_RecordIDs)(nil), "archway.rewards.v1beta1.MsgWithdrawRewards.RecordIDs")
-	proto.RegisterType((*MsgWithdrawRewardsResponse)(nil), "archway.rewards.v1beta1.MsgWithdrawRewardsResponse")
+// MsgSetFlatFee is the request for Msg.SetFlatFee.
+type MsgSetFlatFee struct {
+	// sender_address is the msg sender address (bech32 encoded).
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// contract_address is the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// flat_fee_amount defines the minimum flat fee set by the contract_owner.
+	// Zero amount removes the flat fee.
+	FlatFeeAmount types.Coin `protobuf:"bytes,3,opt,name=flat_fee_amount,json=flatFeeAmount,proto3" json:"flat_fee_amount"`
 }
 
-func init() { proto.RegisterFile("archway/rewards/v1beta1/tx.proto", fileDescriptor_cda0f3b1281e62e0) }
-
-var fileDescriptor_cda0f3b1281e62e0 = []byte{
-	// 511 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xf7, 0xc5, 0xa1, 0x22, 0x2f, 0x49, 0x2b, 0x1d, 0x55, 0x09, 0x1e, 0x5c, 0x2b, 0x50, 0x11,
-	0x84, 0xb0, 0xd5, 0x54, 0x08, 0x56, 0x42, 0x87, 0x56, 0x34, 0x0c, 0x46, 0x02, 0x89, 0x25, 0xba,
-	0xd8, 0x27, 0xc7, 0xa2, 0xf6, 0x45, 0x77, 0x17, 0xd2, 0x4e, 0x0c, 0xac, 0x0c, 0x7c, 0xac, 0xb2,
-	0x75, 0x64, 0x42, 0x28, 0xf9, 0x18, 0x2c, 0xc8, 0xbe, 0xb3, 0x15, 0xe5, 0x4f, 0xa5, 0x6c, 0x79,
-	0x2f, 0xbf, 0xf7, 0xfb, 0xf3, 0xfc, 0x0e, 0x1c, 0xc2, 0x83, 0xd1, 0x94, 0x5c, 0x7b, 0x9c, 0x4e,
-	0x09, 0x0f, 0x85, 0xf7, 0xf5, 0x78, 0x48, 0x25, 0x39, 0xf6, 0xe4, 0x95, 0x3b, 0xe6, 0x4c, 0x32,
-	0xfc, 0x50, 0x23, 0x5c, 0x8d, 0x70, 0x35, 0xc2, 0xda, 0x8f, 0x58, 0xc4, 0x72, 0x8c, 0x97, 0xfd,
-	0x52, 0x70, 0xcb, 0x0e, 0x98, 0x48, 0x98, 0xf0, 0x86, 0x44, 0xd0, 0x92, 0x2c, 0x60, 0x71, 0xaa,
-	0xff, 0x3f, 0xda, 0x24, 0x58, 0xd0, 0xe7, 0xb0, 0xf6, 0x0f, 0x04, 0x07, 0x7d, 0x11, 0x7d, 0xa0,
-	0xf2, 0x2d, 0x4b, 0x25, 0x27, 0x81, 0xec, 0x53, 0x49, 0x42, 0x22, 0x09, 0x3e, 0x82, 0x5d, 0x41,
-	0xd3, 0x90, 0xf2, 0x01, 0x09, 0x43, 0x4e, 0x85, 0x68, 0x21, 0x07, 0x75, 0x6a, 0x7e, 0x53, 0x75,
-	0xdf, 0xa8, 0x26, 0x7e, 0x07, 0xf7, 0x13, 0x3d, 0xd2, 0xaa, 0x38, 0xa8, 0x53, 0xef, 0x3e, 0x73,
-	0x37, 0x44, 0x71, 0x97, 0x35, 0x7a, 0xd5, 0x9b, 0x3f, 0x87, 0x86, 0x5f, 0x12, 0xb4, 0x1d, 0xb0,
-	0xd7, 0xbb, 0xf1, 0xa9, 0x18, 0xb3, 0x54, 0xd0, 0xf6, 0xaf, 0x0a, 0xe0, 0xbe, 0x88, 0x3e, 0xc5,
-	0x72, 0x14, 0x72, 0x32, 0xf5, 0x95, 0x02, 0x7e, 0x0a, 0x7b, 0x5a, 0x6c, 0xc9, 0xed, 0xae, 0x6e,
-	0x17, 0x76, 0x07, 0xd0, 0xe4, 0x34, 0x60, 0x19, 0xf0, 0x32, 0x4e, 0x62, 0xa9, 0x3d, 0xbf, 0xde,
-	0xe8, 0x79, 0x55, 0xcc, 0xf5, 0x15, 0xc1, 0x45, 0x36, 0x7f, 0x66, 0xf8, 0x0d, 0xbe, 0x50, 0xe3,
-	0x8f, 0x00, 0xaa, 0x1e, 0xc4, 0xa1, 0x68, 0x99, 0x39, 0xfb, 0xcb, 0xed, 0xd9, 0xcf, 0x4f, 0xc5,
-	0x99, 0xe1, 0xd7, 0x14, 0xd5, 0x79, 0x28, 0xac, 0x27, 0xd0, 0x58, 0xd4, 0xc5, 0xfb, 0x70, 0x4f,
-	0x05, 0xc8, 0x72, 0x56, 0x7d, 0x55, 0x58, 0x8f, 0xa1, 0x56, 0xce, 0xe3, 0x03, 0x30, 0x33, 0x0f,
-	0xc8, 0x31, 0x3b, 0x55, 0xbd, 0xea, 0xac, 0xd1, 0xdb, 0x81, 0x6a, 0xc2, 0x42, 0xda, 0xfe, 0x8e,
-	0xc0, 0x5a, 0x35, 0x50, 0xac, 0x1a, 0x1f, 0x42, 0xbd, 0x58, 0x55, 0x3a, 0x49, 0xb4, 0x8e, 0x0e,
-	0x27, 0xde, 0x4f, 0x12, 0x7c, 0x0a, 0x4d, 0xc9, 0x24, 0xb9, 0x1c, 0xe8, 0x54, 0xad, 0x8a, 0x63,
-	0x76, 0xea, 0xdd, 0x47, 0xae, 0xba, 0x4d, 0x37, 0xbb, 0xcd, 0x85, 0x6f, 0x1f, 0xa7, 0xda, 0x44,
-	0x23, 0x9f, 0xd2, 0x72, 0xdd, 0x7f, 0x08, 0xcc, 0xbe, 0x88, 0xf0, 0x37, 0x78, 0xb0, 0xee, 0x0c,
-	0xbd, 0xbb, 0x76, 0xb7, 0x66, 0xc0, 0x7a, 0xb5, 0xe5, 0x40, 0x99, 0x57, 0xc0, 0xde, 0xf2, 0x59,
-	0x3d, 0xdf, 0xe2, 0xc3, 0x59, 0x27, 0x5b, 0x80, 0x0b, 0xd1, 0xde, 0xc5, 0xcd, 0xcc, 0x46, 0xb7,
-	0x33, 0x1b, 0xfd, 0x9d, 0xd9, 0xe8, 0xe7, 0xdc, 0x36, 0x6e, 0xe7, 0xb6, 0xf1, 0x7b, 0x6e, 0x1b,
-	0x9f, 0xbb, 0x51, 0x2c, 0x47, 0x93, 0xa1, 0x1b, 0xb0, 0xc4, 0xd3, 0xc4, 0x2f, 0x52, 0x2a, 0xa7,
-	0x8c, 0x7f, 0x29, 0x6a, 0xef, 0xaa, 0x7c, 0xde, 0xf2, 0x7a, 0x4c, 0xc5, 0x70, 0x27, 0x7f, 0xd5,
-	0x27, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0xad, 0xe4, 0xef, 0x6d, 0x6f, 0x04, 0x00, 0x00,
+func (m *MsgSetFlatFee) Reset()         { *m = MsgSetFlatFee{} }
+func (m *MsgSetFlatFee) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFlatFee) ProtoMessage()    {}
+func (*MsgSetFlatFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cda0f3b1281e62e0, []int{4}
+}
+func (m *MsgSetFlatFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFlatFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFlatFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetFlatFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFlatFee.Merge(m, src)
+}
+func (m *MsgSetFlatFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFlatFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFlatFee.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgSetFlatFee proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgSetFlatFee) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// SetContractMetadata creates or updates an existing contract metadata.
-	// Method is authorized to the contract owner (admin if no metadata exists).
-	SetContractMetadata(ctx context.Context, in *MsgSetContractMetadata, opts ...grpc.CallOption) (*MsgSetContractMetadataResponse, error)
-	// WithdrawRewards performs collected rewards distribution.
-	// Rewards might be credited from multiple contracts (rewards_address must be set in the corresponding contract metadata).
-	WithdrawRewards(ctx context.Context, in *MsgWithdrawRewards, opts ...grpc.CallOption) (*MsgWithdrawRewardsResponse, error)
+func (m *MsgSetFlatFee) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetFlatFee) GetFlatFeeAmount() types.Coin {
+	if m != nil {
+		return m.FlatFeeAmount
+	}
+	return types.Coin{}
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgSetFlatFeeResponse is the response for Msg.SetFlatFee.
+type MsgSetFlatFeeResponse struct {
 }
 
-func (c *msgClient) SetContractMetadata(ctx context.Context, in *MsgSetContractMetadata, opts ...grpc.CallOption) (*MsgSetContractMetadataResponse, error) {
-	out := new(MsgSetContractMetadataResponse)
-	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Msg/SetContractMetadata", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetFlatFeeResponse) Reset()         { *m = MsgSetFlatFeeResponse{} }
+func (m *MsgSetFlatFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFlatFeeResponse) ProtoMessage()    {}
+func (*MsgSetFlatFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cda0f3b1281e62e0, []int{5}
+}
+func (m *MsgSetFlatFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFlatFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFlatFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSetFlatFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFlatFeeResponse.Merge(m, src)
+}
+func (m *MsgSetFlatFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFlatFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFlatFeeResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) WithdrawRewards(ctx context.Context, in *MsgWithdrawRewards, opts ...grpc.CallOption) (*MsgWithdrawRewardsResponse, error) {
-	out := new(MsgWithdrawRewardsResponse)
-	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Msg/WithdrawRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSetFlatFeeResponse proto.InternalMessageInfo
+
+// MsgWithdrawRewardsAndDelegate is the request for Msg.WithdrawRewardsAndDelegate.
+type MsgWithdrawRewardsAndDelegate struct {
+	// rewards_address is the address to distribute rewards to and the delegator address (bech32 encoded).
+	RewardsAddress string `protobuf:"bytes,1,opt,name=rewards_address,json=rewardsAddress,proto3" json:"rewards_address,omitempty"`
+	// validator_address is the validator address to delegate rewards to (bech32 encoded).
+	ValidatorAddress string `protobuf:"bytes,2,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// mode defines the operation type.
+	//
+	// Types that are valid to be assigned to Mode:
+	//	*MsgWithdrawRewardsAndDelegate_RecordsLimit
+	//	*MsgWithdrawRewardsAndDelegate_RecordIds
+	Mode isMsgWithdrawRewardsAndDelegate_Mode `protobuf_oneof:"mode"`
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// SetContractMetadata creates or updates an existing contract metadata.
-	// Method is authorized to the contract owner (admin if no metadata exists).
-	SetContractMetadata(context.Context, *MsgSetContractMetadata) (*MsgSetContractMetadataResponse, error)
-	// WithdrawRewards performs collected rewards distribution.
-	// Rewards might be credited from multiple contracts (rewards_address must be set in the corresponding contract metadata).
-	WithdrawRewards(context.Context, *MsgWithdrawRewards) (*MsgWithdrawRewardsResponse, error)
+func (m *MsgWithdrawRewardsAndDelegate) Reset()         { *m = MsgWithdrawRewardsAndDelegate{} }
+func (m *MsgWithdrawRewardsAndDelegate) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawRewardsAndDelegate) ProtoMessage()    {}
+func (*MsgWithdrawRewardsAndDelegate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cda0f3b1281e62e0, []int{6}
+}
+func (m *MsgWithdrawRewardsAndDelegate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawRewardsAndDelegate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawRewardsAndDelegate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawRewardsAndDelegate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawRewardsAndDelegate.Merge(m, src)
+}
+func (m *MsgWithdrawRewardsAndDelegate) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawRewardsAndDelegate) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawRewardsAndDelegate.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgWithdrawRewardsAndDelegate proto.InternalMessageInfo
+
+type isMsgWithdrawRewardsAndDelegate_Mode interface {
+	isMsgWithdrawRewardsAndDelegate_Mode()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-func (*UnimplementedMsgServer) SetContractMetadata(ctx context.Context, req *MsgSetContractMetadata) (*MsgSetContractMetadataResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetContractMetadata not implemented")
+type MsgWithdrawRewardsAndDelegate_RecordsLimit struct {
+	RecordsLimit *MsgWithdrawRewards_RecordsLimit `protobuf:"bytes,3,opt,name=records_limit,json=recordsLimit,proto3,oneof" json:"records_limit,omitempty"`
 }
-func (*UnimplementedMsgServer) WithdrawRewards(ctx context.Context, req *MsgWithdrawRewards) (*MsgWithdrawRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawRewards not implemented")
+type MsgWithdrawRewardsAndDelegate_RecordIds struct {
+	RecordIds *MsgWithdrawRewards_RecordIDs `protobuf:"bytes,4,opt,name=record_ids,json=recordIds,proto3,oneof" json:"record_ids,omitempty"`
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+func (*MsgWithdrawRewardsAndDelegate_RecordsLimit) isMsgWithdrawRewardsAndDelegate_Mode() {}
+func (*MsgWithdrawRewardsAndDelegate_RecordIds) isMsgWithdrawRewardsAndDelegate_Mode()    {}
 
-func _Msg_SetContractMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetContractMetadata)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetContractMetadata(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.rewards.v1beta1.Msg/SetContractMetadata",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetContractMetadata(ctx, req.(*MsgSetContractMetadata))
+func (m *MsgWithdrawRewardsAndDelegate) GetMode() isMsgWithdrawRewardsAndDelegate_Mode {
+	if m != nil {
+		return m.Mode
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_WithdrawRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawRewards)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawRewards(ctx, in)
+func (m *MsgWithdrawRewardsAndDelegate) GetRewardsAddress() string {
+	if m != nil {
+		return m.RewardsAddress
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/archway.rewards.v1beta1.Msg/WithdrawRewards",
+	return ""
+}
+
+func (m *MsgWithdrawRewardsAndDelegate) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawRewards(ctx, req.(*MsgWithdrawRewards))
+	return ""
+}
+
+func (m *MsgWithdrawRewardsAndDelegate) GetRecordsLimit() *MsgWithdrawRewards_RecordsLimit {
+	if x, ok := m.GetMode().(*MsgWithdrawRewardsAndDelegate_RecordsLimit); ok {
+		return x.RecordsLimit
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "archway.rewards.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SetContractMetadata",
-			Handler:    _Msg_SetContractMetadata_Handler,
-		},
-		{
-			MethodName: "WithdrawRewards",
-			Handler:    _Msg_WithdrawRewards_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "archway/rewards/v1beta1/tx.proto",
+func (m *MsgWithdrawRewardsAndDelegate) GetRecordIds() *MsgWithdrawRewards_RecordIDs {
+	if x, ok := m.GetMode().(*MsgWithdrawRewardsAndDelegate_RecordIds); ok {
+		return x.RecordIds
+	}
+	return nil
 }
 
-func (m *MsgSetContractMetadata) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*MsgWithdrawRewardsAndDelegate) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*MsgWithdrawRewardsAndDelegate_RecordsLimit)(nil),
+		(*MsgWithdrawRewardsAndDelegate_RecordIds)(nil),
 	}
-	return dAtA[:n], nil
 }
 
-func (m *MsgSetContractMetadata) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgWithdrawRewardsAndDelegateResponse is the response for Msg.WithdrawRewardsAndDelegate.
+type MsgWithdrawRewardsAndDelegateResponse struct {
+	// records_num is the number of RewardsRecord objects processed.
+	RecordsNum uint64 `protobuf:"varint,1,opt,name=records_num,json=recordsNum,proto3" json:"records_num,omitempty"`
+	// rewards are the total rewards transferred.
+	TotalRewards []types.Coin `protobuf:"bytes,2,rep,name=total_rewards,json=totalRewards,proto3" json:"total_rewards"`
+	// delegated_amount is the staking denom part of total_rewards delegated to the validator.
+	DelegatedAmount types.Coin `protobuf:"bytes,3,opt,name=delegated_amount,json=delegatedAmount,proto3" json:"delegated_amount"`
 }
 
-func (m *MsgSetContractMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgWithdrawRewardsAndDelegateResponse) Reset()         { *m = MsgWithdrawRewardsAndDelegateResponse{} }
+func (m *MsgWithdrawRewardsAndDelegateResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawRewardsAndDelegateResponse) ProtoMessage()    {}
+func (*MsgWithdrawRewardsAndDelegateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cda0f3b1281e62e0, []int{7}
+}
+func (m *MsgWithdrawRewardsAndDelegateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawRewardsAndDelegateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawRewardsAndDelegateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.SenderAddress) > 0 {
-		i -= len(m.SenderAddress)
-		copy(dAtA[i:], m.SenderAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgWithdrawRewardsAndDelegateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawRewardsAndDelegateResponse.Merge(m, src)
+}
+func (m *MsgWithdrawRewardsAndDelegateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawRewardsAndDelegateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawRewardsAndDelegateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawRewardsAndDelegateResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawRewardsAndDelegateResponse) GetRecordsNum() uint64 {
+	if m != nil {
+		return m.RecordsNum
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgSetContractMetadataResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgWithdrawRewardsAndDelegateResponse) GetTotalRewards() []types.Coin {
+	if m != nil {
+		return m.TotalRewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgSetContractMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgWithdrawRewardsAndDelegateResponse) GetDelegatedAmount() types.Coin {
+	if m != nil {
+		return m.DelegatedAmount
+	}
+	return types.Coin{}
 }
 
-func (m *MsgSetContractMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgAcceptContractMetadataOwnership is the request for Msg.AcceptContractMetadataOwnership.
+type MsgAcceptContractMetadataOwnership struct {
+	// sender_address is the msg sender address (bech32 encoded).
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// contract_address is the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
 }
 
-func (m *MsgWithdrawRewards) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgAcceptContractMetadataOwnership) Reset()         { *m = MsgAcceptContractMetadataOwnership{} }
+func (m *MsgAcceptContractMetadataOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptContractMetadataOwnership) ProtoMessage()    {}
+func (*MsgAcceptContractMetadataOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cda0f3b1281e62e0, []int{8}
+}
+func (m *MsgAcceptContractMetadataOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptContractMetadataOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptContractMetadataOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgWithdrawRewards) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAcceptContractMetadataOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptContractMetadataOwnership.Merge(m, src)
+}
+func (m *MsgAcceptContractMetadataOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptContractMetadataOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptContractMetadataOwnership.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Mode != nil {
-		{
-			size := m.Mode.Size()
-			i -= size
-			if _, err := m.Mode.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-		}
+var xxx_messageInfo_MsgAcceptContractMetadataOwnership proto.InternalMessageInfo
+
+func (m *MsgAcceptContractMetadataOwnership) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
 	}
-	if len(m.RewardsAddress) > 0 {
-		i -= len(m.RewardsAddress)
-		copy(dAtA[i:], m.RewardsAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardsAddress)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgAcceptContractMetadataOwnership) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgWithdrawRewards_RecordsLimit_) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgAcceptContractMetadataOwnershipResponse is the response for Msg.AcceptContractMetadataOwnership.
+type MsgAcceptContractMetadataOwnershipResponse struct {
 }
 
-func (m *MsgWithdrawRewards_RecordsLimit_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RecordsLimit != nil {
-		{
-			size, err := m.RecordsLimit.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgAcceptContractMetadataOwnershipResponse) Reset() {
+	*m = MsgAcceptContractMetadataOwnershipResponse{}
+}
+func (m *MsgAcceptContractMetadataOwnershipResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgAcceptContractMetadataOwnershipResponse) ProtoMessage() {}
+func (*MsgAcceptContractMetadataOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cda0f3b1281e62e0, []int{9}
+}
+func (m *MsgAcceptContractMetadataOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptContractMetadataOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptContractMetadataOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *MsgWithdrawRewards_RecordIds) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAcceptContractMetadataOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptContractMetadataOwnershipResponse.Merge(m, src)
+}
+func (m *MsgAcceptContractMetadataOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptContractMetadataOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptContractMetadataOwnershipResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawRewards_RecordIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.RecordIds != nil {
-		{
-			size, err := m.RecordIds.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+var xxx_messageInfo_MsgAcceptContractMetadataOwnershipResponse proto.InternalMessageInfo
+
+// MsgCancelContractMetadataOwnership is the request for Msg.CancelContractMetadataOwnership.
+type MsgCancelContractMetadataOwnership struct {
+	// sender_address is the msg sender address (bech32 encoded).
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// contract_address is the contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+}
+
+func (m *MsgCancelContractMetadataOwnership) Reset()         { *m = MsgCancelContractMetadataOwnership{} }
+func (m *MsgCancelContractMetadataOwnership) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelContractMetadataOwnership) ProtoMessage()    {}
+func (*MsgCancelContractMetadataOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cda0f3b1281e62e0, []int{10}
+}
+func (m *MsgCancelContractMetadataOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelContractMetadataOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelContractMetadataOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-func (m *MsgWithdrawRewards_RecordsLimit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCancelContractMetadataOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelContractMetadataOwnership.Merge(m, src)
 }
-
-func (m *MsgWithdrawRewards_RecordsLimit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCancelContractMetadataOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelContractMetadataOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelContractMetadataOwnership.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawRewards_RecordsLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Limit != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_MsgCancelContractMetadataOwnership proto.InternalMessageInfo
+
+func (m *MsgCancelContractMetadataOwnership) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgWithdrawRewards_RecordIDs) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCancelContractMetadataOwnership) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgWithdrawRewards_RecordIDs) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCancelContractMetadataOwnershipResponse is the response for Msg.CancelContractMetadataOwnership.
+type MsgCancelContractMetadataOwnershipResponse struct {
 }
 
-func (m *MsgWithdrawRewards_RecordIDs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		dAtA5 := make([]byte, len(m.Ids)*10)
-		var j4 int
-		for _, num := range m.Ids {
-			for num >= 1<<7 {
-				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j4++
-			}
-			dAtA5[j4] = uint8(num)
-			j4++
+func (m *MsgCancelContractMetadataOwnershipResponse) Reset() {
+	*m = MsgCancelContractMetadataOwnershipResponse{}
+}
+func (m *MsgCancelContractMetadataOwnershipResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgCancelContractMetadataOwnershipResponse) ProtoMessage() {}
+func (*MsgCancelContractMetadataOwnershipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cda0f3b1281e62e0, []int{11}
+}
+func (m *MsgCancelContractMetadataOwnershipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelContractMetadataOwnershipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelContractMetadataOwnershipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j4
-		copy(dAtA[i:], dAtA5[:j4])
-		i = encodeVarintTx(dAtA, i, uint64(j4))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgWithdrawRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgCancelContractMetadataOwnershipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelContractMetadataOwnershipResponse.Merge(m, src)
+}
+func (m *MsgCancelContractMetadataOwnershipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelContractMetadataOwnershipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelContractMetadataOwnershipResponse.DiscardUnknown(m)
 }
 
-func (m *MsgWithdrawRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCancelContractMetadataOwnershipResponse proto.InternalMessageInfo
+
+// MsgSetCodeMetadata is the request for Msg.SetCodeMetadata.
+type MsgSetCodeMetadata struct {
+	// sender_address is the msg sender address (bech32 encoded).
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// metadata is the code metadata to set / update.
+	// If metadata exists, non-empty fields will be updated.
+	Metadata CodeMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata"`
 }
 
-func (m *MsgWithdrawRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.TotalRewards) > 0 {
-		for iNdEx := len(m.TotalRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *MsgSetCodeMetadata) Reset()         { *m = MsgSetCodeMetadata{} }
+func (m *MsgSetCodeMetadata) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCodeMetadata) ProtoMessage()    {}
+func (*MsgSetCodeMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cda0f3b1281e62e0, []int{12}
+}
+func (m *MsgSetCodeMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCodeMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCodeMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.RecordsNum != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.RecordsNum))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *MsgSetCodeMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCodeMetadata.Merge(m, src)
+}
+func (m *MsgSetCodeMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCodeMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCodeMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCodeMetadata proto.InternalMessageInfo
+
+func (m *MsgSetCodeMetadata) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetCodeMetadata) GetMetadata() CodeMetadata {
+	if m != nil {
+		return m.Metadata
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return CodeMetadata{}
 }
-func (m *MsgSetContractMetadata) Size() (n int) {
-	if m == nil {
-		return 0
+
+// MsgSetCodeMetadataResponse is the response for Msg.SetCodeMetadata.
+type MsgSetCodeMetadataResponse struct {
+}
+
+func (m *MsgSetCodeMetadataResponse) Reset()         { *m = MsgSetCodeMetadataResponse{} }
+func (m *MsgSetCodeMetadataResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCodeMetadataResponse) ProtoMessage()    {}
+func (*MsgSetCodeMetadataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cda0f3b1281e62e0, []int{13}
+}
+func (m *MsgSetCodeMetadataResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCodeMetadataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCodeMetadataResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.SenderAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgSetCodeMetadataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCodeMetadataResponse.Merge(m, src)
+}
+func (m *MsgSetCodeMetadataResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCodeMetadataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCodeMetadataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCodeMetadataResponse proto.InternalMessageInfo
+
+// MsgSetAutoPayout is the request for Msg.SetAutoPayout.
+type MsgSetAutoPayout struct {
+	// sender_address is the msg sender address (bech32 encoded).
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// contract_address is the contract address (bech32 encoded).
+	// Rewards are paid out to the contract metadata rewards_address (or every rewards_recipients address).
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// interval_blocks defines the number of blocks between payouts (0 to disable).
+	IntervalBlocks uint64 `protobuf:"varint,3,opt,name=interval_blocks,json=intervalBlocks,proto3" json:"interval_blocks,omitempty"`
+	// threshold defines the accrued rewards amount that triggers a payout (empty to disable).
+	// If both interval_blocks and threshold are not set, the contract is opted out.
+	Threshold github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=threshold,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"threshold"`
+}
+
+func (m *MsgSetAutoPayout) Reset()         { *m = MsgSetAutoPayout{} }
+func (m *MsgSetAutoPayout) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoPayout) ProtoMessage()    {}
+func (*MsgSetAutoPayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cda0f3b1281e62e0, []int{14}
+}
+func (m *MsgSetAutoPayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoPayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoPayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Metadata.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgSetAutoPayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoPayout.Merge(m, src)
+}
+func (m *MsgSetAutoPayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoPayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoPayout.DiscardUnknown(m)
 }
 
-func (m *MsgSetContractMetadataResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgSetAutoPayout proto.InternalMessageInfo
+
+func (m *MsgSetAutoPayout) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgWithdrawRewards) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetAutoPayout) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
 	}
-	var l int
-	_ = l
-	l = len(m.RewardsAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSetAutoPayout) GetIntervalBlocks() uint64 {
+	if m != nil {
+		return m.IntervalBlocks
 	}
-	if m.Mode != nil {
-		n += m.Mode.Size()
+	return 0
+}
+
+func (m *MsgSetAutoPayout) GetThreshold() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Threshold
+	}
+	return nil
+}
+
+// MsgSetAutoPayoutResponse is the response for Msg.SetAutoPayout.
+type MsgSetAutoPayoutResponse struct {
+}
+
+func (m *MsgSetAutoPayoutResponse) Reset()         { *m = MsgSetAutoPayoutResponse{} }
+func (m *MsgSetAutoPayoutResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoPayoutResponse) ProtoMessage()    {}
+func (*MsgSetAutoPayoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cda0f3b1281e62e0, []int{15}
+}
+func (m *MsgSetAutoPayoutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoPayoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoPayoutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoPayoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoPayoutResponse.Merge(m, src)
+}
+func (m *MsgSetAutoPayoutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoPayoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoPayoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoPayoutResponse proto.InternalMessageInfo
+
+// MsgSetSponsorship is the request for Msg.SetSponsorship.
+type MsgSetSponsorship struct {
+	// sender_address is the msg sender address (bech32 encoded).
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// contract_address is the sponsoring contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// user_fee_limit defines the maximum amount of fees sponsored for a single user within a user_fee_limit_period (empty to disable the sponsorship).
+	UserFeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=user_fee_limit,json=userFeeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"user_fee_limit"`
+	// block_fee_limit defines the maximum amount of fees sponsored within a single block (empty to disable the sponsorship).
+	BlockFeeLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=block_fee_limit,json=blockFeeLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"block_fee_limit"`
+	// fund_from_rewards flag defines whether the contract rewards are pledged to the deposit.
+	FundFromRewards bool `protobuf:"varint,5,opt,name=fund_from_rewards,json=fundFromRewards,proto3" json:"fund_from_rewards,omitempty"`
+	// user_fee_limit_period defines the number of blocks the user_fee_limit is applied within (must be set if the user_fee_limit is set).
+	UserFeeLimitPeriod uint64 `protobuf:"varint,6,opt,name=user_fee_limit_period,json=userFeeLimitPeriod,proto3" json:"user_fee_limit_period,omitempty"`
+}
+
+func (m *MsgSetSponsorship) Reset()         { *m = MsgSetSponsorship{} }
+func (m *MsgSetSponsorship) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSponsorship) ProtoMessage()    {}
+func (*MsgSetSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cda0f3b1281e62e0, []int{16}
+}
+func (m *MsgSetSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSponsorship.Merge(m, src)
+}
+func (m *MsgSetSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSponsorship proto.InternalMessageInfo
+
+func (m *MsgSetSponsorship) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
+	}
+	return ""
+}
+
+func (m *MsgSetSponsorship) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgSetSponsorship) GetUserFeeLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.UserFeeLimit
+	}
+	return nil
+}
+
+func (m *MsgSetSponsorship) GetBlockFeeLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BlockFeeLimit
+	}
+	return nil
+}
+
+func (m *MsgSetSponsorship) GetFundFromRewards() bool {
+	if m != nil {
+		return m.FundFromRewards
+	}
+	return false
+}
+
+func (m *MsgSetSponsorship) GetUserFeeLimitPeriod() uint64 {
+	if m != nil {
+		return m.UserFeeLimitPeriod
+	}
+	return 0
+}
+
+// MsgSetSponsorshipResponse is the response for Msg.SetSponsorship.
+type MsgSetSponsorshipResponse struct {
+}
+
+func (m *MsgSetSponsorshipResponse) Reset()         { *m = MsgSetSponsorshipResponse{} }
+func (m *MsgSetSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSponsorshipResponse) ProtoMessage()    {}
+func (*MsgSetSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cda0f3b1281e62e0, []int{17}
+}
+func (m *MsgSetSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSponsorshipResponse.Merge(m, src)
+}
+func (m *MsgSetSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetSponsorshipResponse proto.InternalMessageInfo
+
+// MsgDepositSponsorship is the request for Msg.DepositSponsorship.
+type MsgDepositSponsorship struct {
+	// sender_address is the msg sender address (bech32 encoded).
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// contract_address is the sponsoring contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// amount defines the funds to deposit.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgDepositSponsorship) Reset()         { *m = MsgDepositSponsorship{} }
+func (m *MsgDepositSponsorship) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositSponsorship) ProtoMessage()    {}
+func (*MsgDepositSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cda0f3b1281e62e0, []int{18}
+}
+func (m *MsgDepositSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDepositSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositSponsorship.Merge(m, src)
+}
+func (m *MsgDepositSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDepositSponsorship proto.InternalMessageInfo
+
+func (m *MsgDepositSponsorship) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
+	}
+	return ""
+}
+
+func (m *MsgDepositSponsorship) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgDepositSponsorship) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgDepositSponsorshipResponse is the response for Msg.DepositSponsorship.
+type MsgDepositSponsorshipResponse struct {
+}
+
+func (m *MsgDepositSponsorshipResponse) Reset()         { *m = MsgDepositSponsorshipResponse{} }
+func (m *MsgDepositSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositSponsorshipResponse) ProtoMessage()    {}
+func (*MsgDepositSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cda0f3b1281e62e0, []int{19}
+}
+func (m *MsgDepositSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDepositSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositSponsorshipResponse.Merge(m, src)
+}
+func (m *MsgDepositSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDepositSponsorshipResponse proto.InternalMessageInfo
+
+// MsgWithdrawSponsorship is the request for Msg.WithdrawSponsorship.
+type MsgWithdrawSponsorship struct {
+	// sender_address is the msg sender address (bech32 encoded).
+	SenderAddress string `protobuf:"bytes,1,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// contract_address is the sponsoring contract address (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,2,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// amount defines the funds to withdraw.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgWithdrawSponsorship) Reset()         { *m = MsgWithdrawSponsorship{} }
+func (m *MsgWithdrawSponsorship) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawSponsorship) ProtoMessage()    {}
+func (*MsgWithdrawSponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cda0f3b1281e62e0, []int{20}
+}
+func (m *MsgWithdrawSponsorship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawSponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawSponsorship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawSponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawSponsorship.Merge(m, src)
+}
+func (m *MsgWithdrawSponsorship) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawSponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawSponsorship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawSponsorship proto.InternalMessageInfo
+
+func (m *MsgWithdrawSponsorship) GetSenderAddress() string {
+	if m != nil {
+		return m.SenderAddress
+	}
+	return ""
+}
+
+func (m *MsgWithdrawSponsorship) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *MsgWithdrawSponsorship) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgWithdrawSponsorshipResponse is the response for Msg.WithdrawSponsorship.
+type MsgWithdrawSponsorshipResponse struct {
+}
+
+func (m *MsgWithdrawSponsorshipResponse) Reset()         { *m = MsgWithdrawSponsorshipResponse{} }
+func (m *MsgWithdrawSponsorshipResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawSponsorshipResponse) ProtoMessage()    {}
+func (*MsgWithdrawSponsorshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cda0f3b1281e62e0, []int{21}
+}
+func (m *MsgWithdrawSponsorshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawSponsorshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawSponsorshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgWithdrawSponsorshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawSponsorshipResponse.Merge(m, src)
+}
+func (m *MsgWithdrawSponsorshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawSponsorshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawSponsorshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgWithdrawSponsorshipResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetContractMetadata)(nil), "archway.rewards.v1beta1.MsgSetContractMetadata")
+	proto.RegisterType((*MsgSetContractMetadataResponse)(nil), "archway.rewards.v1beta1.MsgSetContractMetadataResponse")
+	proto.RegisterType((*MsgWithdrawRewards)(nil), "archway.rewards.v1beta1.MsgWithdrawRewards")
+	proto.RegisterType((*MsgWithdrawRewards_RecordsLimit)(nil), "archway.rewards.v1beta1.MsgWithdrawRewards.RecordsLimit")
+	proto.RegisterType((*MsgWithdrawRewards_RecordIDs)(nil), "archway.rewards.v1beta1.MsgWithdrawRewards.RecordIDs")
+	proto.RegisterType((*MsgWithdrawRewards_IBCTransfer)(nil), "archway.rewards.v1beta1.MsgWithdrawRewards.IBCTransfer")
+	proto.RegisterType((*MsgWithdrawRewardsResponse)(nil), "archway.rewards.v1beta1.MsgWithdrawRewardsResponse")
+	proto.RegisterType((*MsgSetFlatFee)(nil), "archway.rewards.v1beta1.MsgSetFlatFee")
+	proto.RegisterType((*MsgSetFlatFeeResponse)(nil), "archway.rewards.v1beta1.MsgSetFlatFeeResponse")
+	proto.RegisterType((*MsgWithdrawRewardsAndDelegate)(nil), "archway.rewards.v1beta1.MsgWithdrawRewardsAndDelegate")
+	proto.RegisterType((*MsgWithdrawRewardsAndDelegateResponse)(nil), "archway.rewards.v1beta1.MsgWithdrawRewardsAndDelegateResponse")
+	proto.RegisterType((*MsgAcceptContractMetadataOwnership)(nil), "archway.rewards.v1beta1.MsgAcceptContractMetadataOwnership")
+	proto.RegisterType((*MsgAcceptContractMetadataOwnershipResponse)(nil), "archway.rewards.v1beta1.MsgAcceptContractMetadataOwnershipResponse")
+	proto.RegisterType((*MsgCancelContractMetadataOwnership)(nil), "archway.rewards.v1beta1.MsgCancelContractMetadataOwnership")
+	proto.RegisterType((*MsgCancelContractMetadataOwnershipResponse)(nil), "archway.rewards.v1beta1.MsgCancelContractMetadataOwnershipResponse")
+	proto.RegisterType((*MsgSetCodeMetadata)(nil), "archway.rewards.v1beta1.MsgSetCodeMetadata")
+	proto.RegisterType((*MsgSetCodeMetadataResponse)(nil), "archway.rewards.v1beta1.MsgSetCodeMetadataResponse")
+	proto.RegisterType((*MsgSetAutoPayout)(nil), "archway.rewards.v1beta1.MsgSetAutoPayout")
+	proto.RegisterType((*MsgSetAutoPayoutResponse)(nil), "archway.rewards.v1beta1.MsgSetAutoPayoutResponse")
+	proto.RegisterType((*MsgSetSponsorship)(nil), "archway.rewards.v1beta1.MsgSetSponsorship")
+	proto.RegisterType((*MsgSetSponsorshipResponse)(nil), "archway.rewards.v1beta1.MsgSetSponsorshipResponse")
+	proto.RegisterType((*MsgDepositSponsorship)(nil), "archway.rewards.v1beta1.MsgDepositSponsorship")
+	proto.RegisterType((*MsgDepositSponsorshipResponse)(nil), "archway.rewards.v1beta1.MsgDepositSponsorshipResponse")
+	proto.RegisterType((*MsgWithdrawSponsorship)(nil), "archway.rewards.v1beta1.MsgWithdrawSponsorship")
+	proto.RegisterType((*MsgWithdrawSponsorshipResponse)(nil), "archway.rewards.v1beta1.MsgWithdrawSponsorshipResponse")
+}
+
+func init() { proto.RegisterFile("archway/rewards/v1beta1/tx.proto", fileDescriptor_cda0f3b1281e62e0) }
+
+var fileDescriptor_cda0f3b1281e62e0 = []byte{
+	// 1216 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xf7, 0xd6, 0x6e, 0x94, 0x3c, 0xc7, 0x71, 0xb2, 0xfd, 0xe5, 0xee, 0xf7, 0x5b, 0xc7, 0x32,
+	0x84, 0x3a, 0x09, 0xb5, 0x49, 0x22, 0x1a, 0x24, 0x24, 0xa4, 0xc4, 0x51, 0xda, 0x40, 0x03, 0xd5,
+	0xb6, 0x02, 0xa9, 0x97, 0xd5, 0x78, 0x77, 0x6c, 0xaf, 0xe2, 0xdd, 0x31, 0x33, 0xe3, 0xa4, 0x91,
+	0x40, 0x3d, 0xc0, 0x91, 0x03, 0x07, 0x2e, 0x9c, 0xe1, 0x54, 0x71, 0xe6, 0x6f, 0xe8, 0x09, 0x7a,
+	0x44, 0x1c, 0x00, 0x25, 0xff, 0x04, 0x47, 0xb4, 0xbb, 0xb3, 0x93, 0x8d, 0xed, 0x78, 0xbd, 0x34,
+	0xe9, 0x81, 0x53, 0x32, 0x6f, 0xde, 0x7b, 0x9f, 0xcf, 0x7b, 0xf3, 0xde, 0xbc, 0x59, 0x43, 0x09,
+	0x51, 0xb3, 0x7d, 0x80, 0x0e, 0x6b, 0x14, 0x1f, 0x20, 0x6a, 0xb1, 0xda, 0xfe, 0x4a, 0x03, 0x73,
+	0xb4, 0x52, 0xe3, 0x4f, 0xab, 0x5d, 0x4a, 0x38, 0x51, 0x6f, 0x08, 0x8d, 0xaa, 0xd0, 0xa8, 0x0a,
+	0x0d, 0xed, 0x6a, 0x8b, 0xb4, 0x88, 0xaf, 0x53, 0xf3, 0xfe, 0x0b, 0xd4, 0xb5, 0xa2, 0x49, 0x98,
+	0x43, 0x58, 0xad, 0x81, 0x18, 0x96, 0xce, 0x4c, 0x62, 0xbb, 0x62, 0x7f, 0xe1, 0x2c, 0xc0, 0xd0,
+	0xbd, 0xaf, 0x56, 0xfe, 0x46, 0x81, 0xeb, 0xbb, 0xac, 0xf5, 0x08, 0xf3, 0x3a, 0x71, 0x39, 0x45,
+	0x26, 0xdf, 0xc5, 0x1c, 0x59, 0x88, 0x23, 0x75, 0x01, 0x66, 0x18, 0x76, 0x2d, 0x4c, 0x0d, 0x64,
+	0x59, 0x14, 0x33, 0x56, 0x50, 0x4a, 0x4a, 0x65, 0x4a, 0xcf, 0x05, 0xd2, 0x8d, 0x40, 0xa8, 0x7e,
+	0x04, 0x93, 0x8e, 0x30, 0x29, 0x5c, 0x2a, 0x29, 0x95, 0xec, 0xea, 0x62, 0xf5, 0x8c, 0x50, 0xaa,
+	0xfd, 0x18, 0x9b, 0x99, 0x17, 0x7f, 0xcc, 0xa7, 0x74, 0xe9, 0xa0, 0x5c, 0x82, 0xe2, 0x70, 0x36,
+	0x3a, 0x66, 0x5d, 0xe2, 0x32, 0x5c, 0xfe, 0x31, 0x03, 0xea, 0x2e, 0x6b, 0x7d, 0x66, 0xf3, 0xb6,
+	0x45, 0xd1, 0x81, 0x1e, 0x20, 0xa8, 0xb7, 0x21, 0x2f, 0xc0, 0xfa, 0xd8, 0xce, 0x08, 0x71, 0x48,
+	0xd7, 0x80, 0x1c, 0xc5, 0x26, 0xf1, 0x14, 0x3b, 0xb6, 0x63, 0x73, 0xc1, 0xf9, 0xbd, 0x33, 0x39,
+	0x0f, 0x82, 0x55, 0xf5, 0xc0, 0xc1, 0x03, 0xcf, 0xfe, 0x7e, 0x4a, 0x9f, 0xa6, 0x91, 0xb5, 0xfa,
+	0x29, 0x40, 0xb0, 0x36, 0x6c, 0x8b, 0x15, 0xd2, 0xbe, 0xf7, 0x77, 0x93, 0x7b, 0xdf, 0xd9, 0x62,
+	0xf7, 0x53, 0xfa, 0x54, 0xe0, 0x6a, 0xc7, 0x62, 0xea, 0x13, 0x98, 0xb6, 0x1b, 0xa6, 0xc1, 0x29,
+	0x72, 0x59, 0x13, 0xd3, 0x42, 0xc6, 0xf7, 0xbc, 0x9e, 0xc4, 0xf3, 0xce, 0x66, 0xfd, 0xb1, 0x30,
+	0xd7, 0xb3, 0x76, 0xc3, 0x0c, 0x17, 0xda, 0x9b, 0x30, 0x1d, 0x8d, 0x49, 0xbd, 0x0a, 0x97, 0x83,
+	0xe4, 0x78, 0x39, 0xcc, 0xe8, 0xc1, 0x42, 0x7b, 0x03, 0xa6, 0x24, 0x37, 0xf5, 0x3a, 0xa4, 0xbd,
+	0xf8, 0x94, 0x52, 0xba, 0x92, 0x11, 0xc7, 0xe8, 0x09, 0xb4, 0x2f, 0x21, 0x1b, 0x81, 0xf1, 0x8b,
+	0x88, 0xf4, 0xa8, 0x89, 0x0d, 0xb3, 0x8d, 0x5c, 0x17, 0x77, 0x64, 0x11, 0xf9, 0xd2, 0x7a, 0x20,
+	0x54, 0x35, 0x98, 0xa4, 0xd8, 0xc4, 0xf6, 0x3e, 0xa6, 0xfe, 0x81, 0x4c, 0xe9, 0x72, 0xad, 0x2e,
+	0xc3, 0x1c, 0xb7, 0x1d, 0x4c, 0x7a, 0xdc, 0xf0, 0xfe, 0x32, 0x8e, 0x9c, 0xae, 0x9f, 0xd7, 0x8c,
+	0x3e, 0x2b, 0x36, 0x1e, 0x87, 0xf2, 0xcd, 0x09, 0xc8, 0x38, 0xc4, 0xc2, 0xe5, 0xaf, 0x14, 0xd0,
+	0x06, 0x33, 0x10, 0x56, 0x91, 0x3a, 0x0f, 0xd9, 0xb0, 0x0a, 0xdc, 0x9e, 0x23, 0xc2, 0x14, 0xe7,
+	0xc6, 0x3e, 0xee, 0x39, 0xea, 0x16, 0xe4, 0x38, 0xe1, 0xa8, 0x63, 0x88, 0xb4, 0x16, 0x2e, 0x95,
+	0xd2, 0x95, 0xec, 0xea, 0xcd, 0x6a, 0xd0, 0x76, 0x55, 0xaf, 0xed, 0x22, 0x65, 0x6d, 0xbb, 0x22,
+	0x07, 0xd3, 0xbe, 0x95, 0x80, 0x2b, 0x3f, 0x57, 0x20, 0x17, 0xd4, 0xf3, 0x76, 0x07, 0xf1, 0x6d,
+	0x8c, 0xc7, 0x6d, 0xaa, 0x45, 0x98, 0x35, 0x45, 0x07, 0x48, 0xc5, 0x20, 0x2f, 0xf9, 0x50, 0x1e,
+	0xaa, 0xde, 0x83, 0x7c, 0xb3, 0x83, 0xb8, 0xd1, 0xc4, 0xd8, 0x40, 0x0e, 0xe9, 0xb9, 0x5c, 0x14,
+	0x5d, 0x2c, 0xd7, 0x5c, 0x33, 0x20, 0xb5, 0xe1, 0x5b, 0x95, 0x6f, 0xc0, 0xb5, 0x53, 0x5c, 0x65,
+	0xcb, 0xfd, 0x7c, 0x09, 0x6e, 0x0d, 0xe6, 0x72, 0xc3, 0xb5, 0xb6, 0x70, 0x07, 0xb7, 0x10, 0xc7,
+	0xe3, 0x77, 0xdf, 0x32, 0xcc, 0xed, 0xa3, 0x8e, 0x6d, 0x21, 0x4e, 0x68, 0x5f, 0x60, 0xb3, 0x72,
+	0xe3, 0xcc, 0x56, 0x4d, 0x5f, 0x68, 0xab, 0x66, 0xce, 0xab, 0x55, 0x65, 0x11, 0xfe, 0xae, 0xc0,
+	0xc2, 0xc8, 0xc4, 0xbd, 0xe6, 0x7a, 0x54, 0x3f, 0x84, 0x59, 0x4b, 0x40, 0x5b, 0x09, 0x8b, 0x25,
+	0x2f, 0x0d, 0x45, 0xb9, 0xec, 0x43, 0x79, 0x97, 0xb5, 0x36, 0x4c, 0x13, 0x77, 0x07, 0x6e, 0xeb,
+	0x4f, 0x0e, 0x5c, 0x4c, 0x59, 0xdb, 0xee, 0x9e, 0x7f, 0xbd, 0x97, 0xdf, 0x86, 0xa5, 0x78, 0x5c,
+	0x59, 0xbb, 0x01, 0xcb, 0x3a, 0x72, 0x4d, 0xdc, 0x79, 0xfd, 0x2c, 0x63, 0x70, 0x25, 0xcb, 0xaf,
+	0x15, 0x50, 0xc3, 0xb9, 0x67, 0xe1, 0xa4, 0x13, 0xf8, 0xde, 0xc0, 0x04, 0x5e, 0x18, 0x31, 0x81,
+	0x4f, 0xfc, 0x0f, 0x4c, 0xdf, 0xff, 0x83, 0x36, 0xc8, 0x42, 0x92, 0xfc, 0x5b, 0x81, 0xd9, 0x60,
+	0x7b, 0xa3, 0xc7, 0xc9, 0x43, 0x74, 0x48, 0x7a, 0xfc, 0x02, 0xee, 0xb3, 0xdb, 0x90, 0xb7, 0x5d,
+	0x8e, 0xe9, 0x3e, 0xea, 0x18, 0x8d, 0x0e, 0x31, 0xf7, 0x98, 0xb8, 0xec, 0x67, 0x42, 0xf1, 0xa6,
+	0x2f, 0x55, 0x6d, 0x98, 0xe2, 0x6d, 0x8a, 0x59, 0x9b, 0x74, 0xac, 0x42, 0x26, 0xae, 0x1d, 0xde,
+	0xf1, 0x62, 0x7d, 0xfe, 0xe7, 0x7c, 0xa5, 0x65, 0xf3, 0x76, 0xaf, 0x51, 0x35, 0x89, 0x53, 0x13,
+	0x4f, 0xa8, 0xe0, 0xcf, 0x1d, 0x66, 0xed, 0xd5, 0xf8, 0x61, 0x17, 0x33, 0xdf, 0x80, 0xe9, 0x27,
+	0xde, 0xcb, 0x1a, 0x14, 0xfa, 0x23, 0x97, 0x69, 0xf9, 0x29, 0x0d, 0x73, 0xc1, 0xe6, 0x23, 0x4f,
+	0x40, 0x2e, 0xa8, 0xa2, 0xd4, 0xcf, 0x61, 0xa6, 0xc7, 0x30, 0xf5, 0xef, 0xf9, 0xf0, 0x3a, 0x3c,
+	0xf7, 0x98, 0xa7, 0x3d, 0x88, 0x6d, 0x8c, 0x83, 0xfb, 0x91, 0x41, 0xde, 0x3f, 0x81, 0x08, 0xe6,
+	0x05, 0xe4, 0x39, 0xe7, 0x63, 0x48, 0xd0, 0x25, 0x98, 0x6b, 0xf6, 0x5c, 0xcb, 0x68, 0x52, 0xe2,
+	0xc8, 0xdb, 0xee, 0x72, 0x49, 0xa9, 0x4c, 0xea, 0x79, 0x6f, 0x63, 0x9b, 0x12, 0x27, 0xbc, 0xcf,
+	0x56, 0xe0, 0xda, 0xe9, 0x9c, 0x18, 0x5d, 0x4c, 0x6d, 0x62, 0x15, 0x26, 0xfc, 0x8a, 0x51, 0xa3,
+	0xd1, 0x3c, 0xf4, 0x77, 0xca, 0xff, 0x83, 0x9b, 0x03, 0xa7, 0x25, 0xcf, 0xf2, 0x17, 0xc5, 0x9f,
+	0x81, 0x5b, 0xb8, 0x4b, 0x98, 0x7d, 0xc1, 0xe7, 0x69, 0xc2, 0x84, 0xbc, 0x81, 0xcf, 0x3d, 0xa7,
+	0xc2, 0x75, 0x79, 0x1e, 0x6e, 0x0d, 0x8d, 0x47, 0x46, 0xfc, 0x6b, 0xf0, 0xfe, 0x0f, 0x47, 0xd4,
+	0x7f, 0x20, 0xe4, 0xe0, 0x13, 0x62, 0x48, 0x40, 0x61, 0xcc, 0xab, 0xdf, 0x67, 0x21, 0xbd, 0xcb,
+	0x5a, 0xea, 0x33, 0xb8, 0x32, 0xec, 0xbb, 0xa7, 0x36, 0xea, 0x05, 0x30, 0xc4, 0x40, 0x5b, 0x4f,
+	0x68, 0x20, 0xa7, 0x3e, 0x83, 0x7c, 0xff, 0x77, 0xcc, 0x72, 0x82, 0xe7, 0x87, 0xb6, 0x96, 0x40,
+	0x59, 0x82, 0x5a, 0x00, 0x91, 0xf7, 0xe8, 0x5b, 0x31, 0xdc, 0x85, 0x9e, 0x56, 0x1d, 0x4f, 0x4f,
+	0xa2, 0x7c, 0xa7, 0x80, 0x36, 0xe2, 0xc1, 0x78, 0x37, 0x01, 0xf3, 0x88, 0x9d, 0xf6, 0xc1, 0xbf,
+	0xb3, 0x93, 0xb4, 0x7e, 0x50, 0x60, 0x3e, 0xee, 0xc9, 0xf2, 0xfe, 0x28, 0x8c, 0x18, 0x63, 0xad,
+	0xfe, 0x0a, 0xc6, 0xa7, 0x58, 0xc6, 0x3d, 0x59, 0x46, 0xb2, 0x8c, 0x31, 0xd6, 0xea, 0xaf, 0x60,
+	0x1c, 0xad, 0xde, 0xfe, 0x07, 0xcb, 0x72, 0x6c, 0x27, 0x9c, 0x28, 0x6b, 0x6b, 0x09, 0x94, 0x25,
+	0xa8, 0x03, 0xb9, 0xd3, 0x0f, 0x90, 0xc5, 0x18, 0x2f, 0x27, 0xaa, 0xda, 0xca, 0xd8, 0xaa, 0x12,
+	0xae, 0x0b, 0x33, 0x7d, 0x83, 0x7d, 0x29, 0xc6, 0x49, 0x44, 0x57, 0x5b, 0x1d, 0x5f, 0x57, 0x22,
+	0x7e, 0x01, 0xea, 0x90, 0xf1, 0x33, 0xb2, 0xfd, 0x06, 0xf5, 0xb5, 0xbb, 0xc9, 0xf4, 0x25, 0xfa,
+	0x33, 0xb8, 0x32, 0x6c, 0x14, 0xd4, 0xc6, 0x69, 0xbb, 0x28, 0xfe, 0x7a, 0x42, 0x83, 0x90, 0xc0,
+	0xe6, 0x83, 0x17, 0x47, 0x45, 0xe5, 0xe5, 0x51, 0x51, 0xf9, 0xeb, 0xa8, 0xa8, 0x7c, 0x7b, 0x5c,
+	0x4c, 0xbd, 0x3c, 0x2e, 0xa6, 0x7e, 0x3b, 0x2e, 0xa6, 0x9e, 0xac, 0x46, 0x26, 0x81, 0x70, 0x7e,
+	0xc7, 0xc5, 0xfc, 0x80, 0xd0, 0xbd, 0x70, 0x5d, 0x7b, 0x2a, 0x7f, 0xed, 0xf2, 0x27, 0x43, 0x63,
+	0xc2, 0xff, 0x91, 0x6b, 0xed, 0x9f, 0x01, 0x00, 0x14, 0xdd, 0xaa, 0xec, 0x7e, 0x13, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SetContractMetadata creates or updates an existing contract metadata.
+	// Method is authorized to the contract owner (admin if no metadata exists).
+	// An owner_address change nominates a pending owner which has to accept the ownership (unless the contract itself is nominated).
+	SetContractMetadata(ctx context.Context, in *MsgSetContractMetadata, opts ...grpc.CallOption) (*MsgSetContractMetadataResponse, error)
+	// WithdrawRewards performs collected rewards distribution.
+	// Rewards might be credited from multiple contracts (rewards_address must be set in the corresponding contract metadata).
+	WithdrawRewards(ctx context.Context, in *MsgWithdrawRewards, opts ...grpc.CallOption) (*MsgWithdrawRewardsResponse, error)
+	// SetFlatFee sets or removes (zero amount) the flat fee charged for every contract execution.
+	// Method is authorized to the contract metadata owner.
+	SetFlatFee(ctx context.Context, in *MsgSetFlatFee, opts ...grpc.CallOption) (*MsgSetFlatFeeResponse, error)
+	// WithdrawRewardsAndDelegate performs collected rewards distribution and delegates the staking denom rewards to a validator.
+	// Non-staking denom rewards are kept by the rewards_address.
+	WithdrawRewardsAndDelegate(ctx context.Context, in *MsgWithdrawRewardsAndDelegate, opts ...grpc.CallOption) (*MsgWithdrawRewardsAndDelegateResponse, error)
+	// AcceptContractMetadataOwnership completes the contract metadata ownership transfer.
+	// Method is authorized to the metadata pending owner.
+	AcceptContractMetadataOwnership(ctx context.Context, in *MsgAcceptContractMetadataOwnership, opts ...grpc.CallOption) (*MsgAcceptContractMetadataOwnershipResponse, error)
+	// CancelContractMetadataOwnership cancels the pending contract metadata ownership transfer.
+	// Method is authorized to the contract owner.
+	CancelContractMetadataOwnership(ctx context.Context, in *MsgCancelContractMetadataOwnership, opts ...grpc.CallOption) (*MsgCancelContractMetadataOwnershipResponse, error)
+	// SetCodeMetadata creates or updates an existing code metadata (default for contracts without metadata).
+	// Method is authorized to the code creator.
+	SetCodeMetadata(ctx context.Context, in *MsgSetCodeMetadata, opts ...grpc.CallOption) (*MsgSetCodeMetadataResponse, error)
+	// SetAutoPayout opts the contract in (or out) to the automatic rewards payout to its rewards address / recipients.
+	// Method is authorized to the contract metadata owner.
+	SetAutoPayout(ctx context.Context, in *MsgSetAutoPayout, opts ...grpc.CallOption) (*MsgSetAutoPayoutResponse, error)
+	// SetSponsorship creates or updates the contract transaction fees sponsorship limits.
+	// Method is authorized to the contract metadata owner and the contract itself.
+	SetSponsorship(ctx context.Context, in *MsgSetSponsorship, opts ...grpc.CallOption) (*MsgSetSponsorshipResponse, error)
+	// DepositSponsorship tops up the contract sponsorship deposit.
+	// Method is not authorized (anyone can fund an existing sponsorship).
+	DepositSponsorship(ctx context.Context, in *MsgDepositSponsorship, opts ...grpc.CallOption) (*MsgDepositSponsorshipResponse, error)
+	// WithdrawSponsorship withdraws funds from the contract sponsorship deposit to the sender.
+	// Method is authorized to the contract metadata owner and the contract itself.
+	WithdrawSponsorship(ctx context.Context, in *MsgWithdrawSponsorship, opts ...grpc.CallOption) (*MsgWithdrawSponsorshipResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SetContractMetadata(ctx context.Context, in *MsgSetContractMetadata, opts ...grpc.CallOption) (*MsgSetContractMetadataResponse, error) {
+	out := new(MsgSetContractMetadataResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Msg/SetContractMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawRewards(ctx context.Context, in *MsgWithdrawRewards, opts ...grpc.CallOption) (*MsgWithdrawRewardsResponse, error) {
+	out := new(MsgWithdrawRewardsResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Msg/WithdrawRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetFlatFee(ctx context.Context, in *MsgSetFlatFee, opts ...grpc.CallOption) (*MsgSetFlatFeeResponse, error) {
+	out := new(MsgSetFlatFeeResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Msg/SetFlatFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawRewardsAndDelegate(ctx context.Context, in *MsgWithdrawRewardsAndDelegate, opts ...grpc.CallOption) (*MsgWithdrawRewardsAndDelegateResponse, error) {
+	out := new(MsgWithdrawRewardsAndDelegateResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Msg/WithdrawRewardsAndDelegate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptContractMetadataOwnership(ctx context.Context, in *MsgAcceptContractMetadataOwnership, opts ...grpc.CallOption) (*MsgAcceptContractMetadataOwnershipResponse, error) {
+	out := new(MsgAcceptContractMetadataOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Msg/AcceptContractMetadataOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelContractMetadataOwnership(ctx context.Context, in *MsgCancelContractMetadataOwnership, opts ...grpc.CallOption) (*MsgCancelContractMetadataOwnershipResponse, error) {
+	out := new(MsgCancelContractMetadataOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Msg/CancelContractMetadataOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetCodeMetadata(ctx context.Context, in *MsgSetCodeMetadata, opts ...grpc.CallOption) (*MsgSetCodeMetadataResponse, error) {
+	out := new(MsgSetCodeMetadataResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Msg/SetCodeMetadata", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAutoPayout(ctx context.Context, in *MsgSetAutoPayout, opts ...grpc.CallOption) (*MsgSetAutoPayoutResponse, error) {
+	out := new(MsgSetAutoPayoutResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Msg/SetAutoPayout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSponsorship(ctx context.Context, in *MsgSetSponsorship, opts ...grpc.CallOption) (*MsgSetSponsorshipResponse, error) {
+	out := new(MsgSetSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Msg/SetSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DepositSponsorship(ctx context.Context, in *MsgDepositSponsorship, opts ...grpc.CallOption) (*MsgDepositSponsorshipResponse, error) {
+	out := new(MsgDepositSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Msg/DepositSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawSponsorship(ctx context.Context, in *MsgWithdrawSponsorship, opts ...grpc.CallOption) (*MsgWithdrawSponsorshipResponse, error) {
+	out := new(MsgWithdrawSponsorshipResponse)
+	err := c.cc.Invoke(ctx, "/archway.rewards.v1beta1.Msg/WithdrawSponsorship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SetContractMetadata creates or updates an existing contract metadata.
+	// Method is authorized to the contract owner (admin if no metadata exists).
+	// An owner_address change nominates a pending owner which has to accept the ownership (unless the contract itself is nominated).
+	SetContractMetadata(context.Context, *MsgSetContractMetadata) (*MsgSetContractMetadataResponse, error)
+	// WithdrawRewards performs collected rewards distribution.
+	// Rewards might be credited from multiple contracts (rewards_address must be set in the corresponding contract metadata).
+	WithdrawRewards(context.Context, *MsgWithdrawRewards) (*MsgWithdrawRewardsResponse, error)
+	// SetFlatFee sets or removes (zero amount) the flat fee charged for every contract execution.
+	// Method is authorized to the contract metadata owner.
+	SetFlatFee(context.Context, *MsgSetFlatFee) (*MsgSetFlatFeeResponse, error)
+	// WithdrawRewardsAndDelegate performs collected rewards distribution and delegates the staking denom rewards to a validator.
+	// Non-staking denom rewards are kept by the rewards_address.
+	WithdrawRewardsAndDelegate(context.Context, *MsgWithdrawRewardsAndDelegate) (*MsgWithdrawRewardsAndDelegateResponse, error)
+	// AcceptContractMetadataOwnership completes the contract metadata ownership transfer.
+	// Method is authorized to the metadata pending owner.
+	AcceptContractMetadataOwnership(context.Context, *MsgAcceptContractMetadataOwnership) (*MsgAcceptContractMetadataOwnershipResponse, error)
+	// CancelContractMetadataOwnership cancels the pending contract metadata ownership transfer.
+	// Method is authorized to the contract owner.
+	CancelContractMetadataOwnership(context.Context, *MsgCancelContractMetadataOwnership) (*MsgCancelContractMetadataOwnershipResponse, error)
+	// SetCodeMetadata creates or updates an existing code metadata (default for contracts without metadata).
+	// Method is authorized to the code creator.
+	SetCodeMetadata(context.Context, *MsgSetCodeMetadata) (*MsgSetCodeMetadataResponse, error)
+	// SetAutoPayout opts the contract in (or out) to the automatic rewards payout to its rewards address / recipients.
+	// Method is authorized to the contract metadata owner.
+	SetAutoPayout(context.Context, *MsgSetAutoPayout) (*MsgSetAutoPayoutResponse, error)
+	// SetSponsorship creates or updates the contract transaction fees sponsorship limits.
+	// Method is authorized to the contract metadata owner and the contract itself.
+	SetSponsorship(context.Context, *MsgSetSponsorship) (*MsgSetSponsorshipResponse, error)
+	// DepositSponsorship tops up the contract sponsorship deposit.
+	// Method is not authorized (anyone can fund an existing sponsorship).
+	DepositSponsorship(context.Context, *MsgDepositSponsorship) (*MsgDepositSponsorshipResponse, error)
+	// WithdrawSponsorship withdraws funds from the contract sponsorship deposit to the sender.
+	// Method is authorized to the contract metadata owner and the contract itself.
+	WithdrawSponsorship(context.Context, *MsgWithdrawSponsorship) (*MsgWithdrawSponsorshipResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SetContractMetadata(ctx context.Context, req *MsgSetContractMetadata) (*MsgSetContractMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetContractMetadata not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawRewards(ctx context.Context, req *MsgWithdrawRewards) (*MsgWithdrawRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawRewards not implemented")
+}
+func (*UnimplementedMsgServer) SetFlatFee(ctx context.Context, req *MsgSetFlatFee) (*MsgSetFlatFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFlatFee not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawRewardsAndDelegate(ctx context.Context, req *MsgWithdrawRewardsAndDelegate) (*MsgWithdrawRewardsAndDelegateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawRewardsAndDelegate not implemented")
+}
+func (*UnimplementedMsgServer) AcceptContractMetadataOwnership(ctx context.Context, req *MsgAcceptContractMetadataOwnership) (*MsgAcceptContractMetadataOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptContractMetadataOwnership not implemented")
+}
+func (*UnimplementedMsgServer) CancelContractMetadataOwnership(ctx context.Context, req *MsgCancelContractMetadataOwnership) (*MsgCancelContractMetadataOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelContractMetadataOwnership not implemented")
+}
+func (*UnimplementedMsgServer) SetCodeMetadata(ctx context.Context, req *MsgSetCodeMetadata) (*MsgSetCodeMetadataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCodeMetadata not implemented")
+}
+func (*UnimplementedMsgServer) SetAutoPayout(ctx context.Context, req *MsgSetAutoPayout) (*MsgSetAutoPayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoPayout not implemented")
+}
+func (*UnimplementedMsgServer) SetSponsorship(ctx context.Context, req *MsgSetSponsorship) (*MsgSetSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSponsorship not implemented")
+}
+func (*UnimplementedMsgServer) DepositSponsorship(ctx context.Context, req *MsgDepositSponsorship) (*MsgDepositSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositSponsorship not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawSponsorship(ctx context.Context, req *MsgWithdrawSponsorship) (*MsgWithdrawSponsorshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawSponsorship not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SetContractMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetContractMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetContractMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Msg/SetContractMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetContractMetadata(ctx, req.(*MsgSetContractMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawRewards)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Msg/WithdrawRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawRewards(ctx, req.(*MsgWithdrawRewards))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFlatFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFlatFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFlatFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Msg/SetFlatFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFlatFee(ctx, req.(*MsgSetFlatFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawRewardsAndDelegate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawRewardsAndDelegate)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawRewardsAndDelegate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Msg/WithdrawRewardsAndDelegate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawRewardsAndDelegate(ctx, req.(*MsgWithdrawRewardsAndDelegate))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptContractMetadataOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptContractMetadataOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptContractMetadataOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Msg/AcceptContractMetadataOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptContractMetadataOwnership(ctx, req.(*MsgAcceptContractMetadataOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelContractMetadataOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelContractMetadataOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelContractMetadataOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Msg/CancelContractMetadataOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelContractMetadataOwnership(ctx, req.(*MsgCancelContractMetadataOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCodeMetadata_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCodeMetadata)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCodeMetadata(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Msg/SetCodeMetadata",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCodeMetadata(ctx, req.(*MsgSetCodeMetadata))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAutoPayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoPayout)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoPayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Msg/SetAutoPayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoPayout(ctx, req.(*MsgSetAutoPayout))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Msg/SetSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSponsorship(ctx, req.(*MsgSetSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Msg/DepositSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositSponsorship(ctx, req.(*MsgDepositSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawSponsorship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawSponsorship)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawSponsorship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.rewards.v1beta1.Msg/WithdrawSponsorship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawSponsorship(ctx, req.(*MsgWithdrawSponsorship))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "archway.rewards.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetContractMetadata",
+			Handler:    _Msg_SetContractMetadata_Handler,
+		},
+		{
+			MethodName: "WithdrawRewards",
+			Handler:    _Msg_WithdrawRewards_Handler,
+		},
+		{
+			MethodName: "SetFlatFee",
+			Handler:    _Msg_SetFlatFee_Handler,
+		},
+		{
+			MethodName: "WithdrawRewardsAndDelegate",
+			Handler:    _Msg_WithdrawRewardsAndDelegate_Handler,
+		},
+		{
+			MethodName: "AcceptContractMetadataOwnership",
+			Handler:    _Msg_AcceptContractMetadataOwnership_Handler,
+		},
+		{
+			MethodName: "CancelContractMetadataOwnership",
+			Handler:    _Msg_CancelContractMetadataOwnership_Handler,
+		},
+		{
+			MethodName: "SetCodeMetadata",
+			Handler:    _Msg_SetCodeMetadata_Handler,
+		},
+		{
+			MethodName: "SetAutoPayout",
+			Handler:    _Msg_SetAutoPayout_Handler,
+		},
+		{
+			MethodName: "SetSponsorship",
+			Handler:    _Msg_SetSponsorship_Handler,
+		},
+		{
+			MethodName: "DepositSponsorship",
+			Handler:    _Msg_DepositSponsorship_Handler,
+		},
+		{
+			MethodName: "WithdrawSponsorship",
+			Handler:    _Msg_WithdrawSponsorship_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "archway/rewards/v1beta1/tx.proto",
+}
+
+func (m *MsgSetContractMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetContractMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetContractMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetContractMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetContractMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetContractMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.IbcTransfer != nil {
+		{
+			size, err := m.IbcTransfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Mode != nil {
+		{
+			size := m.Mode.Size()
+			i -= size
+			if _, err := m.Mode.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.RewardsAddress) > 0 {
+		i -= len(m.RewardsAddress)
+		copy(dAtA[i:], m.RewardsAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRewards_RecordsLimit_) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRewards_RecordsLimit_) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RecordsLimit != nil {
+		{
+			size, err := m.RecordsLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MsgWithdrawRewards_RecordIds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRewards_RecordIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RecordIds != nil {
+		{
+			size, err := m.RecordIds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MsgWithdrawRewards_RecordsLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRewards_RecordsLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRewards_RecordsLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRewards_RecordIDs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRewards_RecordIDs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRewards_RecordIDs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		dAtA6 := make([]byte, len(m.Ids)*10)
+		var j5 int
+		for _, num := range m.Ids {
+			for num >= 1<<7 {
+				dAtA6[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA6[j5] = uint8(num)
+			j5++
+		}
+		i -= j5
+		copy(dAtA[i:], dAtA6[:j5])
+		i = encodeVarintTx(dAtA, i, uint64(j5))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRewards_IBCTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRewards_IBCTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRewards_IBCTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Receiver) > 0 {
+		i -= len(m.Receiver)
+		copy(dAtA[i:], m.Receiver)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Receiver)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SourceChannel) > 0 {
+		i -= len(m.SourceChannel)
+		copy(dAtA[i:], m.SourceChannel)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SourceChannel)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalRewards) > 0 {
+		for iNdEx := len(m.TotalRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.RecordsNum != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecordsNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFlatFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFlatFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFlatFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.FlatFeeAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFlatFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFlatFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFlatFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRewardsAndDelegate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRewardsAndDelegate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRewardsAndDelegate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Mode != nil {
+		{
+			size := m.Mode.Size()
+			i -= size
+			if _, err := m.Mode.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RewardsAddress) > 0 {
+		i -= len(m.RewardsAddress)
+		copy(dAtA[i:], m.RewardsAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RewardsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRewardsAndDelegate_RecordsLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRewardsAndDelegate_RecordsLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RecordsLimit != nil {
+		{
+			size, err := m.RecordsLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MsgWithdrawRewardsAndDelegate_RecordIds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRewardsAndDelegate_RecordIds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RecordIds != nil {
+		{
+			size, err := m.RecordIds.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MsgWithdrawRewardsAndDelegateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRewardsAndDelegateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRewardsAndDelegateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DelegatedAmount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TotalRewards) > 0 {
+		for iNdEx := len(m.TotalRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.RecordsNum != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecordsNum))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptContractMetadataOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptContractMetadataOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptContractMetadataOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptContractMetadataOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptContractMetadataOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptContractMetadataOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelContractMetadataOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelContractMetadataOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelContractMetadataOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelContractMetadataOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelContractMetadataOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelContractMetadataOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCodeMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCodeMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCodeMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Metadata.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCodeMetadataResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCodeMetadataResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCodeMetadataResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoPayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoPayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoPayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Threshold) > 0 {
+		for iNdEx := len(m.Threshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Threshold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.IntervalBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.IntervalBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoPayoutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoPayoutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoPayoutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UserFeeLimitPeriod != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UserFeeLimitPeriod))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FundFromRewards {
+		i--
+		if m.FundFromRewards {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BlockFeeLimit) > 0 {
+		for iNdEx := len(m.BlockFeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockFeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.UserFeeLimit) > 0 {
+		for iNdEx := len(m.UserFeeLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UserFeeLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawSponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawSponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawSponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SenderAddress) > 0 {
+		i -= len(m.SenderAddress)
+		copy(dAtA[i:], m.SenderAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SenderAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawSponsorshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawSponsorshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawSponsorshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetContractMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetContractMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawRewards) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RewardsAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Mode != nil {
+		n += m.Mode.Size()
+	}
+	if m.IbcTransfer != nil {
+		l = m.IbcTransfer.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawRewards_RecordsLimit_) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordsLimit != nil {
+		l = m.RecordsLimit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *MsgWithdrawRewards_RecordIds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordIds != nil {
+		l = m.RecordIds.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *MsgWithdrawRewards_RecordsLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovTx(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *MsgWithdrawRewards_RecordIDs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Ids) > 0 {
+		l = 0
+		for _, e := range m.Ids {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgWithdrawRewards_IBCTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SourceChannel)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *MsgWithdrawRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordsNum != 0 {
+		n += 1 + sovTx(uint64(m.RecordsNum))
+	}
+	if len(m.TotalRewards) > 0 {
+		for _, e := range m.TotalRewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetFlatFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.FlatFeeAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetFlatFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawRewardsAndDelegate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RewardsAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Mode != nil {
+		n += m.Mode.Size()
+	}
+	return n
+}
+
+func (m *MsgWithdrawRewardsAndDelegate_RecordsLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordsLimit != nil {
+		l = m.RecordsLimit.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *MsgWithdrawRewardsAndDelegate_RecordIds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordIds != nil {
+		l = m.RecordIds.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *MsgWithdrawRewardsAndDelegateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RecordsNum != 0 {
+		n += 1 + sovTx(uint64(m.RecordsNum))
+	}
+	if len(m.TotalRewards) > 0 {
+		for _, e := range m.TotalRewards {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.DelegatedAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgAcceptContractMetadataOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptContractMetadataOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelContractMetadataOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelContractMetadataOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetCodeMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Metadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetCodeMetadataResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAutoPayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.IntervalBlocks != 0 {
+		n += 1 + sovTx(uint64(m.IntervalBlocks))
+	}
+	if len(m.Threshold) > 0 {
+		for _, e := range m.Threshold {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetAutoPayoutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.UserFeeLimit) > 0 {
+		for _, e := range m.UserFeeLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.BlockFeeLimit) > 0 {
+		for _, e := range m.BlockFeeLimit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.FundFromRewards {
+		n += 2
+	}
+	if m.UserFeeLimitPeriod != 0 {
+		n += 1 + sovTx(uint64(m.UserFeeLimitPeriod))
+	}
+	return n
+}
+
+func (m *MsgSetSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDepositSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawSponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SenderAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgWithdrawSponsorshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetContractMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetContractMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetContractMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetContractMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetContractMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetContractMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordsLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgWithdrawRewards_RecordsLimit{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Mode = &MsgWithdrawRewards_RecordsLimit_{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgWithdrawRewards_RecordIDs{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Mode = &MsgWithdrawRewards_RecordIds{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcTransfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.IbcTransfer == nil {
+				m.IbcTransfer = &MsgWithdrawRewards_IBCTransfer{}
+			}
+			if err := m.IbcTransfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawRewards_RecordsLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordsLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordsLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawRewards_RecordIDs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RecordIDs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RecordIDs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Ids = append(m.Ids, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Ids) == 0 {
+					m.Ids = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Ids = append(m.Ids, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawRewards_IBCTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IBCTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IBCTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceChannel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SourceChannel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawRewardsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawRewardsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordsNum", wireType)
+			}
+			m.RecordsNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordsNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalRewards = append(m.TotalRewards, types.Coin{})
+			if err := m.TotalRewards[len(m.TotalRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFlatFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFlatFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFlatFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FlatFeeAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FlatFeeAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetFlatFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetFlatFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetFlatFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawRewardsAndDelegate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawRewardsAndDelegate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawRewardsAndDelegate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordsLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgWithdrawRewards_RecordsLimit{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Mode = &MsgWithdrawRewardsAndDelegate_RecordsLimit{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordIds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgWithdrawRewards_RecordIDs{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Mode = &MsgWithdrawRewardsAndDelegate_RecordIds{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawRewardsAndDelegateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawRewardsAndDelegateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawRewardsAndDelegateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordsNum", wireType)
+			}
+			m.RecordsNum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordsNum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRewards", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalRewards = append(m.TotalRewards, types.Coin{})
+			if err := m.TotalRewards[len(m.TotalRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DelegatedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptContractMetadataOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptContractMetadataOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptContractMetadataOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptContractMetadataOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptContractMetadataOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptContractMetadataOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelContractMetadataOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelContractMetadataOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelContractMetadataOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelContractMetadataOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelContractMetadataOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelContractMetadataOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCodeMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCodeMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCodeMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCodeMetadataResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCodeMetadataResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCodeMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetAutoPayout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoPayout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoPayout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntervalBlocks", wireType)
+			}
+			m.IntervalBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IntervalBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Threshold = append(m.Threshold, types.Coin{})
+			if err := m.Threshold[len(m.Threshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgWithdrawRewards_RecordsLimit_) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RecordsLimit != nil {
-		l = m.RecordsLimit.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-func (m *MsgWithdrawRewards_RecordIds) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RecordIds != nil {
-		l = m.RecordIds.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-func (m *MsgWithdrawRewards_RecordsLimit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Limit != 0 {
-		n += 1 + sovTx(uint64(m.Limit))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *MsgWithdrawRewards_RecordIDs) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Ids) > 0 {
-		l = 0
-		for _, e := range m.Ids {
-			l += sovTx(uint64(e))
+func (m *MsgSetAutoPayoutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-		n += 1 + sovTx(uint64(l)) + l
-	}
-	return n
-}
-
-func (m *MsgWithdrawRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.RecordsNum != 0 {
-		n += 1 + sovTx(uint64(m.RecordsNum))
-	}
-	if len(m.TotalRewards) > 0 {
-		for _, e := range m.TotalRewards {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetAutoPayoutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetAutoPayoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSetContractMetadata) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSponsorship) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -939,10 +5560,10 @@ func (m *MsgSetContractMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetContractMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSponsorship: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetContractMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -979,7 +5600,39 @@ func (m *MsgSetContractMetadata) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserFeeLimit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1006,10 +5659,84 @@ func (m *MsgSetContractMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Metadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UserFeeLimit = append(m.UserFeeLimit, types.Coin{})
+			if err := m.UserFeeLimit[len(m.UserFeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockFeeLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockFeeLimit = append(m.BlockFeeLimit, types.Coin{})
+			if err := m.BlockFeeLimit[len(m.BlockFeeLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FundFromRewards", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FundFromRewards = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UserFeeLimitPeriod", wireType)
+			}
+			m.UserFeeLimitPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UserFeeLimitPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1031,7 +5758,7 @@ func (m *MsgSetContractMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetContractMetadataResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSponsorshipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1054,10 +5781,10 @@ func (m *MsgSetContractMetadataResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetContractMetadataResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSponsorshipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetContractMetadataResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1081,7 +5808,7 @@ func (m *MsgSetContractMetadataResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawRewards) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositSponsorship) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1104,15 +5831,15 @@ func (m *MsgWithdrawRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositSponsorship: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardsAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1140,13 +5867,13 @@ func (m *MsgWithdrawRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardsAddress = string(dAtA[iNdEx:postIndex])
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordsLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1156,30 +5883,27 @@ func (m *MsgWithdrawRewards) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MsgWithdrawRewards_RecordsLimit{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Mode = &MsgWithdrawRewards_RecordsLimit_{v}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1206,11 +5930,10 @@ func (m *MsgWithdrawRewards) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &MsgWithdrawRewards_RecordIDs{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Mode = &MsgWithdrawRewards_RecordIds{v}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1233,7 +5956,7 @@ func (m *MsgWithdrawRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawRewards_RecordsLimit) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositSponsorshipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1256,31 +5979,12 @@ func (m *MsgWithdrawRewards_RecordsLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordsLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositSponsorshipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordsLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
-			}
-			m.Limit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Limit |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1302,7 +6006,7 @@ func (m *MsgWithdrawRewards_RecordsLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawRewards_RecordIDs) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawSponsorship) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1325,88 +6029,110 @@ func (m *MsgWithdrawRewards_RecordIDs) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RecordIDs: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawSponsorship: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RecordIDs: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawSponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SenderAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				m.Ids = append(m.Ids, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTx
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTx
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTx
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SenderAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Ids) == 0 {
-					m.Ids = make([]uint64, 0, elementCount)
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
 				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTx
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Ids = append(m.Ids, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ids", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1428,7 +6154,7 @@ func (m *MsgWithdrawRewards_RecordIDs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawSponsorshipResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1451,65 +6177,12 @@ func (m *MsgWithdrawRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawSponsorshipResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawSponsorshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordsNum", wireType)
-			}
-			m.RecordsNum = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RecordsNum |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalRewards", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TotalRewards = append(m.TotalRewards, types.Coin{})
-			if err := m.TotalRewards[len(m.TotalRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
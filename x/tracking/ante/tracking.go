@@ -9,9 +9,12 @@ var _ sdk.AnteDecorator = TxGasTrackingDecorator{}
 // TrackingKeeperExpected defines the expected interface of the TrackingKeeper.
 type TrackingKeeperExpected interface {
 	TrackNewTx(ctx sdk.Context)
+	TrackTxFeePayer(ctx sdk.Context, feePayer sdk.AccAddress, fees sdk.Coins)
 }
 
 // TxGasTrackingDecorator is an Ante decorator that starts the gas tracking for a new transaction.
+// The transaction gas usage is tracked by the app once the transaction is delivered to estimate the non-contract gas consumption.
+// The transaction fee payer (the fee granter if set) and fees are tracked to detect self-dealing transactions.
 type TxGasTrackingDecorator struct {
 	keeper TrackingKeeperExpected
 }
@@ -20,6 +23,14 @@ type TxGasTrackingDecorator struct {
 func (d TxGasTrackingDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
 	d.keeper.TrackNewTx(ctx)
 
+	if feeTx, ok := tx.(sdk.FeeTx); ok {
+		feePayer := feeTx.FeePayer()
+		if feeGranter := feeTx.FeeGranter(); feeGranter != nil {
+			feePayer = feeGranter
+		}
+		d.keeper.TrackTxFeePayer(ctx, feePayer, feeTx.GetFee())
+	}
+
 	return next(ctx, tx, simulate)
 }
 
@@ -3,6 +3,7 @@ package ante_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -23,3 +24,40 @@ func TestTrackingAnteHandler(t *testing.T) {
 		assert.Equal(t, i, keeper.GetCurrentTxID(ctx))
 	}
 }
+
+func TestTrackingAnteHandlerFeePayer(t *testing.T) {
+	chain := e2eTesting.NewTestChain(t, 1)
+	ctx, keeper := chain.GetContext(), chain.GetApp().TrackingKeeper
+	payerAcc, granterAcc := chain.GetAccount(0), chain.GetAccount(1)
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	txs := []sdk.Tx{
+		testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxPayer(payerAcc.Address),
+			testutils.WithMockFeeTxFees(fees),
+		),
+		testutils.NewMockFeeTx(
+			testutils.WithMockFeeTxPayer(payerAcc.Address),
+			testutils.WithMockFeeTxGranter(granterAcc.Address),
+			testutils.WithMockFeeTxFees(fees),
+		),
+	}
+
+	anteHandler := ante.NewTxGasTrackingDecorator(keeper)
+	for _, tx := range txs {
+		_, err := anteHandler.AnteHandle(ctx, tx, false, testutils.NoopAnteHandler)
+		require.NoError(t, err)
+	}
+	keeper.FinalizeBlockTxTracking(ctx)
+
+	txInfos := keeper.GetState().TxInfoState(ctx).GetTxInfosByBlock(ctx.BlockHeight())
+	require.Len(t, txInfos, 2)
+
+	// Fee payer
+	assert.Equal(t, payerAcc.Address.String(), txInfos[0].FeePayer)
+	assert.Equal(t, fees.String(), txInfos[0].Fees.String())
+
+	// Fee granter
+	assert.Equal(t, granterAcc.Address.String(), txInfos[1].FeePayer)
+	assert.Equal(t, fees.String(), txInfos[1].Fees.String())
+}
@@ -0,0 +1,118 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govCli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/spf13/cobra"
+
+	"github.com/archway-network/archway/pkg"
+	"github.com/archway-network/archway/x/tracking/types"
+)
+
+const (
+	flagContractAddress = "contract-address"
+	flagCodeID          = "code-id"
+)
+
+// NewCmdSubmitSetGasAdjustmentProposal returns a CLI command to submit a SetGasAdjustmentProposal.
+func NewCmdSubmitSetGasAdjustmentProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-gas-adjustment [multiplier]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to set a contract or code gas consumption multiplier",
+		Long: fmt.Sprintf(`Submit a proposal to set a contract or code gas consumption multiplier.
+Exactly one of the %q and %q flags must be set, a contract multiplier takes precedence over a code one.
+The multiplier of 1.0 removes the existing adjustment.`,
+			flagContractAddress, flagCodeID,
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			multiplier, err := sdk.NewDecFromStr(args[0])
+			if err != nil {
+				return fmt.Errorf("parsing multiplier argument: %w", err)
+			}
+
+			contractAddr, err := pkg.ParseAccAddressFlag(cmd, flagContractAddress, false)
+			if err != nil {
+				return err
+			}
+
+			codeID, err := pkg.GetUint64Flag(cmd, flagCodeID, true)
+			if err != nil {
+				return err
+			}
+
+			title, description, deposit, err := parseProposalFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			adjustment := types.GasAdjustment{
+				CodeId:     codeID,
+				Multiplier: multiplier,
+			}
+			if contractAddr != nil {
+				adjustment.ContractAddress = contractAddr.String()
+			}
+			content := types.NewSetGasAdjustmentProposal(title, description, adjustment)
+
+			msg, err := govTypes.NewMsgSubmitProposal(content, deposit, clientCtx.GetFromAddress())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addProposalFlags(cmd)
+	cmd.Flags().String(flagContractAddress, "", "Address of the contract to adjust gas for (bech 32)")
+	cmd.Flags().Uint64(flagCodeID, 0, "Code ID to adjust gas for (all contracts instantiated from the code)")
+
+	return cmd
+}
+
+// addProposalFlags adds the common gov proposal flags.
+func addProposalFlags(cmd *cobra.Command) {
+	cmd.Flags().String(govCli.FlagTitle, "", "Title of proposal")
+	cmd.Flags().String(govCli.FlagDescription, "", "Description of proposal")
+	cmd.Flags().String(govCli.FlagDeposit, "", "Deposit of proposal")
+}
+
+// parseProposalFlags parses the common gov proposal flags.
+func parseProposalFlags(cmd *cobra.Command) (title, description string, deposit sdk.Coins, retErr error) {
+	title, err := cmd.Flags().GetString(govCli.FlagTitle)
+	if err != nil {
+		retErr = fmt.Errorf("parsing %s flag: %w", govCli.FlagTitle, err)
+		return
+	}
+
+	description, err = cmd.Flags().GetString(govCli.FlagDescription)
+	if err != nil {
+		retErr = fmt.Errorf("parsing %s flag: %w", govCli.FlagDescription, err)
+		return
+	}
+
+	depositStr, err := cmd.Flags().GetString(govCli.FlagDeposit)
+	if err != nil {
+		retErr = fmt.Errorf("parsing %s flag: %w", govCli.FlagDeposit, err)
+		return
+	}
+
+	deposit, err = sdk.ParseCoinsNormalized(depositStr)
+	if err != nil {
+		retErr = fmt.Errorf("parsing %s flag: %w", govCli.FlagDeposit, err)
+		return
+	}
+
+	return
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
 
+	"github.com/archway-network/archway/pkg"
 	"github.com/archway-network/archway/x/tracking/types"
 )
 
@@ -19,6 +20,7 @@ func GetQueryCmd() *cobra.Command {
 	}
 	cmd.AddCommand(
 		getQueryBlockGasTrackingCmd(),
+		getQueryGasAdjustmentsCmd(),
 	)
 
 	return cmd
@@ -49,3 +51,37 @@ func getQueryBlockGasTrackingCmd() *cobra.Command {
 
 	return cmd
 }
+
+func getQueryGasAdjustmentsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-adjustments",
+		Args:  cobra.NoArgs,
+		Short: "Query active contract and code gas adjustments with pagination",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := pkg.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.GasAdjustments(cmd.Context(), &types.QueryGasAdjustmentsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "gas-adjustments")
+
+	return cmd
+}
@@ -0,0 +1,27 @@
+package client
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govClient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govRest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+
+	"github.com/archway-network/archway/x/tracking/client/cli"
+)
+
+// Module proposal handlers (used by the x/gov CLI).
+var (
+	SetGasAdjustmentProposalHandler = govClient.NewProposalHandler(cli.NewCmdSubmitSetGasAdjustmentProposal, emptyRestHandler)
+)
+
+// emptyRestHandler is a stub since the legacy REST routes are not supported.
+func emptyRestHandler(client.Context) govRest.ProposalRESTHandler {
+	return govRest.ProposalRESTHandler{
+		SubRoute: "unsupported-tracking",
+		Handler: func(w http.ResponseWriter, r *http.Request) {
+			rest.WriteErrorResponse(w, http.StatusBadRequest, "Legacy REST Routes are not supported for x/tracking proposals")
+		},
+	}
+}
@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/archway-network/archway/x/tracking/types"
+)
+
+// SetGasAdjustment sets a contract or code gas adjustment.
+// The multiplier of 1.0 removes the existing adjustment (no adjustment is the default).
+func (k Keeper) SetGasAdjustment(ctx sdk.Context, adjustment types.GasAdjustment) error {
+	if err := adjustment.Validate(); err != nil {
+		return err
+	}
+
+	state := k.state.GasAdjustmentState(ctx)
+	if adjustment.Multiplier.Equal(sdk.OneDec()) {
+		state.DeleteGasAdjustment(adjustment)
+		return nil
+	}
+	state.SetGasAdjustment(adjustment)
+
+	return nil
+}
+
+// GetGasAdjustments returns all the active gas adjustments paginated.
+func (k Keeper) GetGasAdjustments(ctx sdk.Context, pageReq *query.PageRequest) ([]types.GasAdjustment, *query.PageResponse, error) {
+	return k.state.GasAdjustmentState(ctx).GetGasAdjustmentsPaginated(pageReq)
+}
+
+// GetContractGasAdjustment returns the gas adjustment applied to the contract operations (if any).
+// A contract adjustment takes precedence over the contract's code adjustment.
+// Code adjustment is not resolved for a contract being instantiated (contract info is not stored yet).
+// State reads are not charged, since the call is made by the WASM engine for every contract operation.
+func (k Keeper) GetContractGasAdjustment(ctx sdk.Context, contractAddr sdk.AccAddress) (types.GasAdjustment, bool) {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+	state := k.state.GasAdjustmentState(ctx)
+
+	if adjustment, found := state.GetContractGasAdjustment(contractAddr); found {
+		return adjustment, true
+	}
+
+	if k.contractInfoView == nil {
+		return types.GasAdjustment{}, false
+	}
+
+	contractInfo := k.contractInfoView.GetContractInfo(ctx, contractAddr)
+	if contractInfo == nil {
+		return types.GasAdjustment{}, false
+	}
+
+	return state.GetCodeGasAdjustment(contractInfo.CodeID)
+}
@@ -0,0 +1,147 @@
+package keeper_test
+
+import (
+	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	"github.com/archway-network/archway/pkg/testutils"
+	"github.com/archway-network/archway/x/tracking"
+	"github.com/archway-network/archway/x/tracking/types"
+)
+
+// TestSetGasAdjustmentProposal checks the set gas adjustment gov proposal handling and the multiplier lookup.
+func (s *KeeperTestSuite) TestSetGasAdjustmentProposal() {
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().TrackingKeeper
+
+	contractViewer := testutils.NewMockContractViewer()
+	keeper.SetContractInfoViewer(contractViewer)
+	handler := tracking.NewProposalHandler(keeper)
+
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+	contractAddr, codeContractAddr := contractAddrs[0], contractAddrs[1]
+	contractViewer.AddContractCodeID(contractAddr.String(), 1)
+	contractViewer.AddContractCodeID(codeContractAddr.String(), 1)
+
+	contractAdjustment := types.GasAdjustment{
+		ContractAddress: contractAddr.String(),
+		Multiplier:      sdk.NewDecWithPrec(5, 1),
+	}
+	codeAdjustment := types.GasAdjustment{
+		CodeId:     1,
+		Multiplier: sdk.NewDec(2),
+	}
+
+	s.Run("OK: no adjustments", func() {
+		_, found := keeper.GetContractGasAdjustment(ctx, contractAddr)
+		s.Assert().False(found)
+	})
+
+	s.Run("Fail: invalid adjustment", func() {
+		err := handler(ctx, types.NewSetGasAdjustmentProposal("Title", "Description", types.GasAdjustment{
+			CodeId: 1,
+		}))
+		s.Assert().Error(err)
+	})
+
+	s.Run("OK: code adjustment", func() {
+		s.Require().NoError(handler(ctx, types.NewSetGasAdjustmentProposal("Title", "Description", codeAdjustment)))
+
+		for _, addr := range contractAddrs {
+			adjustment, found := keeper.GetContractGasAdjustment(ctx, addr)
+			s.Require().True(found)
+			s.Assert().Equal(codeAdjustment, adjustment)
+		}
+	})
+
+	s.Run("OK: contract adjustment takes precedence", func() {
+		s.Require().NoError(handler(ctx, types.NewSetGasAdjustmentProposal("Title", "Description", contractAdjustment)))
+
+		adjustment, found := keeper.GetContractGasAdjustment(ctx, contractAddr)
+		s.Require().True(found)
+		s.Assert().Equal(contractAdjustment, adjustment)
+
+		adjustment, found = keeper.GetContractGasAdjustment(ctx, codeContractAddr)
+		s.Require().True(found)
+		s.Assert().Equal(codeAdjustment, adjustment)
+
+		adjustments, _, err := keeper.GetGasAdjustments(ctx, nil)
+		s.Require().NoError(err)
+		s.Assert().ElementsMatch([]types.GasAdjustment{contractAdjustment, codeAdjustment}, adjustments)
+	})
+
+	s.Run("OK: remove code adjustment", func() {
+		s.Require().NoError(handler(ctx, types.NewSetGasAdjustmentProposal("Title", "Description", types.GasAdjustment{
+			CodeId:     1,
+			Multiplier: sdk.OneDec(),
+		})))
+
+		_, found := keeper.GetContractGasAdjustment(ctx, codeContractAddr)
+		s.Assert().False(found)
+
+		_, found = keeper.GetContractGasAdjustment(ctx, contractAddr)
+		s.Assert().True(found)
+	})
+}
+
+// TestGasAdjustmentProcessing checks the gas calculation function and the tracked operation (original and adjusted gas).
+func (s *KeeperTestSuite) TestGasAdjustmentProcessing() {
+	ctx, keeper := s.chain.GetContext(), s.chain.GetApp().TrackingKeeper
+
+	contractAddrs := e2eTesting.GenContractAddresses(2)
+	adjustedContractAddr, contractAddr := contractAddrs[0], contractAddrs[1]
+
+	s.Require().NoError(keeper.SetGasAdjustment(ctx, types.GasAdjustment{
+		ContractAddress: adjustedContractAddr.String(),
+		Multiplier:      sdk.NewDecWithPrec(5, 1),
+	}))
+
+	vmGas := keeper.WasmGasRegister.ToWasmVMGas(3000)
+	gasInfo := wasmTypes.GasConsumptionInfo{VMGas: vmGas, SDKGas: 1000}
+
+	s.Run("Fail: invalid contract address", func() {
+		_, err := keeper.GetGasCalculationFn(ctx, "invalid")
+		s.Assert().Error(err)
+	})
+
+	s.Run("OK: gas calculation", func() {
+		gasCalcFn, err := keeper.GetGasCalculationFn(ctx, adjustedContractAddr.String())
+		s.Require().NoError(err)
+		s.Assert().Equal(wasmTypes.GasConsumptionInfo{VMGas: vmGas / 2, SDKGas: 500}, gasCalcFn(wasmTypes.ContractOperationExecute, gasInfo))
+
+		gasCalcFn, err = keeper.GetGasCalculationFn(ctx, contractAddr.String())
+		s.Require().NoError(err)
+		s.Assert().Equal(gasInfo, gasCalcFn(wasmTypes.ContractOperationExecute, gasInfo))
+	})
+
+	s.Run("OK: operations tracking", func() {
+		keeper.TrackNewTx(ctx)
+		s.Require().NoError(keeper.IngestGasRecord(ctx, []wasmTypes.ContractGasRecord{
+			{
+				OperationId:     wasmTypes.ContractOperationExecute,
+				ContractAddress: adjustedContractAddr.String(),
+				OriginalGas:     gasInfo,
+			},
+			{
+				OperationId:     wasmTypes.ContractOperationExecute,
+				ContractAddress: contractAddr.String(),
+				OriginalGas:     gasInfo,
+			},
+		}))
+
+		ops := keeper.GetState().ContractOpInfoState(ctx).GetContractOpInfoByTxID(keeper.GetCurrentTxID(ctx))
+		s.Require().Len(ops, 2)
+
+		s.Assert().Equal(adjustedContractAddr.String(), ops[0].ContractAddress)
+		s.Assert().EqualValues(1500, ops[0].VmGas)
+		s.Assert().EqualValues(500, ops[0].SdkGas)
+		s.Assert().EqualValues(3000, ops[0].OriginalVmGas)
+		s.Assert().EqualValues(1000, ops[0].OriginalSdkGas)
+
+		s.Assert().Equal(contractAddr.String(), ops[1].ContractAddress)
+		s.Assert().EqualValues(3000, ops[1].VmGas)
+		s.Assert().EqualValues(1000, ops[1].SdkGas)
+		s.Assert().EqualValues(3000, ops[1].OriginalVmGas)
+		s.Assert().EqualValues(1000, ops[1].OriginalSdkGas)
+	})
+}
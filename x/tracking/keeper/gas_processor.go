@@ -13,6 +13,7 @@ var _ wasmTypes.ContractGasProcessor = &Keeper{}
 
 // IngestGasRecord implements the wasmTypes.ContractGasProcessor interface.
 // It is called by the wasmd to track contract gas records.
+// Records contain the original gas consumption, so the contract gas adjustment is applied again to get the actual values.
 func (k Keeper) IngestGasRecord(ctx sdk.Context, records []wasmTypes.ContractGasRecord) error {
 	// Ingest operation for every record
 	for _, record := range records {
@@ -51,10 +52,17 @@ func (k Keeper) IngestGasRecord(ctx sdk.Context, records []wasmTypes.ContractGas
 			opType = types.ContractOperation_CONTRACT_OPERATION_UNSPECIFIED
 		}
 
+		adjustedGas := record.OriginalGas
+		if adjustment, found := k.GetContractGasAdjustment(ctx, contractAddr); found {
+			adjustedGas = adjustGasConsumption(adjustment, record.OriginalGas)
+		}
+
 		k.TrackNewContractOperation(
 			ctx,
 			contractAddr,
 			opType,
+			k.WasmGasRegister.FromWasmVMGas(adjustedGas.VMGas),
+			adjustedGas.SDKGas,
 			k.WasmGasRegister.FromWasmVMGas(record.OriginalGas.VMGas),
 			record.OriginalGas.SDKGas,
 		)
@@ -65,10 +73,22 @@ func (k Keeper) IngestGasRecord(ctx sdk.Context, records []wasmTypes.ContractGas
 
 // GetGasCalculationFn implements the wasmTypes.ContractGasProcessor interface.
 // It is called by the wasmd to get the gas consumption adjustment function for a contract.
-// This is a no-op function since we don't change gas values atm.
+// Function applies the contract (or the contract's code) GasAdjustment multiplier, gas values are not changed otherwise.
 func (k Keeper) GetGasCalculationFn(ctx sdk.Context, contractAddrBz string) (func(operationId uint64, gasInfo wasmTypes.GasConsumptionInfo) wasmTypes.GasConsumptionInfo, error) {
+	contractAddr, err := sdk.AccAddressFromBech32(contractAddrBz)
+	if err != nil {
+		return nil, fmt.Errorf("parsing contract address: %w", err)
+	}
+
+	adjustment, found := k.GetContractGasAdjustment(ctx, contractAddr)
+	if !found {
+		return func(operationID uint64, gasConsumptionInfo wasmTypes.GasConsumptionInfo) wasmTypes.GasConsumptionInfo {
+			return gasConsumptionInfo
+		}, nil
+	}
+
 	return func(operationID uint64, gasConsumptionInfo wasmTypes.GasConsumptionInfo) wasmTypes.GasConsumptionInfo {
-		return gasConsumptionInfo
+		return adjustGasConsumption(adjustment, gasConsumptionInfo)
 	}, nil
 }
 
@@ -82,3 +102,11 @@ func (k Keeper) CalculateUpdatedGas(ctx sdk.Context, record wasmTypes.ContractGa
 
 	return gasCalcFn(record.OperationId, record.OriginalGas), nil
 }
+
+// adjustGasConsumption applies the GasAdjustment multiplier to both VM and SDK gas values.
+func adjustGasConsumption(adjustment types.GasAdjustment, gasInfo wasmTypes.GasConsumptionInfo) wasmTypes.GasConsumptionInfo {
+	return wasmTypes.GasConsumptionInfo{
+		VMGas:  adjustment.AdjustGas(gasInfo.VMGas),
+		SDKGas: adjustment.AdjustGas(gasInfo.SDKGas),
+	}
+}
@@ -10,12 +10,14 @@ import (
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	txInfoLastID, txInfos := k.state.TxInfoState(ctx).Export()
 	opInfoLastID, opInfos := k.state.ContractOpInfoState(ctx).Export()
+	gasAdjustments := k.state.GasAdjustmentState(ctx).Export()
 
 	return types.NewGenesisState(
 		txInfoLastID,
 		txInfos,
 		opInfoLastID,
 		opInfos,
+		gasAdjustments,
 	)
 }
 
@@ -23,4 +25,5 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 func (k Keeper) InitGenesis(ctx sdk.Context, state *types.GenesisState) {
 	k.state.TxInfoState(ctx).Import(state.TxInfoLastId, state.TxInfos)
 	k.state.ContractOpInfoState(ctx).Import(state.ContractOpInfoLastId, state.ContractOpInfos)
+	k.state.GasAdjustmentState(ctx).Import(state.GasAdjustments)
 }
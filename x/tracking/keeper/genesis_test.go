@@ -1,6 +1,8 @@
 package keeper_test
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
 	"github.com/archway-network/archway/pkg/testutils"
 	"github.com/archway-network/archway/x/tracking/types"
@@ -21,6 +23,7 @@ func (s *KeeperTestSuite) TestGenesisImportExport() {
 		s.Assert().Empty(genesisState.TxInfoLastId)
 		s.Assert().Empty(genesisState.TxInfos)
 		s.Assert().Empty(genesisState.ContractOpInfos)
+		s.Assert().Empty(genesisState.GasAdjustments)
 
 		genesisStateInitial = *genesisState
 	})
@@ -57,11 +60,23 @@ func (s *KeeperTestSuite) TestGenesisImportExport() {
 		},
 	}
 
+	newGasAdjustments := []types.GasAdjustment{
+		{
+			ContractAddress: contractAddrs[0].String(),
+			Multiplier:      sdk.NewDecWithPrec(5, 1),
+		},
+		{
+			CodeId:     1,
+			Multiplier: sdk.NewDec(2),
+		},
+	}
+
 	genesisStateImported := types.NewGenesisState(
 		newTxInfos[len(newTxInfos)-1].Id,
 		newTxInfos,
 		newContractOpInfos[len(newContractOpInfos)-1].Id,
 		newContractOpInfos,
+		newGasAdjustments,
 	)
 	s.Run("Check import of an updated genesis", func() {
 		keeper.InitGenesis(ctx, genesisStateImported)
@@ -71,6 +86,7 @@ func (s *KeeperTestSuite) TestGenesisImportExport() {
 			TxInfos:              append(genesisStateInitial.TxInfos, newTxInfos...),
 			ContractOpInfoLastId: newContractOpInfos[len(newContractOpInfos)-1].Id,
 			ContractOpInfos:      append(genesisStateInitial.ContractOpInfos, newContractOpInfos...),
+			GasAdjustments:       append(genesisStateInitial.GasAdjustments, newGasAdjustments...),
 		}
 
 		genesisStateReceived := keeper.ExportGenesis(ctx)
@@ -79,5 +95,6 @@ func (s *KeeperTestSuite) TestGenesisImportExport() {
 		s.Assert().ElementsMatch(genesisStateExpected.TxInfos, genesisStateReceived.TxInfos)
 		s.Assert().Equal(genesisStateExpected.ContractOpInfoLastId, genesisStateReceived.ContractOpInfoLastId)
 		s.Assert().ElementsMatch(genesisStateExpected.ContractOpInfos, genesisStateReceived.ContractOpInfos)
+		s.Assert().ElementsMatch(genesisStateExpected.GasAdjustments, genesisStateReceived.GasAdjustments)
 	})
 }
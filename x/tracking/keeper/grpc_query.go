@@ -37,3 +37,22 @@ func (s *QueryServer) BlockGasTracking(c context.Context, request *types.QueryBl
 		Block: blockInfo,
 	}, nil
 }
+
+// GasAdjustments implements the types.QueryServer interface.
+func (s *QueryServer) GasAdjustments(c context.Context, request *types.QueryGasAdjustmentsRequest) (*types.QueryGasAdjustmentsResponse, error) {
+	if request == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	adjustments, pageResp, err := s.keeper.GetGasAdjustments(ctx, request.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "pagination request: "+err.Error())
+	}
+
+	return &types.QueryGasAdjustmentsResponse{
+		Adjustments: adjustments,
+		Pagination:  pageResp,
+	}, nil
+}
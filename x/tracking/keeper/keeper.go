@@ -2,41 +2,106 @@ package keeper
 
 import (
 	wasmKeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	paramTypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/tendermint/tendermint/libs/log"
 
 	"github.com/archway-network/archway/x/tracking/types"
 )
 
+// ContractInfoReaderExpected defines the interface for the x/wasmd module dependency.
+type ContractInfoReaderExpected interface {
+	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmTypes.ContractInfo
+}
+
 // Keeper provides module state operations.
 type Keeper struct {
 	WasmGasRegister wasmKeeper.GasRegister
 
-	cdc        codec.Codec
-	paramStore paramTypes.Subspace
-	state      State
+	cdc              codec.Codec
+	tStoreKey        sdk.StoreKey
+	state            State
+	contractInfoView ContractInfoReaderExpected
 }
 
 // NewKeeper creates a new Keeper instance.
-func NewKeeper(cdc codec.Codec, key sdk.StoreKey, gasRegister wasmKeeper.GasRegister) Keeper {
+func NewKeeper(cdc codec.Codec, key, tKey sdk.StoreKey, gasRegister wasmKeeper.GasRegister) Keeper {
 	return Keeper{
 		cdc:             cdc,
+		tStoreKey:       tKey,
 		WasmGasRegister: gasRegister,
 		state:           NewState(cdc, key),
 	}
 }
 
+// SetContractInfoViewer sets the contract info view dependency (used to resolve code gas adjustments).
+// The x/wasmd keeper depends on this module, so the dependency is set after the x/wasmd keeper is created
+// and before this keeper is passed to the WASM engine as a gas processor.
+func (k *Keeper) SetContractInfoViewer(viewer ContractInfoReaderExpected) {
+	k.contractInfoView = viewer
+}
+
 // Logger returns a module-specific logger.
 func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", "x/"+types.ModuleName)
 }
 
 // TrackNewTx creates a new transaction tracking info with a unique ID that is used to link new contract operations to.
-// TxInfo object itself is created later during the EndBlocker.
+// TxInfo object itself is finalized later during the EndBlocker.
+// The new TxInfo is marked as pending for the gas usage tracking (refer to TrackTxGasUsage).
 func (k Keeper) TrackNewTx(ctx sdk.Context) {
-	k.state.TxInfoState(ctx).CreateEmptyTxInfo()
+	txInfo := k.state.TxInfoState(ctx).CreateEmptyTxInfo()
+
+	// Pending ID write should not affect the tx gas consumption
+	tStore := ctx.WithGasMeter(sdk.NewInfiniteGasMeter()).TransientStore(k.tStoreKey)
+	tStore.Set(types.TxGasPendingIDKey, sdk.Uint64ToBigEndian(txInfo.Id))
+}
+
+// TrackTxFeePayer sets the current transaction fee payer (the fee granter if set) and fees to the current TxInfo.
+// That data is used by the x/rewards module to detect self-dealing transactions.
+// The latest call wins (the x/rewards module overrides the fee payer for sponsored transactions for example).
+func (k Keeper) TrackTxFeePayer(ctx sdk.Context, feePayer sdk.AccAddress, fees sdk.Coins) {
+	// State read / write should not affect the tx gas consumption
+	txState := k.state.TxInfoState(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()))
+
+	txInfo, found := txState.GetTxInfo(txState.GetCurrentTxID())
+	if !found {
+		return
+	}
+	txInfo.FeePayer = feePayer.String()
+	txInfo.Fees = fees
+
+	txState.SetTxInfo(txInfo)
+}
+
+// TrackTxGasUsage sets the gas limit and the gas used to the pending TxInfo (created by the last TrackNewTx call).
+// Function is called by the app once a transaction is delivered (tx execution is finished by then),
+// the gas values are taken from the ResponseDeliverTx.
+// Call is a noop if there is no pending TxInfo (the AnteHandler has failed before the TrackNewTx call or its state was reverted).
+// Gas used might exceed the gas limit for an out of gas transaction, so it is capped by the limit.
+func (k Keeper) TrackTxGasUsage(ctx sdk.Context, gasLimit, gasUsed uint64) {
+	tStore := ctx.TransientStore(k.tStoreKey)
+
+	txIDBz := tStore.Get(types.TxGasPendingIDKey)
+	if txIDBz == nil {
+		return
+	}
+	tStore.Delete(types.TxGasPendingIDKey)
+
+	txState := k.state.TxInfoState(ctx)
+	txInfo, found := txState.GetTxInfo(sdk.BigEndianToUint64(txIDBz))
+	if !found {
+		return
+	}
+
+	if gasUsed > gasLimit {
+		gasUsed = gasLimit
+	}
+	txInfo.GasLimit = gasLimit
+	txInfo.GasUsed = gasUsed
+
+	txState.SetTxInfo(txInfo)
 }
 
 // GetCurrentTxID returns the current transaction ID being tracked.
@@ -46,7 +111,8 @@ func (k Keeper) GetCurrentTxID(ctx sdk.Context) uint64 {
 }
 
 // TrackNewContractOperation creates a new contract operation tracking entry with a unique ID using the current transaction ID.
-func (k Keeper) TrackNewContractOperation(ctx sdk.Context, contractAddr sdk.AccAddress, opType types.ContractOperation, vmGasConsumed, sdkGasConsumed uint64) {
+// Adjusted (vm/sdkGasConsumed) and original (before the GasAdjustment is applied) gas values are stored.
+func (k Keeper) TrackNewContractOperation(ctx sdk.Context, contractAddr sdk.AccAddress, opType types.ContractOperation, vmGasConsumed, sdkGasConsumed, originalVMGas, originalSDKGas uint64) {
 	curTxID := k.GetCurrentTxID(ctx)
 	k.state.ContractOpInfoState(ctx).CreateContractOpInfo(
 		curTxID,
@@ -54,18 +120,29 @@ func (k Keeper) TrackNewContractOperation(ctx sdk.Context, contractAddr sdk.AccA
 		opType,
 		vmGasConsumed,
 		sdkGasConsumed,
+		originalVMGas,
+		originalSDKGas,
 	)
 }
 
-// FinalizeBlockTxTracking updates block transactions total gas consumed value using tracked contract operations.
+// FinalizeBlockTxTracking updates block transactions total gas consumed value using tracked contract operations
+// and tracked transaction gas usage (non-contract gas).
 func (k Keeper) FinalizeBlockTxTracking(ctx sdk.Context) {
 	txState := k.state.TxInfoState(ctx)
 	contractOpState := k.state.ContractOpInfoState(ctx)
 
 	for _, txInfo := range txState.GetTxInfosByBlock(ctx.BlockHeight()) {
+		contractGas := uint64(0)
 		for _, contractOp := range contractOpState.GetContractOpInfoByTxID(txInfo.Id) {
-			txInfo.TotalGas += contractOp.VmGas + contractOp.SdkGas
+			contractGas += contractOp.VmGas + contractOp.SdkGas
 		}
+
+		// Contract operations gas is adjusted by the module, so it might exceed the actual tx gas used
+		if txInfo.GasUsed > contractGas {
+			txInfo.NonContractGas = txInfo.GasUsed - contractGas
+		}
+		txInfo.TotalGas = contractGas + txInfo.NonContractGas
+
 		txState.SetTxInfo(txInfo)
 	}
 }
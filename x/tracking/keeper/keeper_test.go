@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/archway-network/archway/x/tracking/keeper"
+)
+
+// TestTxNonContractGasTracking tests the transaction non-contract gas estimation using tracked tx gas usage.
+// Transaction gas limit and gas used are checked as well.
+func (s *KeeperTestSuite) TestTxNonContractGasTracking() {
+	const txGasLimit = 100_000_000
+
+	type testCase struct {
+		name string
+		// Inputs
+		skipGasUsage bool   // emulate the AnteHandler failure (DeliverTx gas usage is not tracked)
+		contractGas  uint64 // contract operation SDK gas (0 to skip the operation)
+		txGasUsed    uint64 // gas used reported by the DeliverTx
+		// Output expected
+		gasUsedExpected uint64
+	}
+
+	chain := s.chain
+	ctx, tKeeper := chain.GetContext(), chain.GetApp().TrackingKeeper
+	contractAddr := chain.GetAccount(0).Address
+
+	testCases := []testCase{
+		{
+			name:            "Contract and non-contract gas",
+			contractGas:     1000,
+			txGasUsed:       6000,
+			gasUsedExpected: 6000,
+		},
+		{
+			name:            "Non-contract gas only",
+			txGasUsed:       5000,
+			gasUsedExpected: 5000,
+		},
+		{
+			name:            "Contract gas exceeds the tx gas used (adjusted gas)",
+			contractGas:     10_000_000,
+			txGasUsed:       5000,
+			gasUsedExpected: 5000,
+		},
+		{
+			name:            "Out of gas (gas used is capped by the limit)",
+			txGasUsed:       txGasLimit + 1000,
+			gasUsedExpected: txGasLimit,
+		},
+		{
+			name:         "Gas usage is not tracked",
+			skipGasUsage: true,
+			contractGas:  1000,
+			txGasUsed:    6000,
+		},
+	}
+
+	// Emulate txs
+	for _, tc := range testCases {
+		// Emulate the x/tracking AnteHandler call
+		tKeeper.TrackNewTx(ctx)
+
+		if tc.contractGas > 0 {
+			s.Require().NoError(tKeeper.IngestGasRecord(ctx, []wasmTypes.ContractGasRecord{
+				{
+					OperationId:     wasmTypes.ContractOperationExecute,
+					ContractAddress: contractAddr.String(),
+					OriginalGas:     wasmTypes.GasConsumptionInfo{SDKGas: tc.contractGas},
+				},
+			}))
+		}
+
+		// Emulate the app DeliverTx call
+		if tc.skipGasUsage {
+			continue
+		}
+		tKeeper.TrackTxGasUsage(ctx, txGasLimit, tc.txGasUsed)
+
+		// The pending TxInfo is tracked only once (DeliverTx of a tx rejected by the AnteHandler is a noop)
+		tKeeper.TrackTxGasUsage(ctx, 1, 1)
+	}
+	tKeeper.FinalizeBlockTxTracking(ctx)
+
+	// Check tracked TxInfos
+	txInfos := tKeeper.GetState().TxInfoState(ctx).GetTxInfosByBlock(ctx.BlockHeight())
+	s.Require().Len(txInfos, len(testCases))
+
+	for i, tc := range testCases {
+		s.Run(tc.name, func() {
+			txInfo := txInfos[i]
+			s.Assert().Equal(tc.contractGas, txInfo.ContractGas())
+
+			if tc.skipGasUsage {
+				s.Assert().Zero(txInfo.GasLimit)
+				s.Assert().Zero(txInfo.GasUsed)
+			} else {
+				s.Assert().EqualValues(txGasLimit, txInfo.GasLimit)
+				s.Assert().Equal(tc.gasUsedExpected, txInfo.GasUsed)
+				s.Assert().Equal(txGasLimit-tc.gasUsedExpected, txInfo.UnusedGas())
+			}
+
+			if tc.gasUsedExpected <= tc.contractGas {
+				s.Assert().Zero(txInfo.NonContractGas)
+				s.Assert().Equal(tc.contractGas, txInfo.TotalGas)
+				return
+			}
+
+			s.Assert().Equal(tc.gasUsedExpected, txInfo.TotalGas)
+			s.Assert().Equal(tc.gasUsedExpected-tc.contractGas, txInfo.NonContractGas)
+		})
+	}
+
+	s.Run("Check migration 1 -> 2", func() {
+		s.Assert().NoError(keeper.NewMigrator(tKeeper).Migrate1to2(ctx))
+	})
+}
+
+// TestTxFeePayerTracking tests the transaction fee payer tracking which is stored to the current TxInfo.
+func (s *KeeperTestSuite) TestTxFeePayerTracking() {
+	chain := s.chain
+	ctx, tKeeper := chain.GetContext(), chain.GetApp().TrackingKeeper
+	payerAcc, sponsorAcc := chain.GetAccount(0), chain.GetAccount(1)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+
+	tKeeper.TrackNewTx(ctx)
+	txID := tKeeper.GetCurrentTxID(ctx)
+
+	s.Run("OK: fee payer is tracked without gas consumption", func() {
+		gasBefore := ctx.GasMeter().GasConsumed()
+		tKeeper.TrackTxFeePayer(ctx, payerAcc.Address, fees)
+		s.Assert().Equal(gasBefore, ctx.GasMeter().GasConsumed())
+
+		txInfo, found := tKeeper.GetState().TxInfoState(ctx).GetTxInfo(txID)
+		s.Require().True(found)
+		s.Assert().Equal(payerAcc.Address.String(), txInfo.FeePayer)
+		s.Assert().Equal(fees.String(), txInfo.Fees.String())
+	})
+
+	s.Run("OK: the latest fee payer wins", func() {
+		tKeeper.TrackTxFeePayer(ctx, sponsorAcc.Address, fees)
+
+		txInfo, found := tKeeper.GetState().TxInfoState(ctx).GetTxInfo(txID)
+		s.Require().True(found)
+		s.Assert().Equal(sponsorAcc.Address.String(), txInfo.FeePayer)
+	})
+
+	s.Run("OK: fee payer is kept by the EndBlocker", func() {
+		tKeeper.TrackTxGasUsage(ctx, 1000, 500)
+		tKeeper.FinalizeBlockTxTracking(ctx)
+
+		txInfo, found := tKeeper.GetState().TxInfoState(ctx).GetTxInfo(txID)
+		s.Require().True(found)
+		s.Assert().Equal(sponsorAcc.Address.String(), txInfo.FeePayer)
+		s.Assert().Equal(fees.String(), txInfo.Fees.String())
+		s.Assert().EqualValues(500, txInfo.GasUsed)
+	})
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator instance.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the module state from the consensus version 1 to 2.
+// TxInfo got the NonContractGas, FeePayer, Fees, GasLimit and GasUsed fields (TotalGas now includes the NonContractGas).
+// Leaving new fields unset is intentional: zero values are valid and mean "not tracked".
+// Existing entries have TotalGas equal to the contract operations gas, which is a valid state with the zero NonContractGas
+// (the whole fee rebate goes to contracts), and a zero GasLimit / GasUsed / empty Fees skip the unused gas refund.
+// Those entries are pruned within a few blocks anyway, so the store is left as is and only the consistency is checked.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	_, txInfos := m.keeper.state.TxInfoState(ctx).Export()
+	for _, txInfo := range txInfos {
+		if err := txInfo.Validate(); err != nil {
+			return fmt.Errorf("txInfo (%d): %w", txInfo.Id, err)
+		}
+	}
+
+	return nil
+}
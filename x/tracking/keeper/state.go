@@ -53,6 +53,16 @@ func (s State) ContractOpInfoState(ctx sdk.Context) ContractOpInfoState {
 	}
 }
 
+// GasAdjustmentState returns types.GasAdjustment repository.
+func (s State) GasAdjustmentState(ctx sdk.Context) GasAdjustmentState {
+	baseStore := ctx.KVStore(s.key)
+	return GasAdjustmentState{
+		stateStore: prefix.NewStore(baseStore, types.GasAdjustmentStatePrefix),
+		cdc:        s.cdc,
+		ctx:        ctx,
+	}
+}
+
 // GetState returns the module storage state.
 // Only for testing purposes.
 func (k Keeper) GetState() State {
@@ -19,7 +19,7 @@ type ContractOpInfoState struct {
 }
 
 // CreateContractOpInfo creates a new types.ContractOperationInfo object with unique ID.
-func (s ContractOpInfoState) CreateContractOpInfo(txID uint64, contractAddr sdk.AccAddress, opType types.ContractOperation, vmGas, sdkGas uint64) types.ContractOperationInfo {
+func (s ContractOpInfoState) CreateContractOpInfo(txID uint64, contractAddr sdk.AccAddress, opType types.ContractOperation, vmGas, sdkGas, originalVMGas, originalSDKGas uint64) types.ContractOperationInfo {
 	obj := types.ContractOperationInfo{
 		Id:              s.getNextID(),
 		TxId:            txID,
@@ -27,6 +27,8 @@ func (s ContractOpInfoState) CreateContractOpInfo(txID uint64, contractAddr sdk.
 		OperationType:   opType,
 		VmGas:           vmGas,
 		SdkGas:          sdkGas,
+		OriginalVmGas:   originalVMGas,
+		OriginalSdkGas:  originalSDKGas,
 	}
 
 	s.setContractOpInfo(&obj)
@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storeTypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/archway-network/archway/x/tracking/types"
+)
+
+// GasAdjustmentState provides access to the types.GasAdjustment objects storage operations.
+type GasAdjustmentState struct {
+	stateStore storeTypes.KVStore
+	cdc        codec.Codec
+	ctx        sdk.Context
+}
+
+// SetGasAdjustment creates or modifies a types.GasAdjustment object (contract or code one).
+func (s GasAdjustmentState) SetGasAdjustment(obj types.GasAdjustment) {
+	store, key := s.getStoreAndKey(obj)
+	store.Set(key, s.cdc.MustMarshal(&obj))
+}
+
+// DeleteGasAdjustment removes a types.GasAdjustment object (the multiplier value is ignored).
+func (s GasAdjustmentState) DeleteGasAdjustment(obj types.GasAdjustment) {
+	store, key := s.getStoreAndKey(obj)
+	store.Delete(key)
+}
+
+// GetContractGasAdjustment returns a contract types.GasAdjustment object by contract address.
+func (s GasAdjustmentState) GetContractGasAdjustment(contractAddr sdk.AccAddress) (types.GasAdjustment, bool) {
+	store := prefix.NewStore(s.stateStore, types.GasAdjustmentContractPrefix)
+	return s.getGasAdjustment(store, s.buildContractKey(contractAddr))
+}
+
+// GetCodeGasAdjustment returns a code types.GasAdjustment object by code ID.
+func (s GasAdjustmentState) GetCodeGasAdjustment(codeID uint64) (types.GasAdjustment, bool) {
+	store := prefix.NewStore(s.stateStore, types.GasAdjustmentCodePrefix)
+	return s.getGasAdjustment(store, s.buildCodeKey(codeID))
+}
+
+// GetGasAdjustmentsPaginated returns a list of types.GasAdjustment objects paginated (contract ones go first).
+func (s GasAdjustmentState) GetGasAdjustmentsPaginated(pageReq *query.PageRequest) ([]types.GasAdjustment, *query.PageResponse, error) {
+	var objs []types.GasAdjustment
+	pageRes, err := query.Paginate(s.stateStore, pageReq, func(_, value []byte) error {
+		var obj types.GasAdjustment
+		if err := s.cdc.Unmarshal(value, &obj); err != nil {
+			return err
+		}
+		objs = append(objs, obj)
+
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return objs, pageRes, nil
+}
+
+// Import initializes state from the module genesis data.
+func (s GasAdjustmentState) Import(objs []types.GasAdjustment) {
+	for _, obj := range objs {
+		s.SetGasAdjustment(obj)
+	}
+}
+
+// Export returns the module genesis data for the state.
+func (s GasAdjustmentState) Export() (objs []types.GasAdjustment) {
+	iterator := s.stateStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var obj types.GasAdjustment
+		s.cdc.MustUnmarshal(iterator.Value(), &obj)
+
+		objs = append(objs, obj)
+	}
+
+	return
+}
+
+// getGasAdjustment returns a types.GasAdjustment object by key from the contract or code prefixed store.
+func (s GasAdjustmentState) getGasAdjustment(store storeTypes.KVStore, key []byte) (types.GasAdjustment, bool) {
+	bz := store.Get(key)
+	if bz == nil {
+		return types.GasAdjustment{}, false
+	}
+
+	var obj types.GasAdjustment
+	s.cdc.MustUnmarshal(bz, &obj)
+
+	return obj, true
+}
+
+// getStoreAndKey returns the prefixed store and the key used to store a types.GasAdjustment object.
+func (s GasAdjustmentState) getStoreAndKey(obj types.GasAdjustment) (storeTypes.KVStore, []byte) {
+	if obj.IsContractAdjustment() {
+		return prefix.NewStore(s.stateStore, types.GasAdjustmentContractPrefix), s.buildContractKey(obj.MustGetContractAddress())
+	}
+
+	return prefix.NewStore(s.stateStore, types.GasAdjustmentCodePrefix), s.buildCodeKey(obj.CodeId)
+}
+
+// buildContractKey returns the key used to store a contract types.GasAdjustment object.
+func (s GasAdjustmentState) buildContractKey(contractAddr sdk.AccAddress) []byte {
+	return contractAddr.Bytes()
+}
+
+// buildCodeKey returns the key used to store a code types.GasAdjustment object.
+func (s GasAdjustmentState) buildCodeKey(codeID uint64) []byte {
+	return sdk.Uint64ToBigEndian(codeID)
+}
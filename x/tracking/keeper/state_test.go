@@ -176,6 +176,12 @@ func (s *KeeperTestSuite) TestStates() {
 			)
 		}
 		s.Require().NoError(keeper.IngestGasRecord(ctx, records))
+
+		// No gas adjustments are set, so the original gas equals the tracked one
+		for i := range data.Ops {
+			data.Ops[i].OriginalVmGas = data.Ops[i].VmGas
+			data.Ops[i].OriginalSdkGas = data.Ops[i].SdkGas
+		}
 	}
 	keeper.FinalizeBlockTxTracking(ctx)
 
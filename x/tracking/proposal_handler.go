@@ -0,0 +1,22 @@
+package tracking
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/archway-network/archway/x/tracking/keeper"
+	"github.com/archway-network/archway/x/tracking/types"
+)
+
+// NewProposalHandler creates a new x/gov proposal handler for the module's proposal types.
+func NewProposalHandler(k keeper.Keeper) govTypes.Handler {
+	return func(ctx sdk.Context, content govTypes.Content) error {
+		switch c := content.(type) {
+		case *types.SetGasAdjustmentProposal:
+			return k.SetGasAdjustment(ctx, c.Adjustment)
+		default:
+			return sdkErrors.Wrapf(sdkErrors.ErrUnknownRequest, "unrecognized tracking proposal content type: %T", c)
+		}
+	}
+}
@@ -5,14 +5,21 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	cryptoCodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
 // RegisterLegacyAminoCodec registers the necessary interfaces and concrete types on the provided LegacyAmino codec.
 // These types are used for Amino JSON serialization.
-func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {}
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&SetGasAdjustmentProposal{}, "tracking/SetGasAdjustmentProposal", nil)
+}
 
 // RegisterInterfaces registers interfaces types with the interface registry.
-func RegisterInterfaces(registry types.InterfaceRegistry) {}
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*govTypes.Content)(nil),
+		&SetGasAdjustmentProposal{},
+	)
+}
 
 var (
 	ModuleCdc = codec.NewAminoCodec(amino)
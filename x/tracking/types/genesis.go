@@ -3,12 +3,13 @@ package types
 import "fmt"
 
 // NewGenesisState creates a new GenesisState object.
-func NewGenesisState(txInfoLastID uint64, txInfos []TxInfo, contractOpInfoLastID uint64, contractOpInfos []ContractOperationInfo) *GenesisState {
+func NewGenesisState(txInfoLastID uint64, txInfos []TxInfo, contractOpInfoLastID uint64, contractOpInfos []ContractOperationInfo, gasAdjustments []GasAdjustment) *GenesisState {
 	return &GenesisState{
 		TxInfoLastId:         txInfoLastID,
 		TxInfos:              txInfos,
 		ContractOpInfoLastId: contractOpInfoLastID,
 		ContractOpInfos:      contractOpInfos,
+		GasAdjustments:       gasAdjustments,
 	}
 }
 
@@ -19,6 +20,7 @@ func DefaultGenesisState() *GenesisState {
 		TxInfos:              []TxInfo{},
 		ContractOpInfoLastId: 0,
 		ContractOpInfos:      []ContractOperationInfo{},
+		GasAdjustments:       []GasAdjustment{},
 	}
 }
 
@@ -67,5 +69,26 @@ func (m GenesisState) Validate() error {
 		return fmt.Errorf("contractOpInfoLastId: %d < max ContractOpInfo ID (%d)", m.ContractOpInfoLastId, opIDMax)
 	}
 
+	contractAdjustmentSet := make(map[string]struct{})
+	codeAdjustmentSet := make(map[uint64]struct{})
+	for i, adjustment := range m.GasAdjustments {
+		if err := adjustment.Validate(); err != nil {
+			return fmt.Errorf("gasAdjustments [%d]: %w", i, err)
+		}
+
+		if adjustment.IsContractAdjustment() {
+			if _, ok := contractAdjustmentSet[adjustment.ContractAddress]; ok {
+				return fmt.Errorf("gasAdjustments [%d]: duplicated contractAddress: %s", i, adjustment.ContractAddress)
+			}
+			contractAdjustmentSet[adjustment.ContractAddress] = struct{}{}
+			continue
+		}
+
+		if _, ok := codeAdjustmentSet[adjustment.CodeId]; ok {
+			return fmt.Errorf("gasAdjustments [%d]: duplicated codeId: %d", i, adjustment.CodeId)
+		}
+		codeAdjustmentSet[adjustment.CodeId] = struct{}{}
+	}
+
 	return nil
 }
@@ -34,6 +34,8 @@ type GenesisState struct {
 	ContractOpInfoLastId uint64 `protobuf:"varint,3,opt,name=contract_op_info_last_id,json=contractOpInfoLastId,proto3" json:"contract_op_info_last_id,omitempty"`
 	// contract_op_infos defines a list of all the tracked contract operations.
 	ContractOpInfos []ContractOperationInfo `protobuf:"bytes,4,rep,name=contract_op_infos,json=contractOpInfos,proto3" json:"contract_op_infos"`
+	// gas_adjustments defines a list of all the contract and code gas adjustments.
+	GasAdjustments []GasAdjustment `protobuf:"bytes,5,rep,name=gas_adjustments,json=gasAdjustments,proto3" json:"gas_adjustments"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -97,6 +99,13 @@ func (m *GenesisState) GetContractOpInfos() []ContractOperationInfo {
 	return nil
 }
 
+func (m *GenesisState) GetGasAdjustments() []GasAdjustment {
+	if m != nil {
+		return m.GasAdjustments
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "archway.tracking.v1beta1.GenesisState")
 }
@@ -106,27 +115,28 @@ func init() {
 }
 
 var fileDescriptor_40f994a880eb2f25 = []byte{
-	// 305 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0x41, 0x4b, 0xc3, 0x30,
-	0x14, 0xc7, 0xdb, 0x6d, 0xa8, 0xc4, 0xc1, 0xb0, 0xec, 0x50, 0x76, 0x88, 0x43, 0x50, 0x77, 0x31,
-	0x61, 0x0e, 0xbc, 0x3b, 0x0f, 0x32, 0x50, 0x84, 0xe9, 0xc9, 0x4b, 0xc9, 0xba, 0xac, 0x0b, 0xd3,
-	0xa4, 0x34, 0x4f, 0xd7, 0x7d, 0x0b, 0xc1, 0x2f, 0xb5, 0xe3, 0x8e, 0x9e, 0x44, 0xda, 0x2f, 0x22,
-	0x4b, 0xdb, 0xb9, 0x09, 0xbd, 0x25, 0xbc, 0xdf, 0xfb, 0xff, 0xfe, 0x3c, 0x74, 0xc6, 0x22, 0x7f,
-	0x3a, 0x67, 0x0b, 0x0a, 0x11, 0xf3, 0x67, 0x42, 0x06, 0xf4, 0xbd, 0x3b, 0xe2, 0xc0, 0xba, 0x34,
-	0xe0, 0x92, 0x6b, 0xa1, 0x49, 0x18, 0x29, 0x50, 0x8e, 0x9b, 0x73, 0xa4, 0xe0, 0x48, 0xce, 0xb5,
-	0x9a, 0x81, 0x0a, 0x94, 0x81, 0xe8, 0xfa, 0x95, 0xf1, 0xad, 0xf3, 0xd2, 0xdc, 0x4d, 0x80, 0x01,
-	0x4f, 0x3e, 0x2b, 0xa8, 0x7e, 0x9b, 0xa9, 0x1e, 0x81, 0x01, 0x77, 0x4e, 0x51, 0x03, 0x62, 0x4f,
-	0xc8, 0x89, 0xf2, 0x5e, 0x98, 0x06, 0x4f, 0x8c, 0x5d, 0xbb, 0x6d, 0x77, 0x6a, 0xc3, 0x3a, 0xc4,
-	0x03, 0x39, 0x51, 0x77, 0x4c, 0xc3, 0x60, 0xec, 0x5c, 0xa3, 0x83, 0x1c, 0xd3, 0x6e, 0xa5, 0x5d,
-	0xed, 0x1c, 0x5e, 0xb6, 0x49, 0x59, 0x47, 0xf2, 0x64, 0x36, 0xfb, 0xb5, 0xe5, 0xf7, 0xb1, 0x35,
-	0xdc, 0xcf, 0x72, 0xb4, 0x73, 0x85, 0x5c, 0x5f, 0xc9, 0x35, 0x0c, 0x9e, 0x0a, 0x77, 0x95, 0x55,
-	0xa3, 0x6c, 0x16, 0xf3, 0x87, 0x70, 0x4b, 0xcd, 0xd0, 0xd1, 0xff, 0x3d, 0xed, 0xd6, 0x4c, 0x07,
-	0x5a, 0xde, 0xe1, 0x66, 0x13, 0xc5, 0x23, 0x06, 0x42, 0xc9, 0xad, 0x4a, 0x8d, 0x5d, 0x8f, 0xee,
-	0xdf, 0x2f, 0x13, 0x6c, 0xaf, 0x12, 0x6c, 0xff, 0x24, 0xd8, 0xfe, 0x48, 0xb1, 0xb5, 0x4a, 0xb1,
-	0xf5, 0x95, 0x62, 0xeb, 0xb9, 0x17, 0x08, 0x98, 0xbe, 0x8d, 0x88, 0xaf, 0x5e, 0x69, 0xee, 0xba,
-	0x90, 0x1c, 0xe6, 0x2a, 0x9a, 0x15, 0x7f, 0x1a, 0xff, 0x5d, 0x1d, 0x16, 0x21, 0xd7, 0xa3, 0x3d,
-	0x73, 0xeb, 0xde, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x21, 0xe2, 0x96, 0x47, 0xee, 0x01, 0x00,
-	0x00,
+	// 335 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcd, 0x4e, 0xf2, 0x40,
+	0x14, 0x86, 0x5b, 0xe0, 0xfb, 0x34, 0x23, 0x91, 0xd8, 0xb0, 0x68, 0x58, 0x54, 0x62, 0xa2, 0xb0,
+	0xb1, 0x13, 0x24, 0x71, 0x0f, 0x2e, 0x08, 0x89, 0xc6, 0x04, 0x8d, 0x0b, 0x37, 0xcd, 0x50, 0x86,
+	0xa1, 0x22, 0x33, 0x4d, 0xcf, 0x41, 0xe0, 0x2e, 0xbc, 0x2c, 0x96, 0x2c, 0x5d, 0x19, 0x03, 0x4b,
+	0x6f, 0xc2, 0x30, 0x14, 0x2c, 0x26, 0xdd, 0xcd, 0xcf, 0x73, 0xde, 0xe7, 0x4d, 0x0e, 0xb9, 0x60,
+	0x91, 0x3f, 0x98, 0xb0, 0x19, 0xc5, 0x88, 0xf9, 0xc3, 0x40, 0x0a, 0xfa, 0x56, 0xeb, 0x72, 0x64,
+	0x35, 0x2a, 0xb8, 0xe4, 0x10, 0x80, 0x1b, 0x46, 0x0a, 0x95, 0x65, 0xc7, 0x9c, 0xbb, 0xe5, 0xdc,
+	0x98, 0x2b, 0x15, 0x85, 0x12, 0x4a, 0x43, 0x74, 0x7d, 0xda, 0xf0, 0xa5, 0x4a, 0x6a, 0xee, 0x2e,
+	0x40, 0x83, 0x67, 0xdf, 0x19, 0x92, 0x6f, 0x6d, 0x54, 0x0f, 0xc8, 0x90, 0x5b, 0xe7, 0xa4, 0x80,
+	0x53, 0x2f, 0x90, 0x7d, 0xe5, 0xbd, 0x32, 0x40, 0x2f, 0xe8, 0xd9, 0x66, 0xd9, 0xac, 0xe6, 0x3a,
+	0x79, 0x9c, 0xb6, 0x65, 0x5f, 0xdd, 0x32, 0xc0, 0x76, 0xcf, 0x6a, 0x90, 0xc3, 0x18, 0x03, 0x3b,
+	0x53, 0xce, 0x56, 0x8f, 0xae, 0xca, 0x6e, 0x5a, 0x47, 0xf7, 0x51, 0x4f, 0x36, 0x73, 0xf3, 0xcf,
+	0x53, 0xa3, 0x73, 0xb0, 0xc9, 0x01, 0xeb, 0x9a, 0xd8, 0xbe, 0x92, 0x6b, 0x18, 0x3d, 0x15, 0xee,
+	0x2b, 0xb3, 0x5a, 0x59, 0xdc, 0xfe, 0xdf, 0x87, 0x09, 0x35, 0x23, 0x27, 0x7f, 0xe7, 0xc0, 0xce,
+	0xe9, 0x0e, 0x34, 0xbd, 0xc3, 0xcd, 0x2e, 0x8a, 0x47, 0x0c, 0x03, 0x25, 0x13, 0x95, 0x0a, 0xfb,
+	0x1e, 0xb0, 0x9e, 0x48, 0x41, 0x30, 0xf0, 0x58, 0xef, 0x65, 0x0c, 0x38, 0xe2, 0x12, 0xc1, 0xfe,
+	0xa7, 0x05, 0x95, 0x74, 0x41, 0x8b, 0x41, 0x63, 0xc7, 0xc7, 0xc1, 0xc7, 0x22, 0xf9, 0x08, 0xcd,
+	0xbb, 0xf9, 0xd2, 0x31, 0x17, 0x4b, 0xc7, 0xfc, 0x5a, 0x3a, 0xe6, 0xfb, 0xca, 0x31, 0x16, 0x2b,
+	0xc7, 0xf8, 0x58, 0x39, 0xc6, 0x73, 0x5d, 0x04, 0x38, 0x18, 0x77, 0x5d, 0x5f, 0x8d, 0x68, 0xac,
+	0xb8, 0x94, 0x1c, 0x27, 0x2a, 0x1a, 0x6e, 0xef, 0x74, 0xfa, 0xbb, 0x4d, 0x9c, 0x85, 0x1c, 0xba,
+	0xff, 0xf5, 0x0e, 0xeb, 0x3f, 0x03, 0x00, 0x28, 0xf7, 0xc3, 0x5f, 0x46, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -149,6 +159,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.GasAdjustments) > 0 {
+		for iNdEx := len(m.GasAdjustments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasAdjustments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.ContractOpInfos) > 0 {
 		for iNdEx := len(m.ContractOpInfos) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -225,6 +249,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.GasAdjustments) > 0 {
+		for _, e := range m.GasAdjustments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -369,6 +399,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasAdjustments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasAdjustments = append(m.GasAdjustments, GasAdjustment{})
+			if err := m.GasAdjustments[len(m.GasAdjustments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 
 	e2eTesting "github.com/archway-network/archway/e2e/testing"
@@ -48,6 +49,16 @@ func TestTrackingGenesisStateValidation(t *testing.T) {
 						OperationType:   trackingTypes.ContractOperation_CONTRACT_OPERATION_EXECUTION,
 					},
 				},
+				GasAdjustments: []trackingTypes.GasAdjustment{
+					{
+						ContractAddress: contractAddr1.String(),
+						Multiplier:      sdk.NewDecWithPrec(5, 1),
+					},
+					{
+						CodeId:     1,
+						Multiplier: sdk.NewDecWithPrec(5, 1),
+					},
+				},
 			},
 		},
 		{
@@ -141,6 +152,50 @@ func TestTrackingGenesisStateValidation(t *testing.T) {
 			},
 			errExpected: true,
 		},
+		{
+			name: "Fail: invalid GasAdjustments",
+			genesis: trackingTypes.GenesisState{
+				GasAdjustments: []trackingTypes.GasAdjustment{
+					{
+						CodeId:     1,
+						Multiplier: sdk.ZeroDec(),
+					},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: duplicated contract GasAdjustments",
+			genesis: trackingTypes.GenesisState{
+				GasAdjustments: []trackingTypes.GasAdjustment{
+					{
+						ContractAddress: contractAddr1.String(),
+						Multiplier:      sdk.NewDecWithPrec(5, 1),
+					},
+					{
+						ContractAddress: contractAddr1.String(),
+						Multiplier:      sdk.NewDec(2),
+					},
+				},
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: duplicated code GasAdjustments",
+			genesis: trackingTypes.GenesisState{
+				GasAdjustments: []trackingTypes.GasAdjustment{
+					{
+						CodeId:     1,
+						Multiplier: sdk.NewDecWithPrec(5, 1),
+					},
+					{
+						CodeId:     1,
+						Multiplier: sdk.NewDec(2),
+					},
+				},
+			},
+			errExpected: true,
+		},
 		{
 			name: "Fail: invalid lastTxID",
 			genesis: trackingTypes.GenesisState{
@@ -204,6 +259,25 @@ func TestTrackingTxInfoValidate(t *testing.T) {
 				TotalGas: 100,
 			},
 		},
+		{
+			name: "OK: with non-contract gas",
+			txInfo: trackingTypes.TxInfo{
+				Id:             1,
+				Height:         1,
+				TotalGas:       100,
+				NonContractGas: 100,
+			},
+		},
+		{
+			name: "OK: with gas limit and gas used",
+			txInfo: trackingTypes.TxInfo{
+				Id:       1,
+				Height:   1,
+				TotalGas: 100,
+				GasLimit: 1000,
+				GasUsed:  1000,
+			},
+		},
 		{
 			name: "Fail: invalid ID",
 			txInfo: trackingTypes.TxInfo{
@@ -211,6 +285,27 @@ func TestTrackingTxInfoValidate(t *testing.T) {
 			},
 			errExpected: true,
 		},
+		{
+			name: "Fail: gas used GT gas limit",
+			txInfo: trackingTypes.TxInfo{
+				Id:       1,
+				Height:   1,
+				TotalGas: 100,
+				GasLimit: 1000,
+				GasUsed:  1001,
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: non-contract gas GT total gas",
+			txInfo: trackingTypes.TxInfo{
+				Id:             1,
+				Height:         1,
+				TotalGas:       100,
+				NonContractGas: 101,
+			},
+			errExpected: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -299,3 +394,88 @@ func TestTrackingContractOperationInfoValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestTrackingGasAdjustmentValidate(t *testing.T) {
+	type testCase struct {
+		name        string
+		adjustment  trackingTypes.GasAdjustment
+		errExpected bool
+	}
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+
+	testCases := []testCase{
+		{
+			name: "OK: contract",
+			adjustment: trackingTypes.GasAdjustment{
+				ContractAddress: contractAddr.String(),
+				Multiplier:      sdk.NewDecWithPrec(5, 1),
+			},
+		},
+		{
+			name: "OK: code",
+			adjustment: trackingTypes.GasAdjustment{
+				CodeId:     1,
+				Multiplier: trackingTypes.MaxGasAdjustmentMultiplier,
+			},
+		},
+		{
+			name: "Fail: both contract and code are set",
+			adjustment: trackingTypes.GasAdjustment{
+				ContractAddress: contractAddr.String(),
+				CodeId:          1,
+				Multiplier:      sdk.NewDecWithPrec(5, 1),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: neither contract nor code is set",
+			adjustment: trackingTypes.GasAdjustment{
+				Multiplier: sdk.NewDecWithPrec(5, 1),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid ContractAddress",
+			adjustment: trackingTypes.GasAdjustment{
+				ContractAddress: "invalid",
+				Multiplier:      sdk.NewDecWithPrec(5, 1),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: nil multiplier",
+			adjustment: trackingTypes.GasAdjustment{
+				CodeId: 1,
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: zero multiplier",
+			adjustment: trackingTypes.GasAdjustment{
+				CodeId:     1,
+				Multiplier: sdk.ZeroDec(),
+			},
+			errExpected: true,
+		},
+		{
+			name: "Fail: multiplier GT max",
+			adjustment: trackingTypes.GasAdjustment{
+				CodeId:     1,
+				Multiplier: trackingTypes.MaxGasAdjustmentMultiplier.Add(sdk.NewDecWithPrec(1, 2)),
+			},
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.adjustment.Validate()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -5,8 +5,12 @@ const (
 	ModuleName = "tracking"
 	// StoreKey is the module KV storage prefix key.
 	StoreKey = ModuleName
+	// TStoreKey is the module transient storage prefix key.
+	TStoreKey = "transient_" + ModuleName
 	// QuerierRoute is the querier route for the module.
 	QuerierRoute = ModuleName
+	// RouterKey is the msg router key for the module.
+	RouterKey = ModuleName
 )
 
 // TxInfo prefixed store state keys.
@@ -30,6 +34,14 @@ var (
 	TxInfoBlockIndexPrefix = []byte{0x02}
 )
 
+// Transient store keys.
+var (
+	// TxGasPendingIDKey defines the key for storing the ID of the TxInfo which gas usage is not yet tracked.
+	// Key: TxGasPendingIDKey
+	// Value: uint64
+	TxGasPendingIDKey = []byte{0x00}
+)
+
 // ContractOperationInfo prefixed store state keys.
 var (
 	// ContractOpInfoStatePrefix defines the state global prefix.
@@ -50,3 +62,19 @@ var (
 	// Value: None
 	ContractOpInfoTxIndexPrefix = []byte{0x02}
 )
+
+// GasAdjustment prefixed store state keys.
+var (
+	// GasAdjustmentStatePrefix defines the state global prefix.
+	GasAdjustmentStatePrefix = []byte{0x02}
+
+	// GasAdjustmentContractPrefix defines the prefix for storing contract GasAdjustment objects.
+	// Key: GasAdjustmentStatePrefix | GasAdjustmentContractPrefix | {ContractAddress}
+	// Value: GasAdjustment
+	GasAdjustmentContractPrefix = []byte{0x00}
+
+	// GasAdjustmentCodePrefix defines the prefix for storing code GasAdjustment objects.
+	// Key: GasAdjustmentStatePrefix | GasAdjustmentCodePrefix | {CodeID}
+	// Value: GasAdjustment
+	GasAdjustmentCodePrefix = []byte{0x01}
+)
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"fmt"
+
+	govTypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ProposalTypeSetGasAdjustment defines the type for a SetGasAdjustmentProposal.
+	ProposalTypeSetGasAdjustment = "SetGasAdjustment"
+)
+
+var (
+	_ govTypes.Content = &SetGasAdjustmentProposal{}
+)
+
+func init() {
+	govTypes.RegisterProposalType(ProposalTypeSetGasAdjustment)
+	govTypes.RegisterProposalTypeCodec(&SetGasAdjustmentProposal{}, "tracking/SetGasAdjustmentProposal")
+}
+
+// NewSetGasAdjustmentProposal creates a new SetGasAdjustmentProposal instance.
+func NewSetGasAdjustmentProposal(title, description string, adjustment GasAdjustment) *SetGasAdjustmentProposal {
+	return &SetGasAdjustmentProposal{
+		Title:       title,
+		Description: description,
+		Adjustment:  adjustment,
+	}
+}
+
+// GetTitle implements the govTypes.Content interface.
+func (p *SetGasAdjustmentProposal) GetTitle() string { return p.Title }
+
+// GetDescription implements the govTypes.Content interface.
+func (p *SetGasAdjustmentProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute implements the govTypes.Content interface.
+func (p *SetGasAdjustmentProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType implements the govTypes.Content interface.
+func (p *SetGasAdjustmentProposal) ProposalType() string { return ProposalTypeSetGasAdjustment }
+
+// ValidateBasic implements the govTypes.Content interface.
+func (p *SetGasAdjustmentProposal) ValidateBasic() error {
+	if err := govTypes.ValidateAbstract(p); err != nil {
+		return err
+	}
+
+	if err := p.Adjustment.Validate(); err != nil {
+		return fmt.Errorf("adjustment: %w", err)
+	}
+
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (p SetGasAdjustmentProposal) String() string {
+	bz, _ := yaml.Marshal(p)
+	return string(bz)
+}
@@ -0,0 +1,62 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+
+	e2eTesting "github.com/archway-network/archway/e2e/testing"
+	trackingTypes "github.com/archway-network/archway/x/tracking/types"
+)
+
+func TestSetGasAdjustmentProposalValidateBasic(t *testing.T) {
+	type testCase struct {
+		name        string
+		proposal    *trackingTypes.SetGasAdjustmentProposal
+		errExpected bool
+	}
+
+	contractAddr := e2eTesting.GenContractAddresses(1)[0]
+	adjustment := trackingTypes.GasAdjustment{
+		ContractAddress: contractAddr.String(),
+		Multiplier:      sdk.NewDecWithPrec(5, 1),
+	}
+
+	testCases := []testCase{
+		{
+			name:     "OK",
+			proposal: trackingTypes.NewSetGasAdjustmentProposal("Title", "Description", adjustment),
+		},
+		{
+			name: "OK: remove adjustment",
+			proposal: trackingTypes.NewSetGasAdjustmentProposal("Title", "Description", trackingTypes.GasAdjustment{
+				CodeId:     1,
+				Multiplier: sdk.OneDec(),
+			}),
+		},
+		{
+			name:        "Fail: empty title",
+			proposal:    trackingTypes.NewSetGasAdjustmentProposal("", "Description", adjustment),
+			errExpected: true,
+		},
+		{
+			name: "Fail: invalid adjustment",
+			proposal: trackingTypes.NewSetGasAdjustmentProposal("Title", "Description", trackingTypes.GasAdjustment{
+				Multiplier: sdk.NewDecWithPrec(5, 1),
+			}),
+			errExpected: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.proposal.ValidateBasic()
+			if tc.errExpected {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -7,6 +7,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -112,9 +113,112 @@ func (m *QueryBlockGasTrackingResponse) GetBlock() BlockTracking {
 	return BlockTracking{}
 }
 
+// QueryGasAdjustmentsRequest is the request for Query.GasAdjustments.
+type QueryGasAdjustmentsRequest struct {
+	// pagination is an optional pagination options for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGasAdjustmentsRequest) Reset()         { *m = QueryGasAdjustmentsRequest{} }
+func (m *QueryGasAdjustmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGasAdjustmentsRequest) ProtoMessage()    {}
+func (*QueryGasAdjustmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4819b1e6d461893, []int{2}
+}
+func (m *QueryGasAdjustmentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasAdjustmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasAdjustmentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasAdjustmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasAdjustmentsRequest.Merge(m, src)
+}
+func (m *QueryGasAdjustmentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasAdjustmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasAdjustmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasAdjustmentsRequest proto.InternalMessageInfo
+
+func (m *QueryGasAdjustmentsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGasAdjustmentsResponse is the response for Query.GasAdjustments.
+type QueryGasAdjustmentsResponse struct {
+	// adjustments is the list of active gas adjustments.
+	Adjustments []GasAdjustment `protobuf:"bytes,1,rep,name=adjustments,proto3" json:"adjustments"`
+	// pagination is the pagination details in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryGasAdjustmentsResponse) Reset()         { *m = QueryGasAdjustmentsResponse{} }
+func (m *QueryGasAdjustmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGasAdjustmentsResponse) ProtoMessage()    {}
+func (*QueryGasAdjustmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f4819b1e6d461893, []int{3}
+}
+func (m *QueryGasAdjustmentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGasAdjustmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGasAdjustmentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGasAdjustmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGasAdjustmentsResponse.Merge(m, src)
+}
+func (m *QueryGasAdjustmentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGasAdjustmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGasAdjustmentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGasAdjustmentsResponse proto.InternalMessageInfo
+
+func (m *QueryGasAdjustmentsResponse) GetAdjustments() []GasAdjustment {
+	if m != nil {
+		return m.Adjustments
+	}
+	return nil
+}
+
+func (m *QueryGasAdjustmentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryBlockGasTrackingRequest)(nil), "archway.tracking.v1beta1.QueryBlockGasTrackingRequest")
 	proto.RegisterType((*QueryBlockGasTrackingResponse)(nil), "archway.tracking.v1beta1.QueryBlockGasTrackingResponse")
+	proto.RegisterType((*QueryGasAdjustmentsRequest)(nil), "archway.tracking.v1beta1.QueryGasAdjustmentsRequest")
+	proto.RegisterType((*QueryGasAdjustmentsResponse)(nil), "archway.tracking.v1beta1.QueryGasAdjustmentsResponse")
 }
 
 func init() {
@@ -122,27 +226,36 @@ func init() {
 }
 
 var fileDescriptor_f4819b1e6d461893 = []byte{
-	// 306 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0x2c, 0x4a, 0xce,
-	0x28, 0x4f, 0xac, 0xd4, 0x2f, 0x29, 0x4a, 0x4c, 0xce, 0xce, 0xcc, 0x4b, 0xd7, 0x2f, 0x33, 0x4c,
-	0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x2c, 0x4d, 0x2d, 0xaa, 0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x92, 0x80, 0xaa, 0xd2, 0x83, 0xa9, 0xd2, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0x2b, 0xd2, 0x07, 0xb1, 0x20, 0xea, 0xa5, 0x64, 0xd2, 0xf3, 0xf3, 0xd3, 0x73, 0x52, 0xf5,
-	0x13, 0x0b, 0x32, 0xf5, 0x13, 0xf3, 0xf2, 0xf2, 0x4b, 0x12, 0x4b, 0x32, 0xf3, 0xf3, 0x8a, 0xa1,
-	0xb2, 0xea, 0x38, 0xed, 0x84, 0x1b, 0x0f, 0x56, 0xa8, 0x24, 0xc7, 0x25, 0x13, 0x08, 0x72, 0x85,
-	0x53, 0x4e, 0x7e, 0x72, 0xb6, 0x7b, 0x62, 0x71, 0x08, 0x54, 0x3a, 0x28, 0xb5, 0xb0, 0x34, 0xb5,
-	0xb8, 0x44, 0x29, 0x85, 0x4b, 0x16, 0x87, 0x7c, 0x71, 0x41, 0x7e, 0x5e, 0x71, 0xaa, 0x90, 0x33,
-	0x17, 0x6b, 0x12, 0x48, 0x4e, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x5d, 0x0f, 0x97, 0x3f,
-	0xf4, 0xc0, 0x46, 0xc0, 0xf4, 0x3b, 0xb1, 0x9c, 0xb8, 0x27, 0xcf, 0x10, 0x04, 0xd1, 0x6b, 0xb4,
-	0x8f, 0x91, 0x8b, 0x15, 0x6c, 0x8d, 0xd0, 0x16, 0x46, 0x2e, 0x01, 0x74, 0xbb, 0x84, 0xcc, 0x70,
-	0x1b, 0x8a, 0xcf, 0xf1, 0x52, 0xe6, 0x24, 0xeb, 0x83, 0x78, 0x4a, 0x49, 0xbf, 0xe9, 0xf2, 0x93,
-	0xc9, 0x4c, 0x9a, 0x42, 0xea, 0xfa, 0x58, 0xc2, 0x51, 0x1f, 0xec, 0xe6, 0xf8, 0xf4, 0xc4, 0xe2,
-	0x78, 0x98, 0xa8, 0x93, 0xef, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0x3e, 0x78, 0x24,
-	0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37, 0x1e, 0xcb, 0x31, 0x44, 0x19,
-	0xa7, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xc2, 0x0c, 0xd3, 0xcd, 0x4b, 0x2d,
-	0x29, 0xcf, 0x2f, 0xca, 0x86, 0x1b, 0x5e, 0x81, 0x30, 0xbe, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89,
-	0x0d, 0x1c, 0x39, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc3, 0xcc, 0xbc, 0x3a, 0x3b, 0x02,
-	0x00, 0x00,
+	// 454 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x4f, 0x8b, 0xd3, 0x40,
+	0x18, 0xc6, 0x33, 0xd5, 0xf5, 0x30, 0x0b, 0x22, 0x83, 0x87, 0x12, 0xd7, 0xb8, 0x84, 0x65, 0xab,
+	0xa2, 0x33, 0xec, 0xae, 0x7f, 0xce, 0x56, 0xb0, 0x27, 0x51, 0x8b, 0x27, 0x2f, 0xcb, 0x24, 0x1d,
+	0xa6, 0xb1, 0xed, 0x4c, 0x9a, 0x99, 0x58, 0x7b, 0xf5, 0x13, 0x08, 0x7e, 0x0c, 0xaf, 0x82, 0x5f,
+	0xa1, 0xc7, 0x82, 0x17, 0x4f, 0x22, 0xad, 0x47, 0x3f, 0x84, 0x64, 0x32, 0x69, 0x53, 0x49, 0x5a,
+	0x7a, 0x2b, 0x79, 0x9f, 0xf7, 0x79, 0x7e, 0xef, 0xfb, 0x4e, 0xe1, 0x09, 0x4d, 0xc2, 0xfe, 0x84,
+	0x4e, 0x89, 0x4e, 0x68, 0x38, 0x88, 0x04, 0x27, 0x1f, 0xce, 0x02, 0xa6, 0xe9, 0x19, 0x19, 0xa7,
+	0x2c, 0x99, 0xe2, 0x38, 0x91, 0x5a, 0xa2, 0xa6, 0x55, 0xe1, 0x42, 0x85, 0xad, 0xca, 0xbd, 0xc9,
+	0x25, 0x97, 0x46, 0x44, 0xb2, 0x5f, 0xb9, 0xde, 0x3d, 0xe2, 0x52, 0xf2, 0x21, 0x23, 0x34, 0x8e,
+	0x08, 0x15, 0x42, 0x6a, 0xaa, 0x23, 0x29, 0x94, 0xad, 0xde, 0x0f, 0xa5, 0x1a, 0x49, 0x45, 0x02,
+	0xaa, 0x58, 0x1e, 0xb3, 0x0a, 0x8d, 0x29, 0x8f, 0x84, 0x11, 0x5b, 0x6d, 0xab, 0x96, 0x6f, 0x85,
+	0x62, 0x84, 0xbe, 0x07, 0x8f, 0xde, 0x64, 0x56, 0xed, 0xa1, 0x0c, 0x07, 0x1d, 0xaa, 0xde, 0xda,
+	0x72, 0x97, 0x8d, 0x53, 0xa6, 0xb4, 0xdf, 0x83, 0xb7, 0x6b, 0xea, 0x2a, 0x96, 0x42, 0x31, 0xf4,
+	0x1c, 0x1e, 0x04, 0x59, 0xad, 0x09, 0x8e, 0xc1, 0xdd, 0xc3, 0xf3, 0x16, 0xae, 0x9b, 0x19, 0x1b,
+	0x8b, 0xa2, 0xbf, 0x7d, 0x75, 0xf6, 0xeb, 0x8e, 0xd3, 0xcd, 0x7b, 0xfd, 0x1e, 0x74, 0x4d, 0x4a,
+	0x87, 0xaa, 0x67, 0xbd, 0xf7, 0xa9, 0xd2, 0x23, 0x26, 0xb4, 0xb2, 0x0c, 0xe8, 0x05, 0x84, 0xeb,
+	0x01, 0x6d, 0xce, 0x29, 0xce, 0xb7, 0x81, 0xb3, 0x6d, 0xe0, 0x7c, 0xe9, 0x45, 0xd0, 0x6b, 0xca,
+	0x99, 0xed, 0xed, 0x96, 0x3a, 0xfd, 0xef, 0x00, 0xde, 0xaa, 0x8c, 0xb1, 0xa3, 0xbc, 0x82, 0x87,
+	0x74, 0xfd, 0xb9, 0x09, 0x8e, 0xaf, 0x6c, 0x1f, 0x68, 0xc3, 0xc6, 0x0e, 0x54, 0x76, 0x40, 0x9d,
+	0x0d, 0xf0, 0x86, 0x5d, 0xd0, 0x2e, 0xf0, 0x9c, 0xa6, 0x4c, 0x7e, 0xfe, 0xb7, 0x01, 0x0f, 0x0c,
+	0x39, 0xfa, 0x06, 0xe0, 0x8d, 0xff, 0x6f, 0x81, 0x9e, 0xd4, 0x33, 0x6e, 0x3b, 0xae, 0xfb, 0x74,
+	0xef, 0xbe, 0x9c, 0xcd, 0x27, 0x9f, 0x7e, 0xfc, 0xf9, 0xd2, 0xb8, 0x87, 0x5a, 0xa4, 0xe2, 0x9d,
+	0x11, 0x73, 0xd3, 0x4b, 0x4e, 0xd5, 0x65, 0xf1, 0x15, 0x7d, 0x05, 0xf0, 0xfa, 0xe6, 0xd6, 0xd1,
+	0xa3, 0x1d, 0xe1, 0x95, 0x6f, 0xc1, 0x7d, 0xbc, 0x67, 0x97, 0x05, 0x7e, 0x60, 0x80, 0x4f, 0xd1,
+	0x49, 0x25, 0x70, 0x86, 0x5a, 0xba, 0x5b, 0xfb, 0xe5, 0x6c, 0xe1, 0x81, 0xf9, 0xc2, 0x03, 0xbf,
+	0x17, 0x1e, 0xf8, 0xbc, 0xf4, 0x9c, 0xf9, 0xd2, 0x73, 0x7e, 0x2e, 0x3d, 0xe7, 0xdd, 0x05, 0x8f,
+	0x74, 0x3f, 0x0d, 0x70, 0x28, 0x47, 0x85, 0xd3, 0x43, 0xc1, 0xf4, 0x44, 0x26, 0x83, 0x95, 0xf3,
+	0xc7, 0xb5, 0xb7, 0x9e, 0xc6, 0x4c, 0x05, 0xd7, 0xcc, 0x5f, 0xed, 0xe2, 0xdf, 0x00, 0xc1, 0x32,
+	0x63, 0x33, 0x35, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -159,6 +272,8 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// BlockGasTracking returns block gas tracking for the current block
 	BlockGasTracking(ctx context.Context, in *QueryBlockGasTrackingRequest, opts ...grpc.CallOption) (*QueryBlockGasTrackingResponse, error)
+	// GasAdjustments returns all the active contract and code gas adjustments.
+	GasAdjustments(ctx context.Context, in *QueryGasAdjustmentsRequest, opts ...grpc.CallOption) (*QueryGasAdjustmentsResponse, error)
 }
 
 type queryClient struct {
@@ -178,10 +293,21 @@ func (c *queryClient) BlockGasTracking(ctx context.Context, in *QueryBlockGasTra
 	return out, nil
 }
 
+func (c *queryClient) GasAdjustments(ctx context.Context, in *QueryGasAdjustmentsRequest, opts ...grpc.CallOption) (*QueryGasAdjustmentsResponse, error) {
+	out := new(QueryGasAdjustmentsResponse)
+	err := c.cc.Invoke(ctx, "/archway.tracking.v1beta1.Query/GasAdjustments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// BlockGasTracking returns block gas tracking for the current block
 	BlockGasTracking(context.Context, *QueryBlockGasTrackingRequest) (*QueryBlockGasTrackingResponse, error)
+	// GasAdjustments returns all the active contract and code gas adjustments.
+	GasAdjustments(context.Context, *QueryGasAdjustmentsRequest) (*QueryGasAdjustmentsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -191,6 +317,9 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) BlockGasTracking(ctx context.Context, req *QueryBlockGasTrackingRequest) (*QueryBlockGasTrackingResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGasTracking not implemented")
 }
+func (*UnimplementedQueryServer) GasAdjustments(ctx context.Context, req *QueryGasAdjustmentsRequest) (*QueryGasAdjustmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasAdjustments not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -214,6 +343,24 @@ func _Query_BlockGasTracking_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GasAdjustments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGasAdjustmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasAdjustments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/archway.tracking.v1beta1.Query/GasAdjustments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasAdjustments(ctx, req.(*QueryGasAdjustmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "archway.tracking.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -222,6 +369,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGasTracking",
 			Handler:    _Query_BlockGasTracking_Handler,
 		},
+		{
+			MethodName: "GasAdjustments",
+			Handler:    _Query_GasAdjustments_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "archway/tracking/v1beta1/query.proto",
@@ -283,6 +434,90 @@ func (m *QueryBlockGasTrackingResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryGasAdjustmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasAdjustmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasAdjustmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGasAdjustmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGasAdjustmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGasAdjustmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Adjustments) > 0 {
+		for iNdEx := len(m.Adjustments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Adjustments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -314,6 +549,38 @@ func (m *QueryBlockGasTrackingResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryGasAdjustmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGasAdjustmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Adjustments) > 0 {
+		for _, e := range m.Adjustments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -453,6 +720,212 @@ func (m *QueryBlockGasTrackingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryGasAdjustmentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasAdjustmentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasAdjustmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGasAdjustmentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGasAdjustmentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGasAdjustmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Adjustments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Adjustments = append(m.Adjustments, GasAdjustment{})
+			if err := m.Adjustments[len(m.Adjustments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -7,6 +7,9 @@ import (
 	"sigs.k8s.io/yaml"
 )
 
+// MaxGasAdjustmentMultiplier defines the maximum GasAdjustment multiplier value.
+var MaxGasAdjustmentMultiplier = sdk.NewDec(10)
+
 // HasGasUsage returns true if the transaction has contract operations.
 func (m TxInfo) HasGasUsage() bool {
 	return m.TotalGas > 0
@@ -18,9 +21,41 @@ func (m TxInfo) Validate() error {
 		return fmt.Errorf("id: must be GT 0")
 	}
 
+	if m.NonContractGas > m.TotalGas {
+		return fmt.Errorf("nonContractGas: must be LTE totalGas (%d)", m.TotalGas)
+	}
+
+	if m.FeePayer != "" {
+		if _, err := sdk.AccAddressFromBech32(m.FeePayer); err != nil {
+			return fmt.Errorf("feePayer: %s", err.Error())
+		}
+	}
+
+	if err := m.Fees.Validate(); err != nil {
+		return fmt.Errorf("fees: %s", err.Error())
+	}
+
+	if m.GasLimit > 0 && m.GasUsed > m.GasLimit {
+		return fmt.Errorf("gasUsed: must be LTE gasLimit (%d)", m.GasLimit)
+	}
+
 	return nil
 }
 
+// UnusedGas returns the transaction gas limit not consumed by the transaction (0 if gas is not tracked).
+func (m TxInfo) UnusedGas() uint64 {
+	if m.GasUsed >= m.GasLimit {
+		return 0
+	}
+
+	return m.GasLimit - m.GasUsed
+}
+
+// ContractGas returns the gas consumed by the transaction contract operations.
+func (m TxInfo) ContractGas() uint64 {
+	return m.TotalGas - m.NonContractGas
+}
+
 // String implements the fmt.Stringer interface.
 func (m TxInfo) String() string {
 	bz, _ := yaml.Marshal(m)
@@ -71,6 +106,55 @@ func (m ContractOperationInfo) String() string {
 	return string(bz)
 }
 
+// IsContractAdjustment returns true if the adjustment is set for a contract (not for a code ID).
+func (m GasAdjustment) IsContractAdjustment() bool {
+	return m.ContractAddress != ""
+}
+
+// MustGetContractAddress returns the contract address.
+// CONTRACT: panics on parsing error.
+func (m GasAdjustment) MustGetContractAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(m.ContractAddress)
+	if err != nil {
+		panic(fmt.Errorf("parsing contract address (%s): %w", m.ContractAddress, err))
+	}
+
+	return addr
+}
+
+// AdjustGas applies the multiplier to the gas value (the result is truncated).
+func (m GasAdjustment) AdjustGas(gas uint64) uint64 {
+	return m.Multiplier.MulInt(sdk.NewIntFromUint64(gas)).TruncateInt().Uint64()
+}
+
+// Validate performs object fields validation.
+func (m GasAdjustment) Validate() error {
+	if (m.ContractAddress == "") == (m.CodeId == 0) {
+		return fmt.Errorf("exactly one of contractAddress and codeId must be set")
+	}
+
+	if m.ContractAddress != "" {
+		if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+			return fmt.Errorf("contractAddress: %s", err.Error())
+		}
+	}
+
+	if m.Multiplier.IsNil() || !m.Multiplier.IsPositive() {
+		return fmt.Errorf("multiplier: must be GT 0")
+	}
+	if m.Multiplier.GT(MaxGasAdjustmentMultiplier) {
+		return fmt.Errorf("multiplier: must be LTE %s", MaxGasAdjustmentMultiplier)
+	}
+
+	return nil
+}
+
+// String implements the fmt.Stringer interface.
+func (m GasAdjustment) String() string {
+	bz, _ := yaml.Marshal(m)
+	return string(bz)
+}
+
 // String implements the fmt.Stringer interface.
 func (m BlockTracking) String() string {
 	bz, _ := yaml.Marshal(m)
@@ -6,6 +6,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -76,8 +78,19 @@ type TxInfo struct {
 	// height defines the block height of the transaction.
 	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
 	// total_gas defines total gas consumption by the transaction.
-	// It is the sum of gas consumed by all contract operations (VM + SDK gas).
+	// It is the sum of gas consumed by all contract operations (VM + SDK gas) and the non-contract gas.
 	TotalGas uint64 `protobuf:"varint,3,opt,name=total_gas,json=totalGas,proto3" json:"total_gas,omitempty"`
+	// non_contract_gas defines gas consumed by the transaction outside of contract operations (ante handler, non-WASM msgs, etc).
+	// It is the difference between the transaction gas used and the contract operations gas (0 if not tracked).
+	NonContractGas uint64 `protobuf:"varint,4,opt,name=non_contract_gas,json=nonContractGas,proto3" json:"non_contract_gas,omitempty"`
+	// fee_payer defines the account that paid the transaction fees (the fee granter if set, empty if not tracked).
+	FeePayer string `protobuf:"bytes,5,opt,name=fee_payer,json=feePayer,proto3" json:"fee_payer,omitempty"`
+	// fees defines the transaction fees paid.
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+	// gas_limit defines the transaction gas limit (0 if not tracked).
+	GasLimit uint64 `protobuf:"varint,7,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// gas_used defines the actual transaction gas consumption reported by the transaction gas meter (0 if not tracked).
+	GasUsed uint64 `protobuf:"varint,8,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
 }
 
 func (m *TxInfo) Reset()      { *m = TxInfo{} }
@@ -133,6 +146,41 @@ func (m *TxInfo) GetTotalGas() uint64 {
 	return 0
 }
 
+func (m *TxInfo) GetNonContractGas() uint64 {
+	if m != nil {
+		return m.NonContractGas
+	}
+	return 0
+}
+
+func (m *TxInfo) GetFeePayer() string {
+	if m != nil {
+		return m.FeePayer
+	}
+	return ""
+}
+
+func (m *TxInfo) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+func (m *TxInfo) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *TxInfo) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
 // ContractOperationInfo keeps a single contract operation gas consumption data.
 // Object is being created by the IngestGasRecord call from the wasmd.
 type ContractOperationInfo struct {
@@ -150,6 +198,10 @@ type ContractOperationInfo struct {
 	// sdk_gas is the gas consumption reported by the SDK gas meter and the WASM GasRegister (cost of Execute/Query/etc).
 	// Value is adjusted by this module (CalculateUpdatedGas func).
 	SdkGas uint64 `protobuf:"varint,6,opt,name=sdk_gas,json=sdkGas,proto3" json:"sdk_gas,omitempty"`
+	// original_vm_gas is the VM gas consumption before the GasAdjustment is applied (equals vm_gas if not adjusted).
+	OriginalVmGas uint64 `protobuf:"varint,7,opt,name=original_vm_gas,json=originalVmGas,proto3" json:"original_vm_gas,omitempty"`
+	// original_sdk_gas is the SDK gas consumption before the GasAdjustment is applied (equals sdk_gas if not adjusted).
+	OriginalSdkGas uint64 `protobuf:"varint,8,opt,name=original_sdk_gas,json=originalSdkGas,proto3" json:"original_sdk_gas,omitempty"`
 }
 
 func (m *ContractOperationInfo) Reset()      { *m = ContractOperationInfo{} }
@@ -226,6 +278,20 @@ func (m *ContractOperationInfo) GetSdkGas() uint64 {
 	return 0
 }
 
+func (m *ContractOperationInfo) GetOriginalVmGas() uint64 {
+	if m != nil {
+		return m.OriginalVmGas
+	}
+	return 0
+}
+
+func (m *ContractOperationInfo) GetOriginalSdkGas() uint64 {
+	if m != nil {
+		return m.OriginalSdkGas
+	}
+	return 0
+}
+
 // BlockTracking is the tracking information for a block.
 type BlockTracking struct {
 	// txs defines the list of transactions tracked in the block.
@@ -325,12 +391,70 @@ func (m *TxTracking) GetContractOperations() []ContractOperationInfo {
 	return nil
 }
 
+// GasAdjustment defines a governance managed gas consumption multiplier for a contract or for all contracts of a code ID.
+// Exactly one of contract_address and code_id must be set, a contract entry takes precedence over a code entry.
+type GasAdjustment struct {
+	// contract_address defines the contract address the multiplier is applied to (bech32 encoded).
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	// code_id defines the code ID the multiplier is applied to (all contracts instantiated from that code).
+	CodeId uint64 `protobuf:"varint,2,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty"`
+	// multiplier defines the contract operations gas consumption multiplier (VM and SDK gas).
+	Multiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=multiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"multiplier"`
+}
+
+func (m *GasAdjustment) Reset()      { *m = GasAdjustment{} }
+func (*GasAdjustment) ProtoMessage() {}
+func (*GasAdjustment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_792f9386dd247ede, []int{4}
+}
+func (m *GasAdjustment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasAdjustment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasAdjustment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasAdjustment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasAdjustment.Merge(m, src)
+}
+func (m *GasAdjustment) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasAdjustment) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasAdjustment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasAdjustment proto.InternalMessageInfo
+
+func (m *GasAdjustment) GetContractAddress() string {
+	if m != nil {
+		return m.ContractAddress
+	}
+	return ""
+}
+
+func (m *GasAdjustment) GetCodeId() uint64 {
+	if m != nil {
+		return m.CodeId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("archway.tracking.v1beta1.ContractOperation", ContractOperation_name, ContractOperation_value)
 	proto.RegisterType((*TxInfo)(nil), "archway.tracking.v1beta1.TxInfo")
 	proto.RegisterType((*ContractOperationInfo)(nil), "archway.tracking.v1beta1.ContractOperationInfo")
 	proto.RegisterType((*BlockTracking)(nil), "archway.tracking.v1beta1.BlockTracking")
 	proto.RegisterType((*TxTracking)(nil), "archway.tracking.v1beta1.TxTracking")
+	proto.RegisterType((*GasAdjustment)(nil), "archway.tracking.v1beta1.GasAdjustment")
 }
 
 func init() {
@@ -338,43 +462,58 @@ func init() {
 }
 
 var fileDescriptor_792f9386dd247ede = []byte{
-	// 566 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xc1, 0x4e, 0xdb, 0x40,
-	0x10, 0xb5, 0x1d, 0xc7, 0x94, 0x41, 0x50, 0x77, 0x29, 0x60, 0x01, 0x32, 0x16, 0x42, 0x6a, 0xda,
-	0xaa, 0xb1, 0x80, 0x1b, 0xea, 0x25, 0x31, 0x2e, 0xb2, 0x54, 0x12, 0xba, 0x71, 0xa4, 0xd2, 0x8b,
-	0x65, 0x6c, 0xe3, 0x58, 0x01, 0x6f, 0x64, 0x6f, 0xc1, 0xf9, 0x8b, 0x1e, 0x7a, 0xe8, 0xb1, 0x5f,
-	0xd1, 0x6f, 0xe0, 0xc8, 0xb1, 0xa7, 0xaa, 0x4a, 0xbe, 0xa0, 0x7f, 0x50, 0x65, 0xe3, 0x24, 0x55,
-	0x71, 0x2a, 0xf5, 0x96, 0x79, 0xf3, 0x66, 0xde, 0x9b, 0x17, 0x2f, 0x3c, 0x73, 0x13, 0xaf, 0x73,
-	0xeb, 0xf6, 0x75, 0x9a, 0xb8, 0x5e, 0x37, 0x8a, 0x43, 0xfd, 0x66, 0xff, 0x22, 0xa0, 0xee, 0xfe,
-	0x14, 0xa8, 0xf6, 0x12, 0x42, 0x09, 0x52, 0x72, 0x62, 0x75, 0x8a, 0xe7, 0xc4, 0xcd, 0xa7, 0x21,
-	0x09, 0x09, 0x23, 0xe9, 0xa3, 0x5f, 0x63, 0xfe, 0x6e, 0x0b, 0x24, 0x3b, 0xb3, 0xe2, 0x4b, 0x82,
-	0x56, 0x40, 0x88, 0x7c, 0x85, 0xd7, 0xf8, 0x8a, 0x88, 0x85, 0xc8, 0x47, 0xeb, 0x20, 0x75, 0x82,
-	0x28, 0xec, 0x50, 0x45, 0xd0, 0xf8, 0x4a, 0x09, 0xe7, 0x15, 0xda, 0x82, 0x45, 0x4a, 0xa8, 0x7b,
-	0xe5, 0x84, 0x6e, 0xaa, 0x94, 0x18, 0xfd, 0x11, 0x03, 0x4e, 0xdc, 0xf4, 0x48, 0xfc, 0xf2, 0x75,
-	0x87, 0xdb, 0xfd, 0xc5, 0xc3, 0x9a, 0x41, 0xe2, 0x91, 0x05, 0xda, 0xec, 0x05, 0x89, 0x4b, 0x23,
-	0x12, 0x17, 0x8a, 0xac, 0x42, 0x99, 0x66, 0x4e, 0xe4, 0x33, 0x0d, 0x11, 0x8b, 0x34, 0xb3, 0x7c,
-	0xf4, 0x1c, 0x64, 0x2f, 0x9f, 0x76, 0x5c, 0xdf, 0x4f, 0x82, 0x74, 0x2c, 0xb4, 0x88, 0x1f, 0x4f,
-	0xf0, 0xda, 0x18, 0x46, 0x18, 0x56, 0xc8, 0x44, 0xc0, 0xa1, 0xfd, 0x5e, 0xa0, 0x88, 0x1a, 0x5f,
-	0x59, 0x39, 0x78, 0x59, 0x9d, 0x97, 0x43, 0xf5, 0x81, 0x31, 0xbc, 0x3c, 0x5d, 0x61, 0xf7, 0x7b,
-	0x01, 0x5a, 0x03, 0xe9, 0xe6, 0x9a, 0x5d, 0x57, 0x66, 0xa6, 0xca, 0x37, 0xd7, 0x27, 0x6e, 0x8a,
-	0x36, 0x60, 0x21, 0xf5, 0xbb, 0x0c, 0x97, 0x18, 0x2e, 0xa5, 0x7e, 0x77, 0x76, 0x73, 0x0b, 0x96,
-	0xeb, 0x57, 0xc4, 0xeb, 0xda, 0xb9, 0x1e, 0x7a, 0x0d, 0x25, 0x9a, 0xa5, 0x0a, 0xaf, 0x95, 0x2a,
-	0x4b, 0x07, 0x7b, 0xf3, 0xfd, 0xd8, 0xd9, 0x64, 0xa4, 0x2e, 0xde, 0xfd, 0xd8, 0xe1, 0xf0, 0x68,
-	0x2c, 0x5f, 0xfa, 0x8d, 0x07, 0x98, 0xf5, 0xd1, 0x11, 0x88, 0x51, 0x7c, 0x49, 0x58, 0x7e, 0x4b,
-	0x07, 0xda, 0xbf, 0x76, 0x8e, 0xd2, 0xce, 0xf7, 0xb1, 0x19, 0x74, 0x09, 0xab, 0xd3, 0x50, 0xa7,
-	0xf7, 0xa6, 0x8a, 0xc0, 0xec, 0xe9, 0xff, 0x11, 0xd7, 0x1f, 0x9b, 0x91, 0xf7, 0x77, 0x33, 0x37,
-	0xfe, 0xe2, 0xb3, 0x00, 0x4f, 0x1e, 0x4c, 0xa2, 0x5d, 0x50, 0x8d, 0x66, 0xc3, 0xc6, 0x35, 0xc3,
-	0x76, 0x9a, 0x67, 0x26, 0xae, 0xd9, 0x56, 0xb3, 0xe1, 0xb4, 0x1b, 0xad, 0x33, 0xd3, 0xb0, 0xde,
-	0x58, 0xe6, 0xb1, 0xcc, 0xa1, 0x3d, 0xd0, 0x0a, 0x38, 0x56, 0xa3, 0x65, 0xd7, 0x1a, 0xb6, 0xc5,
-	0x2a, 0x99, 0x47, 0x1a, 0x6c, 0x17, 0xb0, 0xcc, 0xf7, 0xa6, 0xd1, 0x66, 0x0c, 0x01, 0x6d, 0x83,
-	0x52, 0xc0, 0x78, 0xd7, 0x36, 0xf1, 0xb9, 0x5c, 0x42, 0x2a, 0x6c, 0x16, 0x74, 0x4f, 0xad, 0x13,
-	0x5c, 0xb3, 0x4d, 0x59, 0x44, 0x9b, 0xb0, 0x5e, 0xe4, 0xa2, 0x6e, 0xc8, 0x65, 0xb4, 0x05, 0x1b,
-	0x05, 0xbd, 0x56, 0xfb, 0xb8, 0x29, 0x4b, 0x73, 0x64, 0xb1, 0x79, 0xf6, 0xf6, 0x5c, 0x5e, 0xa8,
-	0x9f, 0xde, 0x0d, 0x54, 0xfe, 0x7e, 0xa0, 0xf2, 0x3f, 0x07, 0x2a, 0xff, 0x69, 0xa8, 0x72, 0xf7,
-	0x43, 0x95, 0xfb, 0x3e, 0x54, 0xb9, 0x0f, 0x87, 0x61, 0x44, 0x3b, 0x1f, 0x2f, 0xaa, 0x1e, 0xb9,
-	0xd6, 0xf3, 0xff, 0xe2, 0x55, 0x1c, 0xd0, 0x5b, 0x92, 0x74, 0x27, 0xb5, 0x9e, 0xcd, 0x5e, 0xff,
-	0xe8, 0x53, 0x4f, 0x2f, 0x24, 0xf6, 0x86, 0x0f, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x15, 0xde,
-	0x56, 0xbd, 0x1e, 0x04, 0x00, 0x00,
+	// 805 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x6f, 0xdb, 0x46,
+	0x10, 0x15, 0x25, 0x8a, 0xb2, 0x27, 0xb0, 0xc2, 0x6e, 0x9a, 0x98, 0xb1, 0x03, 0x5a, 0x30, 0x82,
+	0x54, 0x6d, 0x11, 0xaa, 0x71, 0x6e, 0x41, 0x2f, 0x92, 0xac, 0x1a, 0x04, 0x12, 0xc9, 0xa5, 0xa8,
+	0xa2, 0xe9, 0x85, 0xa0, 0xc9, 0x15, 0xbd, 0x95, 0xc4, 0x15, 0xb8, 0x6b, 0x47, 0xfa, 0x17, 0x3d,
+	0xf4, 0xd0, 0x63, 0xaf, 0xed, 0xb5, 0x40, 0xff, 0x40, 0x2f, 0x39, 0xe6, 0x58, 0xf4, 0x90, 0x16,
+	0xf6, 0x1f, 0x29, 0x76, 0xf9, 0x61, 0xa3, 0xa6, 0x8b, 0xf6, 0x24, 0xce, 0xcc, 0x9b, 0xc7, 0x79,
+	0x6f, 0x46, 0x84, 0x8f, 0xfc, 0x24, 0x38, 0x7d, 0xe3, 0xaf, 0x3b, 0x3c, 0xf1, 0x83, 0x19, 0x89,
+	0xa3, 0xce, 0xf9, 0xb3, 0x13, 0xcc, 0xfd, 0x67, 0x45, 0xc2, 0x5a, 0x26, 0x94, 0x53, 0x64, 0x64,
+	0x40, 0xab, 0xc8, 0x67, 0xc0, 0x9d, 0x0f, 0x23, 0x1a, 0x51, 0x09, 0xea, 0x88, 0xa7, 0x14, 0xbf,
+	0x63, 0x06, 0x94, 0x2d, 0x28, 0xeb, 0x9c, 0xf8, 0x0c, 0x17, 0x9c, 0x01, 0x25, 0x71, 0x5a, 0xdf,
+	0xff, 0xa5, 0x0a, 0x9a, 0xbb, 0xb2, 0xe3, 0x29, 0x45, 0x4d, 0xa8, 0x92, 0xd0, 0x50, 0x5a, 0x4a,
+	0x5b, 0x75, 0xaa, 0x24, 0x44, 0x0f, 0x40, 0x3b, 0xc5, 0x24, 0x3a, 0xe5, 0x46, 0xb5, 0xa5, 0xb4,
+	0x6b, 0x4e, 0x16, 0xa1, 0x5d, 0xd8, 0xe4, 0x94, 0xfb, 0x73, 0x2f, 0xf2, 0x99, 0x51, 0x93, 0xf0,
+	0x0d, 0x99, 0x38, 0xf2, 0x19, 0x6a, 0x83, 0x1e, 0xd3, 0xd8, 0x0b, 0x68, 0x2c, 0x06, 0xe4, 0x12,
+	0xa3, 0x4a, 0x4c, 0x33, 0xa6, 0x71, 0x3f, 0x4b, 0x0b, 0xe4, 0x2e, 0x6c, 0x4e, 0x31, 0xf6, 0x96,
+	0xfe, 0x1a, 0x27, 0x46, 0xbd, 0xa5, 0xb4, 0x37, 0x9d, 0x8d, 0x29, 0xc6, 0xc7, 0x22, 0x46, 0x1e,
+	0xa8, 0x53, 0x8c, 0x99, 0xa1, 0xb5, 0x6a, 0xed, 0x3b, 0x07, 0x0f, 0xad, 0x54, 0x85, 0x25, 0x54,
+	0xe4, 0x82, 0xad, 0x3e, 0x25, 0x71, 0xef, 0xb3, 0xb7, 0xef, 0xf7, 0x2a, 0x3f, 0xff, 0xb9, 0xd7,
+	0x8e, 0x08, 0x3f, 0x3d, 0x3b, 0xb1, 0x02, 0xba, 0xe8, 0x64, 0x92, 0xd3, 0x9f, 0xa7, 0x2c, 0x9c,
+	0x75, 0xf8, 0x7a, 0x89, 0x99, 0x6c, 0x60, 0x8e, 0x24, 0x16, 0x6f, 0x8f, 0x7c, 0xe6, 0xcd, 0xc9,
+	0x82, 0x70, 0xa3, 0x91, 0x8a, 0x88, 0x7c, 0xf6, 0x52, 0xc4, 0xe8, 0x21, 0x88, 0x67, 0xef, 0x8c,
+	0xe1, 0xd0, 0xd8, 0x90, 0xb5, 0x46, 0xe4, 0xb3, 0x09, 0xc3, 0xe1, 0x0b, 0xf5, 0x87, 0x1f, 0xf7,
+	0x2a, 0xfb, 0xbf, 0x55, 0xe1, 0x7e, 0xae, 0x65, 0xb4, 0xc4, 0x89, 0xcf, 0x09, 0x8d, 0x4b, 0x4d,
+	0xbc, 0x07, 0x75, 0xbe, 0xf2, 0x48, 0x28, 0x3d, 0x54, 0x1d, 0x95, 0xaf, 0xec, 0x10, 0x7d, 0x0c,
+	0x7a, 0x61, 0x90, 0x1f, 0x86, 0x09, 0x66, 0xa9, 0x91, 0x9b, 0xce, 0xdd, 0x3c, 0xdf, 0x4d, 0xd3,
+	0xc8, 0x81, 0x26, 0xcd, 0x5f, 0xe0, 0x09, 0x19, 0xd2, 0xcd, 0xe6, 0xc1, 0xa7, 0xd6, 0x6d, 0x87,
+	0x60, 0xdd, 0x18, 0xcc, 0xd9, 0x2a, 0x28, 0xdc, 0xf5, 0x12, 0xa3, 0xfb, 0xa0, 0x9d, 0x2f, 0xe4,
+	0x66, 0xea, 0x72, 0xa8, 0xfa, 0xf9, 0x42, 0x2c, 0x64, 0x1b, 0x1a, 0x2c, 0x9c, 0xc9, 0xbc, 0x26,
+	0xf3, 0x1a, 0x0b, 0x67, 0xa2, 0xf0, 0x04, 0xee, 0xd2, 0x84, 0x44, 0x24, 0xf6, 0xe7, 0x5e, 0xd6,
+	0x98, 0x3a, 0xb6, 0x95, 0xa7, 0xbf, 0x5a, 0x64, 0xbb, 0x2f, 0x70, 0x39, 0x53, 0x6a, 0x5f, 0x33,
+	0xcf, 0x8f, 0x25, 0x63, 0xe6, 0xe2, 0x18, 0xb6, 0x7a, 0x73, 0x1a, 0xcc, 0xdc, 0x4c, 0x01, 0xfa,
+	0x1c, 0x6a, 0x7c, 0xc5, 0x0c, 0x45, 0x2e, 0xfd, 0xf1, 0xed, 0x0a, 0xdd, 0x55, 0xde, 0xd2, 0x53,
+	0xc5, 0xfe, 0x1d, 0xd1, 0x96, 0x91, 0xfe, 0xaa, 0x00, 0x5c, 0xd5, 0xd1, 0x0b, 0x50, 0x49, 0x3c,
+	0xa5, 0x72, 0x23, 0x77, 0x0e, 0x5a, 0xff, 0xc6, 0x29, 0xf6, 0x97, 0xf1, 0xc9, 0x1e, 0x34, 0x85,
+	0x7b, 0xc5, 0x9a, 0x0a, 0x07, 0x99, 0x51, 0x95, 0xe3, 0x75, 0xfe, 0xc7, 0x02, 0xae, 0x31, 0xa3,
+	0xe0, 0x9f, 0xc5, 0x7c, 0xf0, 0x9f, 0x14, 0xd8, 0x3a, 0xf2, 0x59, 0x37, 0xfc, 0xf6, 0x8c, 0xf1,
+	0x05, 0x8e, 0x79, 0xe9, 0x99, 0x28, 0xe5, 0x67, 0xb2, 0x0d, 0x8d, 0x80, 0x86, 0xf8, 0xea, 0xd0,
+	0x34, 0x11, 0xda, 0x21, 0x1a, 0x02, 0x2c, 0xce, 0xe6, 0x9c, 0x2c, 0xe7, 0x04, 0x27, 0xe9, 0x91,
+	0xf5, 0x2c, 0x31, 0xc9, 0x1f, 0xef, 0xf7, 0x9e, 0xfc, 0x87, 0xff, 0xcc, 0x21, 0x0e, 0x9c, 0x6b,
+	0x0c, 0xe9, 0xac, 0x9f, 0x7c, 0x5f, 0x85, 0x0f, 0x6e, 0xa8, 0x44, 0xfb, 0x60, 0xf6, 0x47, 0x43,
+	0xd7, 0xe9, 0xf6, 0x5d, 0x6f, 0x74, 0x3c, 0x70, 0xba, 0xae, 0x3d, 0x1a, 0x7a, 0x93, 0xe1, 0xf8,
+	0x78, 0xd0, 0xb7, 0xbf, 0xb0, 0x07, 0x87, 0x7a, 0x05, 0x3d, 0x86, 0x56, 0x09, 0xc6, 0x1e, 0x8e,
+	0xdd, 0xee, 0xd0, 0xb5, 0x65, 0xa4, 0x2b, 0xa8, 0x05, 0x8f, 0x4a, 0x50, 0x83, 0xaf, 0x07, 0xfd,
+	0x89, 0x44, 0x54, 0xd1, 0x23, 0x30, 0x4a, 0x10, 0x5f, 0x4e, 0x06, 0xce, 0x6b, 0xbd, 0x86, 0x4c,
+	0xd8, 0x29, 0xa9, 0xbe, 0xb2, 0x8f, 0x9c, 0xae, 0x3b, 0xd0, 0x55, 0xb4, 0x03, 0x0f, 0xca, 0xa6,
+	0xe8, 0xf5, 0xf5, 0x3a, 0xda, 0x85, 0xed, 0x92, 0xda, 0x78, 0x72, 0x38, 0xd2, 0xb5, 0x5b, 0x5e,
+	0xeb, 0x0c, 0x8e, 0x5f, 0xbe, 0xd6, 0x1b, 0xbd, 0x57, 0x6f, 0x2f, 0x4c, 0xe5, 0xdd, 0x85, 0xa9,
+	0xfc, 0x75, 0x61, 0x2a, 0xdf, 0x5d, 0x9a, 0x95, 0x77, 0x97, 0x66, 0xe5, 0xf7, 0x4b, 0xb3, 0xf2,
+	0xcd, 0xf3, 0x6b, 0x56, 0x67, 0x77, 0xf3, 0x34, 0xc6, 0xfc, 0x0d, 0x4d, 0x66, 0x79, 0xdc, 0x59,
+	0x5d, 0x7d, 0xfc, 0xa5, 0xf7, 0x27, 0x9a, 0xfc, 0x44, 0x3f, 0xff, 0x7b, 0x00, 0x3e, 0xd2, 0x1f,
+	0x6b, 0x1d, 0x06, 0x00, 0x00,
 }
 
 func (m *TxInfo) Marshal() (dAtA []byte, err error) {
@@ -397,6 +536,42 @@ func (m *TxInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintTracking(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintTracking(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTracking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.FeePayer) > 0 {
+		i -= len(m.FeePayer)
+		copy(dAtA[i:], m.FeePayer)
+		i = encodeVarintTracking(dAtA, i, uint64(len(m.FeePayer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.NonContractGas != 0 {
+		i = encodeVarintTracking(dAtA, i, uint64(m.NonContractGas))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.TotalGas != 0 {
 		i = encodeVarintTracking(dAtA, i, uint64(m.TotalGas))
 		i--
@@ -435,6 +610,16 @@ func (m *ContractOperationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.OriginalSdkGas != 0 {
+		i = encodeVarintTracking(dAtA, i, uint64(m.OriginalSdkGas))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.OriginalVmGas != 0 {
+		i = encodeVarintTracking(dAtA, i, uint64(m.OriginalVmGas))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.SdkGas != 0 {
 		i = encodeVarintTracking(dAtA, i, uint64(m.SdkGas))
 		i--
@@ -554,6 +739,51 @@ func (m *TxTracking) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GasAdjustment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasAdjustment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasAdjustment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTracking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.CodeId != 0 {
+		i = encodeVarintTracking(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintTracking(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTracking(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTracking(v)
 	base := offset
@@ -580,6 +810,25 @@ func (m *TxInfo) Size() (n int) {
 	if m.TotalGas != 0 {
 		n += 1 + sovTracking(uint64(m.TotalGas))
 	}
+	if m.NonContractGas != 0 {
+		n += 1 + sovTracking(uint64(m.NonContractGas))
+	}
+	l = len(m.FeePayer)
+	if l > 0 {
+		n += 1 + l + sovTracking(uint64(l))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovTracking(uint64(l))
+		}
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTracking(uint64(m.GasLimit))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovTracking(uint64(m.GasUsed))
+	}
 	return n
 }
 
@@ -608,6 +857,12 @@ func (m *ContractOperationInfo) Size() (n int) {
 	if m.SdkGas != 0 {
 		n += 1 + sovTracking(uint64(m.SdkGas))
 	}
+	if m.OriginalVmGas != 0 {
+		n += 1 + sovTracking(uint64(m.OriginalVmGas))
+	}
+	if m.OriginalSdkGas != 0 {
+		n += 1 + sovTracking(uint64(m.OriginalSdkGas))
+	}
 	return n
 }
 
@@ -643,6 +898,24 @@ func (m *TxTracking) Size() (n int) {
 	return n
 }
 
+func (m *GasAdjustment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovTracking(uint64(l))
+	}
+	if m.CodeId != 0 {
+		n += 1 + sovTracking(uint64(m.CodeId))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovTracking(uint64(l))
+	return n
+}
+
 func sovTracking(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -735,6 +1008,129 @@ func (m *TxInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NonContractGas", wireType)
+			}
+			m.NonContractGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTracking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NonContractGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeePayer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTracking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTracking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTracking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeePayer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTracking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTracking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTracking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTracking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTracking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTracking(dAtA[iNdEx:])
@@ -912,6 +1308,44 @@ func (m *ContractOperationInfo) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalVmGas", wireType)
+			}
+			m.OriginalVmGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTracking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginalVmGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalSdkGas", wireType)
+			}
+			m.OriginalSdkGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTracking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OriginalSdkGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTracking(dAtA[iNdEx:])
@@ -1134,6 +1568,141 @@ func (m *TxTracking) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GasAdjustment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTracking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasAdjustment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasAdjustment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTracking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTracking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTracking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			m.CodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTracking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTracking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTracking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTracking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTracking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTracking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTracking(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0